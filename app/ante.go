@@ -9,6 +9,11 @@ import (
 	corestoretypes "cosmossdk.io/core/store"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	contractsponsorante "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/ante"
+	feeabsante "github.com/hippocrat-dao/hippo-protocol/x/feeabs/ante"
+	sponsorante "github.com/hippocrat-dao/hippo-protocol/x/sponsor/ante"
+	valpolicyante "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/ante"
 )
 
 // setAnteHandler Reference github.com/cosmos/cosmos-sdk/x/auth/ante/ante.go
@@ -24,10 +29,16 @@ func (app *App) setAnteHandler(txConfig client.TxConfig, nodeConfig wasmtypes.No
 			// If circuit breaker functionality is needed in the future, the circuit module should be added first.
 			ante.NewExtensionOptionsDecorator(nil),
 			ante.NewValidateBasicDecorator(),
+			valpolicyante.NewValidatorPolicyDecorator(app.ValPolicyKeeper), // rejects validator creations and edits that violate the validator policy
 			ante.NewTxTimeoutHeightDecorator(),
 			ante.NewValidateMemoDecorator(app.AccountKeeper),
 			ante.NewConsumeGasForTxSizeDecorator(app.AccountKeeper),
-			ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, nil),
+			sponsorante.NewAutoGrantDecorator(app.SponsorKeeper), // grants sponsored allowances to new accounts before their fees are deducted
+			// contracts that opted in pay the fees of txs that only call them, fees in approved ibc denoms are
+			// converted at their twap, everything else is deducted as usual
+			contractsponsorante.NewDeductFeeDecorator(app.ContractSponsorKeeper,
+				feeabsante.NewDeductFeeDecorator(app.FeeAbsKeeper, app.AccountKeeper, app.FeeGrantKeeper,
+					ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, nil))),
 			ante.NewSetPubKeyDecorator(app.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 			ante.NewValidateSigCountDecorator(app.AccountKeeper),
 			ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer),
@@ -32,9 +32,40 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/hippocrat-dao/hippo-protocol/app/keepers"
 	"github.com/hippocrat-dao/hippo-protocol/app/upgrades"
-	v_1_0_1 "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v1_0_1"
-	v_1_0_2 "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v1_0_2"
-	v_2_0_0 "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v2_0_0"
+	_ "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v1_0_1"
+	_ "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v1_0_2"
+	_ "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v2_0_0"
+	_ "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v3_0_0"
+	"github.com/hippocrat-dao/hippo-protocol/x/audit"
+	audittypes "github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax"
+	communitytaxtypes "github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow"
+	escrowtypes "github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs"
+	feeabstypes "github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeburn"
+	feeburntypes "github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare"
+	keysharetypes "github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake"
+	liquidstaketypes "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle"
+	oracleabci "github.com/hippocrat-dao/hippo-protocol/x/oracle/abci"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/schema"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor"
+	sponsortypes "github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury"
+	treasurytypes "github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/zk"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+	"github.com/spf13/cast"
 
 	"cosmossdk.io/x/evidence"
 	evidencetypes "cosmossdk.io/x/evidence/types"
@@ -116,21 +147,28 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		nft.ModuleName:                 nil,
-		wasmtypes.ModuleName:           {authtypes.Burner},
+		authtypes.FeeCollectorName:      nil,
+		distrtypes.ModuleName:           nil,
+		minttypes.ModuleName:            {authtypes.Minter},
+		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:             {authtypes.Burner},
+		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		nft.ModuleName:                  nil,
+		wasmtypes.ModuleName:            {authtypes.Burner},
+		escrowtypes.ModuleName:          nil,
+		contractsponsortypes.ModuleName: nil,
+		feeabstypes.ModuleName:          nil,
+		liquidstaketypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		feeburntypes.ModuleName:         {authtypes.Burner},
 	}
 
 	_ runtime.AppI            = (*App)(nil)
 	_ servertypes.Application = (*App)(nil)
 
-	Upgrades = []upgrades.Upgrade{v_1_0_1.Upgrade, v_1_0_2.Upgrade, v_2_0_0.Upgrade}
+	// Upgrades are the upgrades registered by the packages in app/upgrades,
+	// ordered by version.
+	Upgrades = upgrades.Registered()
 )
 
 // App extends an ABCI application, but with most of its parameters exported.
@@ -154,6 +192,9 @@ type App struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// oracleProposalHandler injects and aggregates the price vote extensions
+	oracleProposalHandler *oracleabci.ProposalHandler
 }
 
 func init() {
@@ -267,7 +308,7 @@ func New(
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, CustomInflationCalculationFn, app.GetSubspace(minttypes.ModuleName)),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName), app.interfaceRegistry),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
-		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
+		valpolicy.NewStakingAppModule(staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)), app.ValPolicyKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper, app.AccountKeeper.AddressCodec()),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -281,6 +322,20 @@ func New(
 		// GetSubspace is used to retrieve legacy params subspace for wasm module.
 		// This is required for backward compatibility with older versions that used params module for configuration.
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
+		escrow.NewAppModule(appCodec, app.EscrowKeeper, app.AccountKeeper),
+		audit.NewAppModule(appCodec, app.AuditKeeper, app.AccountKeeper),
+		zk.NewAppModule(appCodec, app.ZKKeeper),
+		keyshare.NewAppModule(appCodec, app.KeyshareKeeper, app.AccountKeeper),
+		schema.NewAppModule(appCodec, app.SchemaKeeper, app.AccountKeeper),
+		sponsor.NewAppModule(appCodec, app.SponsorKeeper, app.AccountKeeper),
+		contractsponsor.NewAppModule(appCodec, app.ContractSponsorKeeper, app.AccountKeeper),
+		feeabs.NewAppModule(appCodec, app.FeeAbsKeeper),
+		oracle.NewAppModule(appCodec, app.OracleKeeper, app.StakingKeeper),
+		liquidstake.NewAppModule(appCodec, app.LiquidStakeKeeper, app.AccountKeeper, app.StakingKeeper),
+		valpolicy.NewAppModule(appCodec, app.ValPolicyKeeper),
+		treasury.NewAppModule(appCodec, app.TreasuryKeeper, app.AccountKeeper),
+		communitytax.NewAppModule(appCodec, app.CommunityTaxKeeper),
+		feeburn.NewAppModule(appCodec, app.FeeBurnKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -309,9 +364,11 @@ func New(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
+	// NOTE: feeburn must come before mint so that only fees are burned, not the block provision.
+	// NOTE: communitytax must come before distr so that a new year's community tax applies to its first block.
 	app.ModuleManager.SetOrderBeginBlockers(
 		capabilitytypes.ModuleName,
-		minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
+		feeburntypes.ModuleName, minttypes.ModuleName, communitytaxtypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, genutiltypes.ModuleName,
 		authz.ModuleName, feegrant.ModuleName, group.ModuleName,
@@ -330,6 +387,12 @@ func New(
 		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, consensusparamtypes.ModuleName,
 		ibcexported.ModuleName, ibctransfertypes.ModuleName,
 		wasmtypes.ModuleName,
+		escrowtypes.ModuleName,
+		audittypes.ModuleName,
+		sponsortypes.ModuleName,
+		feeabstypes.ModuleName,
+		oracletypes.ModuleName,
+		treasurytypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -338,18 +401,32 @@ func New(
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// NOTE: valpolicy must occur before genutil so that the policy is set when gentxs pass the ante handler.
 	// NOTE: wasm module should be at the end as it can call other module functionality direct or via message dispatching during
 	// genesis phase. For example bank transfer, auth account check, staking, ...
 	genesisModuleOrder := []string{
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName,
 		distrtypes.ModuleName, stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
-		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
+		minttypes.ModuleName, valpolicytypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, group.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, consensusparamtypes.ModuleName,
 		ibcexported.ModuleName, ibctransfertypes.ModuleName,
 		// wasm after ibc transfer
 		wasmtypes.ModuleName,
+		escrowtypes.ModuleName,
+		audittypes.ModuleName,
+		zktypes.ModuleName,
+		keysharetypes.ModuleName,
+		schematypes.ModuleName,
+		sponsortypes.ModuleName,
+		contractsponsortypes.ModuleName,
+		feeabstypes.ModuleName,
+		oracletypes.ModuleName,
+		liquidstaketypes.ModuleName,
+		treasurytypes.ModuleName,
+		communitytaxtypes.ModuleName,
+		feeburntypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -401,6 +478,7 @@ func New(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.setAnteHandler(txConfig, wasmConfig, runtime.NewKVStoreService(app.GetKVStoreKey()[wasmtypes.StoreKey]))
+	app.setProposalHandlers(appOpts)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -429,6 +507,9 @@ func New(
 	// Make sure it's called after `app.ModuleManager` and `app.configurator` are set.
 	app.setupUpgradeStoreLoaders()
 	app.setupUpgradeHandlers()
+	if cast.ToBool(appOpts.Get(server.KeyIsTestnet)) {
+		app.SetStoreLoader(testnetStoreLoader(db, app.GetKVStoreKey()))
+	}
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -465,8 +546,15 @@ func (app *App) setPostHandler() {
 func (app *App) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.oracleProposalHandler.PreBlocker(ctx, req); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // BeginBlocker application updates every begin block
@@ -620,7 +708,7 @@ func (app *App) setupUpgradeHandlers() {
 	for _, u := range Upgrades {
 		app.UpgradeKeeper.SetUpgradeHandler(
 			u.UpgradeName,
-			u.CreateUpgradeHandler(app.ModuleManager, app.configurator, &app.AppKeepersWithKey),
+			u.Handler(app.ModuleManager, app.configurator, &app.AppKeepersWithKey),
 		)
 	}
 }
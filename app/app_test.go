@@ -1,6 +1,8 @@
 package app
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -63,6 +65,19 @@ func TestUpgradeStoreLoaders(t *testing.T) {
 
 }
 
+// TestUpgradesRegistered makes sure every upgrade package is imported by the
+// app, so that TestUpgrades checks its upgrade.
+func TestUpgradesRegistered(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("upgrades", "v*_*_*"))
+	assert.NoError(t, err)
+	assert.Len(t, Upgrades, len(dirs), "every upgrade package should be registered")
+	for _, dir := range dirs {
+		name := strings.ReplaceAll(filepath.Base(dir), "_", ".")
+		_, ok := UpgradeByName(name)
+		assert.True(t, ok, "upgrade %s should be registered, import %s in app.go", name, dir)
+	}
+}
+
 func TestBlockedAddresses(t *testing.T) {
 	blockedAddresses := BlockedAddresses()
 	assert.NotNil(t, blockedAddresses, "BlockedAddrs should not return nil")
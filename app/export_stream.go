@@ -0,0 +1,323 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/genesis"
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+)
+
+// DefaultWasmChunkSize is the number of contract state entries StreamGenesis
+// holds in memory at once by default.
+const DefaultWasmChunkSize = 1000
+
+// StreamGenesisOptions configures StreamGenesis.
+type StreamGenesisOptions struct {
+	ForZeroHeight    bool
+	JailAllowedAddrs []string
+	// ModulesToExport limits the app state to the given modules, all modules
+	// are exported when empty.
+	ModulesToExport []string
+	// WasmChunkSize is the number of contract state entries read and written
+	// at once, DefaultWasmChunkSize when not positive.
+	WasmChunkSize int
+	// Progress is called after each module is written, when set.
+	Progress func(ExportProgress)
+}
+
+// ExportProgress reports a module StreamGenesis has written.
+type ExportProgress struct {
+	Module string
+	// Done is the number of modules written so far, out of Total.
+	Done, Total int
+	// Written is the number of bytes written so far.
+	Written int64
+}
+
+// StreamGenesis writes the genesis of the latest state to w. The app state is
+// exported and written one module at a time and the wasm contract state in
+// chunks of opts.WasmChunkSize entries, so unlike
+// ExportAppStateAndValidators the whole state is never held in memory.
+// The other fields of the genesis are taken from appGenesis.
+func (app *App) StreamGenesis(w io.Writer, appGenesis *genutiltypes.AppGenesis, opts StreamGenesisOptions) error {
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	// We export at last height + 1, because that's the height at which
+	// CometBFT will start InitChain.
+	height := app.LastBlockHeight() + 1
+	if opts.ForZeroHeight {
+		height = 0
+		if err := app.prepForZeroHeightGenesis(ctx, opts.JailAllowedAddrs); err != nil {
+			return fmt.Errorf("failed to prepare the state for height zero: %w", err)
+		}
+	}
+
+	modules, err := app.genesisModules(opts.ModulesToExport)
+	if err != nil {
+		return err
+	}
+	if opts.WasmChunkSize <= 0 {
+		opts.WasmChunkSize = DefaultWasmChunkSize
+	}
+
+	header := *appGenesis
+	header.InitialHeight = height
+	header.AppState = nil
+	header.Consensus = nil
+	headerBz, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewWriter(w)
+	out := &countingWriter{w: buf}
+	// the header is a JSON object, reopen it to append the app state
+	out.Write(headerBz[:len(headerBz)-1])
+	out.WriteString(`,"app_state":{`)
+	for i, name := range modules {
+		if i > 0 {
+			out.WriteString(",")
+		}
+		out.WriteJSON(name)
+		out.WriteString(":")
+
+		if name == wasmtypes.ModuleName {
+			err = app.streamWasmGenesis(ctx, out, opts.WasmChunkSize)
+		} else {
+			err = app.writeModuleGenesis(ctx, out, name)
+		}
+		if err == nil {
+			err = out.err
+		}
+		if err != nil {
+			return fmt.Errorf("failed to export %s genesis: %w", name, err)
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(ExportProgress{Module: name, Done: i + 1, Total: len(modules), Written: out.n})
+		}
+	}
+	out.WriteString("}")
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	if err != nil {
+		return fmt.Errorf("failed to export validators: %w", err)
+	}
+	consensusParams := app.BaseApp.GetConsensusParams(ctx)
+	if consensusParams.Block == nil || consensusParams.Evidence == nil || consensusParams.Validator == nil {
+		return fmt.Errorf("consensus params are missing from the state")
+	}
+	out.WriteString(`,"consensus":`)
+	out.WriteJSON(genutiltypes.NewConsensusGenesis(consensusParams, validators))
+	out.WriteString("}")
+	if out.err != nil {
+		return out.err
+	}
+
+	return buf.Flush()
+}
+
+// genesisModules returns the modules of names having a genesis, in export
+// order.
+func (app *App) genesisModules(names []string) ([]string, error) {
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := app.ModuleManager.Modules[name]; !ok {
+			return nil, fmt.Errorf("unknown module %s", name)
+		}
+		selected[name] = true
+	}
+
+	var modules []string
+	for _, name := range app.ModuleManager.OrderExportGenesis {
+		if len(selected) > 0 && !selected[name] {
+			continue
+		}
+		switch app.ModuleManager.Modules[name].(type) {
+		case appmodule.HasGenesis, module.HasGenesis, module.HasABCIGenesis:
+			modules = append(modules, name)
+		}
+	}
+	return modules, nil
+}
+
+// writeModuleGenesis writes the genesis of a module to out, returning the
+// panics of its ExportGenesis as errors.
+func (app *App) writeModuleGenesis(ctx sdk.Context, out *countingWriter, name string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	var bz json.RawMessage
+	switch mod := app.ModuleManager.Modules[name].(type) {
+	case appmodule.HasGenesis:
+		target := genesis.RawJSONTarget{}
+		if err := mod.ExportGenesis(ctx, target.Target()); err != nil {
+			return err
+		}
+		if bz, err = target.JSON(); err != nil {
+			return err
+		}
+	case module.HasGenesis:
+		bz = mod.ExportGenesis(ctx, app.appCodec)
+	case module.HasABCIGenesis:
+		bz = mod.ExportGenesis(ctx, app.appCodec)
+	}
+
+	_, err = out.Write(bz)
+	return err
+}
+
+// streamWasmGenesis writes the wasm genesis to out in the JSON of
+// wasmtypes.GenesisState, reading the state of each contract in chunks of
+// chunkSize entries.
+func (app *App) streamWasmGenesis(ctx sdk.Context, out *countingWriter, chunkSize int) error {
+	k := &app.WasmKeeper
+	params := k.GetParams(ctx)
+
+	out.WriteString(`{"params":`)
+	out.WriteProto(app.appCodec, &params)
+
+	var iterErr error
+	out.WriteString(`,"codes":[`)
+	first := true
+	k.IterateCodeInfos(ctx, func(codeID uint64, info wasmtypes.CodeInfo) bool {
+		bytecode, err := k.GetByteCode(ctx, codeID)
+		if err != nil {
+			iterErr = fmt.Errorf("code %d: %w", codeID, err)
+			return true
+		}
+		if !first {
+			out.WriteString(",")
+		}
+		first = false
+		out.WriteProto(app.appCodec, &wasmtypes.Code{
+			CodeID:    codeID,
+			CodeInfo:  info,
+			CodeBytes: bytecode,
+			Pinned:    k.IsPinnedCode(ctx, codeID),
+		})
+		return out.err != nil
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+
+	out.WriteString(`],"contracts":[`)
+	first = true
+	k.IterateContractInfo(ctx, func(addr sdk.AccAddress, contract wasmtypes.ContractInfo) bool {
+		if !first {
+			out.WriteString(",")
+		}
+		first = false
+
+		out.WriteString(`{"contract_address":`)
+		out.WriteJSON(addr.String())
+		out.WriteString(`,"contract_info":`)
+		out.WriteProto(app.appCodec, &contract)
+
+		out.WriteString(`,"contract_state":[`)
+		chunk := make([]wasmtypes.Model, 0, chunkSize)
+		written := 0
+		flush := func() {
+			for _, model := range chunk {
+				if written > 0 {
+					out.WriteString(",")
+				}
+				written++
+				out.WriteProto(app.appCodec, &model)
+			}
+			chunk = chunk[:0]
+		}
+		k.IterateContractState(ctx, addr, func(key, value []byte) bool {
+			chunk = append(chunk, wasmtypes.Model{Key: key, Value: value})
+			if len(chunk) == chunkSize {
+				flush()
+			}
+			return out.err != nil
+		})
+		flush()
+
+		out.WriteString(`],"contract_code_history":[`)
+		for i, entry := range k.GetContractHistory(ctx, addr) {
+			if i > 0 {
+				out.WriteString(",")
+			}
+			out.WriteProto(app.appCodec, &entry)
+		}
+		out.WriteString("]}")
+		return out.err != nil
+	})
+
+	out.WriteString(`],"sequences":[`)
+	for i, key := range [][]byte{wasmtypes.KeySequenceCodeID, wasmtypes.KeySequenceInstanceID} {
+		id, err := k.PeekAutoIncrementID(ctx, key)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			out.WriteString(",")
+		}
+		out.WriteProto(app.appCodec, &wasmtypes.Sequence{IDKey: key, Value: id})
+	}
+	out.WriteString("]}")
+	return out.err
+}
+
+// countingWriter counts the bytes written to w and keeps the first error,
+// after which writes are no-ops.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
+
+func (cw *countingWriter) WriteString(s string) {
+	_, _ = cw.Write([]byte(s))
+}
+
+func (cw *countingWriter) WriteJSON(v any) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		cw.err = err
+		return
+	}
+	_, _ = cw.Write(bz)
+}
+
+func (cw *countingWriter) WriteProto(cdc codec.JSONCodec, msg gogoproto.Message) {
+	if cw.err != nil {
+		return
+	}
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		cw.err = err
+		return
+	}
+	_, _ = cw.Write(bz)
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"cosmossdk.io/log"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+type failingWriter struct{ n int }
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.n < len(p) {
+		return 0, errors.New("disk full")
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestStreamGenesis(t *testing.T) {
+	if sdk.GetConfig().GetBech32AccountAddrPrefix() != consensus.AddrPrefix {
+		consensus.SetWalletConfig()
+	}
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, NewAppOptionsWithFlagHome(t.TempDir()), EmptyWasmOptions)
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	genesisState := app.DefaultGenesis()
+
+	// a contract with more state entries than a chunk
+	code, err := os.ReadFile("../test/e2e/testdata/contracts/counter.wasm")
+	require.NoError(t, err)
+	checksum := sha256.Sum256(code)
+	creator := sdk.AccAddress([]byte("creator_____________")).String()
+	contract := wasmtypes.Contract{
+		ContractAddress: wasmkeeper.BuildContractAddressClassic(1, 1).String(),
+		ContractInfo: wasmtypes.ContractInfo{
+			CodeID: 1, Creator: creator, Label: "counter", Created: &wasmtypes.AbsoluteTxPosition{BlockHeight: 1},
+		},
+		ContractCodeHistory: []wasmtypes.ContractCodeHistoryEntry{{
+			Operation: wasmtypes.ContractCodeHistoryOperationTypeInit,
+			CodeID:    1,
+			Updated:   &wasmtypes.AbsoluteTxPosition{BlockHeight: 1},
+			Msg:       wasmtypes.RawContractMessage(`{}`),
+		}},
+	}
+	for i := 0; i < 5; i++ {
+		contract.ContractState = append(contract.ContractState, wasmtypes.Model{
+			Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte(fmt.Sprintf(`{"count":%d}`, i)),
+		})
+	}
+	wasmGenState := wasmtypes.GenesisState{
+		Params: wasmtypes.DefaultParams(),
+		Codes: []wasmtypes.Code{{
+			CodeID:    1,
+			CodeInfo:  wasmtypes.CodeInfo{CodeHash: checksum[:], Creator: creator, InstantiateConfig: wasmtypes.AllowEverybody},
+			CodeBytes: code,
+		}},
+		Contracts: []wasmtypes.Contract{contract},
+		Sequences: []wasmtypes.Sequence{
+			{IDKey: wasmtypes.KeySequenceCodeID, Value: 2},
+			{IDKey: wasmtypes.KeySequenceInstanceID, Value: 2},
+		},
+	}
+	genesisState[wasmtypes.ModuleName] = app.AppCodec().MustMarshalJSON(&wasmGenState)
+	// the default genesis has no validator, which InitGenesis reports after
+	// initializing every module
+	_, err = app.ModuleManager.InitGenesis(ctx, app.AppCodec(), genesisState)
+	require.ErrorContains(t, err, "validator set is empty")
+	require.NoError(t, app.StoreConsensusParams(ctx, cmttypes.DefaultConsensusParams().ToProto()))
+
+	template := &genutiltypes.AppGenesis{AppName: Name, ChainID: "hippo-test-1"}
+
+	t.Run("Matches the in-memory export", func(t *testing.T) {
+		var (
+			out      bytes.Buffer
+			progress []ExportProgress
+		)
+		require.NoError(t, app.StreamGenesis(&out, template, StreamGenesisOptions{
+			WasmChunkSize: 2,
+			Progress:      func(p ExportProgress) { progress = append(progress, p) },
+		}))
+
+		total := int64(out.Len())
+		streamed, err := genutiltypes.AppGenesisFromReader(&out)
+		require.NoError(t, err)
+		require.Equal(t, "hippo-test-1", streamed.ChainID)
+		require.Equal(t, app.LastBlockHeight()+1, streamed.InitialHeight)
+		require.NotNil(t, streamed.Consensus.Params)
+
+		exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+		require.NoError(t, err)
+		var expected, actual map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(exported.AppState, &expected))
+		require.NoError(t, json.Unmarshal(streamed.AppState, &actual))
+		require.Len(t, actual, len(expected))
+		for name, bz := range expected {
+			require.JSONEq(t, string(bz), string(actual[name]), name)
+		}
+
+		var streamedWasm wasmtypes.GenesisState
+		app.AppCodec().MustUnmarshalJSON(actual[wasmtypes.ModuleName], &streamedWasm)
+		require.Len(t, streamedWasm.Contracts, 1)
+		require.Equal(t, contract.ContractState, streamedWasm.Contracts[0].ContractState)
+
+		require.Len(t, progress, len(expected))
+		last := progress[len(progress)-1]
+		require.Equal(t, len(expected), last.Done)
+		require.Equal(t, last.Done, last.Total)
+		// the consensus genesis follows the app state
+		require.Less(t, last.Written, total)
+	})
+
+	t.Run("Selected modules", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, app.StreamGenesis(&out, template, StreamGenesisOptions{ModulesToExport: []string{"bank", wasmtypes.ModuleName}}))
+		streamed, err := genutiltypes.AppGenesisFromReader(&out)
+		require.NoError(t, err)
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(streamed.AppState, &appState))
+		require.Len(t, appState, 2)
+
+		require.ErrorContains(t, app.StreamGenesis(&out, template, StreamGenesisOptions{ModulesToExport: []string{"unknown"}}), "unknown module unknown")
+	})
+
+	t.Run("Write errors are returned", func(t *testing.T) {
+		err := app.StreamGenesis(&failingWriter{n: 64 * 1024}, template, StreamGenesisOptions{})
+		require.ErrorContains(t, err, "disk full")
+	})
+}
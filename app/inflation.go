@@ -6,6 +6,8 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
 )
 
 const (
@@ -45,10 +47,10 @@ func CustomInflationCalculationFn(context context.Context, minter minttypes.Mint
 	// https://github.com/cosmos/cosmos-sdk/blob/548ca00b11f89145fc112e54e680f6710172bb5a/UPGRADING.md#core-api
 	ctx := sdk.UnwrapSDKContext(context)
 
-	currentYear := 1 + (ctx.BlockHeight() / int64(params.BlocksPerYear))
+	currentYear := consensus.Year(ctx.BlockHeight(), params.BlocksPerYear)
 
 	for i := int64(1); i <= currentYear; i++ {
-		if i%2 == 1 && i != 1 {
+		if consensus.IsHalvingYear(i) {
 			targetInflatedToken /= 2
 		}
 		targetSupply += targetInflatedToken
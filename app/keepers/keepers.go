@@ -55,7 +55,36 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/app/wasmbinding"
 	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	auditkeeper "github.com/hippocrat-dao/hippo-protocol/x/audit/keeper"
+	audittypes "github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+	communitytaxkeeper "github.com/hippocrat-dao/hippo-protocol/x/communitytax/keeper"
+	communitytaxtypes "github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+	contractsponsorkeeper "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/keeper"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	escrowkeeper "github.com/hippocrat-dao/hippo-protocol/x/escrow/keeper"
+	escrowtypes "github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+	feeabskeeper "github.com/hippocrat-dao/hippo-protocol/x/feeabs/keeper"
+	feeabstypes "github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	feeburnkeeper "github.com/hippocrat-dao/hippo-protocol/x/feeburn/keeper"
+	feeburntypes "github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+	keysharekeeper "github.com/hippocrat-dao/hippo-protocol/x/keyshare/keeper"
+	keysharetypes "github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+	liquidstakekeeper "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/keeper"
+	liquidstaketypes "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+	oraclekeeper "github.com/hippocrat-dao/hippo-protocol/x/oracle/keeper"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schemakeeper "github.com/hippocrat-dao/hippo-protocol/x/schema/keeper"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	sponsorkeeper "github.com/hippocrat-dao/hippo-protocol/x/sponsor/keeper"
+	sponsortypes "github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+	treasurykeeper "github.com/hippocrat-dao/hippo-protocol/x/treasury/keeper"
+	treasurytypes "github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+	valpolicykeeper "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/keeper"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+	zkkeeper "github.com/hippocrat-dao/hippo-protocol/x/zk/keeper"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
 	"github.com/spf13/cast"
 )
 
@@ -80,6 +109,23 @@ type AppKeepersWithKey struct {
 	TransferKeeper ibctransferkeeper.Keeper // for cross-chain fungible token transfers
 	WasmKeeper     wasmkeeper.Keeper
 
+	EscrowKeeper   escrowkeeper.Keeper
+	AuditKeeper    auditkeeper.Keeper
+	ZKKeeper       zkkeeper.Keeper
+	KeyshareKeeper keysharekeeper.Keeper
+	SchemaKeeper   schemakeeper.Keeper
+	SponsorKeeper  sponsorkeeper.Keeper
+	// ContractSponsorKeeper is constructed after the wasm keeper it reads
+	// contract admins from.
+	ContractSponsorKeeper contractsponsorkeeper.Keeper
+	FeeAbsKeeper          feeabskeeper.Keeper
+	OracleKeeper          oraclekeeper.Keeper
+	LiquidStakeKeeper     liquidstakekeeper.Keeper
+	ValPolicyKeeper       valpolicykeeper.Keeper
+	TreasuryKeeper        treasurykeeper.Keeper
+	CommunityTaxKeeper    communitytaxkeeper.Keeper
+	FeeBurnKeeper         feeburnkeeper.Keeper
+
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
@@ -145,7 +191,7 @@ func (appKeepers *AppKeepersWithKey) InitKeyAndKeepers(
 		appCodec, legacyAmino, runtime.NewKVStoreService(appKeepers.keys[slashingtypes.StoreKey]), appKeepers.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	appKeepers.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(appKeepers.keys[feegrant.StoreKey]), appKeepers.AccountKeeper)
+	appKeepers.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(appKeepers.keys[feegrant.StoreKey]), appKeepers.AccountKeeper).SetBankKeeper(appKeepers.BankKeeper)
 
 	appKeepers.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewKVStoreService(appKeepers.keys[authzkeeper.StoreKey]), appCodec, bApp.MsgServiceRouter(), appKeepers.AccountKeeper)
 
@@ -196,12 +242,131 @@ func (appKeepers *AppKeepersWithKey) InitKeyAndKeepers(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	appKeepers.EscrowKeeper = escrowkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[escrowtypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.AuditKeeper = auditkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[audittypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.AuthzKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.ZKKeeper = zkkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[zktypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.KeyshareKeeper = keysharekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[keysharetypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.SchemaKeeper = schemakeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[schematypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.SponsorKeeper = sponsorkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[sponsortypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.FeeGrantKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[oracletypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.StakingKeeper,
+		appKeepers.SlashingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[feeabstypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.OracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.LiquidStakeKeeper = liquidstakekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[liquidstaketypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.ValPolicyKeeper = valpolicykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[valpolicytypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.TreasuryKeeper = treasurykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[treasurytypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		distrkeeper.NewQuerier(appKeepers.DistrKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.CommunityTaxKeeper = communitytaxkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[communitytaxtypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.DistrKeeper.Params,
+		appKeepers.MintKeeper.Params,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.FeeBurnKeeper = feeburnkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[feeburntypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.StakingKeeper,
+		distrkeeper.NewQuerier(appKeepers.DistrKeeper),
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	wasmDir := homePath
 	wasmConfig, err := wasm.ReadNodeConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
+	// Expose the native hippo queries (zk verification, schema registry, fee
+	// sponsorship quotas, oracle prices) to contracts.
+	wasmOpts = append(wasmOpts, wasmbinding.RegisterCustomPlugins(
+		&appKeepers.ZKKeeper,
+		&appKeepers.SchemaKeeper,
+		&appKeepers.ContractSponsorKeeper,
+		&appKeepers.OracleKeeper,
+	)...)
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	// Note: Using PortKeeper here instead of ChannelKeeperV2 because this project uses IBC v8.
@@ -229,6 +394,15 @@ func (appKeepers *AppKeepersWithKey) InitKeyAndKeepers(
 		wasmOpts...,
 	)
 
+	appKeepers.ContractSponsorKeeper = contractsponsorkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[contractsponsortypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Create wasm IBC handler
 	// Note: The IBC integration here is simplified compared to wasmd reference because:
 	// 1. This blockchain uses IBC v8 (wasmd uses v10+)
@@ -21,6 +21,20 @@ import (
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	audittypes "github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+	communitytaxtypes "github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	escrowtypes "github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+	feeabstypes "github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	feeburntypes "github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+	keysharetypes "github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+	liquidstaketypes "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	sponsortypes "github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+	treasurytypes "github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
 )
 
 func (appKeepers *AppKeepersWithKey) GenerateKeys() {
@@ -32,6 +46,20 @@ func (appKeepers *AppKeepersWithKey) GenerateKeys() {
 		authzkeeper.StoreKey, group.StoreKey,
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		wasmtypes.StoreKey,
+		escrowtypes.StoreKey,
+		audittypes.StoreKey,
+		zktypes.StoreKey,
+		keysharetypes.StoreKey,
+		schematypes.StoreKey,
+		sponsortypes.StoreKey,
+		contractsponsortypes.StoreKey,
+		feeabstypes.StoreKey,
+		oracletypes.StoreKey,
+		liquidstaketypes.StoreKey,
+		valpolicytypes.StoreKey,
+		treasurytypes.StoreKey,
+		communitytaxtypes.StoreKey,
+		feeburntypes.StoreKey,
 	)
 
 	appKeepers.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -6,6 +6,20 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hippocrat-dao/hippo-protocol/app/keepers"
+	audittypes "github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+	communitytaxtypes "github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	escrowtypes "github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+	feeabstypes "github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	feeburntypes "github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+	keysharetypes "github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+	liquidstaketypes "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	sponsortypes "github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+	treasurytypes "github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
 
 	evidencetypes "cosmossdk.io/x/evidence/types"
 	"cosmossdk.io/x/feegrant"
@@ -49,6 +63,20 @@ func TestGenerateKeys(t *testing.T) {
 		group.StoreKey,
 		ibcexported.StoreKey,
 		ibctransfertypes.StoreKey,
+		escrowtypes.StoreKey,
+		audittypes.StoreKey,
+		zktypes.StoreKey,
+		keysharetypes.StoreKey,
+		schematypes.StoreKey,
+		sponsortypes.StoreKey,
+		contractsponsortypes.StoreKey,
+		feeabstypes.StoreKey,
+		oracletypes.StoreKey,
+		liquidstaketypes.StoreKey,
+		valpolicytypes.StoreKey,
+		treasurytypes.StoreKey,
+		communitytaxtypes.StoreKey,
+		feeburntypes.StoreKey,
 	}
 
 	for _, key := range expectedKeys {
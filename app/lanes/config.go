@@ -0,0 +1,127 @@
+package lanes
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagPriorityMempool = "lanes.priority-mempool"
+	flagMaxTxs          = "lanes.max-txs"
+	flagSystemGasShare  = "lanes.system-gas-share"
+	flagIBCGasShare     = "lanes.ibc-gas-share"
+
+	// DefaultMaxTxs bounds the transactions of the mempool.
+	DefaultMaxTxs = 5000
+)
+
+var (
+	// DefaultSystemGasShare reserves 10% of the block gas for staking and
+	// governance.
+	DefaultSystemGasShare = math.LegacyNewDecWithPrec(1, 1)
+	// DefaultIBCGasShare reserves 20% of the block gas for IBC relayers.
+	DefaultIBCGasShare = math.LegacyNewDecWithPrec(2, 1)
+)
+
+// Config defines the node local configuration of the mempool lanes.
+type Config struct {
+	// PriorityMempool replaces the mempool configured in [mempool] with an
+	// app-side priority nonce mempool.
+	PriorityMempool bool `mapstructure:"priority-mempool"`
+	// MaxTxs bounds the transactions of the priority mempool, 0 for
+	// unbounded.
+	MaxTxs int `mapstructure:"max-txs"`
+	// SystemGasShare is the share of the block max gas reserved for staking
+	// and governance transactions.
+	SystemGasShare string `mapstructure:"system-gas-share"`
+	// IBCGasShare is the share of the block max gas reserved for IBC relayer
+	// transactions.
+	IBCGasShare string `mapstructure:"ibc-gas-share"`
+}
+
+// DefaultConfig returns the default lanes configuration.
+func DefaultConfig() Config {
+	return Config{
+		PriorityMempool: true,
+		MaxTxs:          DefaultMaxTxs,
+		SystemGasShare:  DefaultSystemGasShare.String(),
+		IBCGasShare:     DefaultIBCGasShare.String(),
+	}
+}
+
+// ReadConfig reads the lanes configuration from the app options.
+func ReadConfig(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get(flagPriorityMempool); v != nil {
+		cfg.PriorityMempool = cast.ToBool(v)
+	}
+	if v := appOpts.Get(flagMaxTxs); v != nil {
+		cfg.MaxTxs = cast.ToInt(v)
+	}
+	if v := appOpts.Get(flagSystemGasShare); v != nil {
+		cfg.SystemGasShare = cast.ToString(v)
+	}
+	if v := appOpts.Get(flagIBCGasShare); v != nil {
+		cfg.IBCGasShare = cast.ToString(v)
+	}
+	return cfg
+}
+
+// Lanes returns the reserved lanes of the configuration.
+func (c Config) Lanes() (Lanes, error) {
+	if c.MaxTxs < 0 {
+		return nil, fmt.Errorf("max txs must not be negative: %d", c.MaxTxs)
+	}
+
+	system, err := parseShare("system gas share", c.SystemGasShare)
+	if err != nil {
+		return nil, err
+	}
+	ibc, err := parseShare("ibc gas share", c.IBCGasShare)
+	if err != nil {
+		return nil, err
+	}
+	if !system.Add(ibc).LT(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("reserved gas shares must leave room for the default lane: %s + %s", system, ibc)
+	}
+
+	return Lanes{NewSystemLane(system), NewIBCLane(ibc)}, nil
+}
+
+func parseShare(name, s string) (math.LegacyDec, error) {
+	share, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, fmt.Errorf("%s must be between 0 and 1: %s", name, share)
+	}
+	return share, nil
+}
+
+// ConfigTemplate is the app.toml section of the lanes configuration.
+const ConfigTemplate = `
+###############################################################################
+###                              Lanes Configuration                        ###
+###############################################################################
+
+[lanes]
+
+# Order pending transactions by fee, and by nonce for each sender, in an
+# app-side priority mempool. When disabled, the mempool configured in [mempool]
+# is used and proposals keep the CometBFT order.
+priority-mempool = {{ .Lanes.PriorityMempool }}
+
+# Maximum number of transactions in the priority mempool, 0 for unbounded.
+max-txs = {{ .Lanes.MaxTxs }}
+
+# Shares of the block max gas reserved for pending staking, slashing and
+# governance transactions and for pending IBC relayer transactions. Transactions
+# of other kinds use the remaining block space, including reserved space left
+# unused, and reserved transactions use the space other transactions leave.
+system-gas-share = "{{ .Lanes.SystemGasShare }}"
+ibc-gas-share = "{{ .Lanes.IBCGasShare }}"
+`
@@ -0,0 +1,99 @@
+package lanes
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Lane reserves a share of the block gas for the transactions it matches.
+type Lane struct {
+	// Name identifies the lane in logs.
+	Name string
+	// GasShare is the share of the block max gas reserved for the pending
+	// transactions of the lane. The lane uses more when the other lanes leave
+	// room.
+	GasShare math.LegacyDec
+	// Match reports whether a transaction belongs to the lane.
+	Match func(sdk.Tx) bool
+}
+
+// Lanes are the reserved lanes of a block. Transactions matching no lane go
+// to the default lane, which uses the block space the reserved lanes do not
+// keep for their pending transactions.
+type Lanes []Lane
+
+// Index returns the index of the first lane matching tx, or len(l) for the
+// default lane.
+func (l Lanes) Index(tx sdk.Tx) int {
+	for i, lane := range l {
+		if lane.Match(tx) {
+			return i
+		}
+	}
+	return len(l)
+}
+
+// Name returns the name of the lane at index i.
+func (l Lanes) Name(i int) string {
+	if i < len(l) {
+		return l[i].Name
+	}
+	return DefaultLaneName
+}
+
+const (
+	// SystemLaneName is the name of the lane for staking and governance.
+	SystemLaneName = "system"
+	// IBCLaneName is the name of the lane for IBC relayer transactions.
+	IBCLaneName = "ibc"
+	// DefaultLaneName is the name of the lane for all other transactions.
+	DefaultLaneName = "default"
+)
+
+var (
+	// SystemMsgPrefixes are the type URL prefixes of the validator operation
+	// and governance messages.
+	SystemMsgPrefixes = []string{"/cosmos.staking.", "/cosmos.slashing.", "/cosmos.gov."}
+	// IBCMsgPrefixes are the type URL prefixes of the IBC relayer messages.
+	IBCMsgPrefixes = []string{"/ibc.core."}
+)
+
+// NewSystemLane returns the lane for staking, slashing and governance
+// transactions.
+func NewSystemLane(gasShare math.LegacyDec) Lane {
+	return Lane{Name: SystemLaneName, GasShare: gasShare, Match: MatchMsgs(SystemMsgPrefixes...)}
+}
+
+// NewIBCLane returns the lane for IBC relayer transactions.
+func NewIBCLane(gasShare math.LegacyDec) Lane {
+	return Lane{Name: IBCLaneName, GasShare: gasShare, Match: MatchMsgs(IBCMsgPrefixes...)}
+}
+
+// MatchMsgs matches transactions whose messages all have a type URL with one
+// of the prefixes. Mixing in any other message sends the transaction to the
+// default lane, so reserved space cannot be used for arbitrary executions.
+func MatchMsgs(prefixes ...string) func(sdk.Tx) bool {
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if !hasPrefix(sdk.MsgTypeURL(msg), prefixes) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func hasPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
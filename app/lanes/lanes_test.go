@@ -0,0 +1,241 @@
+package lanes_test
+
+import (
+	"sync"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/app/lanes"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+var setupOnce sync.Once
+
+type fixture struct {
+	t     *testing.T
+	app   *app.App
+	ctx   sdk.Context
+	lanes lanes.Lanes
+	user  sdk.AccAddress
+}
+
+func setup(t *testing.T) fixture {
+	t.Helper()
+	setupOnce.Do(consensus.SetWalletConfig)
+
+	hippoApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+	blockLanes, err := lanes.DefaultConfig().Lanes()
+	require.NoError(t, err)
+	return fixture{
+		t:     t,
+		app:   hippoApp,
+		ctx:   hippoApp.NewContextLegacy(true, cmtproto.Header{Height: 1}),
+		lanes: blockLanes,
+		user:  sdk.AccAddress([]byte("user________________")),
+	}
+}
+
+func (f fixture) bankMsg() sdk.Msg {
+	return banktypes.NewMsgSend(f.user, f.user, sdk.NewCoins(sdk.NewInt64Coin(consensus.DefaultHippoDenom, 1)))
+}
+
+func (f fixture) stakingMsg() sdk.Msg {
+	return stakingtypes.NewMsgDelegate(f.user.String(), sdk.ValAddress(f.user).String(), sdk.NewInt64Coin(consensus.DefaultHippoDenom, 1))
+}
+
+func (f fixture) govMsg() sdk.Msg {
+	return govv1.NewMsgVote(f.user, 1, govv1.OptionYes, "")
+}
+
+func (f fixture) ibcMsg() sdk.Msg {
+	return &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: f.user.String()}
+}
+
+// tx builds a transaction signed by a new account.
+func (f fixture) tx(gas uint64, msgs ...sdk.Msg) sdk.Tx {
+	return f.txFrom(secp256k1.GenPrivKey(), 0, gas, msgs...)
+}
+
+// txFrom builds a transaction signed by key at sequence.
+func (f fixture) txFrom(key *secp256k1.PrivKey, sequence, gas uint64, msgs ...sdk.Msg) sdk.Tx {
+	builder := f.app.TxConfig().NewTxBuilder()
+	require.NoError(f.t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(gas)
+	require.NoError(f.t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+	return builder.GetTx()
+}
+
+func (f fixture) encode(tx sdk.Tx) []byte {
+	bz, err := f.app.TxConfig().TxEncoder()(tx)
+	require.NoError(f.t, err)
+	return bz
+}
+
+func TestLanesIndex(t *testing.T) {
+	f := setup(t)
+
+	require.Equal(t, 0, f.lanes.Index(f.tx(1, f.stakingMsg())))
+	require.Equal(t, 0, f.lanes.Index(f.tx(1, f.govMsg(), f.stakingMsg())))
+	require.Equal(t, 1, f.lanes.Index(f.tx(1, f.ibcMsg())))
+	require.Equal(t, 2, f.lanes.Index(f.tx(1, f.bankMsg())))
+	// mixing in other messages leaves the reserved lanes
+	require.Equal(t, 2, f.lanes.Index(f.tx(1, f.stakingMsg(), f.bankMsg())))
+	require.Equal(t, 2, f.lanes.Index(f.tx(1, f.ibcMsg(), f.govMsg())))
+
+	require.Equal(t, lanes.SystemLaneName, f.lanes.Name(0))
+	require.Equal(t, lanes.IBCLaneName, f.lanes.Name(1))
+	require.Equal(t, lanes.DefaultLaneName, f.lanes.Name(2))
+}
+
+func TestMempool(t *testing.T) {
+	f := setup(t)
+	mp := lanes.NewMempool(0)
+	require.Nil(t, mp.Select(f.ctx, nil))
+
+	cheapDefault := f.tx(1, f.bankMsg())
+	richDefault := f.tx(1, f.bankMsg())
+	ibc := f.tx(1, f.ibcMsg())
+	system := f.tx(1, f.stakingMsg())
+	// a sender paying more for its second transaction, in another lane
+	sender := secp256k1.GenPrivKey()
+	senderDefault := f.txFrom(sender, 0, 1, f.bankMsg())
+	senderSystem := f.txFrom(sender, 1, 1, f.stakingMsg())
+	for _, tc := range []struct {
+		tx       sdk.Tx
+		priority int64
+	}{{cheapDefault, 1}, {richDefault, 100}, {ibc, 3}, {system, 2}, {senderSystem, 200}, {senderDefault, 50}} {
+		require.NoError(t, mp.Insert(f.ctx.WithPriority(tc.priority), tc.tx))
+	}
+	require.Equal(t, 6, mp.CountTx())
+
+	// fee priority whatever the lane, nonce order for each sender
+	var selected []sdk.Tx
+	for iter := mp.Select(f.ctx, nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx())
+	}
+	require.Equal(t, []sdk.Tx{richDefault, senderDefault, senderSystem, ibc, system, cheapDefault}, selected)
+
+	require.NoError(t, mp.Remove(ibc))
+	require.ErrorIs(t, mp.Remove(ibc), mempool.ErrTxNotFound)
+	require.Equal(t, 5, mp.CountTx())
+
+	bounded := lanes.NewMempool(1)
+	require.NoError(t, bounded.Insert(f.ctx, f.tx(1, f.bankMsg())))
+	require.ErrorIs(t, bounded.Insert(f.ctx, f.tx(1, f.ibcMsg())), mempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestTxSelector(t *testing.T) {
+	f := setup(t)
+	ts := lanes.NewTxSelector(f.lanes)
+	const maxBlockGas, maxTxBytes = 1000, 1 << 20
+
+	selectTx := func(tx sdk.Tx) bool {
+		return ts.SelectTxForProposal(f.ctx, maxTxBytes, maxBlockGas, tx, f.encode(tx))
+	}
+
+	// 100 gas of the system lane and 150 gas of the ibc lane, below its 20%
+	// share, are pending
+	ts.Reserve([]uint64{100, 150})
+	sender := secp256k1.GenPrivKey()
+	require.False(t, selectTx(f.txFrom(sender, 0, 800, f.bankMsg())))
+	require.Empty(t, ts.SelectedTxs(f.ctx))
+	// the next transaction of the sender would not follow its sequence
+	require.False(t, selectTx(f.txFrom(sender, 1, 10, f.bankMsg())))
+	require.Empty(t, ts.SelectedTxs(f.ctx))
+	require.False(t, selectTx(f.tx(700, f.bankMsg())))
+	require.False(t, selectTx(f.tx(80, f.stakingMsg())))
+	require.False(t, selectTx(f.tx(150, f.ibcMsg())))
+	require.Len(t, ts.SelectedTxs(f.ctx), 3)
+
+	// the default lane leaves the rest of the system share
+	require.False(t, selectTx(f.tx(60, f.bankMsg())))
+	require.Len(t, ts.SelectedTxs(f.ctx), 3)
+	// which the system lane exceeds into the space left
+	require.True(t, selectTx(f.tx(70, f.stakingMsg())))
+	require.Len(t, ts.SelectedTxs(f.ctx), 4)
+
+	// without pending transactions nothing is reserved
+	ts.Clear()
+	require.Empty(t, ts.SelectedTxs(f.ctx))
+	require.False(t, selectTx(f.tx(900, f.bankMsg())))
+	require.True(t, selectTx(f.tx(100, f.ibcMsg())))
+	require.Len(t, ts.SelectedTxs(f.ctx), 2)
+}
+
+func TestProposalHandler(t *testing.T) {
+	f := setup(t)
+	f.ctx = f.ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000}})
+	h := lanes.NewProposalHandler(f.lanes, mempool.NoOpMempool{}, f.app)
+
+	large := f.encode(f.tx(900, f.bankMsg()))
+	ibc := f.encode(f.tx(100, f.ibcMsg()))
+	system := f.encode(f.tx(50, f.stakingMsg()))
+	bank := f.encode(f.tx(50, f.bankMsg()))
+
+	// the transactions from CometBFT keep their order, but the pending ibc and
+	// system transactions keep their space
+	res, err := h.PrepareProposal()(f.ctx, &abci.RequestPrepareProposal{Height: 1, MaxTxBytes: 1 << 20, Txs: [][]byte{large, ibc, system, bank}})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{ibc, system, bank}, res.Txs)
+
+	// without them the large transaction fits
+	res, err = h.PrepareProposal()(f.ctx, &abci.RequestPrepareProposal{Height: 1, MaxTxBytes: 1 << 20, Txs: [][]byte{large, bank}})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{large, bank}, res.Txs)
+
+	process, err := h.ProcessProposal()(f.ctx, &abci.RequestProcessProposal{Height: 1, Txs: [][]byte{bank, system, ibc}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process.Status)
+}
+
+func TestConfig(t *testing.T) {
+	cfg := lanes.ReadConfig(simtestutil.AppOptionsMap{})
+	require.Equal(t, lanes.DefaultConfig(), cfg)
+	blockLanes, err := cfg.Lanes()
+	require.NoError(t, err)
+	require.Len(t, blockLanes, 2)
+	require.Equal(t, lanes.DefaultSystemGasShare, blockLanes[0].GasShare)
+	require.Equal(t, lanes.DefaultIBCGasShare, blockLanes[1].GasShare)
+
+	cfg = lanes.ReadConfig(simtestutil.AppOptionsMap{
+		"lanes.priority-mempool": false,
+		"lanes.max-txs":          100,
+		"lanes.system-gas-share": "0.05",
+		"lanes.ibc-gas-share":    "0.3",
+	})
+	require.False(t, cfg.PriorityMempool)
+	require.Equal(t, 100, cfg.MaxTxs)
+	blockLanes, err = cfg.Lanes()
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3"), blockLanes[1].GasShare)
+
+	for _, invalid := range []lanes.Config{
+		{MaxTxs: -1, SystemGasShare: "0.1", IBCGasShare: "0.1"},
+		{SystemGasShare: "abc", IBCGasShare: "0.1"},
+		{SystemGasShare: "-0.1", IBCGasShare: "0.1"},
+		{SystemGasShare: "0.5", IBCGasShare: "0.5"},
+	} {
+		_, err := invalid.Lanes()
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,15 @@
+package lanes
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// NewMempool returns an app-side priority nonce mempool bounded to maxTxs
+// transactions, zero meaning unbounded. The transactions of all lanes share
+// the pool so the transactions of a sender stay in nonce order whatever their
+// lanes; the lanes only apply when the proposal is built.
+func NewMempool(maxTxs int) mempool.Mempool {
+	cfg := mempool.DefaultPriorityNonceMempoolConfig()
+	cfg.MaxTx = maxTxs
+	return mempool.NewPriorityMempool(cfg)
+}
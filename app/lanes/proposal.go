@@ -0,0 +1,69 @@
+package lanes
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// ProposalHandler builds proposals on top of the default proposal handler of
+// baseapp, keeping block space for the pending transactions of the reserved
+// lanes.
+type ProposalHandler struct {
+	lanes      Lanes
+	selector   *TxSelector
+	txVerifier baseapp.ProposalTxVerifier
+	prepare    sdk.PrepareProposalHandler
+	process    sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a proposal handler for the lanes selecting
+// transactions from mp.
+func NewProposalHandler(lanes Lanes, mp mempool.Mempool, txVerifier baseapp.ProposalTxVerifier) *ProposalHandler {
+	selector := NewTxSelector(lanes)
+	defaultHandler := baseapp.NewDefaultProposalHandler(mp, txVerifier)
+	defaultHandler.SetTxSelector(selector)
+
+	return &ProposalHandler{
+		lanes:      lanes,
+		selector:   selector,
+		txVerifier: txVerifier,
+		prepare:    defaultHandler.PrepareProposalHandler(),
+		process:    defaultHandler.ProcessProposalHandler(),
+	}
+}
+
+// PrepareProposal reserves block space for the reserved lanes with pending
+// transactions among the transactions from CometBFT, which hold the mempool,
+// and selects the transactions in mempool order.
+func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		h.selector.Reserve(h.pendingGas(req.Txs))
+		return h.prepare(ctx, req)
+	}
+}
+
+// ProcessProposal is the default handler. The space reserved by the proposer
+// depends on its mempool, so it is not checked.
+func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	return h.process
+}
+
+// pendingGas returns the gas limits of the transactions of each reserved lane.
+// Transactions that cannot be decoded are left to the default handler to
+// reject.
+func (h *ProposalHandler) pendingGas(txs [][]byte) []uint64 {
+	pending := make([]uint64, len(h.lanes))
+	for _, bz := range txs {
+		tx, err := h.txVerifier.TxDecode(bz)
+		if err != nil {
+			continue
+		}
+		gasTx, ok := tx.(baseapp.GasTx)
+		if lane := h.lanes.Index(tx); ok && lane < len(h.lanes) {
+			pending[lane] += gasTx.GetGas()
+		}
+	}
+	return pending
+}
@@ -0,0 +1,129 @@
+package lanes
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// TxSelector selects proposal transactions like the default selector of
+// baseapp, but keeps the share of the block max gas of each reserved lane
+// free for the pending transactions of the lane. Reserved lanes use the block
+// space left by the other lanes once their share is used.
+type TxSelector struct {
+	lanes   Lanes
+	signers mempool.SignerExtractionAdapter
+
+	pendingGas   []uint64
+	totalTxBytes uint64
+	totalTxGas   uint64
+	laneGas      []uint64
+	skipped      map[string]bool
+	selectedTxs  [][]byte
+}
+
+var _ baseapp.TxSelector = (*TxSelector)(nil)
+
+// NewTxSelector returns a transaction selector for the lanes.
+func NewTxSelector(lanes Lanes) *TxSelector {
+	ts := &TxSelector{lanes: lanes, signers: mempool.NewDefaultSignerExtractionAdapter()}
+	ts.Clear()
+	return ts
+}
+
+// Reserve sets the gas of the pending transactions of each reserved lane for
+// the next proposal. A lane only reserves the gas of its pending transactions,
+// up to its share, so the default lane gets the rest.
+func (ts *TxSelector) Reserve(pendingGas []uint64) {
+	copy(ts.pendingGas, pendingGas)
+}
+
+// SelectedTxs implements baseapp.TxSelector.
+func (ts *TxSelector) SelectedTxs(_ context.Context) [][]byte {
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+// Clear implements baseapp.TxSelector.
+func (ts *TxSelector) Clear() {
+	ts.pendingGas = make([]uint64, len(ts.lanes))
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+	ts.laneGas = make([]uint64, len(ts.lanes))
+	ts.skipped = make(map[string]bool)
+	ts.selectedTxs = nil
+}
+
+// SelectTxForProposal implements baseapp.TxSelector. A transaction that does
+// not fit is skipped, and so are the later transactions of its signers, whose
+// sequences would no longer follow.
+func (ts *TxSelector) SelectTxForProposal(_ context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	txSize := uint64(len(txBz))
+
+	var txGasLimit uint64
+	if gasTx, ok := memTx.(baseapp.GasTx); ok {
+		txGasLimit = gasTx.GetGas()
+	}
+
+	lane := len(ts.lanes)
+	var signers []string
+	if memTx != nil {
+		lane = ts.lanes.Index(memTx)
+		signers = ts.signersOf(memTx)
+	}
+
+	fits := txSize+ts.totalTxBytes <= maxTxBytes &&
+		(maxBlockGas == 0 || txGasLimit+ts.totalTxGas+ts.reservedGas(lane, maxBlockGas) <= maxBlockGas)
+	for _, signer := range signers {
+		fits = fits && !ts.skipped[signer]
+	}
+
+	// only add the transaction to the proposal if we have enough capacity
+	if fits {
+		ts.totalTxGas += txGasLimit
+		ts.totalTxBytes += txSize
+		if lane < len(ts.lanes) {
+			ts.laneGas[lane] += txGasLimit
+		}
+		ts.selectedTxs = append(ts.selectedTxs, txBz)
+	} else {
+		for _, signer := range signers {
+			ts.skipped[signer] = true
+		}
+	}
+
+	// check if we've reached capacity; if so, we cannot select any more transactions
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && ts.totalTxGas >= maxBlockGas)
+}
+
+// reservedGas returns the gas the reserved lanes other than lane keep for
+// their pending transactions.
+func (ts *TxSelector) reservedGas(lane int, maxBlockGas uint64) uint64 {
+	var reserved uint64
+	for i, l := range ts.lanes {
+		if i == lane {
+			continue
+		}
+		share := l.GasShare.MulInt(math.NewIntFromUint64(maxBlockGas)).TruncateInt().Uint64()
+		if ts.laneGas[i] < min(share, ts.pendingGas[i]) {
+			reserved += min(share, ts.pendingGas[i]) - ts.laneGas[i]
+		}
+	}
+	return reserved
+}
+
+func (ts *TxSelector) signersOf(tx sdk.Tx) []string {
+	signerData, err := ts.signers.GetSigners(tx)
+	if err != nil {
+		return nil
+	}
+	signers := make([]string, len(signerData))
+	for i, signer := range signerData {
+		signers[i] = signer.Signer.String()
+	}
+	return signers
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracleabci "github.com/hippocrat-dao/hippo-protocol/x/oracle/abci"
+)
+
+// setOracleHandlers registers the vote extension handlers reporting prices
+// and wraps the given proposal handlers to carry the reports into blocks.
+func (app *App) setOracleHandlers(appOpts servertypes.AppOptions, prepare sdk.PrepareProposalHandler, process sdk.ProcessProposalHandler) {
+	cfg := oracleabci.ReadConfig(appOpts)
+
+	var source oracleabci.PriceSource
+	if cfg.PriceFeedURL != "" {
+		source = oracleabci.NewHTTPPriceSource(cfg.PriceFeedURL)
+	}
+
+	voteExtHandler := oracleabci.NewVoteExtensionHandler(app.Logger(), app.OracleKeeper, source, cfg.PriceFeedTimeout)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVote())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtension())
+
+	app.oracleProposalHandler = oracleabci.NewProposalHandler(
+		app.Logger(),
+		app.OracleKeeper,
+		app.StakingKeeper,
+		prepare,
+		process,
+	)
+	app.SetPrepareProposal(app.oracleProposalHandler.PrepareProposal())
+	app.SetProcessProposal(app.oracleProposalHandler.ProcessProposal())
+}
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/app/lanes"
+)
+
+// setProposalHandlers sets up the mempool lanes and the proposal handlers.
+// The oracle handler wraps the lane handler so the injected price reports
+// stay the first transaction of a block.
+func (app *App) setProposalHandlers(appOpts servertypes.AppOptions) {
+	cfg := lanes.ReadConfig(appOpts)
+	blockLanes, err := cfg.Lanes()
+	if err != nil {
+		panic(fmt.Sprintf("error while reading lanes config: %s", err))
+	}
+
+	if cfg.PriorityMempool {
+		app.SetMempool(lanes.NewMempool(cfg.MaxTxs))
+	}
+
+	laneHandler := lanes.NewProposalHandler(blockLanes, app.Mempool(), app)
+	app.setOracleHandlers(appOpts, laneHandler.PrepareProposal(), laneHandler.ProcessProposal())
+}
@@ -0,0 +1,160 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+)
+
+// SnapshotHeader identifies the state a snapshot is taken from.
+type SnapshotHeader struct {
+	Height int64 `json:"height"`
+	// Time is the time of the block at Height, zero when the staking module
+	// no longer keeps its header.
+	Time time.Time `json:"time"`
+}
+
+// AccountSnapshot holds the balances, delegations and vesting position of an
+// account.
+type AccountSnapshot struct {
+	Address string `json:"address"`
+	// Module is the name of the module owning the account, if any.
+	Module      string               `json:"module,omitempty"`
+	Balances    sdk.Coins            `json:"balances"`
+	Delegations []DelegationSnapshot `json:"delegations"`
+	Vesting     *VestingSnapshot     `json:"vesting,omitempty"`
+}
+
+// DelegationSnapshot is a delegation with the tokens its shares are worth.
+type DelegationSnapshot struct {
+	Validator string         `json:"validator"`
+	Shares    math.LegacyDec `json:"shares"`
+	Amount    sdk.Coin       `json:"amount"`
+}
+
+// VestingSnapshot is the vesting position of a vesting account.
+type VestingSnapshot struct {
+	Type             string    `json:"type"`
+	StartTime        int64     `json:"start_time"`
+	EndTime          int64     `json:"end_time"`
+	OriginalVesting  sdk.Coins `json:"original_vesting"`
+	DelegatedFree    sdk.Coins `json:"delegated_free"`
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"`
+	// Vesting are the coins still vesting at the time of the snapshot, nil
+	// when the time is unknown.
+	Vesting sdk.Coins `json:"vesting,omitempty"`
+}
+
+// SnapshotHeader returns the height and the block time of the loaded state.
+func (app *App) SnapshotHeader() (SnapshotHeader, error) {
+	header := SnapshotHeader{Height: app.LastBlockHeight()}
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: header.Height})
+	historicalInfo, err := app.StakingKeeper.GetHistoricalInfo(ctx, header.Height)
+	switch {
+	case err == nil:
+		header.Time = historicalInfo.Header.Time
+	case !errors.Is(err, stakingtypes.ErrNoHistoricalInfo):
+		return header, err
+	}
+	return header, nil
+}
+
+// SnapshotAccounts calls fn with the snapshot of every account of the loaded
+// state, or of the given addresses only when there are any, in that order.
+// The state is only read, unlike the export for height zero.
+func (app *App) SnapshotAccounts(addresses []sdk.AccAddress, fn func(AccountSnapshot) error) error {
+	header, err := app.SnapshotHeader()
+	if err != nil {
+		return err
+	}
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: header.Height, Time: header.Time})
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	snapshot := func(address sdk.AccAddress, account sdk.AccountI) error {
+		accountSnapshot, err := app.snapshotAccount(ctx, header, bondDenom, address, account)
+		if err != nil {
+			return fmt.Errorf("failed to snapshot %s: %w", address, err)
+		}
+		return fn(accountSnapshot)
+	}
+
+	if len(addresses) > 0 {
+		for _, address := range addresses {
+			if err := snapshot(address, app.AccountKeeper.GetAccount(ctx, address)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	app.AccountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		err = snapshot(account.GetAddress(), account)
+		return err != nil
+	})
+	return err
+}
+
+func (app *App) snapshotAccount(ctx sdk.Context, header SnapshotHeader, bondDenom string, address sdk.AccAddress, account sdk.AccountI) (AccountSnapshot, error) {
+	snapshot := AccountSnapshot{
+		Address:     address.String(),
+		Balances:    app.BankKeeper.GetAllBalances(ctx, address),
+		Delegations: []DelegationSnapshot{},
+	}
+	if snapshot.Balances == nil {
+		snapshot.Balances = sdk.Coins{}
+	}
+	if moduleAccount, ok := account.(sdk.ModuleAccountI); ok {
+		snapshot.Module = moduleAccount.GetName()
+	}
+
+	var err error
+	iterErr := app.StakingKeeper.IterateDelegatorDelegations(ctx, address, func(delegation stakingtypes.Delegation) bool {
+		var valAddr sdk.ValAddress
+		valAddr, err = app.StakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return true
+		}
+		var validator stakingtypes.Validator
+		validator, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true
+		}
+		snapshot.Delegations = append(snapshot.Delegations, DelegationSnapshot{
+			Validator: delegation.ValidatorAddress,
+			Shares:    delegation.Shares,
+			Amount:    sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()),
+		})
+		return false
+	})
+	if err != nil {
+		return snapshot, err
+	}
+	if iterErr != nil {
+		return snapshot, iterErr
+	}
+
+	if vestingAccount, ok := account.(vestexported.VestingAccount); ok {
+		snapshot.Vesting = &VestingSnapshot{
+			Type:             gogoproto.MessageName(vestingAccount),
+			StartTime:        vestingAccount.GetStartTime(),
+			EndTime:          vestingAccount.GetEndTime(),
+			OriginalVesting:  vestingAccount.GetOriginalVesting(),
+			DelegatedFree:    vestingAccount.GetDelegatedFree(),
+			DelegatedVesting: vestingAccount.GetDelegatedVesting(),
+		}
+		if !header.Time.IsZero() {
+			snapshot.Vesting.Vesting = vestingAccount.GetVestingCoins(header.Time)
+		}
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,152 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+func TestSnapshotAccounts(t *testing.T) {
+	if sdk.GetConfig().GetBech32AccountAddrPrefix() != consensus.AddrPrefix {
+		consensus.SetWalletConfig()
+	}
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, NewAppOptionsWithFlagHome(t.TempDir()), EmptyWasmOptions)
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	cdc := app.AppCodec()
+	genesisState := app.DefaultGenesis()
+	// amounts are counted in units of consensus power
+	hp := func(power int64) sdk.Coin {
+		return sdk.NewCoin(consensus.DefaultHippoDenom, sdk.DefaultPowerReduction.MulRaw(power))
+	}
+
+	// a vesting account delegating to its validator, whose shares are worth
+	// half their amount, and a plain account
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	pubKey := ed25519.GenPrivKey().PubKey()
+	vester := sdk.AccAddress(pubKey.Address())
+	holder := sdk.AccAddress([]byte("holder______________"))
+	vestingAccount, err := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(vester), sdk.NewCoins(hp(4)), start.Unix(), start.Add(1000*time.Second).Unix())
+	require.NoError(t, err)
+	vestingAccount.DelegatedVesting = sdk.NewCoins(hp(1))
+	accounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{vestingAccount, authtypes.NewBaseAccountWithAddress(holder)})
+	require.NoError(t, err)
+	var authGenesis authtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenesis)
+	authGenesis.Accounts = accounts
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenesis)
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	var stakingGenesis stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenesis)
+	stakingGenesis.Params.BondDenom = consensus.DefaultHippoDenom
+	stakingGenesis.Validators = []stakingtypes.Validator{{
+		OperatorAddress:   sdk.ValAddress(vester).String(),
+		ConsensusPubkey:   pubKeyAny,
+		Status:            stakingtypes.Bonded,
+		Tokens:            hp(1).Amount,
+		DelegatorShares:   math.LegacyNewDecFromInt(hp(2).Amount),
+		Commission:        stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		MinSelfDelegation: math.OneInt(),
+	}}
+	stakingGenesis.Delegations = []stakingtypes.Delegation{
+		stakingtypes.NewDelegation(vester.String(), sdk.ValAddress(vester).String(), math.LegacyNewDecFromInt(hp(2).Amount)),
+	}
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenesis)
+
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = []banktypes.Balance{
+		{Address: vester.String(), Coins: sdk.NewCoins(hp(3))},
+		{Address: holder.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(consensus.DefaultHippoDenom, 5), sdk.NewInt64Coin("uother", 7))},
+		{Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(), Coins: sdk.NewCoins(hp(1))},
+	}
+	bankGenesis.Supply = sdk.NewCoins(hp(4).AddAmount(math.NewInt(5)), sdk.NewInt64Coin("uother", 7))
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
+	_, err = app.ModuleManager.InitGenesis(ctx, cdc, genesisState)
+	require.NoError(t, err)
+
+	collect := func(addresses ...sdk.AccAddress) map[string]AccountSnapshot {
+		snapshots := make(map[string]AccountSnapshot)
+		require.NoError(t, app.SnapshotAccounts(addresses, func(snapshot AccountSnapshot) error {
+			snapshots[snapshot.Address] = snapshot
+			return nil
+		}))
+		return snapshots
+	}
+
+	t.Run("Without the block time", func(t *testing.T) {
+		header, err := app.SnapshotHeader()
+		require.NoError(t, err)
+		require.Equal(t, SnapshotHeader{}, header)
+
+		snapshots := collect()
+		require.Equal(t, AccountSnapshot{
+			Address:     holder.String(),
+			Balances:    sdk.NewCoins(sdk.NewInt64Coin(consensus.DefaultHippoDenom, 5), sdk.NewInt64Coin("uother", 7)),
+			Delegations: []DelegationSnapshot{},
+		}, snapshots[holder.String()])
+
+		snapshot := snapshots[vester.String()]
+		require.Equal(t, sdk.NewCoins(hp(3)), snapshot.Balances)
+		require.Equal(t, []DelegationSnapshot{{
+			Validator: sdk.ValAddress(vester).String(),
+			Shares:    math.LegacyNewDecFromInt(hp(2).Amount),
+			Amount:    hp(1),
+		}}, snapshot.Delegations)
+		require.Equal(t, &VestingSnapshot{
+			Type:             "cosmos.vesting.v1beta1.ContinuousVestingAccount",
+			StartTime:        start.Unix(),
+			EndTime:          start.Add(1000 * time.Second).Unix(),
+			OriginalVesting:  sdk.NewCoins(hp(4)),
+			DelegatedVesting: sdk.NewCoins(hp(1)),
+		}, snapshot.Vesting)
+
+		bondedPool := snapshots[authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()]
+		require.Equal(t, stakingtypes.BondedPoolName, bondedPool.Module)
+		require.Equal(t, sdk.NewCoins(hp(1)), bondedPool.Balances)
+	})
+
+	t.Run("At the block time", func(t *testing.T) {
+		blockTime := start.Add(250 * time.Second)
+		require.NoError(t, app.StakingKeeper.SetHistoricalInfo(ctx, app.LastBlockHeight(), &stakingtypes.HistoricalInfo{
+			Header: cmtproto.Header{Height: app.LastBlockHeight(), Time: blockTime},
+		}))
+		header, err := app.SnapshotHeader()
+		require.NoError(t, err)
+		require.Equal(t, blockTime, header.Time)
+
+		require.Equal(t, sdk.NewCoins(hp(3)), collect()[vester.String()].Vesting.Vesting)
+	})
+
+	t.Run("Addresses", func(t *testing.T) {
+		unknown := sdk.AccAddress([]byte("unknown_____________"))
+		var addresses []string
+		require.NoError(t, app.SnapshotAccounts([]sdk.AccAddress{unknown, holder}, func(snapshot AccountSnapshot) error {
+			addresses = append(addresses, snapshot.Address)
+			if snapshot.Address == unknown.String() {
+				require.Empty(t, snapshot.Balances)
+				require.Empty(t, snapshot.Delegations)
+				require.Nil(t, snapshot.Vesting)
+			}
+			return nil
+		}))
+		require.Equal(t, []string{unknown.String(), holder.String()}, addresses)
+	})
+}
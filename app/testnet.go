@@ -0,0 +1,236 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestnetOptions describes how InitForTestnet turns the state of a network
+// into a local single validator testnet.
+type TestnetOptions struct {
+	// NewValPubKey is the consensus key of the local validator, the one in
+	// priv_validator_key.json.
+	NewValPubKey cmtcrypto.PubKey
+	// NewOperatorAddress is the account operating and self delegating to the
+	// local validator.
+	NewOperatorAddress sdk.AccAddress
+	// UpgradeToTrigger replaces the pending upgrade plan, if any, by a plan
+	// running the named upgrade handler on the next block when set.
+	UpgradeToTrigger string
+	// AccountsToFund receive FundAmount each.
+	AccountsToFund []sdk.AccAddress
+	FundAmount     sdk.Coins
+	// VotingPeriod replaces the gov voting and deposit periods, the
+	// expedited voting period becomes half of it.
+	VotingPeriod time.Duration
+}
+
+// InitForTestnet rewrites the latest state so that only the local validator
+// of opts is bonded and it alone can pass governance proposals. The changes
+// are committed together with the first block the testnet produces.
+func (app *App) InitForTestnet(opts TestnetOptions) error {
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+	return app.testnetify(ctx, opts)
+}
+
+func (app *App) testnetify(ctx sdk.Context, opts TestnetOptions) error {
+	if opts.NewValPubKey == nil {
+		return fmt.Errorf("testnet validator pubkey is missing")
+	}
+	if opts.NewOperatorAddress.Empty() {
+		return fmt.Errorf("testnet operator address is missing")
+	}
+	if opts.VotingPeriod <= 0 {
+		return fmt.Errorf("testnet voting period must be positive: %s", opts.VotingPeriod)
+	}
+
+	if err := app.replaceValidatorSet(ctx, opts); err != nil {
+		return fmt.Errorf("failed to replace the validator set: %w", err)
+	}
+
+	govParams, err := app.GovKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	votingPeriod, expeditedVotingPeriod := opts.VotingPeriod, opts.VotingPeriod/2
+	govParams.VotingPeriod = &votingPeriod
+	govParams.MaxDepositPeriod = &votingPeriod
+	govParams.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	if err := app.GovKeeper.Params.Set(ctx, govParams); err != nil {
+		return err
+	}
+
+	for _, addr := range opts.AccountsToFund {
+		if err := app.mintTo(ctx, opts.FundAmount, func(coins sdk.Coins) error {
+			return app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins)
+		}); err != nil {
+			return fmt.Errorf("failed to fund %s: %w", addr, err)
+		}
+	}
+
+	if opts.UpgradeToTrigger != "" {
+		if err := app.UpgradeKeeper.ClearUpgradePlan(ctx); err != nil {
+			return err
+		}
+		// the plan must be due on the very next block, the upgrade module
+		// refuses to run past a known handler whose plan is pending
+		if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{
+			Name:   opts.UpgradeToTrigger,
+			Height: ctx.BlockHeight() + 1,
+		}); err != nil {
+			return fmt.Errorf("failed to schedule upgrade %s: %w", opts.UpgradeToTrigger, err)
+		}
+	}
+	return nil
+}
+
+// replaceValidatorSet removes every validator of the network from the active
+// set, then bonds a validator for the local consensus key holding three
+// quarters of the bonded tokens, so it passes gov quorum and threshold alone.
+func (app *App) replaceValidatorSet(ctx sdk.Context, opts TestnetOptions) error {
+	stakingStore := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
+
+	powerIterator, err := app.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	if err != nil {
+		return err
+	}
+	deleteAll(stakingStore, powerIterator)
+	lastIterator, err := app.StakingKeeper.LastValidatorsIterator(ctx)
+	if err != nil {
+		return err
+	}
+	deleteAll(stakingStore, lastIterator)
+	deleteAll(stakingStore, storetypes.KVStorePrefixIterator(stakingStore, stakingtypes.ValidatorQueueKey))
+
+	pubKey, err := cryptocodec.FromCmtPubKeyInterface(opts.NewValPubKey)
+	if err != nil {
+		return err
+	}
+	valAddr := sdk.ValAddress(opts.NewOperatorAddress)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	bonded, err := app.StakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	tokens := bonded.MulRaw(3).Add(sdk.TokensFromConsensusPower(1_000_000, sdk.DefaultPowerReduction))
+
+	validator, err := stakingtypes.NewValidator(valAddr.String(), pubKey, stakingtypes.Description{Moniker: "testnet-validator"})
+	if err != nil {
+		return err
+	}
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = tokens
+	validator.DelegatorShares = math.LegacyNewDecFromInt(tokens)
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2))
+
+	if err := app.mintTo(ctx, sdk.NewCoins(sdk.NewCoin(bondDenom, tokens)), func(coins sdk.Coins) error {
+		return app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, coins)
+	}); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator); err != nil {
+		return err
+	}
+	// a zero last power makes the next EndBlock send the actual power of the
+	// validator to CometBFT
+	if err := app.StakingKeeper.SetLastValidatorPower(ctx, valAddr, 0); err != nil {
+		return err
+	}
+
+	hooks := app.StakingKeeper.Hooks()
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	if err := hooks.AfterValidatorCreated(ctx, valAddr); err != nil {
+		return err
+	}
+	if err := hooks.AfterValidatorBonded(ctx, consAddr, valAddr); err != nil {
+		return err
+	}
+	if err := hooks.BeforeDelegationCreated(ctx, opts.NewOperatorAddress, valAddr); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(
+		opts.NewOperatorAddress.String(), valAddr.String(), validator.DelegatorShares,
+	)); err != nil {
+		return err
+	}
+	return hooks.AfterDelegationModified(ctx, opts.NewOperatorAddress, valAddr)
+}
+
+// mintTo mints coins to the mint module account and hands them to send.
+func (app *App) mintTo(ctx sdk.Context, coins sdk.Coins, send func(sdk.Coins) error) error {
+	if coins.IsZero() {
+		return nil
+	}
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return send(coins)
+}
+
+// deleteAll deletes every key of iterator from store and closes it.
+func deleteAll(store storetypes.KVStore, iterator storetypes.Iterator) {
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// testnetStoreLoader loads the latest version, adding the stores the binary
+// mounts but db has not committed yet, so an in-place testnet of a newer
+// binary does not panic on stores its upgrade would add.
+func testnetStoreLoader(db dbm.DB, keys map[string]*storetypes.KVStoreKey) baseapp.StoreLoader {
+	return func(ms storetypes.CommitMultiStore) error {
+		rs, ok := ms.(*rootmulti.Store)
+		version := rootmulti.GetLatestVersion(db)
+		if !ok || version == 0 {
+			return baseapp.DefaultStoreLoader(ms)
+		}
+
+		commitInfo, err := rs.GetCommitInfo(version)
+		if err != nil {
+			return err
+		}
+		committed := make(map[string]bool, len(commitInfo.StoreInfos))
+		for _, info := range commitInfo.StoreInfos {
+			committed[info.Name] = true
+		}
+		var added []string
+		for name := range keys {
+			if !committed[name] {
+				added = append(added, name)
+			}
+		}
+		if len(added) == 0 {
+			return ms.LoadLatestVersion()
+		}
+		sort.Strings(added)
+		return ms.LoadLatestVersionAndUpgrade(&storetypes.StoreUpgrades{Added: added})
+	}
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdked25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+func TestTestnetify(t *testing.T) {
+	if sdk.GetConfig().GetBech32AccountAddrPrefix() != consensus.AddrPrefix {
+		consensus.SetWalletConfig()
+	}
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, NewAppOptionsWithFlagHome(t.TempDir()), EmptyWasmOptions)
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: 10, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, app.DistrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
+	require.NoError(t, app.GovKeeper.Params.Set(ctx, govv1.DefaultParams()))
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = consensus.DefaultHippoDenom
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, stakingParams))
+	bondDenom := stakingParams.BondDenom
+
+	// a validator of the original network
+	mainnetOperator := sdk.AccAddress([]byte("mainnet_operator____"))
+	tokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, mainnetOperator, coins))
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(mainnetOperator).String(), sdked25519.GenPrivKey().PubKey(), sdk.NewCoin(bondDenom, tokens),
+		stakingtypes.Description{Moniker: "mainnet"}, stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+		math.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(ctx, msg)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	valPubKey := ed25519.GenPrivKey().PubKey()
+	operator := sdk.AccAddress([]byte("testnet_operator____"))
+	user := sdk.AccAddress([]byte("testnet_user________"))
+	fund := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+
+	require.ErrorContains(t, app.testnetify(ctx, TestnetOptions{NewOperatorAddress: operator, VotingPeriod: time.Minute}), "pubkey is missing")
+	require.NoError(t, app.testnetify(ctx, TestnetOptions{
+		NewValPubKey:       valPubKey,
+		NewOperatorAddress: operator,
+		UpgradeToTrigger:   Upgrades[len(Upgrades)-1].UpgradeName,
+		AccountsToFund:     []sdk.AccAddress{user},
+		FundAmount:         fund,
+		VotingPeriod:       time.Minute,
+	}))
+
+	// only the local validator is left in the active set
+	updates, err := app.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+	sdkPubKey, err := cryptocodec.FromCmtPubKeyInterface(valPubKey)
+	require.NoError(t, err)
+	cmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(sdkPubKey)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, cmtPubKey, updates[0].PubKey)
+
+	validator, err := app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operator))
+	require.NoError(t, err)
+	require.True(t, validator.IsBonded())
+	totalBonded, err := app.StakingKeeper.TotalBondedTokens(ctx)
+	require.NoError(t, err)
+	require.True(t, validator.Tokens.MulRaw(4).GTE(totalBonded.MulRaw(3)), "validator holds three quarters of the bonded tokens")
+	delegation, err := app.StakingKeeper.GetDelegation(ctx, operator, sdk.ValAddress(operator))
+	require.NoError(t, err)
+	require.Equal(t, validator.DelegatorShares, delegation.Shares)
+	_, err = app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(valPubKey.Address()))
+	require.NoError(t, err)
+
+	govParams, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, *govParams.VotingPeriod)
+	require.Equal(t, 30*time.Second, *govParams.ExpeditedVotingPeriod)
+
+	require.Equal(t, fund, app.BankKeeper.GetAllBalances(ctx, user))
+
+	plan, err := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, Upgrades[len(Upgrades)-1].UpgradeName, plan.Name)
+	require.Equal(t, ctx.BlockHeight()+1, plan.Height)
+}
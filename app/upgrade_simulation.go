@@ -0,0 +1,407 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/hippocrat-dao/hippo-protocol/app/upgrades"
+)
+
+// UpgradeSimulationOptions configures SimulateUpgrade.
+type UpgradeSimulationOptions struct {
+	// Height is the height the upgrade is applied at, the height after the
+	// latest one when zero.
+	Height int64
+	// Time is the block time the upgrade is applied at, the current time
+	// when zero.
+	Time time.Time
+	// SkipInvariants skips running the invariants after the upgrade.
+	SkipInvariants bool
+}
+
+// UpgradeSimulation reports the effects of an upgrade applied by
+// SimulateUpgrade.
+type UpgradeSimulation struct {
+	Name           string                `json:"name"`
+	Height         int64                 `json:"height"`
+	ModuleVersions []ModuleVersionChange `json:"module_versions"`
+	Params         []ParamsChange        `json:"params"`
+	Invariants     []InvariantResult     `json:"invariants"`
+}
+
+// BrokenInvariants returns the number of invariants broken after the upgrade.
+func (s UpgradeSimulation) BrokenInvariants() int {
+	broken := 0
+	for _, result := range s.Invariants {
+		if result.Broken {
+			broken++
+		}
+	}
+	return broken
+}
+
+// ModuleVersionChange is the consensus version of a module before and after
+// an upgrade, zero when the module is not in the version map.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// ParamsChange is the response of a Params query before and after an
+// upgrade, nil when the query fails, e.g. because the module is added by the
+// upgrade.
+type ParamsChange struct {
+	Query  string          `json:"query"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// InvariantResult is the result of an invariant after an upgrade.
+type InvariantResult struct {
+	Route   string `json:"route"`
+	Broken  bool   `json:"broken"`
+	Message string `json:"message,omitempty"`
+}
+
+// UpgradeByName returns the upgrade of Upgrades named name.
+func UpgradeByName(name string) (upgrades.Upgrade, bool) {
+	for _, u := range Upgrades {
+		if u.UpgradeName == name {
+			return u, true
+		}
+	}
+	return upgrades.Upgrade{}, false
+}
+
+// LoadForUpgrade loads the stores at height, or the latest height when
+// height is -1, applying the StoreUpgrades of the named upgrade the stores
+// are missing. Added, renamed and deleted stores only change the working
+// state, which is never committed by SimulateUpgrade. The app must have been
+// created without loading the latest version.
+func (app *App) LoadForUpgrade(name string, height int64) error {
+	u, ok := UpgradeByName(name)
+	if !ok {
+		return fmt.Errorf("unknown upgrade %s", name)
+	}
+
+	app.SetStoreLoader(func(ms storetypes.CommitMultiStore) error {
+		version := height
+		if version == -1 {
+			version = ms.LastCommitID().Version
+		}
+		storeUpgrades, err := pendingStoreUpgrades(ms, version, u.StoreUpgrades)
+		if err != nil {
+			return err
+		}
+		return ms.LoadVersionAndUpgrade(version, storeUpgrades)
+	})
+	return app.LoadLatestVersion()
+}
+
+// pendingStoreUpgrades returns the store upgrades not applied to the stores
+// committed at version yet.
+func pendingStoreUpgrades(ms storetypes.CommitMultiStore, version int64, upgrades storetypes.StoreUpgrades) (*storetypes.StoreUpgrades, error) {
+	rs, ok := ms.(*rootmulti.Store)
+	if !ok || version == 0 {
+		return &upgrades, nil
+	}
+	commitInfo, err := rs.GetCommitInfo(version)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]bool, len(commitInfo.StoreInfos))
+	for _, storeInfo := range commitInfo.StoreInfos {
+		stored[storeInfo.Name] = true
+	}
+
+	pending := &storetypes.StoreUpgrades{}
+	for _, name := range upgrades.Added {
+		if !stored[name] {
+			pending.Added = append(pending.Added, name)
+		}
+	}
+	for _, rename := range upgrades.Renamed {
+		if stored[rename.OldKey] {
+			pending.Renamed = append(pending.Renamed, rename)
+		}
+	}
+	for _, name := range upgrades.Deleted {
+		if stored[name] {
+			pending.Deleted = append(pending.Deleted, name)
+		}
+	}
+	return pending, nil
+}
+
+// InitFromGenesis initializes the working state from appGenesis without
+// committing it, to simulate an upgrade of the chain it was exported from.
+// The modules with a genesis missing from its app state did not exist on
+// that chain, so they are left out of the module version map. Gentxs are only delivered by
+// a running chain, so appGenesis must be an export without any.
+func (app *App) InitFromGenesis(appGenesis *genutiltypes.AppGenesis) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	var genesisState GenesisState
+	if err := json.Unmarshal(appGenesis.AppState, &genesisState); err != nil {
+		return fmt.Errorf("invalid app state: %w", err)
+	}
+	if genTxs := genutiltypes.GetGenesisStateFromAppState(app.appCodec, genesisState).GenTxs; len(genTxs) > 0 {
+		return fmt.Errorf("genesis has %d gentxs, expected an export of the state of a chain", len(genTxs))
+	}
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: appGenesis.ChainID,
+		Height:  appGenesis.InitialHeight,
+		Time:    appGenesis.GenesisTime,
+	})
+	if appGenesis.Consensus != nil && appGenesis.Consensus.Params != nil {
+		if err := app.StoreConsensusParams(ctx, appGenesis.Consensus.Params.ToProto()); err != nil {
+			return err
+		}
+	}
+
+	exported, err := app.genesisModules(nil)
+	if err != nil {
+		return err
+	}
+	vm := app.ModuleManager.GetVersionMap()
+	for _, name := range exported {
+		if _, ok := genesisState[name]; !ok {
+			delete(vm, name)
+		}
+	}
+	if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, vm); err != nil {
+		return err
+	}
+
+	_, err = app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
+	return err
+}
+
+// SimulateUpgrade applies the handler of the named upgrade to the loaded
+// state in a cached branch, and reports the module versions and params it
+// changes and the invariants after it. The branch is discarded, so the state
+// of the app is left as it was.
+func (app *App) SimulateUpgrade(name string, opts UpgradeSimulationOptions) (*UpgradeSimulation, error) {
+	if _, ok := UpgradeByName(name); !ok {
+		return nil, fmt.Errorf("unknown upgrade %s", name)
+	}
+	if opts.Height == 0 {
+		opts.Height = app.LastBlockHeight() + 1
+	}
+	if opts.Time.IsZero() {
+		opts.Time = time.Now().UTC()
+	}
+
+	ctx, _ := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: app.ChainID(),
+		Height:  opts.Height,
+		Time:    opts.Time,
+	}).CacheContext()
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	doneHeight, err := app.UpgradeKeeper.GetDoneHeight(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if doneHeight > 0 {
+		return nil, fmt.Errorf("upgrade %s was applied at height %d", name, doneHeight)
+	}
+
+	versionsBefore, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paramsBefore := app.queryParams(ctx)
+
+	if err := app.applyUpgrade(ctx, upgradetypes.Plan{Name: name, Height: opts.Height}); err != nil {
+		return nil, fmt.Errorf("upgrade %s failed: %w", name, err)
+	}
+
+	versionsAfter, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paramsAfter := app.queryParams(ctx)
+
+	simulation := &UpgradeSimulation{
+		Name:           name,
+		Height:         opts.Height,
+		ModuleVersions: diffVersionMaps(versionsBefore, versionsAfter),
+		Params:         diffParams(paramsBefore, paramsAfter),
+	}
+	if !opts.SkipInvariants {
+		simulation.Invariants = app.runInvariants(ctx)
+	}
+	return simulation, nil
+}
+
+// applyUpgrade applies plan like the upgrade module does at the upgrade
+// height, returning the panics of its handler as errors.
+func (app *App) applyUpgrade(ctx sdk.Context, plan upgradetypes.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+}
+
+func diffVersionMaps(before, after module.VersionMap) []ModuleVersionChange {
+	var changes []ModuleVersionChange
+	for name, to := range after {
+		if from := before[name]; from != to {
+			changes = append(changes, ModuleVersionChange{Module: name, From: from, To: to})
+		}
+	}
+	for name, from := range before {
+		if _, ok := after[name]; !ok {
+			changes = append(changes, ModuleVersionChange{Module: name, From: from})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Module < changes[j].Module })
+	return changes
+}
+
+// queryParams returns the JSON responses of the Params queries of every
+// query service, by service name. Failing queries are left out.
+func (app *App) queryParams(ctx sdk.Context) map[string]json.RawMessage {
+	params := make(map[string]json.RawMessage)
+	app.interfaceRegistry.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			method := services.Get(i).Methods().ByName("Params")
+			if method == nil {
+				continue
+			}
+			service := string(services.Get(i).FullName())
+			if bz, ok := app.queryParamsOf(ctx, service, method); ok {
+				params[service] = bz
+			}
+		}
+		return true
+	})
+	return params
+}
+
+func (app *App) queryParamsOf(ctx sdk.Context, service string, method protoreflect.MethodDescriptor) (bz json.RawMessage, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	path := fmt.Sprintf("/%s/%s", service, method.Name())
+	handler := app.GRPCQueryRouter().Route(path)
+	if handler == nil {
+		return nil, false
+	}
+	responseType := gogoproto.MessageType(string(method.Output().FullName()))
+	if responseType == nil {
+		return nil, false
+	}
+
+	res, err := handler(ctx, &abci.RequestQuery{Path: path})
+	if err != nil {
+		return nil, false
+	}
+	response, ok := newMessage(responseType)
+	if !ok {
+		return nil, false
+	}
+	if err := app.appCodec.Unmarshal(res.Value, response); err != nil {
+		return nil, false
+	}
+	bz, err = app.appCodec.MarshalJSON(response)
+	if err != nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// newMessage returns a new message of the pointer type t.
+func newMessage(t reflect.Type) (gogoproto.Message, bool) {
+	if t.Kind() != reflect.Ptr {
+		return nil, false
+	}
+	msg, ok := reflect.New(t.Elem()).Interface().(gogoproto.Message)
+	return msg, ok
+}
+
+func diffParams(before, after map[string]json.RawMessage) []ParamsChange {
+	var changes []ParamsChange
+	for service, bz := range after {
+		if string(before[service]) != string(bz) {
+			changes = append(changes, ParamsChange{Query: service, Before: before[service], After: bz})
+		}
+	}
+	for service, bz := range before {
+		if _, ok := after[service]; !ok {
+			changes = append(changes, ParamsChange{Query: service, Before: bz})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Query < changes[j].Query })
+	return changes
+}
+
+// invariantRegistry collects the invariants of the modules.
+type invariantRegistry []registeredInvariant
+
+type registeredInvariant struct {
+	route     string
+	invariant sdk.Invariant
+}
+
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	*r = append(*r, registeredInvariant{route: moduleName + "/" + route, invariant: invar})
+}
+
+// runInvariants runs the invariants of every module, in genesis order.
+func (app *App) runInvariants(ctx sdk.Context) []InvariantResult {
+	var registry invariantRegistry
+	for _, name := range app.ModuleManager.OrderInitGenesis {
+		if mod, ok := app.ModuleManager.Modules[name].(module.HasInvariants); ok {
+			mod.RegisterInvariants(&registry)
+		}
+	}
+
+	results := make([]InvariantResult, 0, len(registry))
+	for _, registered := range registry {
+		results = append(results, runInvariant(ctx, registered))
+	}
+	return results
+}
+
+func runInvariant(ctx sdk.Context, registered registeredInvariant) (result InvariantResult) {
+	result.Route = registered.route
+	defer func() {
+		if r := recover(); r != nil {
+			result.Broken = true
+			result.Message = fmt.Sprintf("panic: %v", r)
+		}
+	}()
+	result.Message, result.Broken = registered.invariant(ctx)
+	if !result.Broken {
+		result.Message = ""
+	}
+	return result
+}
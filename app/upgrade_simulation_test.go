@@ -0,0 +1,132 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	v_3_0_0 "github.com/hippocrat-dao/hippo-protocol/app/upgrades/v3_0_0"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+)
+
+func TestSimulateUpgrade(t *testing.T) {
+	if sdk.GetConfig().GetBech32AccountAddrPrefix() != consensus.AddrPrefix {
+		consensus.SetWalletConfig()
+	}
+
+	db := dbm.NewMemDB()
+	app := New(log.NewNopLogger(), db, nil, true, NewAppOptionsWithFlagHome(t.TempDir()), EmptyWasmOptions)
+
+	// the genesis of a chain preceding v3.0.0, without the modules it adds
+	genesisState := app.DefaultGenesis()
+	for _, name := range v_3_0_0.Upgrade.StoreUpgrades.Added {
+		delete(genesisState, name)
+	}
+	var stakingGenesis stakingtypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenesis)
+	stakingGenesis.Params.BondDenom = consensus.DefaultHippoDenom
+	genesisState[stakingtypes.ModuleName] = app.appCodec.MustMarshalJSON(&stakingGenesis)
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	// the default genesis has no validator, which InitGenesis reports after
+	// initializing every module
+	require.ErrorContains(t, app.InitFromGenesis(&genutiltypes.AppGenesis{
+		ChainID:       "hippo-test-1",
+		InitialHeight: 1,
+		GenesisTime:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		AppState:      appState,
+		Consensus:     &genutiltypes.ConsensusGenesis{Params: cmttypes.DefaultConsensusParams()},
+	}), "validator set is empty")
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	versions, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, versions, valpolicytypes.ModuleName)
+
+	_, err = app.SimulateUpgrade("v99.0.0", UpgradeSimulationOptions{})
+	require.ErrorContains(t, err, "unknown upgrade v99.0.0")
+
+	simulation, err := app.SimulateUpgrade(v_3_0_0.UpgradeName, UpgradeSimulationOptions{Height: 100})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), simulation.Height)
+
+	// the added modules are initialized by the migrations
+	require.Contains(t, simulation.ModuleVersions, ModuleVersionChange{Module: valpolicytypes.ModuleName, From: 0, To: 1})
+	for _, change := range simulation.ModuleVersions {
+		require.Zero(t, change.From, change.Module)
+	}
+	var valpolicyParams, consensusParams *ParamsChange
+	for i, change := range simulation.Params {
+		switch change.Query {
+		case "hippo.valpolicy.v1.Query":
+			valpolicyParams = &simulation.Params[i]
+		case "cosmos.consensus.v1.Query":
+			consensusParams = &simulation.Params[i]
+		}
+	}
+	require.NotNil(t, valpolicyParams)
+	require.Nil(t, valpolicyParams.Before)
+	require.Contains(t, string(valpolicyParams.After), "max_commission_rate")
+	// vote extensions are enabled from the block following the upgrade
+	require.NotNil(t, consensusParams)
+	require.Contains(t, string(consensusParams.After), `"vote_extensions_enable_height":"101"`)
+
+	require.NotEmpty(t, simulation.Invariants)
+	require.Zero(t, simulation.BrokenInvariants(), simulation.Invariants)
+
+	// the upgrade is discarded, so it can be simulated again
+	versions, err = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, versions, valpolicytypes.ModuleName)
+	doneHeight, err := app.UpgradeKeeper.GetDoneHeight(ctx, v_3_0_0.UpgradeName)
+	require.NoError(t, err)
+	require.Zero(t, doneHeight)
+
+	again, err := app.SimulateUpgrade(v_3_0_0.UpgradeName, UpgradeSimulationOptions{Height: 100, SkipInvariants: true})
+	require.NoError(t, err)
+	require.Equal(t, simulation.ModuleVersions, again.ModuleVersions)
+	require.Empty(t, again.Invariants)
+
+	// the committed state loaded by a node
+	commitID := app.CommitMultiStore().Commit()
+	loaded := New(log.NewNopLogger(), db, nil, false, NewAppOptionsWithFlagHome(t.TempDir()), EmptyWasmOptions)
+	require.NoError(t, loaded.LoadForUpgrade(v_3_0_0.UpgradeName, -1))
+	fromDB, err := loaded.SimulateUpgrade(v_3_0_0.UpgradeName, UpgradeSimulationOptions{SkipInvariants: true})
+	require.NoError(t, err)
+	require.Equal(t, commitID.Version+1, fromDB.Height)
+	require.Equal(t, simulation.ModuleVersions, fromDB.ModuleVersions)
+	require.Equal(t, commitID, loaded.CommitMultiStore().LastCommitID())
+}
+
+func TestPendingStoreUpgrades(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storetypes.NewKVStoreKey("bank"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	commitID := ms.Commit()
+
+	pending, err := pendingStoreUpgrades(ms, commitID.Version, storetypes.StoreUpgrades{
+		Added:   []string{"bank", "escrow"},
+		Renamed: []storetypes.StoreRename{{OldKey: "bank", NewKey: "coins"}, {OldKey: "gone", NewKey: "back"}},
+		Deleted: []string{"bank", "gone"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &storetypes.StoreUpgrades{
+		Added:   []string{"escrow"},
+		Renamed: []storetypes.StoreRename{{OldKey: "bank", NewKey: "coins"}},
+		Deleted: []string{"bank"},
+	}, pending)
+}
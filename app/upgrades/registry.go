@@ -0,0 +1,50 @@
+package upgrades
+
+import (
+	"fmt"
+	"sort"
+)
+
+var registry = map[string]Upgrade{}
+
+// Register adds an upgrade to the upgrades of the app. Every upgrade package
+// registers its upgrade from an init function, the app imports the package for
+// its side effects. It panics when the name is not a version or already taken.
+func Register(u Upgrade) {
+	if _, err := parseVersion(u.UpgradeName); err != nil {
+		panic(err)
+	}
+	if _, ok := registry[u.UpgradeName]; ok {
+		panic(fmt.Sprintf("upgrade %s registered twice", u.UpgradeName))
+	}
+	registry[u.UpgradeName] = u
+}
+
+// Registered returns the registered upgrades ordered by version.
+func Registered() []Upgrade {
+	registered := make([]Upgrade, 0, len(registry))
+	for _, u := range registry {
+		registered = append(registered, u)
+	}
+	sort.Slice(registered, func(i, j int) bool {
+		a, _ := parseVersion(registered[i].UpgradeName)
+		b, _ := parseVersion(registered[j].UpgradeName)
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+	return registered
+}
+
+// parseVersion parses an upgrade name of the form vMAJOR.MINOR.PATCH.
+func parseVersion(name string) ([3]int, error) {
+	var version [3]int
+	n, _ := fmt.Sscanf(name, "v%d.%d.%d", &version[0], &version[1], &version[2])
+	if n != 3 || fmt.Sprintf("v%d.%d.%d", version[0], version[1], version[2]) != name {
+		return version, fmt.Errorf("upgrade name %q is not a version of the form vMAJOR.MINOR.PATCH", name)
+	}
+	return version, nil
+}
@@ -1,8 +1,12 @@
 package upgrades
 
 import (
+	"context"
+	"fmt"
+
 	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/hippocrat-dao/hippo-protocol/app/keepers"
 )
@@ -11,9 +15,84 @@ type Upgrade struct {
 	// Upgrade version name, for the upgrade handler, e.g. `v7`
 	UpgradeName string
 
-	// CreateUpgradeHandler defines the function that creates an upgrade handler
+	// CreateUpgradeHandler defines the function that creates an upgrade handler.
+	// It is optional, the module migrations are run when it is nil.
 	CreateUpgradeHandler func(*module.Manager, module.Configurator, *keepers.AppKeepersWithKey) upgradetypes.UpgradeHandler
 
 	// Store upgrades, should be used for any new modules introduced, new modules deleted, or store names renamed.
 	StoreUpgrades storetypes.StoreUpgrades
+
+	// PreUpgradeChecks must pass before anything is migrated, the upgrade
+	// refuses to run otherwise.
+	PreUpgradeChecks []Check
+
+	// ParamChanges are applied after the migrations.
+	ParamChanges []ParamChange
+
+	// PostUpgradeChecks must pass once the migrations ran and the params
+	// changed, the upgrade fails otherwise.
+	PostUpgradeChecks []Check
+}
+
+// Check is a named assertion on the state of the chain.
+type Check struct {
+	Name string
+	Run  func(ctx sdk.Context, keepers *keepers.AppKeepersWithKey) error
+}
+
+// ParamChange updates the params of a module.
+type ParamChange struct {
+	Module string
+	Apply  func(ctx sdk.Context, keepers *keepers.AppKeepersWithKey) error
+}
+
+// RunMigrations creates the upgrade handler of the upgrades without a
+// CreateUpgradeHandler, it runs the migrations of all modules.
+func RunMigrations(mm *module.Manager, configurator module.Configurator, _ *keepers.AppKeepersWithKey) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// Handler returns the upgrade handler registered for the upgrade. It runs the
+// pre-upgrade checks, the handler created by CreateUpgradeHandler, the param
+// changes and the post-upgrade checks, and stops at the first error, which
+// halts the chain at the upgrade height.
+func (u Upgrade) Handler(mm *module.Manager, configurator module.Configurator, keepers *keepers.AppKeepersWithKey) upgradetypes.UpgradeHandler {
+	createUpgradeHandler := u.CreateUpgradeHandler
+	if createUpgradeHandler == nil {
+		createUpgradeHandler = RunMigrations
+	}
+	handler := createUpgradeHandler(mm, configurator, keepers)
+
+	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		for _, check := range u.PreUpgradeChecks {
+			if err := check.Run(ctx, keepers); err != nil {
+				return vm, fmt.Errorf("pre-upgrade check %s failed: %w", check.Name, err)
+			}
+		}
+
+		ctx.Logger().Info("Starting module migrations...")
+		vm, err := handler(ctx, plan, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		for _, change := range u.ParamChanges {
+			if err := change.Apply(ctx, keepers); err != nil {
+				return vm, fmt.Errorf("unable to change %s params: %w", change.Module, err)
+			}
+			ctx.Logger().Info("Params changed", "module", change.Module)
+		}
+
+		for _, check := range u.PostUpgradeChecks {
+			if err := check.Run(ctx, keepers); err != nil {
+				return vm, fmt.Errorf("post-upgrade check %s failed: %w", check.Name, err)
+			}
+		}
+
+		ctx.Logger().Info(fmt.Sprintf("Upgrade %s complete", u.UpgradeName))
+		return vm, nil
+	}
 }
@@ -0,0 +1,76 @@
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app/keepers"
+)
+
+func TestHandler(t *testing.T) {
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	var steps []string
+	step := func(name string, err error) func(sdk.Context, *keepers.AppKeepersWithKey) error {
+		return func(sdk.Context, *keepers.AppKeepersWithKey) error {
+			steps = append(steps, name)
+			return err
+		}
+	}
+	u := Upgrade{
+		UpgradeName: "v9.0.0",
+		CreateUpgradeHandler: func(*module.Manager, module.Configurator, *keepers.AppKeepersWithKey) upgradetypes.UpgradeHandler {
+			return func(_ context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+				steps = append(steps, "migrations")
+				vm["bank"] = 4
+				return vm, nil
+			}
+		},
+		PreUpgradeChecks:  []Check{{Name: "pre", Run: step("pre", nil)}},
+		ParamChanges:      []ParamChange{{Module: "bank", Apply: step("params", nil)}},
+		PostUpgradeChecks: []Check{{Name: "post", Run: step("post", nil)}},
+	}
+
+	vm, err := u.Handler(nil, nil, nil)(ctx, upgradetypes.Plan{Name: u.UpgradeName}, module.VersionMap{"bank": 3})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"bank": 4}, vm)
+	require.Equal(t, []string{"pre", "migrations", "params", "post"}, steps)
+
+	// a failed pre-upgrade check refuses to run the migrations
+	steps = nil
+	u.PreUpgradeChecks = []Check{{Name: "pre", Run: step("pre", errors.New("bad state"))}}
+	vm, err = u.Handler(nil, nil, nil)(ctx, upgradetypes.Plan{Name: u.UpgradeName}, module.VersionMap{"bank": 3})
+	require.EqualError(t, err, "pre-upgrade check pre failed: bad state")
+	require.Equal(t, module.VersionMap{"bank": 3}, vm)
+	require.Equal(t, []string{"pre"}, steps)
+
+	steps = nil
+	u.PreUpgradeChecks = nil
+	u.PostUpgradeChecks = []Check{{Name: "post", Run: step("post", errors.New("bad state"))}}
+	_, err = u.Handler(nil, nil, nil)(ctx, upgradetypes.Plan{Name: u.UpgradeName}, module.VersionMap{"bank": 3})
+	require.EqualError(t, err, "post-upgrade check post failed: bad state")
+	require.Equal(t, []string{"migrations", "params", "post"}, steps)
+}
+
+func TestRegistered(t *testing.T) {
+	defer func() { registry = map[string]Upgrade{} }()
+	for _, name := range []string{"v10.0.0", "v1.0.2", "v2.0.0", "v1.0.10"} {
+		Register(Upgrade{UpgradeName: name})
+	}
+
+	var names []string
+	for _, u := range Registered() {
+		names = append(names, u.UpgradeName)
+	}
+	require.Equal(t, []string{"v1.0.2", "v1.0.10", "v2.0.0", "v10.0.0"}, names)
+
+	require.PanicsWithValue(t, "upgrade v2.0.0 registered twice", func() { Register(Upgrade{UpgradeName: "v2.0.0"}) })
+	require.Panics(t, func() { Register(Upgrade{UpgradeName: "v2"}) })
+	require.Panics(t, func() { Register(Upgrade{UpgradeName: "v2.0.0-rc1"}) })
+}
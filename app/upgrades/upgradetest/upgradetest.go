@@ -0,0 +1,181 @@
+// Package upgradetest checks the upgrades of app/upgrades. The app tests run
+// Run for every registered upgrade.
+package upgradetest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	hippoapp "github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/app/upgrades"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+// Run checks that the upgrade is registered, that its store upgrades fit the
+// stores mounted by the app, and that its handler runs cleanly.
+//
+// Run only checks the store upgrades and the module versions: the chain it
+// upgrades is initialized at the module versions of the app, less the added
+// modules. An upgrade adding no store runs on a chain already at the versions
+// of the app, so its migrations and param changes must be tested by its own
+// package.
+func Run(t *testing.T, u upgrades.Upgrade) {
+	t.Helper()
+	if sdk.GetConfig().GetBech32AccountAddrPrefix() != consensus.AddrPrefix {
+		consensus.SetWalletConfig()
+	}
+
+	registered, ok := hippoapp.UpgradeByName(u.UpgradeName)
+	require.True(t, ok, "upgrade %s is not registered", u.UpgradeName)
+	require.Equal(t, u.StoreUpgrades, registered.StoreUpgrades)
+
+	app := hippoapp.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), hippoapp.EmptyWasmOptions)
+	checkStoreUpgrades(t, app.GetKVStoreKey(), u.StoreUpgrades)
+	checkHandler(t, app, u)
+}
+
+// checkStoreUpgrades loads the stores of a chain preceding the upgrade with
+// the store upgrades and the stores of the app.
+func checkStoreUpgrades(t *testing.T, keys map[string]*storetypes.KVStoreKey, storeUpgrades storetypes.StoreUpgrades) {
+	t.Helper()
+	listed := make(map[string]bool)
+	list := func(name string) {
+		require.False(t, listed[name], "store %s is upgraded twice", name)
+		listed[name] = true
+	}
+	for _, name := range storeUpgrades.Added {
+		list(name)
+		require.Contains(t, keys, name, "added store %s is not mounted", name)
+	}
+	for _, rename := range storeUpgrades.Renamed {
+		list(rename.OldKey)
+		list(rename.NewKey)
+		require.Contains(t, keys, rename.NewKey, "renamed store %s is not mounted", rename.NewKey)
+		require.NotContains(t, keys, rename.OldKey, "renamed store %s is still mounted", rename.OldKey)
+	}
+	for _, name := range storeUpgrades.Deleted {
+		list(name)
+	}
+
+	db := dbm.NewMemDB()
+	before := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for name, key := range keys {
+		if !listed[name] {
+			before.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+	}
+	for _, rename := range storeUpgrades.Renamed {
+		before.MountStoreWithDB(storetypes.NewKVStoreKey(rename.OldKey), storetypes.StoreTypeIAVL, nil)
+	}
+	for _, name := range storeUpgrades.Deleted {
+		before.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, before.LoadLatestVersion())
+	before.Commit()
+
+	after := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range keys {
+		after.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, after.LoadLatestVersionAndUpgrade(&storeUpgrades))
+}
+
+// checkHandler initializes the state of a chain without the modules added by
+// the upgrade and simulates the upgrade on it. The handler, its checks and its
+// param changes must succeed without breaking an invariant, and the added
+// modules must reach the version of the app.
+func checkHandler(t *testing.T, app *hippoapp.App, u upgrades.Upgrade) {
+	t.Helper()
+	genesisState := genesisWithValidator(t, app.AppCodec(), app.DefaultGenesis())
+	for _, name := range u.StoreUpgrades.Added {
+		require.Contains(t, app.ModuleManager.Modules, name, "added store %s is not the store of a module", name)
+		delete(genesisState, name)
+	}
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	require.NoError(t, app.InitFromGenesis(&genutiltypes.AppGenesis{
+		ChainID:       "hippo-test-1",
+		InitialHeight: 1,
+		GenesisTime:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		AppState:      appState,
+		Consensus:     &genutiltypes.ConsensusGenesis{Params: cmttypes.DefaultConsensusParams()},
+	}))
+
+	simulation, err := app.SimulateUpgrade(u.UpgradeName, hippoapp.UpgradeSimulationOptions{Height: 100})
+	require.NoError(t, err)
+	require.Zero(t, simulation.BrokenInvariants(), simulation.Invariants)
+
+	// the upgrade brings every module to the version of the app
+	versions := app.ModuleManager.GetVersionMap()
+	for _, name := range u.StoreUpgrades.Added {
+		require.Contains(t, simulation.ModuleVersions, hippoapp.ModuleVersionChange{Module: name, To: versions[name]})
+	}
+	for _, change := range simulation.ModuleVersions {
+		require.Equal(t, versions[change.Module], change.To, change.Module)
+	}
+}
+
+// genesisWithValidator adds a bonded validator staking the hippo denom to
+// genesisState.
+func genesisWithValidator(t *testing.T, cdc codec.Codec, genesisState hippoapp.GenesisState) hippoapp.GenesisState {
+	t.Helper()
+	pubKey := ed25519.GenPrivKey().PubKey()
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	address := sdk.AccAddress(pubKey.Address())
+	tokens := sdk.DefaultPowerReduction
+	bondedTokens := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, tokens))
+
+	var authGenesis authtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenesis)
+	accounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(address, nil, 0, 0)})
+	require.NoError(t, err)
+	authGenesis.Accounts = append(authGenesis.Accounts, accounts...)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenesis)
+
+	var stakingGenesis stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenesis)
+	stakingGenesis.Params.BondDenom = consensus.DefaultHippoDenom
+	stakingGenesis.Validators = append(stakingGenesis.Validators, stakingtypes.Validator{
+		OperatorAddress:   sdk.ValAddress(address).String(),
+		ConsensusPubkey:   pubKeyAny,
+		Status:            stakingtypes.Bonded,
+		Tokens:            tokens,
+		DelegatorShares:   math.LegacyNewDecFromInt(tokens),
+		Description:       stakingtypes.NewDescription("validator", "", "", "", ""),
+		Commission:        stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(1, 2)),
+		MinSelfDelegation: math.OneInt(),
+	})
+	stakingGenesis.Delegations = append(stakingGenesis.Delegations, stakingtypes.NewDelegation(address.String(), sdk.ValAddress(address).String(), math.LegacyNewDecFromInt(tokens)))
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenesis)
+
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   bondedTokens,
+	})
+	bankGenesis.Supply = bankGenesis.Supply.Add(bondedTokens...)
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
+	return genesisState
+}
@@ -12,3 +12,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 }
+
+func init() {
+	upgrades.Register(Upgrade)
+}
@@ -19,8 +19,6 @@ func CreateUpgradeHandler(
 ) upgradetypes.UpgradeHandler {
 	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		ctx := sdk.UnwrapSDKContext(c)
-		ctx.Logger().Info("Starting module migrations...")
-
 		if vm[capabilitytypes.ModuleName] == 0 {
 			vm[capabilitytypes.ModuleName] = 1
 		}
@@ -30,12 +28,6 @@ func CreateUpgradeHandler(
 			return vm, err
 		}
 
-		vm, err := mm.RunMigrations(ctx, configurator, vm) // Run migrations for all modules
-		if err != nil {
-			return vm, err
-		}
-
-		ctx.Logger().Info("Upgrade v1.0.1 complete")
-		return vm, nil
+		return mm.RunMigrations(ctx, configurator, vm) // Run migrations for all modules
 	}
 }
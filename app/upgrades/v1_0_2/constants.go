@@ -9,6 +9,9 @@ const (
 )
 
 var Upgrade = upgrades.Upgrade{
-	UpgradeName:          UpgradeName,
-	CreateUpgradeHandler: CreateUpgradeHandler,
+	UpgradeName: UpgradeName,
+}
+
+func init() {
+	upgrades.Register(Upgrade)
 }
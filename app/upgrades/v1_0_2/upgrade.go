@@ -1,31 +0,0 @@
-package v_1_0_2
-
-import (
-	"context"
-
-	upgradetypes "cosmossdk.io/x/upgrade/types"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/module"
-
-	"github.com/hippocrat-dao/hippo-protocol/app/keepers"
-)
-
-func CreateUpgradeHandler(
-	mm *module.Manager,
-	configurator module.Configurator,
-	keepers *keepers.AppKeepersWithKey,
-) upgradetypes.UpgradeHandler {
-	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
-		ctx := sdk.UnwrapSDKContext(c)
-		ctx.Logger().Info("Starting module migrations...")
-
-		vm, err := mm.RunMigrations(ctx, configurator, vm) // Run migrations for all modules
-		if err != nil {
-			return vm, err
-		}
-
-		ctx.Logger().Info("Upgrade v1.0.2 complete")
-		return vm, nil
-	}
-}
@@ -11,9 +11,15 @@ const (
 )
 
 var Upgrade = upgrades.Upgrade{
-	UpgradeName:          UpgradeName,
-	CreateUpgradeHandler: CreateUpgradeHandler,
+	UpgradeName: UpgradeName,
 	StoreUpgrades: storetypes.StoreUpgrades{
 		Added: []string{wasmtypes.ModuleName},
 	},
+	ParamChanges: []upgrades.ParamChange{
+		{Module: wasmtypes.ModuleName, Apply: SetWasmParams},
+	},
+}
+
+func init() {
+	upgrades.Register(Upgrade)
 }
@@ -105,7 +105,7 @@ func TestUpgradeHandlerIntegration(t *testing.T) {
 	moduleManager := module.NewManager()
 
 	// Create upgrade handler
-	handler := CreateUpgradeHandler(moduleManager, configurator, appKeepers)
+	handler := Upgrade.Handler(moduleManager, configurator, appKeepers)
 
 	// Create a plan
 	plan := upgradetypes.Plan{
@@ -228,7 +228,7 @@ func TestUpgradeReadiness(t *testing.T) {
 	// Check upgrade object is complete
 	require.NotNil(t, Upgrade, "upgrade object should exist")
 	require.Equal(t, UpgradeName, Upgrade.UpgradeName, "upgrade name should match")
-	require.NotNil(t, Upgrade.CreateUpgradeHandler, "upgrade handler should exist")
+	require.NotEmpty(t, Upgrade.ParamChanges, "wasm params change should exist")
 	require.NotNil(t, Upgrade.StoreUpgrades, "store upgrades should be defined")
 
 	// Check wasm module configuration
@@ -236,7 +236,7 @@ func TestUpgradeReadiness(t *testing.T) {
 
 	// Verify upgrade handler can be created
 	require.NotPanics(t, func() {
-		handler := CreateUpgradeHandler(nil, nil, nil)
+		handler := Upgrade.Handler(nil, nil, nil)
 		require.NotNil(t, handler, "handler should be created")
 	}, "upgrade handler creation should not panic")
 
@@ -1,40 +1,20 @@
 package v_2_0_0
 
 import (
-	"context"
-
-	upgradetypes "cosmossdk.io/x/upgrade/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/module"
 
 	errorsmod "cosmossdk.io/errors"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/hippocrat-dao/hippo-protocol/app/keepers"
 )
 
-func CreateUpgradeHandler(
-	mm *module.Manager,
-	configurator module.Configurator,
-	keepers *keepers.AppKeepersWithKey,
-) upgradetypes.UpgradeHandler {
-	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
-		ctx := sdk.UnwrapSDKContext(c)
-		ctx.Logger().Info("Starting module migrations...")
-
-		vm, err := mm.RunMigrations(ctx, configurator, vm) // Run migrations for all modules
-		if err != nil {
-			return vm, err
-		}
-
-		wasmParams := wasmtypes.DefaultParams()
-		wasmParams.CodeUploadAccess = wasmtypes.AllowEverybody
-		wasmParams.InstantiateDefaultPermission = wasmtypes.AccessTypeEverybody
-		if err := keepers.WasmKeeper.SetParams(ctx, wasmParams); err != nil {
-			return vm, errorsmod.Wrapf(err, "unable to set CosmWasm params")
-		}
-
-		ctx.Logger().Info("Upgrade v2.0.0 complete")
-		return vm, nil
+// SetWasmParams lets everybody upload and instantiate contracts.
+func SetWasmParams(ctx sdk.Context, keepers *keepers.AppKeepersWithKey) error {
+	wasmParams := wasmtypes.DefaultParams()
+	wasmParams.CodeUploadAccess = wasmtypes.AllowEverybody
+	wasmParams.InstantiateDefaultPermission = wasmtypes.AccessTypeEverybody
+	if err := keepers.WasmKeeper.SetParams(ctx, wasmParams); err != nil {
+		return errorsmod.Wrapf(err, "unable to set CosmWasm params")
 	}
+	return nil
 }
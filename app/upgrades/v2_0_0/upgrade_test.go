@@ -6,6 +6,7 @@ import (
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
 	"github.com/stretchr/testify/require"
 )
@@ -16,7 +17,9 @@ var once sync.Once
 // This is necessary because cosmos-sdk's config can only be sealed once per process
 func setupWalletConfig() {
 	once.Do(func() {
-		consensus.SetWalletConfig()
+		if sdk.GetConfig().GetBech32AccountAddrPrefix() != consensus.AddrPrefix {
+			consensus.SetWalletConfig()
+		}
 	})
 }
 
@@ -39,7 +42,7 @@ func TestUpgradeStoreConfiguration(t *testing.T) {
 func TestUpgradeConfiguration(t *testing.T) {
 	require.NotNil(t, Upgrade, "upgrade configuration should not be nil")
 	require.Equal(t, UpgradeName, Upgrade.UpgradeName, "upgrade name should match")
-	require.NotNil(t, Upgrade.CreateUpgradeHandler, "upgrade handler creator should not be nil")
+	require.Equal(t, wasmtypes.ModuleName, Upgrade.ParamChanges[0].Module, "wasm params should be changed")
 	require.NotNil(t, Upgrade.StoreUpgrades, "store upgrades should not be nil")
 }
 
@@ -47,9 +50,9 @@ func TestUpgradeConfiguration(t *testing.T) {
 func TestUpgradeHandlerCreation(t *testing.T) {
 	setupWalletConfig()
 
-	// Test that CreateUpgradeHandler doesn't panic and returns a valid handler
+	// Test that Handler doesn't panic and returns a valid handler
 	require.NotPanics(t, func() {
-		handler := CreateUpgradeHandler(nil, nil, nil)
+		handler := Upgrade.Handler(nil, nil, nil)
 		require.NotNil(t, handler, "handler should not be nil")
 	})
 }
@@ -109,7 +112,7 @@ func TestUpgradeHandlerSignature(t *testing.T) {
 	setupWalletConfig()
 
 	// Create a handler
-	handler := CreateUpgradeHandler(nil, nil, nil)
+	handler := Upgrade.Handler(nil, nil, nil)
 
 	// Verify it's a valid upgrade handler function
 	require.NotNil(t, handler)
@@ -0,0 +1,45 @@
+package v_3_0_0
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/hippocrat-dao/hippo-protocol/app/upgrades"
+	audittypes "github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+	communitytaxtypes "github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	escrowtypes "github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+	feeabstypes "github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	feeburntypes "github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+	keysharetypes "github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+	liquidstaketypes "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	sponsortypes "github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+	treasurytypes "github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+const (
+	UpgradeName = "v3.0.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName: UpgradeName,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{escrowtypes.StoreKey, audittypes.StoreKey, zktypes.StoreKey, keysharetypes.StoreKey, schematypes.StoreKey, sponsortypes.StoreKey, contractsponsortypes.StoreKey, feeabstypes.StoreKey, oracletypes.StoreKey, liquidstaketypes.StoreKey, valpolicytypes.StoreKey, treasurytypes.StoreKey, communitytaxtypes.StoreKey, feeburntypes.StoreKey},
+	},
+	PreUpgradeChecks: []upgrades.Check{
+		{Name: "bond denom", Run: CheckBondDenom},
+	},
+	ParamChanges: []upgrades.ParamChange{
+		{Module: consensusparamtypes.ModuleName, Apply: EnableVoteExtensions},
+	},
+	PostUpgradeChecks: []upgrades.Check{
+		{Name: "validator policy", Run: CheckValidatorPolicy},
+	},
+}
+
+func init() {
+	upgrades.Register(Upgrade)
+}
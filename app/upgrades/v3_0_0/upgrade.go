@@ -0,0 +1,49 @@
+package v_3_0_0
+
+import (
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/app/keepers"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+// CheckBondDenom makes sure the chain stakes the hippo denom, which the
+// default params of the feeabs and sponsor modules added in this upgrade use.
+func CheckBondDenom(ctx sdk.Context, keepers *keepers.AppKeepersWithKey) error {
+	bondDenom, err := keepers.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if bondDenom != consensus.DefaultHippoDenom {
+		return fmt.Errorf("bond denom is %s, expected %s", bondDenom, consensus.DefaultHippoDenom)
+	}
+	return nil
+}
+
+// EnableVoteExtensions enables vote extensions from the block following the
+// upgrade, for the oracle to aggregate the prices its validators report.
+func EnableVoteExtensions(ctx sdk.Context, keepers *keepers.AppKeepersWithKey) error {
+	params, err := keepers.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.Abci != nil && params.Abci.VoteExtensionsEnableHeight > 0 {
+		return nil
+	}
+	params.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: ctx.BlockHeight() + 1}
+	return keepers.ConsensusParamsKeeper.ParamsStore.Set(ctx, params)
+}
+
+// CheckValidatorPolicy reports the validators that violate the new validator
+// policy. They are not adjusted, governance decides how to bring them in line.
+func CheckValidatorPolicy(ctx sdk.Context, keepers *keepers.AppKeepersWithKey) error {
+	violations, err := keepers.ValPolicyKeeper.EnforcePolicy(ctx, false)
+	if err != nil {
+		return err
+	}
+	ctx.Logger().Info("Validator policy checked", "violations", len(violations))
+	return nil
+}
@@ -0,0 +1,54 @@
+package v_3_0_0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	audittypes "github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+	communitytaxtypes "github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	escrowtypes "github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+	feeabstypes "github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	feeburntypes "github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+	keysharetypes "github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+	liquidstaketypes "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	sponsortypes "github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+	treasurytypes "github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+// TestUpgradeNameConstant verifies the upgrade name constant
+func TestUpgradeNameConstant(t *testing.T) {
+	require.Equal(t, "v3.0.0", UpgradeName, "upgrade name should be v3.0.0")
+}
+
+// TestUpgradeConfiguration verifies the upgrade configuration
+func TestUpgradeConfiguration(t *testing.T) {
+	require.Equal(t, UpgradeName, Upgrade.UpgradeName, "upgrade name should match")
+	require.Nil(t, Upgrade.CreateUpgradeHandler, "upgrade should only run the module migrations")
+	require.NotNil(t, Upgrade.Handler(nil, nil, nil), "handler should not be nil")
+}
+
+// TestUpgradeStoreConfiguration verifies the stores added by the upgrade
+func TestUpgradeStoreConfiguration(t *testing.T) {
+	require.Contains(t, Upgrade.StoreUpgrades.Added, escrowtypes.StoreKey, "escrow store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, audittypes.StoreKey, "audit store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, zktypes.StoreKey, "zk store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, keysharetypes.StoreKey, "keyshare store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, schematypes.StoreKey, "schema store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, sponsortypes.StoreKey, "sponsor store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, contractsponsortypes.StoreKey, "contractsponsor store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, feeabstypes.StoreKey, "feeabs store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, oracletypes.StoreKey, "oracle store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, liquidstaketypes.StoreKey, "liquidstake store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, valpolicytypes.StoreKey, "valpolicy store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, treasurytypes.StoreKey, "treasury store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, communitytaxtypes.StoreKey, "communitytax store should be added")
+	require.Contains(t, Upgrade.StoreUpgrades.Added, feeburntypes.StoreKey, "feeburn store should be added")
+	require.Empty(t, Upgrade.StoreUpgrades.Deleted, "should not delete any stores")
+	require.Empty(t, Upgrade.StoreUpgrades.Renamed, "should not rename any stores")
+}
@@ -0,0 +1,16 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/hippocrat-dao/hippo-protocol/app/upgrades"
+	"github.com/hippocrat-dao/hippo-protocol/app/upgrades/upgradetest"
+)
+
+func TestUpgrades(t *testing.T) {
+	for _, u := range upgrades.Registered() {
+		t.Run(u.UpgradeName, func(t *testing.T) {
+			upgradetest.Run(t, u)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package wasmbinding
+
+// HippoQuery is the set of custom queries contracts can issue against the
+// chain. Exactly one field must be set.
+type HippoQuery struct {
+	// ZK verifies zero-knowledge proofs with the native verifiers.
+	ZK *ZKQuery `json:"zk,omitempty"`
+	// Schema resolves references into the healthcare schema registry.
+	Schema *SchemaQuery `json:"schema,omitempty"`
+	// ContractSponsor reports the fee sponsorship quotas of contracts.
+	ContractSponsor *ContractSponsorQuery `json:"contract_sponsor,omitempty"`
+	// Oracle reports the prices aggregated from validator vote extensions.
+	Oracle *OracleQuery `json:"oracle,omitempty"`
+}
+
+// ZKQuery selects the proof system to verify against. Exactly one field must
+// be set.
+type ZKQuery struct {
+	VerifyGroth16     *VerifyGroth16     `json:"verify_groth16,omitempty"`
+	VerifyBulletproof *VerifyBulletproof `json:"verify_bulletproof,omitempty"`
+}
+
+// VerifyGroth16 mirrors MsgVerifyGroth16. Binary fields are base64 encoded.
+type VerifyGroth16 struct {
+	VerifyingKey []byte   `json:"verifying_key"`
+	Proof        []byte   `json:"proof"`
+	PublicInputs [][]byte `json:"public_inputs"`
+}
+
+// VerifyBulletproof mirrors MsgVerifyBulletproof. Binary fields are base64
+// encoded.
+type VerifyBulletproof struct {
+	Proof           []byte   `json:"proof"`
+	Commitments     [][]byte `json:"commitments"`
+	BitSize         uint32   `json:"bit_size"`
+	TranscriptLabel string   `json:"transcript_label"`
+}
+
+// VerifyProofResponse is returned for every ZK query. A proof that is well
+// formed but does not verify yields Verified false together with the reason,
+// so contracts can branch on it; malformed input fails the query instead.
+type VerifyProofResponse struct {
+	Verified bool   `json:"verified"`
+	Error    string `json:"error,omitempty"`
+}
+
+// SchemaQuery selects the schema registry query. Exactly one field must be
+// set.
+type SchemaQuery struct {
+	ValidateSchemaRef *ValidateSchemaRef `json:"validate_schema_ref,omitempty"`
+}
+
+// ValidateSchemaRef checks a schema reference. A zero version selects the
+// latest version.
+type ValidateSchemaRef struct {
+	SchemaID string `json:"schema_id"`
+	Version  uint32 `json:"version,omitempty"`
+}
+
+// SchemaRefResponse reports whether a schema reference exists and is active,
+// together with the resolved version document.
+type SchemaRefResponse struct {
+	Exists  bool   `json:"exists"`
+	Active  bool   `json:"active"`
+	Version uint32 `json:"version,omitempty"`
+	URI     string `json:"uri,omitempty"`
+	Hash    []byte `json:"hash,omitempty"`
+}
+
+// ContractSponsorQuery selects the contract fee sponsorship query. Exactly
+// one field must be set.
+type ContractSponsorQuery struct {
+	UserQuota *UserQuota `json:"user_quota,omitempty"`
+}
+
+// UserQuota asks for the sponsored tx quota of a user for a contract.
+type UserQuota struct {
+	Contract string `json:"contract"`
+	User     string `json:"user"`
+}
+
+// UserQuotaResponse reports the sponsored tx quota of a user. Registered is
+// false when the contract does not sponsor fees. WindowEnd is the unix time
+// in seconds at which Used resets, zero when nothing was used yet.
+type UserQuotaResponse struct {
+	Registered bool   `json:"registered"`
+	Quota      uint64 `json:"quota"`
+	Used       uint64 `json:"used"`
+	Remaining  uint64 `json:"remaining"`
+	WindowEnd  int64  `json:"window_end,omitempty"`
+}
+
+// OracleQuery selects the price oracle query. Exactly one field must be set.
+type OracleQuery struct {
+	Price *OraclePrice `json:"price,omitempty"`
+}
+
+// OraclePrice asks for the latest aggregated price of an asset, e.g.
+// "ATOM/USD".
+type OraclePrice struct {
+	Asset string `json:"asset"`
+}
+
+// OraclePriceResponse reports the latest aggregated price of an asset as a
+// decimal string. Found is false when no price was aggregated yet. BlockTime
+// is the unix time in seconds of the block the price was aggregated in.
+type OraclePriceResponse struct {
+	Found       bool   `json:"found"`
+	Price       string `json:"price,omitempty"`
+	BlockHeight int64  `json:"block_height,omitempty"`
+	BlockTime   int64  `json:"block_time,omitempty"`
+}
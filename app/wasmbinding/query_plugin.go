@@ -0,0 +1,175 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	contractsponsorkeeper "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/keeper"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	oraclekeeper "github.com/hippocrat-dao/hippo-protocol/x/oracle/keeper"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schemakeeper "github.com/hippocrat-dao/hippo-protocol/x/schema/keeper"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	zkkeeper "github.com/hippocrat-dao/hippo-protocol/x/zk/keeper"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+// QueryPlugin answers the hippo custom queries from the native modules.
+type QueryPlugin struct {
+	zkKeeper              *zkkeeper.Keeper
+	schemaKeeper          *schemakeeper.Keeper
+	contractSponsorKeeper *contractsponsorkeeper.Keeper
+	oracleKeeper          *oraclekeeper.Keeper
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(zk *zkkeeper.Keeper, schema *schemakeeper.Keeper, contractSponsor *contractsponsorkeeper.Keeper, oracle *oraclekeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		zkKeeper:              zk,
+		schemaKeeper:          schema,
+		contractSponsorKeeper: contractSponsor,
+		oracleKeeper:          oracle,
+	}
+}
+
+// CustomQuerier dispatches HippoQuery requests to the native modules.
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query HippoQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrap(err, "hippo query")
+		}
+
+		var (
+			res any
+			err error
+		)
+		switch {
+		case query.ZK != nil:
+			res, err = qp.zkQuery(ctx, query.ZK)
+		case query.Schema != nil:
+			res, err = qp.schemaQuery(ctx, query.Schema)
+		case query.ContractSponsor != nil:
+			res, err = qp.contractSponsorQuery(ctx, query.ContractSponsor)
+		case query.Oracle != nil:
+			res, err = qp.oracleQuery(ctx, query.Oracle)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown hippo query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "hippo query response")
+		}
+		return bz, nil
+	}
+}
+
+func (qp *QueryPlugin) zkQuery(ctx sdk.Context, query *ZKQuery) (*VerifyProofResponse, error) {
+	var err error
+	switch {
+	case query.VerifyGroth16 != nil:
+		q := query.VerifyGroth16
+		err = qp.zkKeeper.VerifyGroth16(ctx, q.VerifyingKey, q.Proof, q.PublicInputs)
+	case query.VerifyBulletproof != nil:
+		q := query.VerifyBulletproof
+		err = qp.zkKeeper.VerifyBulletproof(ctx, q.Proof, q.Commitments, q.BitSize, q.TranscriptLabel)
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown zk query variant"}
+	}
+
+	res := &VerifyProofResponse{Verified: err == nil}
+	if err != nil {
+		if !errors.Is(err, zktypes.ErrVerificationFailed) {
+			return nil, err
+		}
+		res.Error = err.Error()
+	}
+	return res, nil
+}
+
+func (qp *QueryPlugin) schemaQuery(ctx sdk.Context, query *SchemaQuery) (*SchemaRefResponse, error) {
+	if query.ValidateSchemaRef == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown schema query variant"}
+	}
+
+	q := query.ValidateSchemaRef
+	schema, version, err := qp.schemaKeeper.ResolveSchemaRef(ctx, q.SchemaID, q.Version)
+	switch {
+	case errors.Is(err, schematypes.ErrSchemaNotFound), errors.Is(err, schematypes.ErrSchemaVersionNotFound):
+		return &SchemaRefResponse{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return &SchemaRefResponse{
+		Exists:  true,
+		Active:  schema.IsActive(version),
+		Version: version.Version,
+		URI:     version.Uri,
+		Hash:    version.Hash,
+	}, nil
+}
+
+func (qp *QueryPlugin) contractSponsorQuery(ctx sdk.Context, query *ContractSponsorQuery) (*UserQuotaResponse, error) {
+	if query.UserQuota == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown contract sponsor query variant"}
+	}
+
+	q := query.UserQuota
+	contract, err := sdk.AccAddressFromBech32(q.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	user, err := sdk.AccAddressFromBech32(q.User)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "user")
+	}
+
+	quota, err := qp.contractSponsorKeeper.QueryUserQuota(ctx, contract, user)
+	switch {
+	case errors.Is(err, contractsponsortypes.ErrSponsorshipNotFound):
+		return &UserQuotaResponse{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	res := &UserQuotaResponse{
+		Registered: true,
+		Quota:      quota.Quota,
+		Used:       quota.Used,
+		Remaining:  quota.Remaining,
+	}
+	if quota.WindowEnd != nil {
+		res.WindowEnd = quota.WindowEnd.Unix()
+	}
+	return res, nil
+}
+
+func (qp *QueryPlugin) oracleQuery(ctx sdk.Context, query *OracleQuery) (*OraclePriceResponse, error) {
+	if query.Price == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown oracle query variant"}
+	}
+
+	price, err := qp.oracleKeeper.GetPrice(ctx, query.Price.Asset)
+	switch {
+	case errors.Is(err, oracletypes.ErrPriceNotFound):
+		return &OraclePriceResponse{}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return &OraclePriceResponse{
+		Found:       true,
+		Price:       price.Price.String(),
+		BlockHeight: price.BlockHeight,
+		BlockTime:   price.BlockTime.Unix(),
+	}, nil
+}
@@ -0,0 +1,222 @@
+package wasmbinding_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/app/wasmbinding"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schemakeeper "github.com/hippocrat-dao/hippo-protocol/x/schema/keeper"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+const (
+	rangeProofHex = "8026afd76427529f11bcc07e29a182e3122bab7595b61237dda31548ba96cc3e4a84148c615bb889cd99bab5519e2e7d815a2469b76b5e6bf56c1051264f9b5b0a75a84e179a21b7701de8b744612ecd96b5e73f2ad4ffda4dde5a0bf0fa5b4490bd0c7ec41b331068f3db152278f5147c876201e741a6817616ece7c58a6507a7736c1fc341bb3ab65cc6e7196855a42eed503f04b56b190fced87eab134400c9fdcb1eb43fc7fed2882b2f56b9eea62ce8a024bca4f23aa4d70afb323d4c0ad3a38d409012207bb35e174a112794008d2c3f8a0d7f4282ab718493096da30d5e432f7917f017e4ee80191990aed9a51d404700c1e441ef3c46e83129aa2f5b4a1047757dc4ce4c11d1ea429c7a95dd95bc13f7c9fd5b4c64c5aa97040948142a72e57ef4658bf2894029fc69dcd893fe5bf72d90aced60e2b4608b0bfa6a06f26414c843a86df58d95f92c1904565898262d1170ad70252445bd883ec208415ef350cb0515a602d37cbb668d78e6f6211fa4caf338513c5e551f3a36b33214c89e9681301b830da28be02204d062ca19b2edacd56fa5ce4c7e1d0a9f1fd85f7049fe27dffc601b41f35dce8b0f61b3c92a8f51ab40299e6bf452c81d95ee1880dede9a6da64b3237451715c8da5970296d3b34b0c9b585b355f31e2b71c46cd49fe004d2ec5371b3029ee2d6d0881d90d73ac81b1d16a82a74f46e36b14e33a6abaf35b81fdccbe00031d8c5918974f53d35973cd7077b839c2dbfcead70236581065006dbb5f1e1541fa6226e172e0e9471a7a0a1ed5aa627d26e9aac140f0b2ddee38a4502fe9f6327e81fdb849cd7c7698e9add48aecab22f512b56fd0b"
+	commitmentHex = "5e50cca6bdd5d8c04e1a2848d74d885647d93b883cb4f182fbb5e3bdbf00506c"
+	basepointHex  = "e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76"
+)
+
+var setupOnce sync.Once
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func setupApp(t *testing.T) (*app.App, sdk.Context) {
+	t.Helper()
+	setupOnce.Do(consensus.SetWalletConfig)
+
+	hippoApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+	ctx := hippoApp.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, hippoApp.ZKKeeper.InitGenesis(ctx, zktypes.DefaultGenesisState()))
+	require.NoError(t, hippoApp.SchemaKeeper.InitGenesis(ctx, schematypes.DefaultGenesisState()))
+	return hippoApp, ctx
+}
+
+func TestCustomQuerierZK(t *testing.T) {
+	hippoApp, ctx := setupApp(t)
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(&hippoApp.ZKKeeper, &hippoApp.SchemaKeeper, &hippoApp.ContractSponsorKeeper, &hippoApp.OracleKeeper))
+	query := func(commitment []byte, proof []byte) ([]byte, error) {
+		bz, err := json.Marshal(wasmbinding.HippoQuery{ZK: &wasmbinding.ZKQuery{
+			VerifyBulletproof: &wasmbinding.VerifyBulletproof{
+				Proof:           proof,
+				Commitments:     [][]byte{commitment},
+				BitSize:         32,
+				TranscriptLabel: "doctest example",
+			},
+		}})
+		require.NoError(t, err)
+		return querier(ctx, bz)
+	}
+	proof := mustDecodeHex(t, rangeProofHex)
+
+	bz, err := query(mustDecodeHex(t, commitmentHex), proof)
+	require.NoError(t, err)
+	var res wasmbinding.VerifyProofResponse
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.True(t, res.Verified)
+	require.Empty(t, res.Error)
+
+	// a proof that does not verify is reported rather than failing the query
+	bz, err = query(mustDecodeHex(t, basepointHex), proof)
+	require.NoError(t, err)
+	res = wasmbinding.VerifyProofResponse{}
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.False(t, res.Verified)
+	require.NotEmpty(t, res.Error)
+
+	// malformed input fails the query
+	_, err = query(mustDecodeHex(t, commitmentHex), proof[:10])
+	require.ErrorIs(t, err, zktypes.ErrInvalidProof)
+
+	_, err = querier(ctx, []byte(`{"unknown":{}}`))
+	require.Error(t, err)
+
+	_, err = querier(ctx, []byte(`{"zk":{}}`))
+	require.Error(t, err)
+}
+
+func TestCustomQuerierSchema(t *testing.T) {
+	hippoApp, ctx := setupApp(t)
+
+	msgServer := schemakeeper.NewMsgServerImpl(hippoApp.SchemaKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	hash := sha256.Sum256([]byte("fhir patient"))
+	_, err := msgServer.RegisterSchema(ctx, &schematypes.MsgRegisterSchema{
+		Curator:    authority,
+		SchemaId:   "fhir.Patient",
+		SchemaType: schematypes.SCHEMA_TYPE_FHIR,
+		Uri:        "https://hl7.org/fhir/R4/patient.html",
+		Hash:       hash[:],
+	})
+	require.NoError(t, err)
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(&hippoApp.ZKKeeper, &hippoApp.SchemaKeeper, &hippoApp.ContractSponsorKeeper, &hippoApp.OracleKeeper))
+	query := func(schemaID string, version uint32) wasmbinding.SchemaRefResponse {
+		bz, err := json.Marshal(wasmbinding.HippoQuery{Schema: &wasmbinding.SchemaQuery{
+			ValidateSchemaRef: &wasmbinding.ValidateSchemaRef{SchemaID: schemaID, Version: version},
+		}})
+		require.NoError(t, err)
+		bz, err = querier(ctx, bz)
+		require.NoError(t, err)
+		var res wasmbinding.SchemaRefResponse
+		require.NoError(t, json.Unmarshal(bz, &res))
+		return res
+	}
+
+	res := query("fhir.Patient", 0)
+	require.True(t, res.Exists)
+	require.True(t, res.Active)
+	require.Equal(t, uint32(1), res.Version)
+	require.Equal(t, hash[:], res.Hash)
+
+	require.False(t, query("fhir.Patient", 2).Exists)
+	require.False(t, query("omop.person", 0).Exists)
+
+	_, err = msgServer.SetDeprecated(ctx, &schematypes.MsgSetDeprecated{Curator: authority, SchemaId: "fhir.Patient", Deprecated: true})
+	require.NoError(t, err)
+	res = query("fhir.Patient", 1)
+	require.True(t, res.Exists)
+	require.False(t, res.Active)
+}
+
+func TestCustomQuerierContractSponsor(t *testing.T) {
+	hippoApp, ctx := setupApp(t)
+
+	contract := sdk.AccAddress([]byte("contract____________"))
+	user := sdk.AccAddress([]byte("user________________"))
+	genesis := contractsponsortypes.DefaultGenesisState()
+	genesis.Sponsorships = []contractsponsortypes.Sponsorship{{
+		Contract:     contract.String(),
+		Active:       true,
+		MaxFeePerTx:  sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(100))),
+		DefaultQuota: 3,
+		QuotaWindow:  time.Hour,
+	}}
+	genesis.UserUsages = []contractsponsortypes.UserUsage{{
+		Contract:    contract.String(),
+		User:        user.String(),
+		WindowStart: ctx.BlockTime(),
+		Used:        1,
+	}}
+	require.NoError(t, hippoApp.ContractSponsorKeeper.InitGenesis(ctx, genesis))
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(&hippoApp.ZKKeeper, &hippoApp.SchemaKeeper, &hippoApp.ContractSponsorKeeper, &hippoApp.OracleKeeper))
+	query := func(contract sdk.AccAddress) wasmbinding.UserQuotaResponse {
+		bz, err := json.Marshal(wasmbinding.HippoQuery{ContractSponsor: &wasmbinding.ContractSponsorQuery{
+			UserQuota: &wasmbinding.UserQuota{Contract: contract.String(), User: user.String()},
+		}})
+		require.NoError(t, err)
+		bz, err = querier(ctx, bz)
+		require.NoError(t, err)
+		var res wasmbinding.UserQuotaResponse
+		require.NoError(t, json.Unmarshal(bz, &res))
+		return res
+	}
+
+	res := query(contract)
+	require.True(t, res.Registered)
+	require.Equal(t, uint64(3), res.Quota)
+	require.Equal(t, uint64(1), res.Used)
+	require.Equal(t, uint64(2), res.Remaining)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour).Unix(), res.WindowEnd)
+
+	require.False(t, query(user).Registered)
+}
+
+func TestCustomQuerierOracle(t *testing.T) {
+	hippoApp, ctx := setupApp(t)
+
+	genesis := oracletypes.DefaultGenesisState()
+	genesis.Params.Assets = []string{"ATOM/USD"}
+	genesis.Prices = []oracletypes.Price{{
+		Asset:       "ATOM/USD",
+		Price:       math.LegacyMustNewDecFromStr("10.5"),
+		BlockHeight: 1,
+		BlockTime:   ctx.BlockTime(),
+	}}
+	require.NoError(t, hippoApp.OracleKeeper.InitGenesis(ctx, genesis))
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(&hippoApp.ZKKeeper, &hippoApp.SchemaKeeper, &hippoApp.ContractSponsorKeeper, &hippoApp.OracleKeeper))
+	query := func(asset string) wasmbinding.OraclePriceResponse {
+		bz, err := json.Marshal(wasmbinding.HippoQuery{Oracle: &wasmbinding.OracleQuery{
+			Price: &wasmbinding.OraclePrice{Asset: asset},
+		}})
+		require.NoError(t, err)
+		bz, err = querier(ctx, bz)
+		require.NoError(t, err)
+		var res wasmbinding.OraclePriceResponse
+		require.NoError(t, json.Unmarshal(bz, &res))
+		return res
+	}
+
+	res := query("ATOM/USD")
+	require.True(t, res.Found)
+	require.Equal(t, "10.500000000000000000", res.Price)
+	require.Equal(t, int64(1), res.BlockHeight)
+	require.Equal(t, ctx.BlockTime().Unix(), res.BlockTime)
+
+	require.False(t, query("BTC/USD").Found)
+}
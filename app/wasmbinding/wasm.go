@@ -0,0 +1,27 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	contractsponsorkeeper "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/keeper"
+	oraclekeeper "github.com/hippocrat-dao/hippo-protocol/x/oracle/keeper"
+	schemakeeper "github.com/hippocrat-dao/hippo-protocol/x/schema/keeper"
+	zkkeeper "github.com/hippocrat-dao/hippo-protocol/x/zk/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm keeper options that expose the
+// hippo custom queries to contracts.
+func RegisterCustomPlugins(
+	zk *zkkeeper.Keeper,
+	schema *schemakeeper.Keeper,
+	contractSponsor *contractsponsorkeeper.Keeper,
+	oracle *oraclekeeper.Keeper,
+) []wasmkeeper.Option {
+	queryPlugin := NewQueryPlugin(zk, schema, contractSponsor, oracle)
+
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: CustomQuerier(queryPlugin),
+		}),
+	}
+}
@@ -0,0 +1,489 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagFormat        = "format"
+	flagExpectedTotal = "expected-total"
+
+	vestingContinuous = "continuous"
+	vestingDelayed    = "delayed"
+	vestingPeriodic   = "periodic"
+	vestingCliff      = "cliff"
+
+	// defaultCliffInterval is how often a cliff+linear schedule releases
+	// tokens after the cliff when the row does not set an interval.
+	defaultCliffInterval = 30 * 24 * 60 * 60
+)
+
+// BulkAccount is a row of the bulk-add-accounts input. Times are unix
+// seconds. Coins use the usual "100ahp,5uatom" notation.
+type BulkAccount struct {
+	Address string       `json:"address"`
+	Coins   string       `json:"coins"`
+	Vesting *BulkVesting `json:"vesting,omitempty"`
+}
+
+// BulkVesting is the vesting schedule of a bulk account.
+//
+//   - continuous vests amount linearly from start_time to end_time.
+//   - delayed vests amount at once at end_time.
+//   - periodic vests the periods one after another from start_time, amount
+//     defaults to the sum of the periods.
+//   - cliff vests nothing until cliff_time, then what would have vested
+//     linearly from start_time, then the rest every interval seconds until
+//     end_time.
+type BulkVesting struct {
+	Type      string       `json:"type"`
+	Amount    string       `json:"amount,omitempty"`
+	StartTime int64        `json:"start_time,omitempty"`
+	EndTime   int64        `json:"end_time,omitempty"`
+	CliffTime int64        `json:"cliff_time,omitempty"`
+	Interval  int64        `json:"interval,omitempty"`
+	Periods   []BulkPeriod `json:"periods,omitempty"`
+}
+
+// BulkPeriod is a period of a periodic vesting schedule, length is in
+// seconds.
+type BulkPeriod struct {
+	Length int64  `json:"length"`
+	Amount string `json:"amount"`
+}
+
+// BulkAddGenesisAccountsCmd returns bulk-add-accounts cobra Command.
+func BulkAddGenesisAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-add-accounts [file]",
+		Short: "Add many genesis accounts from a CSV or JSON file to genesis.json",
+		Long: `Add many genesis accounts from a CSV or JSON file to genesis.json in one pass.
+All rows are validated before genesis.json is written, the bank supply is updated once.
+
+A JSON file holds a list of rows:
+
+  [{"address": "hippo1...", "coins": "1000ahp",
+    "vesting": {"type": "periodic", "start_time": 1767225600,
+                "periods": [{"length": 2592000, "amount": "500ahp"}, {"length": 2592000, "amount": "500ahp"}]}}]
+
+A CSV file has a header row naming its columns, of which address and coins are required:
+
+  address,coins,vesting_type,vesting_amount,start_time,end_time,cliff_time,interval,periods
+  hippo1...,1000ahp,cliff,1000ahp,1767225600,1830297600,1798761600,,
+  hippo1...,1000ahp,periodic,,1767225600,,,,2592000:500ahp;2592000:500ahp
+
+Vesting types are continuous, delayed, periodic and cliff (cliff followed by linear
+vesting released every interval seconds, 30 days by default). Times are unix seconds.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			rows, err := readBulkAccounts(args[0], format)
+			if err != nil {
+				return err
+			}
+
+			genAccounts, balances, err := BuildBulkAccounts(rows)
+			if err != nil {
+				return err
+			}
+
+			total := sdk.NewCoins()
+			for _, balance := range balances {
+				total = total.Add(balance.Coins...)
+			}
+			if expectedStr, _ := cmd.Flags().GetString(flagExpectedTotal); expectedStr != "" {
+				expected, err := sdk.ParseCoinsNormalized(expectedStr)
+				if err != nil {
+					return fmt.Errorf("failed to parse expected total: %w", err)
+				}
+				if !total.Equal(expected) {
+					return fmt.Errorf("accounts total %s, expected %s", total, expected)
+				}
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+			for _, acc := range genAccounts {
+				if accs.Contains(acc.GetAddress()) {
+					return fmt.Errorf("cannot add account at existing address %s", acc.GetAddress())
+				}
+			}
+
+			accs = append(accs, genAccounts...)
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			bankGenState.Balances = banktypes.SanitizeGenesisBalances(append(bankGenState.Balances, balances...))
+			bankGenState.Supply = bankGenState.Supply.Add(total...)
+
+			bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			}
+			appState[banktypes.ModuleName] = bankGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			return printBulkSummary(cmd.OutOrStdout(), rows, genAccounts, total, bankGenState.Supply)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagFormat, "", "format of the file, csv or json (default from the file extension)")
+	cmd.Flags().String(flagExpectedTotal, "", "fail unless the coins of all accounts add up to this amount")
+
+	return cmd
+}
+
+// readBulkAccounts reads the rows of a CSV or JSON file. An empty format is
+// taken from the file extension.
+func readBulkAccounts(path, format string) ([]BulkAccount, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch format {
+	case "json":
+		var rows []BulkAccount
+		dec := json.NewDecoder(f)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rows); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		return rows, nil
+	case "csv":
+		return ParseBulkAccountsCSV(f)
+	default:
+		return nil, fmt.Errorf("unknown format %q, must be csv or json", format)
+	}
+}
+
+// ParseBulkAccountsCSV parses CSV rows under a header naming the columns.
+// Periods are written as "length:coins" separated by ";".
+func ParseBulkAccountsCSV(r io.Reader) ([]BulkAccount, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse csv: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("csv has no header row")
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"address", "coins"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv has no %s column", name)
+		}
+	}
+
+	rows := make([]BulkAccount, 0, len(records)-1)
+	for line, record := range records[1:] {
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		unix := func(name string) (int64, error) {
+			if field(name) == "" {
+				return 0, nil
+			}
+			v, err := strconv.ParseInt(field(name), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("line %d: invalid %s: %w", line+2, name, err)
+			}
+			return v, nil
+		}
+
+		row := BulkAccount{Address: field("address"), Coins: field("coins")}
+		if vestingType := field("vesting_type"); vestingType != "" {
+			vesting := &BulkVesting{Type: vestingType, Amount: field("vesting_amount")}
+			for _, f := range []struct {
+				name string
+				dst  *int64
+			}{
+				{"start_time", &vesting.StartTime},
+				{"end_time", &vesting.EndTime},
+				{"cliff_time", &vesting.CliffTime},
+				{"interval", &vesting.Interval},
+			} {
+				if *f.dst, err = unix(f.name); err != nil {
+					return nil, err
+				}
+			}
+			if periods := field("periods"); periods != "" {
+				for _, p := range strings.Split(periods, ";") {
+					length, amount, ok := strings.Cut(p, ":")
+					if !ok {
+						return nil, fmt.Errorf("line %d: period %q must be length:coins", line+2, p)
+					}
+					l, err := strconv.ParseInt(strings.TrimSpace(length), 10, 64)
+					if err != nil {
+						return nil, fmt.Errorf("line %d: invalid period length: %w", line+2, err)
+					}
+					vesting.Periods = append(vesting.Periods, BulkPeriod{Length: l, Amount: strings.TrimSpace(amount)})
+				}
+			}
+			row.Vesting = vesting
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// BuildBulkAccounts turns rows into validated genesis accounts and their
+// balances. It fails on the first invalid row and on duplicate addresses.
+func BuildBulkAccounts(rows []BulkAccount) ([]authtypes.GenesisAccount, []banktypes.Balance, error) {
+	if len(rows) == 0 {
+		return nil, nil, errors.New("no accounts to add")
+	}
+
+	seen := make(map[string]int, len(rows))
+	accounts := make([]authtypes.GenesisAccount, 0, len(rows))
+	balances := make([]banktypes.Balance, 0, len(rows))
+	for i, row := range rows {
+		addr, err := sdk.AccAddressFromBech32(row.Address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("row %d: invalid address %q: %w", i+1, row.Address, err)
+		}
+		if prev, ok := seen[addr.String()]; ok {
+			return nil, nil, fmt.Errorf("row %d: duplicate address %s, first in row %d", i+1, addr, prev)
+		}
+		seen[addr.String()] = i + 1
+
+		coins, err := sdk.ParseCoinsNormalized(row.Coins)
+		if err != nil {
+			return nil, nil, fmt.Errorf("row %d: failed to parse coins: %w", i+1, err)
+		}
+		if coins.IsZero() {
+			return nil, nil, fmt.Errorf("row %d: coins must not be empty", i+1)
+		}
+
+		account, err := newBulkGenesisAccount(addr, coins, row.Vesting)
+		if err != nil {
+			return nil, nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+		if err := account.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("row %d: failed to validate new genesis account: %w", i+1, err)
+		}
+
+		accounts = append(accounts, account)
+		balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+	}
+	return accounts, balances, nil
+}
+
+func newBulkGenesisAccount(addr sdk.AccAddress, coins sdk.Coins, vesting *BulkVesting) (authtypes.GenesisAccount, error) {
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+	if vesting == nil {
+		return baseAccount, nil
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(vesting.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+	var periods authvesting.Periods
+	if vesting.Type == vestingPeriodic {
+		if periods, err = vesting.periodicPeriods(); err != nil {
+			return nil, err
+		}
+		if amount.IsZero() {
+			amount = periods.TotalAmount()
+		}
+		if !amount.Equal(periods.TotalAmount()) {
+			return nil, fmt.Errorf("vesting amount %s does not match the periods total %s", amount, periods.TotalAmount())
+		}
+	}
+	if amount.IsZero() {
+		return nil, errors.New("vesting amount must not be empty")
+	}
+	if amount.IsAnyGT(coins) {
+		return nil, errors.New("vesting amount cannot be greater than total amount")
+	}
+
+	switch vesting.Type {
+	case vestingContinuous:
+		if vesting.StartTime == 0 || vesting.EndTime <= vesting.StartTime {
+			return nil, errors.New("continuous vesting needs a start time before its end time")
+		}
+		return authvesting.NewContinuousVestingAccount(baseAccount, amount, vesting.StartTime, vesting.EndTime)
+
+	case vestingDelayed:
+		if vesting.EndTime == 0 {
+			return nil, errors.New("delayed vesting needs an end time")
+		}
+		return authvesting.NewDelayedVestingAccount(baseAccount, amount, vesting.EndTime)
+
+	case vestingPeriodic:
+		if vesting.StartTime == 0 {
+			return nil, errors.New("periodic vesting needs a start time")
+		}
+		return authvesting.NewPeriodicVestingAccount(baseAccount, amount, vesting.StartTime, periods)
+
+	case vestingCliff:
+		periods, err := vesting.cliffPeriods(amount)
+		if err != nil {
+			return nil, err
+		}
+		return authvesting.NewPeriodicVestingAccount(baseAccount, amount, vesting.StartTime, periods)
+
+	default:
+		return nil, fmt.Errorf("unknown vesting type %q, must be %s, %s, %s or %s",
+			vesting.Type, vestingContinuous, vestingDelayed, vestingPeriodic, vestingCliff)
+	}
+}
+
+func (v BulkVesting) periodicPeriods() (authvesting.Periods, error) {
+	if len(v.Periods) == 0 {
+		return nil, errors.New("periodic vesting needs periods")
+	}
+	periods := make(authvesting.Periods, 0, len(v.Periods))
+	for i, p := range v.Periods {
+		if p.Length <= 0 {
+			return nil, fmt.Errorf("length of period %d must be positive", i+1)
+		}
+		amount, err := sdk.ParseCoinsNormalized(p.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse amount of period %d: %w", i+1, err)
+		}
+		periods = append(periods, authvesting.Period{Length: p.Length, Amount: amount})
+	}
+	return periods, nil
+}
+
+// cliffPeriods releases at the cliff what vested linearly since the start
+// and the rest in equal steps every interval until the end, the last step
+// taking the rounding remainder.
+func (v BulkVesting) cliffPeriods(amount sdk.Coins) (authvesting.Periods, error) {
+	if v.StartTime == 0 || v.CliffTime <= v.StartTime || v.EndTime < v.CliffTime {
+		return nil, errors.New("cliff vesting needs start time < cliff time <= end time")
+	}
+	interval := v.Interval
+	if interval == 0 {
+		interval = defaultCliffInterval
+	}
+	if interval < 0 {
+		return nil, errors.New("interval must be positive")
+	}
+
+	duration := math.NewInt(v.EndTime - v.StartTime)
+	vestedBy := func(t int64) sdk.Coins {
+		vested := sdk.NewCoins()
+		for _, coin := range amount {
+			vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(t-v.StartTime).Quo(duration)))
+		}
+		return vested
+	}
+
+	periods := authvesting.Periods{{Length: v.CliffTime - v.StartTime, Amount: vestedBy(v.CliffTime)}}
+	released := periods[0].Amount
+	for t := v.CliffTime; t < v.EndTime; {
+		next := min(t+interval, v.EndTime)
+		step := vestedBy(next).Sub(released...)
+		if next == v.EndTime {
+			step = amount.Sub(released...)
+		}
+		periods = append(periods, authvesting.Period{Length: next - t, Amount: step})
+		released = released.Add(step...)
+		t = next
+	}
+	return periods, nil
+}
+
+func printBulkSummary(w io.Writer, rows []BulkAccount, accounts []authtypes.GenesisAccount, total, supply sdk.Coins) error {
+	counts := make(map[string]int)
+	for _, row := range rows {
+		if row.Vesting == nil {
+			counts["none"]++
+		} else {
+			counts[row.Vesting.Type]++
+		}
+	}
+	vesting := sdk.NewCoins()
+	for _, acc := range accounts {
+		if acc, ok := acc.(vestingexported.VestingAccount); ok {
+			vesting = vesting.Add(acc.GetOriginalVesting()...)
+		}
+	}
+
+	_, err := fmt.Fprintf(w, `added %d genesis accounts
+  without vesting: %d
+  continuous:      %d
+  delayed:         %d
+  periodic:        %d
+  cliff:           %d
+total:   %s
+vesting: %s
+supply:  %s
+`, len(accounts), counts["none"], counts[vestingContinuous], counts[vestingDelayed], counts[vestingPeriodic], counts[vestingCliff], total, vesting, supply)
+	return err
+}
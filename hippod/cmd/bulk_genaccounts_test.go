@@ -0,0 +1,173 @@
+package cmd_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	testcmd "github.com/hippocrat-dao/hippo-protocol/hippod/cmd"
+)
+
+const (
+	vestingStartTime = int64(1767225600)
+	vestingYear      = int64(365 * 24 * 60 * 60)
+)
+
+func bulkAddr(i int) string {
+	return mustAccAddress([]byte(fmt.Sprintf("bulkaddr%012d", i))).String()
+}
+
+func runBulkAddAccounts(t *testing.T, home, file string, extraArgs ...string) (string, error) {
+	cmd := testcmd.BulkAddGenesisAccountsCmd(home)
+	args := append([]string{file, "--home", home}, extraArgs...)
+	require.NoError(t, cmd.ParseFlags(args))
+	viper.Set(flags.FlagHome, home)
+	injectClientCtx(cmd, home)
+
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	err := cmd.RunE(cmd, args[:1])
+	return out.String(), err
+}
+
+func readGenesisAccounts(t *testing.T, home string) (authtypes.GenesisAccounts, *banktypes.GenesisState) {
+	cdc, _ := newTestCodecAndRegistry()
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	return accs, banktypes.GetGenesisStateFromAppState(cdc, appState)
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestBulkAddGenesisAccountsCmd(t *testing.T) {
+	home := t.TempDir()
+
+	t.Run("CSV", func(t *testing.T) {
+		createGenesisFile(t, home, []authtypes.GenesisAccount{}, []banktypes.Balance{})
+		file := writeFile(t, t.TempDir(), "accounts.csv", strings.Join([]string{
+			"address,coins,vesting_type,vesting_amount,start_time,end_time,cliff_time,interval,periods",
+			bulkAddr(1) + ",100stake,,,,,,,",
+			fmt.Sprintf("%s,100stake,continuous,50stake,%d,%d,,,", bulkAddr(2), vestingStartTime, vestingStartTime+vestingYear),
+			fmt.Sprintf("%s,100stake,delayed,100stake,,%d,,,", bulkAddr(3), vestingStartTime+vestingYear),
+			fmt.Sprintf("%s,100stake,periodic,,%d,,,,100:30stake;200:70stake", bulkAddr(4), vestingStartTime),
+			fmt.Sprintf("%s,100stake,cliff,100stake,%d,%d,%d,%d,", bulkAddr(5), vestingStartTime, vestingStartTime+4*vestingYear, vestingStartTime+vestingYear, vestingYear),
+		}, "\n"))
+
+		out, err := runBulkAddAccounts(t, home, file, "--expected-total", "500stake")
+		require.NoError(t, err)
+		require.Contains(t, out, "added 5 genesis accounts")
+		require.Contains(t, out, "vesting: 350stake")
+
+		accs, bankGenState := readGenesisAccounts(t, home)
+		require.Len(t, accs, 5)
+		require.Len(t, bankGenState.Balances, 5)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), bankGenState.Supply)
+
+		byAddr := make(map[string]authtypes.GenesisAccount)
+		for _, acc := range accs {
+			byAddr[acc.GetAddress().String()] = acc
+		}
+		require.IsType(t, &authtypes.BaseAccount{}, byAddr[bulkAddr(1)])
+		require.IsType(t, &authvesting.ContinuousVestingAccount{}, byAddr[bulkAddr(2)])
+		require.IsType(t, &authvesting.DelayedVestingAccount{}, byAddr[bulkAddr(3)])
+		require.IsType(t, &authvesting.PeriodicVestingAccount{}, byAddr[bulkAddr(4)])
+
+		// a quarter vests at the cliff, the rest yearly until the end
+		cliff, ok := byAddr[bulkAddr(5)].(*authvesting.PeriodicVestingAccount)
+		require.True(t, ok)
+		require.Len(t, cliff.VestingPeriods, 4)
+		require.Equal(t, vestingYear, cliff.VestingPeriods[0].Length)
+		require.Equal(t, math.NewInt(25), cliff.VestingPeriods[0].Amount.AmountOf("stake"))
+		require.Equal(t, vestingStartTime+4*vestingYear, cliff.EndTime)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		createGenesisFile(t, home, []authtypes.GenesisAccount{}, []banktypes.Balance{})
+		file := writeFile(t, t.TempDir(), "accounts.json", fmt.Sprintf(`[
+  {"address": %q, "coins": "100stake"},
+  {"address": %q, "coins": "100stake,10uatom", "vesting": {"type": "periodic", "start_time": %d,
+    "periods": [{"length": 100, "amount": "40stake"}, {"length": 100, "amount": "60stake"}]}}
+]`, bulkAddr(1), bulkAddr(2), vestingStartTime))
+
+		_, err := runBulkAddAccounts(t, home, file)
+		require.NoError(t, err)
+
+		accs, bankGenState := readGenesisAccounts(t, home)
+		require.Len(t, accs, 2)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200), sdk.NewInt64Coin("uatom", 10)), bankGenState.Supply)
+	})
+
+	t.Run("Duplicate address", func(t *testing.T) {
+		createGenesisFile(t, home, []authtypes.GenesisAccount{}, []banktypes.Balance{})
+		file := writeFile(t, t.TempDir(), "accounts.csv", "address,coins\n"+bulkAddr(1)+",100stake\n"+bulkAddr(1)+",5stake\n")
+
+		_, err := runBulkAddAccounts(t, home, file)
+		require.ErrorContains(t, err, "duplicate address")
+	})
+
+	t.Run("Existing address", func(t *testing.T) {
+		acc := authtypes.NewBaseAccount(sdk.MustAccAddressFromBech32(bulkAddr(1)), nil, 0, 0)
+		createGenesisFile(t, home, []authtypes.GenesisAccount{acc}, []banktypes.Balance{})
+		file := writeFile(t, t.TempDir(), "accounts.csv", "address,coins\n"+bulkAddr(1)+",100stake\n")
+
+		_, err := runBulkAddAccounts(t, home, file)
+		require.ErrorContains(t, err, "existing address")
+	})
+
+	t.Run("Unexpected total", func(t *testing.T) {
+		createGenesisFile(t, home, []authtypes.GenesisAccount{}, []banktypes.Balance{})
+		file := writeFile(t, t.TempDir(), "accounts.csv", "address,coins\n"+bulkAddr(1)+",100stake\n")
+
+		_, err := runBulkAddAccounts(t, home, file, "--expected-total", "200stake")
+		require.ErrorContains(t, err, "expected 200stake")
+
+		accs, _ := readGenesisAccounts(t, home)
+		require.Empty(t, accs)
+	})
+
+	t.Run("Periods do not match the vesting amount", func(t *testing.T) {
+		createGenesisFile(t, home, []authtypes.GenesisAccount{}, []banktypes.Balance{})
+		file := writeFile(t, t.TempDir(), "accounts.csv", fmt.Sprintf(
+			"address,coins,vesting_type,vesting_amount,start_time,periods\n%s,100stake,periodic,80stake,%d,100:30stake;100:30stake\n", bulkAddr(1), vestingStartTime))
+
+		_, err := runBulkAddAccounts(t, home, file)
+		require.ErrorContains(t, err, "row 1: vesting amount 80stake does not match")
+	})
+
+	t.Run("Vesting > balance", func(t *testing.T) {
+		createGenesisFile(t, home, []authtypes.GenesisAccount{}, []banktypes.Balance{})
+		file := writeFile(t, t.TempDir(), "accounts.csv", fmt.Sprintf(
+			"address,coins,vesting_type,vesting_amount,end_time\n%s,100stake,delayed,150stake,%d\n", bulkAddr(1), vestingStartTime))
+
+		_, err := runBulkAddAccounts(t, home, file)
+		require.ErrorContains(t, err, "vesting amount cannot be greater")
+	})
+
+	t.Run("Unknown format", func(t *testing.T) {
+		file := writeFile(t, t.TempDir(), "accounts.txt", "")
+		_, err := runBulkAddAccounts(t, home, file)
+		require.ErrorContains(t, err, "unknown format")
+	})
+}
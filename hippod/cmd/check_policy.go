@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+// PolicyDeviation is a genesis value that differs from the consensus policy.
+// Path locates the value in the genesis file.
+type PolicyDeviation struct {
+	Path     string `json:"path"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// PolicyReport is printed by check-policy.
+type PolicyReport struct {
+	Genesis    string            `json:"genesis"`
+	Profile    string            `json:"profile"`
+	Valid      bool              `json:"valid"`
+	Deviations []PolicyDeviation `json:"deviations"`
+}
+
+// CheckPolicyCmd validates a genesis file against the Hippo consensus policy
+// hippod init writes, and fails when any value deviates from it.
+func CheckPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-policy [file]",
+		Short: "Check the genesis file at the default location or at the location passed as an arg against the Hippo consensus policy",
+		Long: `Check a genesis file against the Hippo consensus policy of a network profile: bond and mint denoms,
+validator set size, evidence age versus unbonding period, gov deposits, denom metadata, wasm permissions
+and block limits. Every deviation is printed as JSON and the command exits non-zero if there is any.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genesis := serverCtx.Config.GenesisFile()
+			if len(args) > 0 {
+				genesis = args[0]
+			}
+
+			profileName, _ := cmd.Flags().GetString(FlagProfile)
+			profile, err := LoadNetworkProfile(profileName)
+			if err != nil {
+				return err
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+			if err != nil {
+				return err
+			}
+			if err := appGenesis.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("invalid genesis file %s: %w", genesis, err)
+			}
+
+			deviations, err := CheckGenesisPolicy(clientCtx.Codec, appGenesis, profile)
+			if err != nil {
+				return fmt.Errorf("failed to check genesis file %s: %w", genesis, err)
+			}
+
+			report := PolicyReport{
+				Genesis:    genesis,
+				Profile:    profile.Name,
+				Valid:      len(deviations) == 0,
+				Deviations: deviations,
+			}
+			out, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+
+			if !report.Valid {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("genesis file %s deviates from the %s policy in %d places", genesis, profile.Name, len(deviations))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagProfile, ProfileMainnet, fmt.Sprintf("network profile of the policy, one of %s or a path to a YAML/JSON profile file", strings.Join(ProfileNames(), ", ")))
+	return cmd
+}
+
+// policyChecker collects the deviations of a genesis from the policy.
+type policyChecker struct {
+	deviations []PolicyDeviation
+}
+
+// expect records a deviation when the string forms of expected and actual
+// differ.
+func (c *policyChecker) expect(path string, expected, actual any) {
+	e, a := fmt.Sprint(expected), fmt.Sprint(actual)
+	if e != a {
+		c.deviations = append(c.deviations, PolicyDeviation{Path: path, Expected: e, Actual: a})
+	}
+}
+
+// CheckGenesisPolicy returns every value of appGenesis deviating from the
+// consensus policy of profile, grouped by module.
+func CheckGenesisPolicy(cdc codec.JSONCodec, appGenesis *genutiltypes.AppGenesis, profile NetworkProfile) ([]PolicyDeviation, error) {
+	c := &policyChecker{deviations: []PolicyDeviation{}}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal app_state: %w", err)
+	}
+	unmarshal := func(module string, state proto.Message) error {
+		bz, ok := appState[module]
+		if !ok {
+			return fmt.Errorf("app_state.%s is missing", module)
+		}
+		if err := cdc.UnmarshalJSON(bz, state); err != nil {
+			return fmt.Errorf("failed to unmarshal app_state.%s: %w", module, err)
+		}
+		return nil
+	}
+
+	var (
+		bankGenState     banktypes.GenesisState
+		stakingGenState  stakingtypes.GenesisState
+		mintGenState     minttypes.GenesisState
+		distrGenState    distrtypes.GenesisState
+		govGenState      govv1types.GenesisState
+		slashingGenState slashingtypes.GenesisState
+		wasmGenState     wasmtypes.GenesisState
+	)
+	for _, m := range []struct {
+		name  string
+		state proto.Message
+	}{
+		{banktypes.ModuleName, &bankGenState},
+		{distrtypes.ModuleName, &distrGenState},
+		{govtypes.ModuleName, &govGenState},
+		{minttypes.ModuleName, &mintGenState},
+		{slashingtypes.ModuleName, &slashingGenState},
+		{stakingtypes.ModuleName, &stakingGenState},
+		{wasmtypes.ModuleName, &wasmGenState},
+	} {
+		if err := unmarshal(m.name, m.state); err != nil {
+			return nil, err
+		}
+	}
+
+	// consensus
+	params := appGenesis.Consensus.Params
+	c.expect("consensus.params.block.max_bytes", profile.MaxBlockBytes, params.Block.MaxBytes)
+	c.expect("consensus.params.block.max_gas", profile.MaxBlockGas, params.Block.MaxGas)
+	c.expect("consensus.params.evidence.max_age_duration", time.Duration(profile.EvidenceMaxAgeDuration), params.Evidence.MaxAgeDuration)
+	c.expect("consensus.params.evidence.max_age_num_blocks", profile.EvidenceMaxAgeNumBlocks, params.Evidence.MaxAgeNumBlocks)
+
+	// Evidence must outlive the unbonding period actually in the genesis,
+	// whatever the profile says, or validators escape slashing.
+	unbonding := stakingGenState.Params.UnbondingTime
+	if params.Evidence.MaxAgeDuration <= unbonding {
+		c.deviations = append(c.deviations, PolicyDeviation{
+			Path:     "consensus.params.evidence.max_age_duration",
+			Expected: fmt.Sprintf("longer than app_state.staking.params.unbonding_time %s", unbonding),
+			Actual:   params.Evidence.MaxAgeDuration.String(),
+		})
+	}
+	if ageTime := time.Duration(params.Evidence.MaxAgeNumBlocks) * consensus.BlockTimeSec * time.Second; ageTime <= unbonding {
+		c.deviations = append(c.deviations, PolicyDeviation{
+			Path:     "consensus.params.evidence.max_age_num_blocks",
+			Expected: fmt.Sprintf("longer than app_state.staking.params.unbonding_time %s at %ds blocks", unbonding, consensus.BlockTimeSec),
+			Actual:   fmt.Sprintf("%d (%s)", params.Evidence.MaxAgeNumBlocks, ageTime),
+		})
+	}
+	// the oracle aggregates the prices reported in vote extensions
+	if params.ABCI.VoteExtensionsEnableHeight <= 0 {
+		c.deviations = append(c.deviations, PolicyDeviation{
+			Path:     "consensus.params.abci.vote_extensions_enable_height",
+			Expected: "greater than 0",
+			Actual:   fmt.Sprint(params.ABCI.VoteExtensionsEnableHeight),
+		})
+	}
+
+	// bank
+	expectedMetadata := hippoDenomMetadata()
+	actualMetadata, found := banktypes.Metadata{}, false
+	for _, m := range bankGenState.DenomMetadata {
+		if m.Base == expectedMetadata.Base {
+			actualMetadata, found = m, true
+			break
+		}
+	}
+	if !found {
+		c.expect("app_state.bank.denom_metadata", expectedMetadata.Base, "missing")
+	} else {
+		path := fmt.Sprintf("app_state.bank.denom_metadata[%s]", expectedMetadata.Base)
+		c.expect(path+".name", expectedMetadata.Name, actualMetadata.Name)
+		c.expect(path+".symbol", expectedMetadata.Symbol, actualMetadata.Symbol)
+		c.expect(path+".display", expectedMetadata.Display, actualMetadata.Display)
+		c.expect(path+".denom_units", denomUnitsString(expectedMetadata.DenomUnits), denomUnitsString(actualMetadata.DenomUnits))
+	}
+
+	// distribution
+	c.expect("app_state.distribution.params.community_tax", profile.CommunityTax, distrGenState.Params.CommunityTax)
+
+	// gov
+	minDeposit := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, sdk.TokensFromConsensusPower(int64(profile.MinDeposit), sdk.DefaultPowerReduction)))
+	expeditedMinDeposit := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, sdk.TokensFromConsensusPower(int64(profile.ExpeditedMinDeposit), sdk.DefaultPowerReduction)))
+	c.expect("app_state.gov.params.min_deposit", minDeposit, sdk.Coins(govGenState.Params.MinDeposit))
+	c.expect("app_state.gov.params.expedited_min_deposit", expeditedMinDeposit, sdk.Coins(govGenState.Params.ExpeditedMinDeposit))
+	c.expect("app_state.gov.params.max_deposit_period", time.Duration(profile.MaxDepositPeriod), durationString(govGenState.Params.MaxDepositPeriod))
+	c.expect("app_state.gov.params.voting_period", time.Duration(profile.VotingPeriod), durationString(govGenState.Params.VotingPeriod))
+	c.expect("app_state.gov.params.expedited_voting_period", time.Duration(profile.ExpeditedVotingPeriod), durationString(govGenState.Params.ExpeditedVotingPeriod))
+
+	// mint
+	c.expect("app_state.mint.minter.inflation", math.LegacyNewDecWithPrec(consensus.Minter, 2), mintGenState.Minter.Inflation)
+	c.expect("app_state.mint.params.mint_denom", consensus.DefaultHippoDenom, mintGenState.Params.MintDenom)
+	c.expect("app_state.mint.params.inflation_rate_change", math.LegacyNewDecWithPrec(consensus.InflationRateChange, 2), mintGenState.Params.InflationRateChange)
+	c.expect("app_state.mint.params.inflation_max", math.LegacyNewDecWithPrec(consensus.InflationMax, 2), mintGenState.Params.InflationMax)
+	c.expect("app_state.mint.params.inflation_min", math.LegacyNewDecWithPrec(consensus.InflationMin, 2), mintGenState.Params.InflationMin)
+	c.expect("app_state.mint.params.blocks_per_year", consensus.BlocksPerYear, mintGenState.Params.BlocksPerYear)
+
+	// slashing
+	c.expect("app_state.slashing.params.signed_blocks_window", profile.SignedBlocksWindow, slashingGenState.Params.SignedBlocksWindow)
+	c.expect("app_state.slashing.params.min_signed_per_window", profile.MinSignedPerWindow, slashingGenState.Params.MinSignedPerWindow)
+	c.expect("app_state.slashing.params.slash_fraction_double_sign", profile.SlashFractionDoubleSign, slashingGenState.Params.SlashFractionDoubleSign)
+	c.expect("app_state.slashing.params.slash_fraction_downtime", profile.SlashFractionDowntime, slashingGenState.Params.SlashFractionDowntime)
+
+	// staking
+	c.expect("app_state.staking.params.unbonding_time", time.Duration(profile.UnbondingTime), unbonding)
+	c.expect("app_state.staking.params.max_validators", profile.MaxValidators, stakingGenState.Params.MaxValidators)
+	c.expect("app_state.staking.params.bond_denom", consensus.DefaultHippoDenom, stakingGenState.Params.BondDenom)
+	c.expect("app_state.staking.params.min_commission_rate", profile.MinCommissionRate, stakingGenState.Params.MinCommissionRate)
+
+	// wasm, permissionless since the v2.0.0 upgrade
+	c.expect("app_state.wasm.params.code_upload_access.permission", wasmtypes.AllowEverybody.Permission, wasmGenState.Params.CodeUploadAccess.Permission)
+	c.expect("app_state.wasm.params.instantiate_default_permission", wasmtypes.AccessTypeEverybody, wasmGenState.Params.InstantiateDefaultPermission)
+
+	return c.deviations, nil
+}
+
+func durationString(d *time.Duration) string {
+	if d == nil {
+		return "<nil>"
+	}
+	return d.String()
+}
+
+func denomUnitsString(units []*banktypes.DenomUnit) string {
+	s := ""
+	for i, u := range units {
+		if i > 0 {
+			s += ","
+		}
+		s += fmt.Sprintf("%s^%d", u.Denom, u.Exponent)
+	}
+	return s
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPolicyCmd(t *testing.T) {
+	hippoApp := newTestApp(t)
+	cdc := hippoApp.AppCodec()
+
+	withContext := func(command *cobra.Command, home string) {
+		srvCtx := server.NewDefaultContext()
+		srvCtx.Config.SetRoot(home)
+		clientCtx := client.Context{}.WithHomeDir(home).WithCodec(cdc)
+		command.SetContext(context.WithValue(
+			context.WithValue(context.Background(), server.ServerContextKey, srvCtx),
+			client.ClientContextKey, &clientCtx,
+		))
+	}
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	initCmd := InitCmd(hippoApp.BasicModuleManager, home)
+	initCmd.SetArgs([]string{"hippo-moniker", "--home", home})
+	withContext(initCmd, home)
+	require.NoError(t, initCmd.Execute())
+	genFile := filepath.Join(home, "config", "genesis.json")
+
+	checkPolicy := func(args ...string) (PolicyReport, error) {
+		command := CheckPolicyCmd()
+		command.SetArgs(args)
+		withContext(command, home)
+		out := new(bytes.Buffer)
+		command.SetOut(out)
+		command.SetErr(new(bytes.Buffer))
+		err := command.Execute()
+
+		var report PolicyReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+		return report, err
+	}
+
+	t.Run("Genesis written by init", func(t *testing.T) {
+		report, err := checkPolicy()
+		require.NoError(t, err)
+		require.True(t, report.Valid)
+		require.Equal(t, genFile, report.Genesis)
+		require.Empty(t, report.Deviations)
+	})
+
+	t.Run("Other profile", func(t *testing.T) {
+		report, err := checkPolicy(genFile, "--profile", ProfileLocalnet)
+		require.ErrorContains(t, err, "deviates from the localnet policy")
+		require.False(t, report.Valid)
+		require.Contains(t, report.Deviations, PolicyDeviation{
+			Path:     "app_state.staking.params.unbonding_time",
+			Expected: (10 * time.Minute).String(),
+			Actual:   (504 * time.Hour).String(),
+		})
+	})
+
+	t.Run("Hand-edited genesis", func(t *testing.T) {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+		require.NoError(t, err)
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+
+		var stakingGenState stakingtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState)
+		stakingGenState.Params.MaxValidators = 100
+		stakingGenState.Params.UnbondingTime = 60 * 24 * time.Hour
+		appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
+
+		var wasmGenState wasmtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[wasmtypes.ModuleName], &wasmGenState)
+		wasmGenState.Params.CodeUploadAccess = wasmtypes.AllowNobody
+		appState[wasmtypes.ModuleName] = cdc.MustMarshalJSON(&wasmGenState)
+
+		appGenesis.AppState, err = json.Marshal(appState)
+		require.NoError(t, err)
+		appGenesis.Consensus.Params.Block.MaxGas = -1
+		appGenesis.Consensus.Params.ABCI.VoteExtensionsEnableHeight = 0
+		edited := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, appGenesis.SaveAs(edited))
+
+		report, err := checkPolicy(edited)
+		require.ErrorContains(t, err, "deviates from the mainnet policy in 7 places")
+
+		paths := make([]string, 0, len(report.Deviations))
+		for _, d := range report.Deviations {
+			paths = append(paths, d.Path)
+		}
+		require.Equal(t, []string{
+			"consensus.params.block.max_gas",
+			"consensus.params.evidence.max_age_duration",
+			"consensus.params.evidence.max_age_num_blocks",
+			"consensus.params.abci.vote_extensions_enable_height",
+			"app_state.staking.params.unbonding_time",
+			"app_state.staking.params.max_validators",
+			"app_state.wasm.params.code_upload_access.permission",
+		}, paths)
+		require.Contains(t, report.Deviations, PolicyDeviation{
+			Path:     "app_state.wasm.params.code_upload_access.permission",
+			Expected: "Everybody",
+			Actual:   "Nobody",
+		})
+	})
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+)
+
+const (
+	flagAddresses     = "addresses"
+	flagAddressesFile = "addresses-file"
+
+	outputFormatCSV = "csv"
+)
+
+// ExportSnapshotCmd exports the balances, delegations and vesting positions
+// of accounts at a height, for audits and airdrop snapshots.
+func ExportSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-snapshot",
+		Short: "Export the balances, delegations and vesting positions of accounts at a height",
+		Long: `Export the balances, delegations and vesting positions of every account, or
+of the accounts of --addresses and --addresses-file, from the state at
+--height. The state is only read, no genesis is produced and nothing is
+prepared for height zero. The node must be stopped.
+
+Delegations are reported with the tokens their shares are worth. The coins
+still vesting are computed at the time of the block at --height, and left out
+when the staking module no longer keeps the block header.
+
+The json output holds an object per account. The csv output holds a row per
+coin: balance, delegation, original_vesting, delegated_free, delegated_vesting
+and vesting.`,
+		Example: fmt.Sprintf(`%[1]s export-snapshot --height 1000000 --output csv --output-document snapshot.csv
+%[1]s export-snapshot --addresses-file airdrop.txt`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output != outputFormatCSV && output != flags.OutputFormatJSON {
+				return fmt.Errorf("unknown output format %q, expected %s or %s", output, outputFormatCSV, flags.OutputFormatJSON)
+			}
+			addresses, err := snapshotAddresses(cmd)
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			hippoApp, err := loadExportApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error loading state: %w", err)
+			}
+
+			var out io.Writer = cmd.OutOrStdout()
+			if outputDocument != "" {
+				file, openErr := os.OpenFile(outputDocument, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+				if openErr != nil {
+					return openErr
+				}
+				defer func() {
+					if closeErr := file.Close(); err == nil {
+						err = closeErr
+					}
+					if err != nil {
+						_ = os.Remove(outputDocument)
+					}
+				}()
+				out = file
+			}
+
+			if output == outputFormatCSV {
+				err = writeSnapshotCSV(out, hippoApp, addresses)
+			} else {
+				err = writeSnapshotJSON(out, hippoApp, addresses)
+			}
+			if err != nil {
+				return fmt.Errorf("error exporting snapshot: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export the snapshot of a particular height (-1 means latest height)")
+	cmd.Flags().StringSlice(flagAddresses, []string{}, "Comma-separated list of accounts to export. If empty, will export all accounts")
+	cmd.Flags().String(flagAddressesFile, "", "File listing the accounts to export, one per line")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatJSON, "Output format (json|csv)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Snapshot is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// snapshotAddresses returns the accounts of --addresses and --addresses-file.
+// Blank lines and lines starting with # are skipped in the file.
+func snapshotAddresses(cmd *cobra.Command) ([]sdk.AccAddress, error) {
+	bech32Addresses, _ := cmd.Flags().GetStringSlice(flagAddresses)
+	if addressesFile, _ := cmd.Flags().GetString(flagAddressesFile); addressesFile != "" {
+		bz, err := os.ReadFile(addressesFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(string(bz), "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" && !strings.HasPrefix(line, "#") {
+				bech32Addresses = append(bech32Addresses, line)
+			}
+		}
+	}
+
+	addresses := make([]sdk.AccAddress, 0, len(bech32Addresses))
+	for _, bech32Address := range bech32Addresses {
+		address, err := sdk.AccAddressFromBech32(bech32Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s: %w", bech32Address, err)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func writeSnapshotJSON(w io.Writer, hippoApp *app.App, addresses []sdk.AccAddress) error {
+	header, err := hippoApp.SnapshotHeader()
+	if err != nil {
+		return err
+	}
+	headerBz, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewWriter(w)
+	// the header is a JSON object, reopen it to append the accounts
+	buf.Write(headerBz[:len(headerBz)-1])
+	buf.WriteString(`,"accounts":[`)
+	first := true
+	if err := hippoApp.SnapshotAccounts(addresses, func(account app.AccountSnapshot) error {
+		bz, err := json.Marshal(account)
+		if err != nil {
+			return err
+		}
+		if !first {
+			buf.WriteString(",")
+		}
+		first = false
+		_, err = buf.Write(bz)
+		return err
+	}); err != nil {
+		return err
+	}
+	buf.WriteString("]}\n")
+	return buf.Flush()
+}
+
+func writeSnapshotCSV(w io.Writer, hippoApp *app.App, addresses []sdk.AccAddress) error {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{"address", "module", "category", "validator", "denom", "amount"}); err != nil {
+		return err
+	}
+	if err := hippoApp.SnapshotAccounts(addresses, func(account app.AccountSnapshot) error {
+		writeCoins := func(category, validator string, coins ...sdk.Coin) {
+			for _, coin := range coins {
+				_ = out.Write([]string{account.Address, account.Module, category, validator, coin.Denom, coin.Amount.String()})
+			}
+		}
+		writeCoins("balance", "", account.Balances...)
+		for _, delegation := range account.Delegations {
+			writeCoins("delegation", delegation.Validator, delegation.Amount)
+		}
+		if account.Vesting != nil {
+			writeCoins("original_vesting", "", account.Vesting.OriginalVesting...)
+			writeCoins("delegated_free", "", account.Vesting.DelegatedFree...)
+			writeCoins("delegated_vesting", "", account.Vesting.DelegatedVesting...)
+			writeCoins("vesting", "", account.Vesting.Vesting...)
+		}
+		return out.Error()
+	}); err != nil {
+		return err
+	}
+	out.Flush()
+	return out.Error()
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportSnapshotCmd(t *testing.T) {
+	setAddressPrefixes()
+	home := t.TempDir()
+	exportSnapshot := func(args ...string) error {
+		srvCtx := server.NewDefaultContext()
+		srvCtx.Config.SetRoot(home)
+		command := ExportSnapshotCmd(home)
+		command.SetArgs(args)
+		command.SetContext(context.WithValue(context.Background(), server.ServerContextKey, srvCtx))
+		command.SetOut(new(bytes.Buffer))
+		command.SetErr(new(bytes.Buffer))
+		return command.Execute()
+	}
+
+	require.ErrorContains(t, exportSnapshot("--output", "yaml"), "unknown output format")
+	require.ErrorContains(t, exportSnapshot("--addresses", "cosmos1invalid"), "invalid address cosmos1invalid")
+	require.ErrorContains(t, exportSnapshot("--addresses-file", "missing.txt"), "no such file")
+	addressesFile := writeProfile(t, "addresses.txt", "# airdrop\n\nhippo1invalid\n")
+	require.ErrorContains(t, exportSnapshot("--addresses-file", addressesFile), "invalid address hippo1invalid")
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	hippoApp := newTestApp(t)
+	ctx := hippoApp.NewContextLegacy(true, cmtproto.Header{Height: hippoApp.LastBlockHeight()})
+	holder := sdk.AccAddress([]byte("holder______________"))
+	genesisState := hippoApp.DefaultGenesis()
+	var bankGenesis banktypes.GenesisState
+	hippoApp.AppCodec().MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = []banktypes.Balance{{Address: holder.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("ahp", 5), sdk.NewInt64Coin("uother", 7))}}
+	bankGenesis.Supply = bankGenesis.Balances[0].Coins
+	genesisState[banktypes.ModuleName] = hippoApp.AppCodec().MustMarshalJSON(&bankGenesis)
+	// the default genesis has no validator, which InitGenesis reports after
+	// initializing every module
+	_, err := hippoApp.ModuleManager.InitGenesis(ctx, hippoApp.AppCodec(), genesisState)
+	require.ErrorContains(t, err, "validator set is empty")
+
+	addresses, err := snapshotAddresses(ExportSnapshotCmd(t.TempDir()))
+	require.NoError(t, err)
+	require.Empty(t, addresses)
+	command := ExportSnapshotCmd(t.TempDir())
+	require.NoError(t, command.ParseFlags([]string{"--addresses", holder.String(), "--addresses-file", writeProfile(t, "addresses.txt", "# airdrop\n\n"+holder.String()+"\n")}))
+	addresses, err = snapshotAddresses(command)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{holder, holder}, addresses)
+
+	var out bytes.Buffer
+	require.NoError(t, writeSnapshotCSV(&out, hippoApp, []sdk.AccAddress{holder}))
+	require.Equal(t, `address,module,category,validator,denom,amount
+`+holder.String()+`,,balance,,ahp,5
+`+holder.String()+`,,balance,,uother,7
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, writeSnapshotJSON(&out, hippoApp, []sdk.AccAddress{holder}))
+	require.JSONEq(t, `{
+		"height": 0,
+		"time": "0001-01-01T00:00:00Z",
+		"accounts": [{
+			"address": "`+holder.String()+`",
+			"balances": [{"denom": "ahp", "amount": "5"}, {"denom": "uother", "amount": "7"}],
+			"delegations": []
+		}]
+	}`, out.String())
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+)
+
+const (
+	flagGzip          = "gzip"
+	flagWasmChunkSize = "wasm-chunk-size"
+)
+
+// ExportStreamCmd exports the state to a genesis file like export, writing
+// it module by module instead of building the whole genesis in memory.
+func ExportStreamCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-stream",
+		Short: "Export state to JSON without holding it in memory",
+		Long: `Export state to JSON like export does, streaming each module's genesis to
+the output as soon as it is exported. Contract state of the wasm module is
+read and written in chunks of --wasm-chunk-size entries. Progress is reported
+on stderr after every module.
+
+Errors of the export are returned instead of crashing the node, and a partially
+written --output-document is removed.`,
+		Example: fmt.Sprintf("%s export-stream --height 1000000 --output-document genesis.json.gz --gzip", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+			// set current binary version
+			appGenesis.AppName = version.AppName
+			appGenesis.AppVersion = version.Version
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+			modulesToExport, _ := cmd.Flags().GetStringSlice(server.FlagModulesToExport)
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			compress, _ := cmd.Flags().GetBool(flagGzip)
+			wasmChunkSize, _ := cmd.Flags().GetInt(flagWasmChunkSize)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			hippoApp, err := loadExportApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+
+			var out io.Writer = cmd.OutOrStdout()
+			if outputDocument != "" {
+				file, openErr := os.OpenFile(outputDocument, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+				if openErr != nil {
+					return openErr
+				}
+				defer func() {
+					if closeErr := file.Close(); err == nil {
+						err = closeErr
+					}
+					if err != nil {
+						_ = os.Remove(outputDocument)
+					}
+				}()
+				out = file
+			}
+			if compress {
+				gz := gzip.NewWriter(out)
+				defer func() {
+					if closeErr := gz.Close(); err == nil {
+						err = closeErr
+					}
+				}()
+				out = gz
+			}
+
+			start := time.Now()
+			if err := hippoApp.StreamGenesis(out, appGenesis, app.StreamGenesisOptions{
+				ForZeroHeight:    forZeroHeight,
+				JailAllowedAddrs: jailAllowedAddrs,
+				ModulesToExport:  modulesToExport,
+				WasmChunkSize:    wasmChunkSize,
+				Progress: func(p app.ExportProgress) {
+					cmd.PrintErrf("exported %s (%d/%d modules), %d bytes in %s\n", p.Module, p.Done, p.Total, p.Written, time.Since(start).Round(time.Millisecond))
+				},
+			}); err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(server.FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(server.FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().StringSlice(server.FlagModulesToExport, []string{}, "Comma-separated list of modules to export. If empty, will export all modules")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
+	cmd.Flags().Bool(flagGzip, false, "Compress the exported state with gzip")
+	cmd.Flags().Int(flagWasmChunkSize, app.DefaultWasmChunkSize, "Number of wasm contract state entries held in memory at once")
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportStreamCmd(t *testing.T) {
+	hippoApp := newTestApp(t)
+	home := t.TempDir()
+	srvCtx := server.NewDefaultContext()
+	srvCtx.Config.SetRoot(home)
+	srvCtx.Viper.Set("home", home)
+	srvCtx.Logger = log.NewNopLogger()
+	clientCtx := client.Context{}.WithHomeDir(home).WithCodec(hippoApp.AppCodec())
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), server.ServerContextKey, srvCtx),
+		client.ClientContextKey, &clientCtx,
+	)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	initCmd := InitCmd(hippoApp.BasicModuleManager, home)
+	initCmd.SetArgs([]string{"hippo-moniker", "--home", home})
+	initCmd.SetContext(ctx)
+	require.NoError(t, initCmd.Execute())
+
+	// the node has not run InitChain, so the state cannot be exported
+	output := filepath.Join(t.TempDir(), "genesis.json.gz")
+	cmd := ExportStreamCmd(home)
+	cmd.SetArgs([]string{"--home", home, "--output-document", output, "--gzip"})
+	cmd.SetContext(ctx)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	require.ErrorContains(t, cmd.Execute(), "error exporting state")
+	require.NoFileExists(t, output, "partial export is removed")
+}
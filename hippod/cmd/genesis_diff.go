@@ -0,0 +1,651 @@
+package cmd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"cosmossdk.io/x/feegrant"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v8/modules/core/types"
+	"github.com/spf13/cobra"
+
+	audittypes "github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+	communitytaxtypes "github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+	contractsponsortypes "github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+	escrowtypes "github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+	feeabstypes "github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	feeburntypes "github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+	keysharetypes "github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+	liquidstaketypes "github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+	schematypes "github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+	sponsortypes "github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+	treasurytypes "github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+	zktypes "github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+// Kinds of GenesisChange.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Pseudo modules of the genesis fields outside of the app state.
+const (
+	diffModuleGenesis   = "genesis"
+	diffModuleConsensus = "consensus"
+)
+
+// GenesisChange is a value that differs between two genesis files. Path
+// locates the value in its module, list elements matched by identity are
+// located by their key in brackets, e.g. balances[hippo1...].coins[ahp].
+type GenesisChange struct {
+	Module string `json:"module"`
+	Kind   string `json:"kind"`
+	Path   string `json:"path"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// GenesisDiff is printed by genesis diff.
+type GenesisDiff struct {
+	A       string          `json:"a"`
+	B       string          `json:"b"`
+	Changes []GenesisChange `json:"changes"`
+}
+
+// genesisStateTypes are the genesis states the diff round trips through the
+// app codec, so that omitted defaults and Any-typed fields compare by value.
+// They cover every module of the app with a proto genesis state; the others
+// have no genesis state to normalize.
+var genesisStateTypes = map[string]func() proto.Message{
+	authtypes.ModuleName:        func() proto.Message { return &authtypes.GenesisState{} },
+	authz.ModuleName:            func() proto.Message { return &authz.GenesisState{} },
+	banktypes.ModuleName:        func() proto.Message { return &banktypes.GenesisState{} },
+	capabilitytypes.ModuleName:  func() proto.Message { return &capabilitytypes.GenesisState{} },
+	distrtypes.ModuleName:       func() proto.Message { return &distrtypes.GenesisState{} },
+	evidencetypes.ModuleName:    func() proto.Message { return &evidencetypes.GenesisState{} },
+	feegrant.ModuleName:         func() proto.Message { return &feegrant.GenesisState{} },
+	genutiltypes.ModuleName:     func() proto.Message { return &genutiltypes.GenesisState{} },
+	govtypes.ModuleName:         func() proto.Message { return &govv1types.GenesisState{} },
+	group.ModuleName:            func() proto.Message { return &group.GenesisState{} },
+	ibcexported.ModuleName:      func() proto.Message { return &ibctypes.GenesisState{} },
+	ibctransfertypes.ModuleName: func() proto.Message { return &ibctransfertypes.GenesisState{} },
+	minttypes.ModuleName:        func() proto.Message { return &minttypes.GenesisState{} },
+	slashingtypes.ModuleName:    func() proto.Message { return &slashingtypes.GenesisState{} },
+	stakingtypes.ModuleName:     func() proto.Message { return &stakingtypes.GenesisState{} },
+	wasmtypes.ModuleName:        func() proto.Message { return &wasmtypes.GenesisState{} },
+
+	audittypes.ModuleName:           func() proto.Message { return &audittypes.GenesisState{} },
+	communitytaxtypes.ModuleName:    func() proto.Message { return &communitytaxtypes.GenesisState{} },
+	contractsponsortypes.ModuleName: func() proto.Message { return &contractsponsortypes.GenesisState{} },
+	escrowtypes.ModuleName:          func() proto.Message { return &escrowtypes.GenesisState{} },
+	feeabstypes.ModuleName:          func() proto.Message { return &feeabstypes.GenesisState{} },
+	feeburntypes.ModuleName:         func() proto.Message { return &feeburntypes.GenesisState{} },
+	keysharetypes.ModuleName:        func() proto.Message { return &keysharetypes.GenesisState{} },
+	liquidstaketypes.ModuleName:     func() proto.Message { return &liquidstaketypes.GenesisState{} },
+	oracletypes.ModuleName:          func() proto.Message { return &oracletypes.GenesisState{} },
+	schematypes.ModuleName:          func() proto.Message { return &schematypes.GenesisState{} },
+	sponsortypes.ModuleName:         func() proto.Message { return &sponsortypes.GenesisState{} },
+	treasurytypes.ModuleName:        func() proto.Message { return &treasurytypes.GenesisState{} },
+	valpolicytypes.ModuleName:       func() proto.Message { return &valpolicytypes.GenesisState{} },
+	zktypes.ModuleName:              func() proto.Message { return &zktypes.GenesisState{} },
+}
+
+// genesisListKeys are the fields identifying the elements of genesis lists,
+// by the path of the list with the keys of its parents left out. Lists of
+// coins are matched by denom wherever they are.
+var genesisListKeys = map[string][]string{
+	"bank.balances":                                  {"address"},
+	"bank.supply":                                    {"denom"},
+	"bank.denom_metadata":                            {"base"},
+	"bank.send_enabled":                              {"denom"},
+	"staking.validators":                             {"operator_address"},
+	"staking.last_validator_powers":                  {"address"},
+	"staking.delegations":                            {"delegator_address", "validator_address"},
+	"staking.unbonding_delegations":                  {"delegator_address", "validator_address"},
+	"staking.redelegations":                          {"delegator_address", "validator_src_address", "validator_dst_address"},
+	"distribution.delegator_withdraw_infos":          {"delegator_address"},
+	"distribution.outstanding_rewards":               {"validator_address"},
+	"distribution.validator_accumulated_commissions": {"validator_address"},
+	"distribution.validator_current_rewards":         {"validator_address"},
+	"distribution.delegator_starting_infos":          {"delegator_address", "validator_address"},
+	"slashing.signing_infos":                         {"address"},
+	"slashing.missed_blocks":                         {"address"},
+	"gov.proposals":                                  {"id"},
+	"gov.deposits":                                   {"proposal_id", "depositor"},
+	"gov.votes":                                      {"proposal_id", "voter"},
+	"wasm.codes":                                     {"code_id"},
+	"wasm.contracts":                                 {"contract_address"},
+	"wasm.sequences":                                 {"id_key"},
+	"consensus.validators":                           {"address"},
+}
+
+// GenesisDiffCmd compares two genesis files module by module.
+func GenesisDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [a.json] [b.json]",
+		Short: "Compare two genesis files module by module",
+		Long: `Compare two genesis files, e.g. the exports before and after an upgrade rehearsal.
+The app state of every module is decoded with the app codec, so the order of keys,
+omitted defaults and the encoding of Any-typed fields do not matter. Accounts, balances,
+validators, delegations, proposals and wasm codes and contracts are matched by their
+address or id, so additions and removals are reported as such. Wasm code bytes and
+contract state are compared by their sha256 digest. Files ending with .gz are decompressed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			a, err := readAppGenesis(args[0])
+			if err != nil {
+				return err
+			}
+			b, err := readAppGenesis(args[1])
+			if err != nil {
+				return err
+			}
+
+			changes, err := DiffGenesis(clientCtx.Codec, a, b)
+			if err != nil {
+				return err
+			}
+			diff := GenesisDiff{A: args[0], B: args[1], Changes: changes}
+
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			switch output {
+			case flags.OutputFormatJSON:
+				bz, err := json.MarshalIndent(diff, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			case flags.OutputFormatText:
+				printGenesisDiff(cmd.OutOrStdout(), diff)
+			default:
+				return fmt.Errorf("unknown output format %q, expected %s or %s", output, flags.OutputFormatText, flags.OutputFormatJSON)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// readAppGenesis reads a genesis file, gzipped when its name ends with .gz.
+func readAppGenesis(path string) (*genutiltypes.AppGenesis, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress %s: %w", path, err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	appGenesis, err := genutiltypes.AppGenesisFromReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis file %s: %w", path, err)
+	}
+	return appGenesis, nil
+}
+
+// DiffGenesis returns the changes from genesis a to genesis b, ordered by
+// module, the fields outside of the app state first.
+func DiffGenesis(cdc codec.Codec, a, b *genutiltypes.AppGenesis) ([]GenesisChange, error) {
+	d := &genesisDiffer{cdc: cdc}
+
+	header := func(ag *genutiltypes.AppGenesis) map[string]any {
+		return map[string]any{
+			"chain_id":       ag.ChainID,
+			"genesis_time":   ag.GenesisTime.UTC().String(),
+			"initial_height": ag.InitialHeight,
+			"app_hash":       hex.EncodeToString(ag.AppHash),
+		}
+	}
+	d.diff(diffModuleGenesis, nil, header(a), header(b))
+
+	consensusA, err := consensusValue(a)
+	if err != nil {
+		return nil, err
+	}
+	consensusB, err := consensusValue(b)
+	if err != nil {
+		return nil, err
+	}
+	d.diff(diffModuleConsensus, nil, consensusA, consensusB)
+
+	var stateA, stateB map[string]json.RawMessage
+	if err := json.Unmarshal(a.AppState, &stateA); err != nil {
+		return nil, fmt.Errorf("invalid app state: %w", err)
+	}
+	if err := json.Unmarshal(b.AppState, &stateB); err != nil {
+		return nil, fmt.Errorf("invalid app state: %w", err)
+	}
+
+	modules := make([]string, 0, len(stateA)+len(stateB))
+	for name := range stateA {
+		modules = append(modules, name)
+	}
+	for name := range stateB {
+		if _, ok := stateA[name]; !ok {
+			modules = append(modules, name)
+		}
+	}
+	sort.Strings(modules)
+
+	for _, name := range modules {
+		rawA, okA := stateA[name]
+		rawB, okB := stateB[name]
+		switch {
+		case !okA:
+			d.add(GenesisChange{Module: name, Kind: ChangeAdded})
+			continue
+		case !okB:
+			d.add(GenesisChange{Module: name, Kind: ChangeRemoved})
+			continue
+		}
+
+		valueA, err := d.moduleValue(name, rawA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s genesis of the first file: %w", name, err)
+		}
+		valueB, err := d.moduleValue(name, rawB)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s genesis of the second file: %w", name, err)
+		}
+		d.diff(name, nil, valueA, valueB)
+	}
+
+	return d.changes, nil
+}
+
+type genesisDiffer struct {
+	cdc     codec.Codec
+	changes []GenesisChange
+}
+
+func (d *genesisDiffer) add(change GenesisChange) {
+	d.changes = append(d.changes, change)
+}
+
+// moduleValue decodes the genesis of a module into a generic JSON value,
+// round tripping it through the app codec when its type is known.
+func (d *genesisDiffer) moduleValue(name string, raw json.RawMessage) (any, error) {
+	if newState, ok := genesisStateTypes[name]; ok {
+		state := newState()
+		if err := d.cdc.UnmarshalJSON(raw, state); err != nil {
+			return nil, err
+		}
+		bz, err := d.cdc.MarshalJSON(state)
+		if err != nil {
+			return nil, err
+		}
+		raw = bz
+	}
+
+	value, err := decodeJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case authtypes.ModuleName:
+		return d.keyAccounts(value)
+	case wasmtypes.ModuleName:
+		return digestWasmState(value)
+	}
+	return value, nil
+}
+
+// keyAccounts replaces the account list of the auth genesis by an object
+// keyed by address, found by unpacking each account with the app codec.
+func (d *genesisDiffer) keyAccounts(value any) (any, error) {
+	state, ok := value.(map[string]any)
+	if !ok {
+		return value, nil
+	}
+	accounts, _ := state["accounts"].([]any)
+	keyed := make(map[string]any, len(accounts))
+	for _, account := range accounts {
+		bz, err := json.Marshal(account)
+		if err != nil {
+			return nil, err
+		}
+		var acc sdk.AccountI
+		if err := d.cdc.UnmarshalInterfaceJSON(bz, &acc); err != nil {
+			return nil, fmt.Errorf("invalid account: %w", err)
+		}
+		keyed[acc.GetAddress().String()] = account
+	}
+	state["accounts"] = keyedValues(keyed)
+	return state, nil
+}
+
+// digestWasmState replaces the code bytes and contract state of the wasm
+// genesis by their size and sha256 digest.
+func digestWasmState(value any) (any, error) {
+	state, ok := value.(map[string]any)
+	if !ok {
+		return value, nil
+	}
+
+	codes, _ := state["codes"].([]any)
+	for _, code := range codes {
+		code, ok := code.(map[string]any)
+		if !ok {
+			continue
+		}
+		encoded, _ := code["code_bytes"].(string)
+		bytecode, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code bytes: %w", err)
+		}
+		checksum := sha256.Sum256(bytecode)
+		code["code_bytes"] = map[string]any{"size": len(bytecode), "sha256": hex.EncodeToString(checksum[:])}
+	}
+
+	contracts, _ := state["contracts"].([]any)
+	for _, contract := range contracts {
+		contract, ok := contract.(map[string]any)
+		if !ok {
+			continue
+		}
+		models, _ := contract["contract_state"].([]any)
+		hash := sha256.New()
+		for _, model := range models {
+			bz, err := json.Marshal(model)
+			if err != nil {
+				return nil, err
+			}
+			hash.Write(bz)
+		}
+		contract["contract_state"] = map[string]any{"entries": len(models), "sha256": hex.EncodeToString(hash.Sum(nil))}
+	}
+	return state, nil
+}
+
+func consensusValue(ag *genutiltypes.AppGenesis) (any, error) {
+	if ag.Consensus == nil {
+		return nil, nil
+	}
+	bz, err := json.Marshal(ag.Consensus)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSON(bz)
+}
+
+func decodeJSON(bz []byte) (any, error) {
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// keyedValues is a list whose elements were matched by key.
+type keyedValues map[string]any
+
+// pathSegment is a field of an object, a key of a list matched by identity or
+// an index of any other list.
+type pathSegment struct {
+	field string
+	key   string
+	index int
+}
+
+func formatPath(path []pathSegment) string {
+	var sb strings.Builder
+	for _, seg := range path {
+		switch {
+		case seg.field != "":
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(seg.field)
+		case seg.key != "":
+			fmt.Fprintf(&sb, "[%s]", seg.key)
+		default:
+			fmt.Fprintf(&sb, "[%d]", seg.index)
+		}
+	}
+	return sb.String()
+}
+
+// listPattern is the path of a list in genesisListKeys.
+func listPattern(module string, path []pathSegment) string {
+	fields := []string{module}
+	for _, seg := range path {
+		if seg.field != "" {
+			fields = append(fields, seg.field)
+		}
+	}
+	return strings.Join(fields, ".")
+}
+
+func (d *genesisDiffer) diff(module string, path []pathSegment, a, b any) {
+	if listA, ok := a.([]any); ok {
+		a = d.keyList(module, path, listA)
+	}
+	if listB, ok := b.([]any); ok {
+		b = d.keyList(module, path, listB)
+	}
+	// an empty list is matched by key when the other one is
+	if _, ok := b.(keyedValues); ok && isEmptyList(a) {
+		a = keyedValues{}
+	}
+	if _, ok := a.(keyedValues); ok && isEmptyList(b) {
+		b = keyedValues{}
+	}
+
+	switch a := a.(type) {
+	case map[string]any:
+		if b, ok := b.(map[string]any); ok {
+			d.diffObjects(module, path, a, b, func(field string) pathSegment { return pathSegment{field: field} })
+			return
+		}
+	case keyedValues:
+		if b, ok := b.(keyedValues); ok {
+			d.diffObjects(module, path, a, b, func(key string) pathSegment { return pathSegment{key: key} })
+			return
+		}
+	case []any:
+		if b, ok := b.([]any); ok {
+			for i := 0; i < len(a) || i < len(b); i++ {
+				elemPath := append(append([]pathSegment{}, path...), pathSegment{index: i})
+				switch {
+				case i >= len(b):
+					d.add(GenesisChange{Module: module, Kind: ChangeRemoved, Path: formatPath(elemPath), Before: a[i]})
+				case i >= len(a):
+					d.add(GenesisChange{Module: module, Kind: ChangeAdded, Path: formatPath(elemPath), After: b[i]})
+				default:
+					d.diff(module, elemPath, a[i], b[i])
+				}
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		d.add(GenesisChange{Module: module, Kind: ChangeChanged, Path: formatPath(path), Before: plainValue(a), After: plainValue(b)})
+	}
+}
+
+func (d *genesisDiffer) diffObjects(module string, path []pathSegment, a, b map[string]any, segment func(string) pathSegment) {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		valueA, okA := a[key]
+		valueB, okB := b[key]
+		keyPath := append(append([]pathSegment{}, path...), segment(key))
+		switch {
+		case !okA:
+			d.add(GenesisChange{Module: module, Kind: ChangeAdded, Path: formatPath(keyPath), After: plainValue(valueB)})
+		case !okB:
+			d.add(GenesisChange{Module: module, Kind: ChangeRemoved, Path: formatPath(keyPath), Before: plainValue(valueA)})
+		default:
+			d.diff(module, keyPath, valueA, valueB)
+		}
+	}
+}
+
+// keyList returns the elements of list keyed by their identity when the list
+// has one, the list otherwise.
+func (d *genesisDiffer) keyList(module string, path []pathSegment, list []any) any {
+	if len(list) == 0 {
+		return list
+	}
+	fields, ok := genesisListKeys[listPattern(module, path)]
+	if !ok {
+		if !isCoinList(list) {
+			return list
+		}
+		fields = []string{"denom"}
+	}
+
+	keyed := make(keyedValues, len(list))
+	for _, elem := range list {
+		obj, ok := elem.(map[string]any)
+		if !ok {
+			return list
+		}
+		parts := make([]string, len(fields))
+		for i, field := range fields {
+			parts[i] = fmt.Sprint(obj[field])
+		}
+		key := strings.Join(parts, "/")
+		if _, dup := keyed[key]; dup {
+			return list
+		}
+		keyed[key] = elem
+	}
+	return keyed
+}
+
+func isEmptyList(value any) bool {
+	list, ok := value.([]any)
+	return ok && len(list) == 0
+}
+
+func isCoinList(list []any) bool {
+	for _, elem := range list {
+		obj, ok := elem.(map[string]any)
+		if !ok || len(obj) != 2 || obj["denom"] == nil || obj["amount"] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// plainValue turns keyed lists back into lists for printing.
+func plainValue(value any) any {
+	keyed, ok := value.(keyedValues)
+	if !ok {
+		return value
+	}
+	keys := make([]string, 0, len(keyed))
+	for key := range keyed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	list := make([]any, 0, len(keyed))
+	for _, key := range keys {
+		list = append(list, keyed[key])
+	}
+	return list
+}
+
+// printGenesisDiff prints the changes grouped by module, "+" marking added,
+// "-" removed and "~" changed values.
+func printGenesisDiff(w io.Writer, diff GenesisDiff) {
+	if len(diff.Changes) == 0 {
+		fmt.Fprintf(w, "%s and %s are equivalent\n", diff.A, diff.B)
+		return
+	}
+
+	module := ""
+	for i, change := range diff.Changes {
+		if i == 0 || change.Module != module {
+			module = change.Module
+			fmt.Fprintln(w, module)
+		}
+		path := change.Path
+		if path == "" {
+			path = "(module)"
+		}
+		switch change.Kind {
+		case ChangeAdded:
+			fmt.Fprintf(w, "  + %s\n", path)
+		case ChangeRemoved:
+			fmt.Fprintf(w, "  - %s\n", path)
+		default:
+			fmt.Fprintf(w, "  ~ %s: %s -> %s\n", path, shortJSON(change.Before), shortJSON(change.After))
+		}
+	}
+	fmt.Fprintf(w, "%d changes\n", len(diff.Changes))
+}
+
+// shortJSON is value in compact JSON, truncated to fit a line.
+func shortJSON(value any) string {
+	const maxLen = 80
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	if len(bz) > maxLen {
+		return string(bz[:maxLen-3]) + "..."
+	}
+	return string(bz)
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisDiffCmd(t *testing.T) {
+	hippoApp := newTestApp(t)
+	cdc := hippoApp.AppCodec()
+
+	home := t.TempDir()
+	srvCtx := server.NewDefaultContext()
+	srvCtx.Config.SetRoot(home)
+	clientCtx := client.Context{}.WithHomeDir(home).WithCodec(cdc)
+	ctx := context.WithValue(
+		context.WithValue(context.Background(), server.ServerContextKey, srvCtx),
+		client.ClientContextKey, &clientCtx,
+	)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	initCmd := InitCmd(hippoApp.BasicModuleManager, home)
+	initCmd.SetArgs([]string{"hippo-moniker", "--home", home})
+	initCmd.SetContext(ctx)
+	initCmd.SetOut(new(bytes.Buffer))
+	initCmd.SetErr(new(bytes.Buffer))
+	require.NoError(t, initCmd.Execute())
+	genFile := filepath.Join(home, "config", "genesis.json")
+
+	writeGenesis := func(modify func(appState map[string]json.RawMessage)) string {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+		require.NoError(t, err)
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+		modify(appState)
+		appGenesis.AppState, err = json.Marshal(appState)
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, appGenesis.SaveAs(path))
+		return path
+	}
+
+	genesisDiff := func(args ...string) (string, error) {
+		command := GenesisDiffCmd()
+		command.SetArgs(args)
+		command.SetContext(ctx)
+		out := new(bytes.Buffer)
+		command.SetOut(out)
+		command.SetErr(new(bytes.Buffer))
+		err := command.Execute()
+		return out.String(), err
+	}
+
+	addr := sdk.AccAddress([]byte("new_account_________"))
+	modified := writeGenesis(func(appState map[string]json.RawMessage) {
+		var authGenState authtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[authtypes.ModuleName], &authGenState)
+		accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+		require.NoError(t, err)
+		accounts = append(accounts, authtypes.NewBaseAccountWithAddress(addr))
+		authGenState.Accounts, err = authtypes.PackAccounts(accounts)
+		require.NoError(t, err)
+		appState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+
+		var bankGenState banktypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenState)
+		coins := sdk.NewCoins(sdk.NewCoin("ahp", sdkmath.NewInt(1000)))
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+		appState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+		var stakingGenState stakingtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState)
+		stakingGenState.Params.MaxValidators++
+		appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
+
+		var wasmGenState wasmtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[wasmtypes.ModuleName], &wasmGenState)
+		wasmGenState.Codes = append(wasmGenState.Codes, wasmtypes.Code{
+			CodeID:    1,
+			CodeInfo:  wasmtypes.CodeInfo{CodeHash: make([]byte, 32), Creator: addr.String(), InstantiateConfig: wasmtypes.AllowEverybody},
+			CodeBytes: []byte("wasm"),
+		})
+		appState[wasmtypes.ModuleName] = cdc.MustMarshalJSON(&wasmGenState)
+	})
+
+	t.Run("Reordered keys are equivalent", func(t *testing.T) {
+		// re-encoding the app state through generic maps sorts its keys
+		reordered := writeGenesis(func(appState map[string]json.RawMessage) {
+			for name, bz := range appState {
+				var value map[string]any
+				require.NoError(t, json.Unmarshal(bz, &value))
+				reencoded, err := json.Marshal(value)
+				require.NoError(t, err)
+				appState[name] = reencoded
+			}
+		})
+		out, err := genesisDiff(genFile, reordered)
+		require.NoError(t, err)
+		require.Contains(t, out, "are equivalent")
+	})
+
+	t.Run("Changes per module", func(t *testing.T) {
+		out, err := genesisDiff(genFile, modified, "--output", "json")
+		require.NoError(t, err)
+		var diff GenesisDiff
+		require.NoError(t, json.Unmarshal([]byte(out), &diff))
+
+		paths := make(map[string]string, len(diff.Changes))
+		for _, change := range diff.Changes {
+			paths[change.Module+" "+change.Path] = change.Kind
+		}
+		require.Equal(t, map[string]string{
+			"auth accounts[" + addr.String() + "]": ChangeAdded,
+			"bank balances[" + addr.String() + "]": ChangeAdded,
+			"bank supply[ahp]":                     ChangeAdded,
+			"staking params.max_validators":        ChangeChanged,
+			"wasm codes[1]":                        ChangeAdded,
+		}, paths)
+
+		// code bytes are compared by digest
+		checksum := sha256.Sum256([]byte("wasm"))
+		for _, change := range diff.Changes {
+			if change.Module == wasmtypes.ModuleName {
+				require.Equal(t, map[string]any{
+					"size":   float64(4),
+					"sha256": hex.EncodeToString(checksum[:]),
+				}, change.After.(map[string]any)["code_bytes"])
+			}
+		}
+	})
+
+	t.Run("Text output", func(t *testing.T) {
+		out, err := genesisDiff(genFile, modified)
+		require.NoError(t, err)
+		require.Contains(t, out, "staking\n  ~ params.max_validators: ")
+		require.Contains(t, out, "  + balances["+addr.String()+"]")
+		require.Contains(t, out, "5 changes")
+	})
+
+	t.Run("Unknown output format", func(t *testing.T) {
+		_, err := genesisDiff(genFile, modified, "--output", "yaml")
+		require.ErrorContains(t, err, "unknown output format")
+	})
+}
+
+func TestGenesisStateTypes(t *testing.T) {
+	hippoApp := newTestApp(t)
+	cdc := hippoApp.AppCodec()
+
+	// every module with a genesis state is decoded by the app codec
+	for name, raw := range hippoApp.DefaultGenesis() {
+		newState, ok := genesisStateTypes[name]
+		if !ok {
+			require.Contains(t, []string{"", "{}", "null"}, string(raw), "%s has a genesis state", name)
+			continue
+		}
+		require.NoError(t, cdc.UnmarshalJSON(raw, newState()), name)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/cli"
@@ -92,6 +94,13 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 				chainID = fmt.Sprintf("test-chain-%v", tmrand.Str(6))
 			}
 
+			// Load the network profile before writing any file
+			profileName, _ := cmd.Flags().GetString(FlagProfile)
+			profile, err := LoadNetworkProfile(profileName)
+			if err != nil {
+				return err
+			}
+
 			// Get bip39 mnemonic
 			var mnemonic string
 			recover, _ := cmd.Flags().GetBool(FlagRecover)
@@ -158,7 +167,7 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 				Version:   types.DefaultVersionParams(),
 			}
 
-			appState, err := overrideGenesis(cdc, genDoc, appGenState)
+			appState, err := overrideGenesisWithProfile(cdc, genDoc, appGenState, profile)
 			if err != nil {
 				return errors.Wrap(err, "Failed to marshal default genesis state")
 			}
@@ -191,6 +200,9 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 					Version: types.VersionParams{
 						App: genDoc.ConsensusParams.Version.App,
 					},
+					ABCI: types.ABCIParams{
+						VoteExtensionsEnableHeight: genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight,
+					},
 				},
 			}
 
@@ -210,35 +222,28 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().String(FlagDefaultBondDenom, "", "genesis file default denomination, if left blank default value is 'stake'")
 	cmd.Flags().Int64(flags.FlagInitHeight, 1, "specify the initial block height at genesis")
+	cmd.Flags().String(FlagProfile, ProfileMainnet, fmt.Sprintf("network profile of the genesis parameters, one of %s or a path to a YAML/JSON profile file", strings.Join(ProfileNames(), ", ")))
 
 	return cmd
 }
 
 // overrideGenesis overrides some parameters in the genesis doc to the hippo-specific values.
 func overrideGenesis(cdc codec.JSONCodec, genDoc *types.GenesisDoc, appState map[string]json.RawMessage) (json.RawMessage, error) {
-	genDoc.ConsensusParams.Block.MaxBytes = consensus.MaxBlockSize // 4MB
-	genDoc.ConsensusParams.Block.MaxGas = consensus.MaxBlockGas    // 100 milion
+	return overrideGenesisWithProfile(cdc, genDoc, appState, MainnetProfile())
+}
+
+// overrideGenesisWithProfile overrides some parameters in the genesis doc to
+// the hippo-specific values, taking the consensus policy from profile.
+func overrideGenesisWithProfile(cdc codec.JSONCodec, genDoc *types.GenesisDoc, appState map[string]json.RawMessage, profile NetworkProfile) (json.RawMessage, error) {
+	genDoc.ConsensusParams.Block.MaxBytes = profile.MaxBlockBytes
+	genDoc.ConsensusParams.Block.MaxGas = profile.MaxBlockGas
 
 	var bankGenState banktypes.GenesisState
 	if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenState); err != nil {
 		return nil, err
 	}
 
-	hpMetadata := banktypes.Metadata{
-		Name:        "Hippo", // Often the full name
-		Symbol:      "HP",    // The commonly used ticker symbol
-		Description: "The native staking token of the Hippo Protocol.",
-		DenomUnits: []*banktypes.DenomUnit{ // Note: DenomUnits is a slice of *pointers* to DenomUnit
-			{
-				Denom:    "ahp", // base unit
-				Exponent: 0,
-			},
-		},
-		Base:    "ahp", // The base denomination (exponent 0)
-		Display: "ahp", // The denomination users typically see
-	}
-
-	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, hpMetadata)
+	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, hippoDenomMetadata())
 
 	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
 
@@ -247,10 +252,10 @@ func overrideGenesis(cdc codec.JSONCodec, genDoc *types.GenesisDoc, appState map
 		return nil, err
 	}
 
-	stakingGenState.Params.UnbondingTime = unbondingPeriod
-	stakingGenState.Params.MaxValidators = consensus.MaxValidators
+	stakingGenState.Params.UnbondingTime = time.Duration(profile.UnbondingTime)
+	stakingGenState.Params.MaxValidators = profile.MaxValidators
 	stakingGenState.Params.BondDenom = consensus.DefaultHippoDenom
-	stakingGenState.Params.MinCommissionRate = math.LegacyNewDecWithPrec(consensus.MinCommissionRate, 2)
+	stakingGenState.Params.MinCommissionRate = profile.MinCommissionRate
 	appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
 
 	var mintGenState minttypes.GenesisState
@@ -269,22 +274,22 @@ func overrideGenesis(cdc codec.JSONCodec, genDoc *types.GenesisDoc, appState map
 	if err := cdc.UnmarshalJSON(appState[distrtypes.ModuleName], &distrGenState); err != nil {
 		return nil, err
 	}
-	distrGenState.Params.CommunityTax = math.LegacyNewDecWithPrec(consensus.CommunityTax, 2)
+	distrGenState.Params.CommunityTax = profile.CommunityTax
 	appState[distrtypes.ModuleName] = cdc.MustMarshalJSON(&distrGenState)
 
 	var govGenState govv1types.GenesisState
 	if err := cdc.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState); err != nil {
 		return nil, err
 	}
-	minDepositTokens := sdk.TokensFromConsensusPower(consensus.MinDepositTokens, sdk.DefaultPowerReduction) // 50,000 HP
+	minDepositTokens := sdk.TokensFromConsensusPower(int64(profile.MinDeposit), sdk.DefaultPowerReduction)
 	govGenState.Params.MinDeposit = sdk.Coins{sdk.NewCoin(consensus.DefaultHippoDenom, minDepositTokens)}
-	expeditedMinDepositTokens := sdk.TokensFromConsensusPower(consensus.ExpeditedMinDeposit, sdk.DefaultPowerReduction) // 100,000 HP
+	expeditedMinDepositTokens := sdk.TokensFromConsensusPower(int64(profile.ExpeditedMinDeposit), sdk.DefaultPowerReduction)
 	govGenState.Params.ExpeditedMinDeposit = sdk.Coins{sdk.NewCoin(consensus.DefaultHippoDenom, expeditedMinDepositTokens)}
-	maxDepositPeriod := consensus.MaxDepositPeriod // 14 days
+	maxDepositPeriod := time.Duration(profile.MaxDepositPeriod)
 	govGenState.Params.MaxDepositPeriod = &maxDepositPeriod
-	votingPeriod := consensus.VotingPeriod
+	votingPeriod := time.Duration(profile.VotingPeriod)
 	govGenState.Params.VotingPeriod = &votingPeriod
-	expeditedVotingPeriod := consensus.ExpeditedVotingPeriod
+	expeditedVotingPeriod := time.Duration(profile.ExpeditedVotingPeriod)
 	govGenState.Params.ExpeditedVotingPeriod = &expeditedVotingPeriod
 	appState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenState)
 
@@ -292,21 +297,43 @@ func overrideGenesis(cdc codec.JSONCodec, genDoc *types.GenesisDoc, appState map
 	if err := cdc.UnmarshalJSON(appState[slashingtypes.ModuleName], &slashingGenState); err != nil {
 		return nil, err
 	}
-	slashingGenState.Params.SignedBlocksWindow = consensus.SignedBlocksWindow
-	slashingGenState.Params.MinSignedPerWindow = math.LegacyNewDecWithPrec(consensus.MinSignedPerWindow, 2)
-	slashingGenState.Params.SlashFractionDoubleSign = math.LegacyNewDecWithPrec(consensus.SlashFractionDoubleSign, 2) // 5%
-	slashingGenState.Params.SlashFractionDowntime = math.LegacyNewDecWithPrec(consensus.SlashFractionDowntime*100, 4) // 0.01%
+	slashingGenState.Params.SignedBlocksWindow = profile.SignedBlocksWindow
+	slashingGenState.Params.MinSignedPerWindow = profile.MinSignedPerWindow
+	slashingGenState.Params.SlashFractionDoubleSign = profile.SlashFractionDoubleSign
+	slashingGenState.Params.SlashFractionDowntime = profile.SlashFractionDowntime
 	appState[slashingtypes.ModuleName] = cdc.MustMarshalJSON(&slashingGenState)
 
 	// MaxAgeDuration and MaxAgeNumBlocks values should be longer than unbonding period.
 	// Otherwise it may allow malicious validators to escape penalties.
 	// https://github.com/advisories/GHSA-555p-m4v6-cqxv
-	genDoc.ConsensusParams.Evidence.MaxAgeDuration = consensus.MaxAgeDuration          // 30 days
-	genDoc.ConsensusParams.Evidence.MaxAgeNumBlocks = int64(consensus.MaxAgeNumBlocks) // 30 days
+	// NetworkProfile.Validate enforces this for every profile.
+	genDoc.ConsensusParams.Evidence.MaxAgeDuration = time.Duration(profile.EvidenceMaxAgeDuration)
+	genDoc.ConsensusParams.Evidence.MaxAgeNumBlocks = profile.EvidenceMaxAgeNumBlocks
+
+	// The oracle aggregates the prices reported in vote extensions, enable
+	// them from the first block.
+	genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight = max(genDoc.InitialHeight, 1)
 
 	return tmjson.Marshal(appState)
 }
 
+// hippoDenomMetadata returns the bank metadata of the native staking token.
+func hippoDenomMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Name:        "Hippo", // Often the full name
+		Symbol:      "HP",    // The commonly used ticker symbol
+		Description: "The native staking token of the Hippo Protocol.",
+		DenomUnits: []*banktypes.DenomUnit{ // Note: DenomUnits is a slice of *pointers* to DenomUnit
+			{
+				Denom:    "ahp", // base unit
+				Exponent: 0,
+			},
+		},
+		Base:    "ahp", // The base denomination (exponent 0)
+		Display: "ahp", // The denomination users typically see
+	}
+}
+
 func newPrintInfo(moniker, chainID, nodeID, genTxsDir string, appMessage json.RawMessage) printInfo {
 	return printInfo{
 		Moniker:    moniker,
@@ -272,6 +272,7 @@ func TestOverrideGenesis(t *testing.T) {
 	require.Equal(t, consensus.MaxBlockGas, int(genDoc.ConsensusParams.Block.MaxGas))
 	require.Equal(t, consensus.MaxAgeDuration, time.Duration(genDoc.ConsensusParams.Evidence.MaxAgeDuration))
 	require.Equal(t, consensus.MaxAgeNumBlocks, uint64(genDoc.ConsensusParams.Evidence.MaxAgeNumBlocks))
+	require.Equal(t, int64(1), genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight)
 
 	var appState map[string]json.RawMessage
 	err = json.Unmarshal(appStateJson, &appState)
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+const (
+	flagAccountsToFund = "accounts-to-fund"
+	flagFundAmount     = "fund-amount"
+	flagVotingPeriod   = "voting-period"
+)
+
+// addTestnetFlags adds the flags of in-place-testnet on top of the start
+// flags.
+func addTestnetFlags(cmd *cobra.Command) {
+	addModuleInitFlags(cmd)
+	cmd.Flags().StringSlice(flagAccountsToFund, nil, "comma separated addresses of the test accounts to fund")
+	cmd.Flags().String(flagFundAmount, "1000000000000000000000000"+consensus.DefaultHippoDenom, "coins each test account is funded with")
+	cmd.Flags().Duration(flagVotingPeriod, time.Duration(LocalnetProfile().VotingPeriod), "gov voting and deposit period of the testnet, the expedited voting period is half of it")
+}
+
+// newTestnetApp creates the app of in-place-testnet, rewriting the state of
+// the data directory into a single validator testnet controlled by the local
+// validator key before the node starts.
+func newTestnetApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+	hippoApp, ok := newApp(logger, db, traceStore, appOpts).(*app.App)
+	if !ok {
+		panic("app created by newApp is not of type *app.App")
+	}
+
+	opts, err := testnetOptionsFromAppOpts(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("invalid in-place testnet options: %s", err))
+	}
+	if err := hippoApp.InitForTestnet(opts); err != nil {
+		panic(fmt.Sprintf("failed to initialize in-place testnet: %s", err))
+	}
+	return hippoApp
+}
+
+func testnetOptionsFromAppOpts(appOpts servertypes.AppOptions) (app.TestnetOptions, error) {
+	newValPubKey, ok := appOpts.Get(server.KeyUserPubKey).(cmtcrypto.PubKey)
+	if !ok {
+		return app.TestnetOptions{}, fmt.Errorf("validator pubkey is missing")
+	}
+	operator, err := sdk.AccAddressFromBech32(cast.ToString(appOpts.Get(server.KeyNewOpAddr)))
+	if err != nil {
+		return app.TestnetOptions{}, fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	upgradeToTrigger := cast.ToString(appOpts.Get(server.KeyTriggerTestnetUpgrade))
+	if upgradeToTrigger != "" {
+		found := false
+		for _, u := range app.Upgrades {
+			found = found || u.UpgradeName == upgradeToTrigger
+		}
+		if !found {
+			return app.TestnetOptions{}, fmt.Errorf("binary has no handler for upgrade %q", upgradeToTrigger)
+		}
+	}
+
+	var accounts []sdk.AccAddress
+	for _, bech32 := range cast.ToStringSlice(appOpts.Get(flagAccountsToFund)) {
+		addr, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			return app.TestnetOptions{}, fmt.Errorf("invalid account to fund %s: %w", bech32, err)
+		}
+		accounts = append(accounts, addr)
+	}
+	fundAmount, err := sdk.ParseCoinsNormalized(cast.ToString(appOpts.Get(flagFundAmount)))
+	if err != nil {
+		return app.TestnetOptions{}, fmt.Errorf("invalid fund amount: %w", err)
+	}
+
+	return app.TestnetOptions{
+		NewValPubKey:       newValPubKey,
+		NewOperatorAddress: operator,
+		UpgradeToTrigger:   upgradeToTrigger,
+		AccountsToFund:     accounts,
+		FundAmount:         fundAmount,
+		VotingPeriod:       cast.ToDuration(appOpts.Get(flagVotingPeriod)),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+)
+
+func TestTestnetOptionsFromAppOpts(t *testing.T) {
+	setAddressPrefixes()
+	operator := sdk.AccAddress([]byte("testnet_operator____"))
+	user := sdk.AccAddress([]byte("testnet_user________"))
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	appOpts := simtestutil.AppOptionsMap{
+		server.KeyUserPubKey:            pubKey,
+		server.KeyNewOpAddr:             operator.String(),
+		server.KeyTriggerTestnetUpgrade: app.Upgrades[len(app.Upgrades)-1].UpgradeName,
+		flagAccountsToFund:              []string{user.String()},
+		flagFundAmount:                  "100ahp",
+		flagVotingPeriod:                "90s",
+	}
+	opts, err := testnetOptionsFromAppOpts(appOpts)
+	require.NoError(t, err)
+	require.Equal(t, app.TestnetOptions{
+		NewValPubKey:       pubKey,
+		NewOperatorAddress: operator,
+		UpgradeToTrigger:   app.Upgrades[len(app.Upgrades)-1].UpgradeName,
+		AccountsToFund:     []sdk.AccAddress{user},
+		FundAmount:         sdk.NewCoins(sdk.NewInt64Coin("ahp", 100)),
+		VotingPeriod:       90 * time.Second,
+	}, opts)
+
+	appOpts[server.KeyTriggerTestnetUpgrade] = "v99"
+	_, err = testnetOptionsFromAppOpts(appOpts)
+	require.ErrorContains(t, err, `no handler for upgrade "v99"`)
+
+	delete(appOpts, server.KeyUserPubKey)
+	_, err = testnetOptionsFromAppOpts(appOpts)
+	require.ErrorContains(t, err, "validator pubkey is missing")
+}
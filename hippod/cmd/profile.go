@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	stdmath "math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	"sigs.k8s.io/yaml"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+const (
+	// FlagProfile defines a flag to select the network profile the genesis
+	// parameters are taken from.
+	FlagProfile = "profile"
+
+	ProfileMainnet  = "mainnet"
+	ProfileTestnet  = "testnet"
+	ProfileLocalnet = "localnet"
+)
+
+// Duration is a time.Duration written as a Go duration string, e.g. "336h",
+// in profile files.
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"336h\": %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// NetworkProfile holds the consensus policy values hippod init writes into a
+// new genesis file. Deposits are in whole HP, decimals are quoted strings.
+type NetworkProfile struct {
+	// Name of the profile. Profile files set Base to the built-in profile
+	// they override, mainnet when left empty.
+	Name string `json:"name,omitempty"`
+	Base string `json:"base,omitempty"`
+
+	// block
+	MaxBlockBytes int64 `json:"max_block_bytes"`
+	MaxBlockGas   int64 `json:"max_block_gas"`
+	// staking
+	UnbondingTime     Duration       `json:"unbonding_time"`
+	MaxValidators     uint32         `json:"max_validators"`
+	MinCommissionRate math.LegacyDec `json:"min_commission_rate"`
+	// distr
+	CommunityTax math.LegacyDec `json:"community_tax"`
+	// gov
+	MinDeposit            uint64   `json:"min_deposit"`
+	ExpeditedMinDeposit   uint64   `json:"expedited_min_deposit"`
+	MaxDepositPeriod      Duration `json:"max_deposit_period"`
+	VotingPeriod          Duration `json:"voting_period"`
+	ExpeditedVotingPeriod Duration `json:"expedited_voting_period"`
+	// slashing
+	SignedBlocksWindow      int64          `json:"signed_blocks_window"`
+	MinSignedPerWindow      math.LegacyDec `json:"min_signed_per_window"`
+	SlashFractionDoubleSign math.LegacyDec `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime   math.LegacyDec `json:"slash_fraction_downtime"`
+	// evidence
+	EvidenceMaxAgeDuration  Duration `json:"evidence_max_age_duration"`
+	EvidenceMaxAgeNumBlocks int64    `json:"evidence_max_age_num_blocks"`
+}
+
+// MainnetProfile returns the consensus policy of the Hippo mainnet.
+func MainnetProfile() NetworkProfile {
+	return NetworkProfile{
+		Name:                    ProfileMainnet,
+		MaxBlockBytes:           consensus.MaxBlockSize,
+		MaxBlockGas:             consensus.MaxBlockGas,
+		UnbondingTime:           Duration(consensus.UnbondingPeriod),
+		MaxValidators:           consensus.MaxValidators,
+		MinCommissionRate:       math.LegacyNewDecWithPrec(consensus.MinCommissionRate, 2),
+		CommunityTax:            math.LegacyNewDecWithPrec(consensus.CommunityTax, 2),
+		MinDeposit:              consensus.MinDepositTokens,
+		ExpeditedMinDeposit:     consensus.ExpeditedMinDeposit,
+		MaxDepositPeriod:        Duration(consensus.MaxDepositPeriod),
+		VotingPeriod:            Duration(consensus.VotingPeriod),
+		ExpeditedVotingPeriod:   Duration(consensus.ExpeditedVotingPeriod),
+		SignedBlocksWindow:      consensus.SignedBlocksWindow,
+		MinSignedPerWindow:      math.LegacyNewDecWithPrec(consensus.MinSignedPerWindow, 2),
+		SlashFractionDoubleSign: math.LegacyNewDecWithPrec(consensus.SlashFractionDoubleSign, 2),
+		SlashFractionDowntime:   math.LegacyNewDecWithPrec(consensus.SlashFractionDowntime*100, 4),
+		EvidenceMaxAgeDuration:  Duration(consensus.MaxAgeDuration),
+		EvidenceMaxAgeNumBlocks: int64(consensus.MaxAgeNumBlocks),
+	}
+}
+
+// TestnetProfile returns the mainnet policy with periods shortened to days
+// and deposits lowered, for public testnets.
+func TestnetProfile() NetworkProfile {
+	p := MainnetProfile()
+	p.Name = ProfileTestnet
+	p.UnbondingTime = Duration(3 * 24 * time.Hour)
+	p.MaxValidators = 50
+	p.MinDeposit = 1_000
+	p.ExpeditedMinDeposit = 2_000
+	p.MaxDepositPeriod = Duration(2 * 24 * time.Hour)
+	p.VotingPeriod = Duration(2 * 24 * time.Hour)
+	p.ExpeditedVotingPeriod = Duration(24 * time.Hour)
+	p.EvidenceMaxAgeDuration = Duration(4 * 24 * time.Hour)
+	p.EvidenceMaxAgeNumBlocks = 4 * 24 * 60 * 60 / consensus.BlockTimeSec
+	return p
+}
+
+// LocalnetProfile returns the mainnet policy with periods shortened to
+// minutes and token deposits, for local development networks.
+func LocalnetProfile() NetworkProfile {
+	p := MainnetProfile()
+	p.Name = ProfileLocalnet
+	p.UnbondingTime = Duration(10 * time.Minute)
+	p.MinDeposit = 10
+	p.ExpeditedMinDeposit = 20
+	p.MaxDepositPeriod = Duration(2 * time.Minute)
+	p.VotingPeriod = Duration(2 * time.Minute)
+	p.ExpeditedVotingPeriod = Duration(time.Minute)
+	p.SignedBlocksWindow = 100
+	p.MinSignedPerWindow = math.LegacyNewDecWithPrec(50, 2)
+	p.EvidenceMaxAgeDuration = Duration(15 * time.Minute)
+	p.EvidenceMaxAgeNumBlocks = 15 * 60 / consensus.BlockTimeSec
+	return p
+}
+
+var builtinProfiles = map[string]func() NetworkProfile{
+	ProfileMainnet:  MainnetProfile,
+	ProfileTestnet:  TestnetProfile,
+	ProfileLocalnet: LocalnetProfile,
+}
+
+// ProfileNames returns the names of the built-in profiles.
+func ProfileNames() []string {
+	names := make([]string, 0, len(builtinProfiles))
+	for name := range builtinProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadNetworkProfile returns the built-in profile called nameOrPath, or else
+// reads a YAML or JSON profile file whose values override its base profile.
+// The returned profile is validated.
+func LoadNetworkProfile(nameOrPath string) (NetworkProfile, error) {
+	if builtin, ok := builtinProfiles[nameOrPath]; ok {
+		p := builtin()
+		return p, p.Validate()
+	}
+
+	bz, err := os.ReadFile(nameOrPath)
+	if err != nil {
+		return NetworkProfile{}, fmt.Errorf("profile %q is neither one of %s nor a readable file: %w",
+			nameOrPath, strings.Join(ProfileNames(), ", "), err)
+	}
+
+	var header struct {
+		Base string `json:"base"`
+	}
+	if err := yaml.Unmarshal(bz, &header); err != nil {
+		return NetworkProfile{}, fmt.Errorf("failed to parse profile %s: %w", nameOrPath, err)
+	}
+	if header.Base == "" {
+		header.Base = ProfileMainnet
+	}
+	builtin, ok := builtinProfiles[header.Base]
+	if !ok {
+		return NetworkProfile{}, fmt.Errorf("unknown base profile %q, expected one of %s",
+			header.Base, strings.Join(ProfileNames(), ", "))
+	}
+
+	p := builtin()
+	p.Name = ""
+	if err := yaml.UnmarshalStrict(bz, &p); err != nil {
+		return NetworkProfile{}, fmt.Errorf("failed to parse profile %s: %w", nameOrPath, err)
+	}
+	if p.Name == "" {
+		p.Name = strings.TrimSuffix(filepath.Base(nameOrPath), filepath.Ext(nameOrPath))
+	}
+	if err := p.Validate(); err != nil {
+		return NetworkProfile{}, fmt.Errorf("invalid profile %s: %w", nameOrPath, err)
+	}
+	return p, nil
+}
+
+// Validate checks the profile keeps the invariants mainnet safety relies on,
+// whatever values a profile file overrides.
+func (p NetworkProfile) Validate() error {
+	if p.MaxBlockBytes <= 0 {
+		return fmt.Errorf("max_block_bytes must be positive: %d", p.MaxBlockBytes)
+	}
+	if p.MaxBlockGas < -1 || p.MaxBlockGas == 0 {
+		return fmt.Errorf("max_block_gas must be positive or -1: %d", p.MaxBlockGas)
+	}
+	if p.UnbondingTime <= 0 {
+		return fmt.Errorf("unbonding_time must be positive: %s", time.Duration(p.UnbondingTime))
+	}
+	if p.MaxValidators == 0 {
+		return fmt.Errorf("max_validators must be positive")
+	}
+	if p.MinDeposit == 0 {
+		return fmt.Errorf("min_deposit must be positive")
+	}
+	if p.ExpeditedMinDeposit <= p.MinDeposit {
+		return fmt.Errorf("expedited_min_deposit %d must be greater than min_deposit %d", p.ExpeditedMinDeposit, p.MinDeposit)
+	}
+	// deposits are converted to tokens as int64 consensus power
+	if p.ExpeditedMinDeposit > stdmath.MaxInt64 {
+		return fmt.Errorf("expedited_min_deposit %d must not exceed %d", p.ExpeditedMinDeposit, int64(stdmath.MaxInt64))
+	}
+	if p.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("max_deposit_period must be positive: %s", time.Duration(p.MaxDepositPeriod))
+	}
+	if p.VotingPeriod <= 0 {
+		return fmt.Errorf("voting_period must be positive: %s", time.Duration(p.VotingPeriod))
+	}
+	if p.ExpeditedVotingPeriod <= 0 || p.ExpeditedVotingPeriod >= p.VotingPeriod {
+		return fmt.Errorf("expedited_voting_period %s must be positive and shorter than voting_period %s",
+			time.Duration(p.ExpeditedVotingPeriod), time.Duration(p.VotingPeriod))
+	}
+	if p.SignedBlocksWindow <= 0 {
+		return fmt.Errorf("signed_blocks_window must be positive: %d", p.SignedBlocksWindow)
+	}
+	for _, dec := range []struct {
+		name string
+		val  math.LegacyDec
+	}{
+		{"min_commission_rate", p.MinCommissionRate},
+		{"community_tax", p.CommunityTax},
+		{"min_signed_per_window", p.MinSignedPerWindow},
+		{"slash_fraction_double_sign", p.SlashFractionDoubleSign},
+		{"slash_fraction_downtime", p.SlashFractionDowntime},
+	} {
+		if dec.val.IsNil() || dec.val.IsNegative() || dec.val.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s must be within [0, 1]: %s", dec.name, dec.val)
+		}
+	}
+
+	// Evidence older than the unbonding period could no longer be punished,
+	// letting malicious validators escape slashing by unbonding first.
+	// https://github.com/advisories/GHSA-555p-m4v6-cqxv
+	if p.EvidenceMaxAgeDuration <= p.UnbondingTime {
+		return fmt.Errorf("evidence_max_age_duration %s must be longer than unbonding_time %s",
+			time.Duration(p.EvidenceMaxAgeDuration), time.Duration(p.UnbondingTime))
+	}
+	if ageTime := time.Duration(p.EvidenceMaxAgeNumBlocks) * consensus.BlockTimeSec * time.Second; ageTime <= time.Duration(p.UnbondingTime) {
+		return fmt.Errorf("evidence_max_age_num_blocks %d lasts %s at %ds blocks, must be longer than unbonding_time %s",
+			p.EvidenceMaxAgeNumBlocks, ageTime, consensus.BlockTimeSec, time.Duration(p.UnbondingTime))
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+// setAddressPrefixes sets the hippo address prefixes without sealing the
+// config, which other tests of the package set as well. The root command
+// tests seal the config with the same prefixes.
+func setAddressPrefixes() {
+	config := sdk.GetConfig()
+	if config.GetBech32AccountAddrPrefix() == consensus.AddrPrefix {
+		return
+	}
+	config.SetBech32PrefixForAccount(consensus.AddrPrefix, consensus.PubkeyPrefix)
+	config.SetBech32PrefixForValidator(consensus.ValidatorAddrPrefix, consensus.ValidatorPubkeyPrefix)
+	config.SetBech32PrefixForConsensusNode(consensus.ConsensusNodeAddrPrefix, consensus.ConsensusNodePubkeyPrefix)
+}
+
+// newTestApp returns an app for building genesis files, with the hippo
+// address prefixes its keepers expect.
+func newTestApp(t *testing.T) *app.App {
+	setAddressPrefixes()
+	return app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+}
+
+func writeProfile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestBuiltinProfiles(t *testing.T) {
+	require.Equal(t, []string{ProfileLocalnet, ProfileMainnet, ProfileTestnet}, ProfileNames())
+	for _, name := range ProfileNames() {
+		p, err := LoadNetworkProfile(name)
+		require.NoError(t, err, name)
+		require.Equal(t, name, p.Name)
+	}
+
+	localnet := LocalnetProfile()
+	mainnet := MainnetProfile()
+	require.Less(t, localnet.UnbondingTime, mainnet.UnbondingTime)
+	require.Less(t, localnet.VotingPeriod, mainnet.VotingPeriod)
+	require.Less(t, localnet.MinDeposit, mainnet.MinDeposit)
+}
+
+func TestLoadNetworkProfile(t *testing.T) {
+	t.Run("YAML overrides its base", func(t *testing.T) {
+		path := writeProfile(t, "devnet.yaml", `
+base: localnet
+voting_period: 90s
+min_deposit: 5
+community_tax: "0.5"
+`)
+		p, err := LoadNetworkProfile(path)
+		require.NoError(t, err)
+		require.Equal(t, "devnet", p.Name)
+		require.Equal(t, Duration(90*time.Second), p.VotingPeriod)
+		require.Equal(t, uint64(5), p.MinDeposit)
+		require.Equal(t, math.LegacyNewDecWithPrec(5, 1), p.CommunityTax)
+		require.Equal(t, LocalnetProfile().UnbondingTime, p.UnbondingTime)
+	})
+
+	t.Run("JSON defaults to mainnet", func(t *testing.T) {
+		path := writeProfile(t, "custom.json", `{"name": "custom", "max_validators": 30}`)
+		p, err := LoadNetworkProfile(path)
+		require.NoError(t, err)
+		require.Equal(t, "custom", p.Name)
+		require.Equal(t, uint32(30), p.MaxValidators)
+		require.Equal(t, MainnetProfile().VotingPeriod, p.VotingPeriod)
+	})
+
+	for _, tc := range []struct {
+		name    string
+		content string
+		errMsg  string
+	}{
+		{"evidence age shorter than unbonding", "unbonding_time: 720h", "evidence_max_age_duration 720h0m0s must be longer than unbonding_time"},
+		{"evidence blocks shorter than unbonding", "base: localnet\nevidence_max_age_num_blocks: 10", "evidence_max_age_num_blocks 10 lasts 1m0s"},
+		{"expedited voting not shorter", "expedited_voting_period: 336h", "expedited_voting_period"},
+		{"expedited deposit not greater", "expedited_min_deposit: 50000", "expedited_min_deposit"},
+		{"deposit above int64", "expedited_min_deposit: 9223372036854775808", "expedited_min_deposit 9223372036854775808 must not exceed 9223372036854775807"},
+		{"rate above one", `community_tax: "1.5"`, "community_tax must be within [0, 1]"},
+		{"unknown field", "votingperiod: 1h", "unknown field"},
+		{"unknown base", "base: devnet", "unknown base profile"},
+		{"bad duration", "voting_period: 10", "duration must be a string"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := LoadNetworkProfile(writeProfile(t, "profile.yaml", tc.content))
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+
+	_, err := LoadNetworkProfile("devnet")
+	require.ErrorContains(t, err, "neither one of localnet, mainnet, testnet nor a readable file")
+}
+
+func TestOverrideGenesisWithProfile(t *testing.T) {
+	hippoApp := newTestApp(t)
+	appCodec := hippoApp.AppCodec()
+
+	genDoc := &cmttypes.GenesisDoc{
+		ConsensusParams: &cmttypes.ConsensusParams{
+			Block:    cmttypes.DefaultBlockParams(),
+			Evidence: cmttypes.DefaultEvidenceParams(),
+		},
+	}
+	profile := LocalnetProfile()
+	appStateJson, err := overrideGenesisWithProfile(appCodec, genDoc, hippoApp.DefaultGenesis(), profile)
+	require.NoError(t, err)
+
+	require.Equal(t, time.Duration(profile.EvidenceMaxAgeDuration), genDoc.ConsensusParams.Evidence.MaxAgeDuration)
+	require.Equal(t, profile.EvidenceMaxAgeNumBlocks, genDoc.ConsensusParams.Evidence.MaxAgeNumBlocks)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appStateJson, &appState))
+
+	var stakingGenState stakingtypes.GenesisState
+	require.NoError(t, appCodec.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState))
+	require.Equal(t, 10*time.Minute, stakingGenState.Params.UnbondingTime)
+	require.Equal(t, consensus.DefaultHippoDenom, stakingGenState.Params.BondDenom)
+
+	var govGenState govv1types.GenesisState
+	require.NoError(t, appCodec.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState))
+	require.Equal(t, 2*time.Minute, *govGenState.Params.VotingPeriod)
+	require.Equal(t, time.Minute, *govGenState.Params.ExpeditedVotingPeriod)
+	minDeposit := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, minDeposit)), sdk.NewCoins(govGenState.Params.MinDeposit...))
+	require.NoError(t, govGenState.Params.ValidateBasic())
+}
+
+func TestInitCmdProfile(t *testing.T) {
+	hippoApp := newTestApp(t)
+
+	run := func(home string, args ...string) error {
+		command := InitCmd(hippoApp.BasicModuleManager, home)
+		command.SetArgs(append([]string{"hippo-moniker", "--home", home}, args...))
+
+		srvCtx := server.NewDefaultContext()
+		srvCtx.Config.RootDir = home
+		clientCtx := client.Context{}.WithHomeDir(home).WithCodec(hippoApp.AppCodec())
+		command.SetContext(context.WithValue(
+			context.WithValue(context.Background(), server.ServerContextKey, srvCtx),
+			client.ClientContextKey, &clientCtx,
+		))
+		return command.Execute()
+	}
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, run(home, "--profile", ProfileTestnet))
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+	var stakingGenState stakingtypes.GenesisState
+	require.NoError(t, hippoApp.AppCodec().UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState))
+	require.Equal(t, 72*time.Hour, stakingGenState.Params.UnbondingTime)
+
+	home = t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	err = run(home, "--profile", writeProfile(t, "unsafe.yaml", "evidence_max_age_duration: 24h"))
+	require.ErrorContains(t, err, "must be longer than unbonding_time")
+	_, err = os.Stat(filepath.Join(home, "config", "genesis.json"))
+	require.True(t, os.IsNotExist(err))
+}
@@ -36,9 +36,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtxconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/app/lanes"
+	oracleabci "github.com/hippocrat-dao/hippo-protocol/x/oracle/abci"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/spf13/cast"
@@ -157,20 +160,29 @@ func NewRootCmd() *cobra.Command {
 	return rootCmd
 }
 
+// CustomAppConfig is the app.toml of hippod, the server config extended by
+// the lanes and oracle sections.
+type CustomAppConfig struct {
+	serverconfig.Config
+
+	Lanes  lanes.Config      `mapstructure:"lanes"`
+	Oracle oracleabci.Config `mapstructure:"oracle"`
+}
+
 // All methods below from command.go of simd
 // https://github.com/cosmos/cosmos-sdk/blob/v0.50.12/simapp/simd/cmd/commands.go
 // initAppConfig returns custom app template and configs.
 func initAppConfig() (string, interface{}) {
-	type CustomAppConfig struct {
-		serverconfig.Config
-	}
-
 	srvCfg := serverconfig.DefaultConfig()
 	srvCfg.MinGasPrices = consensus.MinGasPrices
 
-	HippoAppConfig := CustomAppConfig{Config: *srvCfg}
+	HippoAppConfig := CustomAppConfig{
+		Config: *srvCfg,
+		Lanes:  lanes.DefaultConfig(),
+		Oracle: oracleabci.DefaultConfig(),
+	}
 
-	return serverconfig.DefaultConfigTemplate, HippoAppConfig
+	return serverconfig.DefaultConfigTemplate + lanes.ConfigTemplate + oracleabci.ConfigTemplate, HippoAppConfig
 }
 
 func initCometBFTConfig() *cmbtcfg.Config {
@@ -189,7 +201,8 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager
 
 	rootCmd.AddCommand(
 		InitCmd(basicManager, app.DefaultNodeHome),
-		debug.Cmd(),
+		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
+		debugCommand(SimulateUpgradeCmd(app.DefaultNodeHome)),
 		// confix is used instead of config.Cmd
 		// https://docs.cosmos.network/v0.50/build/migrations/upgrading#config-files
 		confixcmd.ConfigCommand(),
@@ -199,13 +212,15 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, addModuleInitFlags)
+	server.AddTestnetCreatorCommand(rootCmd, newTestnetApp, addTestnetFlags)
+	rootCmd.AddCommand(ExportStreamCmd(app.DefaultNodeHome), ExportSnapshotCmd(app.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		// client/rpc.StatusCommand() is now at server.StatusCommand()
 		// https://github.com/cosmos/cosmos-sdk/blob/main/CHANGELOG.md#improvements-12
 		server.StatusCommand(),
-		genesisCommand(txConfig, basicManager),
+		genesisCommand(txConfig, basicManager, BulkAddGenesisAccountsCmd(app.DefaultNodeHome), CheckPolicyCmd(), GenesisDiffCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
@@ -226,6 +241,13 @@ func genesisCommand(txConfig client.TxConfig, basicManager module.BasicManager,
 	return cmd
 }
 
+// debugCommand builds the `hippod debug` command with the application specific debug commands.
+func debugCommand(cmds ...*cobra.Command) *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(cmds...)
+	return cmd
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -305,33 +327,48 @@ func appExport(
 	appOpts servertypes.AppOptions,
 	modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
-	var hippoApp *app.App
+	hippoApp, err := loadExportApp(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
 
+	return hippoApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
+
+// loadExportApp creates the app to export the state of, loaded at height or
+// at the latest height when height is -1.
+func loadExportApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+) (*app.App, error) {
 	// this check is necessary as we use the flag in x/upgrade.
 	// we can exit more gracefully by checking the flag here.
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home not set")
+		return nil, errors.New("application home not set")
 	}
 
 	viperAppOpts, ok := appOpts.(*viper.Viper)
 	if !ok {
-		return servertypes.ExportedApp{}, errors.New("appOpts is not viper.Viper")
+		return nil, errors.New("appOpts is not viper.Viper")
 	}
 
 	// overwrite the FlagInvCheckPeriod
 	viperAppOpts.Set(server.FlagInvCheckPeriod, 1)
 	appOpts = viperAppOpts
 
-	hippoApp = app.New(logger, db, traceStore, height == -1, appOpts, app.EmptyWasmOptions)
+	hippoApp := app.New(logger, db, traceStore, height == -1, appOpts, app.EmptyWasmOptions)
 
 	if height != -1 {
 		if err := hippoApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
+			return nil, err
 		}
 	}
 
-	return hippoApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	return hippoApp, nil
 }
 
 func overwriteFlagDefaults(c *cobra.Command, defaults map[string]string) {
@@ -17,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/app/lanes"
+	oracleabci "github.com/hippocrat-dao/hippo-protocol/x/oracle/abci"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -117,7 +119,7 @@ func TestInitCometBFTConfig(t *testing.T) {
 func TestInitAppConfig(t *testing.T) {
 	defaultConfig, _ := initAppConfig()
 
-	require.Equal(t, serverconfig.DefaultConfigTemplate, defaultConfig)
+	require.Equal(t, serverconfig.DefaultConfigTemplate+lanes.ConfigTemplate+oracleabci.ConfigTemplate, defaultConfig)
 	// add test for min gas price
 }
 
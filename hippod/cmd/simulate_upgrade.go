@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+)
+
+const (
+	flagGenesis        = "genesis"
+	flagSkipInvariants = "skip-invariants"
+)
+
+// SimulateUpgradeCmd applies an upgrade to the local state or to a genesis
+// export and reports its effects, discarding the upgraded state.
+func SimulateUpgradeCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-upgrade [name]",
+		Short: "Dry-run an upgrade against the local state or a genesis export",
+		Long: `Load the state of the node, or of a genesis export with --genesis, apply the
+store upgrades and the handler of the named upgrade in a cached branch, and
+report the module versions and params the upgrade changes and the invariants
+after it. The upgraded state is discarded, nothing is written to the data
+directory. The node must be stopped when its state is used.
+
+The command fails when the upgrade fails or breaks an invariant.`,
+		Example: fmt.Sprintf(`%[1]s debug simulate-upgrade v3.0.0
+%[1]s debug simulate-upgrade v3.0.0 --genesis exported.json --output json`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+			genesisFile, _ := cmd.Flags().GetString(flagGenesis)
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			skipInvariants, _ := cmd.Flags().GetBool(flagSkipInvariants)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output != flags.OutputFormatText && output != flags.OutputFormatJSON {
+				return fmt.Errorf("unknown output format %q, expected %s or %s", output, flags.OutputFormatText, flags.OutputFormatJSON)
+			}
+
+			name := args[0]
+			if _, ok := app.UpgradeByName(name); !ok {
+				names := make([]string, 0, len(app.Upgrades))
+				for _, u := range app.Upgrades {
+					names = append(names, u.UpgradeName)
+				}
+				return fmt.Errorf("unknown upgrade %s, expected one of %s", name, strings.Join(names, ", "))
+			}
+
+			opts := app.UpgradeSimulationOptions{SkipInvariants: skipInvariants}
+			var hippoApp *app.App
+			if genesisFile != "" {
+				appGenesis, err := readAppGenesis(genesisFile)
+				if err != nil {
+					return err
+				}
+				// the wasm codes of the genesis are stored in a throwaway home
+				tmpHome, err := os.MkdirTemp("", "simulate-upgrade")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(tmpHome)
+				serverCtx.Viper.Set(flags.FlagHome, tmpHome)
+
+				hippoApp = app.New(serverCtx.Logger, dbm.NewMemDB(), nil, true, serverCtx.Viper, app.EmptyWasmOptions, baseapp.SetChainID(appGenesis.ChainID))
+				if err := hippoApp.InitFromGenesis(appGenesis); err != nil {
+					return fmt.Errorf("failed to initialize the state from %s: %w", genesisFile, err)
+				}
+				opts.Height = appGenesis.InitialHeight
+				opts.Time = appGenesis.GenesisTime
+			} else {
+				db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				hippoApp = app.New(serverCtx.Logger, db, nil, false, serverCtx.Viper, app.EmptyWasmOptions)
+				if err := hippoApp.LoadForUpgrade(name, height); err != nil {
+					return fmt.Errorf("failed to load the state: %w", err)
+				}
+			}
+
+			simulation, err := hippoApp.SimulateUpgrade(name, opts)
+			if err != nil {
+				return err
+			}
+
+			if output == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(simulation, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			} else {
+				printUpgradeSimulation(cmd.OutOrStdout(), simulation)
+			}
+
+			if broken := simulation.BrokenInvariants(); broken > 0 {
+				return fmt.Errorf("upgrade %s breaks %d invariants", name, broken)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagGenesis, "", "Simulate the upgrade of the state of this genesis export instead of the local state")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Simulate the upgrade of the local state at a particular height (-1 means latest height)")
+	cmd.Flags().Bool(flagSkipInvariants, false, "Skip running the invariants after the upgrade")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+func printUpgradeSimulation(w io.Writer, simulation *app.UpgradeSimulation) {
+	fmt.Fprintf(w, "upgrade %s at height %d\n", simulation.Name, simulation.Height)
+
+	fmt.Fprintln(w, "module versions")
+	if len(simulation.ModuleVersions) == 0 {
+		fmt.Fprintln(w, "  unchanged")
+	}
+	for _, change := range simulation.ModuleVersions {
+		switch {
+		case change.From == 0:
+			fmt.Fprintf(w, "  + %s %d\n", change.Module, change.To)
+		case change.To == 0:
+			fmt.Fprintf(w, "  - %s %d\n", change.Module, change.From)
+		default:
+			fmt.Fprintf(w, "  ~ %s %d -> %d\n", change.Module, change.From, change.To)
+		}
+	}
+
+	fmt.Fprintln(w, "params")
+	if len(simulation.Params) == 0 {
+		fmt.Fprintln(w, "  unchanged")
+	}
+	for _, change := range simulation.Params {
+		switch {
+		case change.Before == nil:
+			fmt.Fprintf(w, "  + %s: %s\n", change.Query, change.After)
+		case change.After == nil:
+			fmt.Fprintf(w, "  - %s: %s\n", change.Query, change.Before)
+		default:
+			fmt.Fprintf(w, "  ~ %s: %s -> %s\n", change.Query, change.Before, change.After)
+		}
+	}
+
+	if simulation.Invariants == nil {
+		return
+	}
+	fmt.Fprintf(w, "invariants (%d broken of %d)\n", simulation.BrokenInvariants(), len(simulation.Invariants))
+	for _, result := range simulation.Invariants {
+		if result.Broken {
+			fmt.Fprintf(w, "  broken %s: %s\n", result.Route, strings.TrimSpace(result.Message))
+		} else {
+			fmt.Fprintf(w, "  ok %s\n", result.Route)
+		}
+	}
+}
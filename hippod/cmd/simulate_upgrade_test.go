@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+)
+
+func TestSimulateUpgradeCmd(t *testing.T) {
+	home := t.TempDir()
+	simulateUpgrade := func(args ...string) error {
+		srvCtx := server.NewDefaultContext()
+		srvCtx.Config.SetRoot(home)
+		command := SimulateUpgradeCmd(home)
+		command.SetArgs(args)
+		command.SetContext(context.WithValue(context.Background(), server.ServerContextKey, srvCtx))
+		command.SetOut(new(bytes.Buffer))
+		command.SetErr(new(bytes.Buffer))
+		return command.Execute()
+	}
+
+	require.ErrorContains(t, simulateUpgrade("v99.0.0"), "unknown upgrade v99.0.0, expected one of v1.0.1, v1.0.2, v2.0.0, v3.0.0")
+	require.ErrorContains(t, simulateUpgrade("v3.0.0", "--output", "yaml"), "unknown output format")
+	require.ErrorContains(t, simulateUpgrade("v3.0.0", "--genesis", "missing.json"), "no such file")
+}
+
+func TestPrintUpgradeSimulation(t *testing.T) {
+	var out bytes.Buffer
+	printUpgradeSimulation(&out, &app.UpgradeSimulation{
+		Name:   "v3.0.0",
+		Height: 10,
+		ModuleVersions: []app.ModuleVersionChange{
+			{Module: "bank", From: 3, To: 4},
+			{Module: "valpolicy", To: 1},
+		},
+		Params: []app.ParamsChange{
+			{Query: "hippo.valpolicy.v1.Query", After: []byte(`{"params":{}}`)},
+		},
+		Invariants: []app.InvariantResult{
+			{Route: "bank/total-supply"},
+			{Route: "staking/delegator-shares", Broken: true, Message: "shares mismatch\n"},
+		},
+	})
+
+	require.Equal(t, `upgrade v3.0.0 at height 10
+module versions
+  ~ bank 3 -> 4
+  + valpolicy 1
+params
+  + hippo.valpolicy.v1.Query: {"params":{}}
+invariants (1 broken of 2)
+  ok bank/total-supply
+  broken staking/delegator-shares: shares mismatch
+`, out.String())
+}
@@ -0,0 +1,634 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cosmossdk.io/math"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	cmtconfig "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	valpolicytypes "github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+)
+
+const (
+	flagNodeDirPrefix     = "node-dir-prefix"
+	flagNumValidators     = "v"
+	flagOutputDir         = "output-dir"
+	flagNodeDaemonHome    = "node-daemon-home"
+	flagListenIPAddress   = "listen-ip-address"
+	flagEnableLogging     = "enable-logging"
+	flagGRPCAddress       = "grpc.address"
+	flagRPCAddress        = "rpc.address"
+	flagAPIAddress        = "api.address"
+	flagPrintMnemonic     = "print-mnemonic"
+	testnetNodeDirPerm    = 0o755
+	testnetPortStride     = 10
+	testnetAccountPower   = 1000
+	testnetValidatorPower = 100
+)
+
+type testnetInitArgs struct {
+	algo           string
+	chainID        string
+	keyringBackend string
+	minGasPrices   string
+	nodeDaemonHome string
+	nodeDirPrefix  string
+	numValidators  int
+	outputDir      string
+	listenIP       string
+	profile        NetworkProfile
+}
+
+type testnetStartArgs struct {
+	algo          string
+	apiAddress    string
+	chainID       string
+	enableLogging bool
+	grpcAddress   string
+	minGasPrices  string
+	outputDir     string
+	printMnemonic bool
+	rpcAddress    string
+	numValidators int
+	profile       NetworkProfile
+}
+
+// testnetNodePorts are the ports the i-th node of a testnet listens on. Every
+// node gets its own range so that the whole network runs on a single host.
+type testnetNodePorts struct {
+	P2P, RPC, ABCI, GRPC, API, Pprof int
+}
+
+func nodePorts(i int) testnetNodePorts {
+	offset := i * testnetPortStride
+	return testnetNodePorts{
+		P2P:   26656 + offset,
+		RPC:   26657 + offset,
+		ABCI:  26658 + offset,
+		GRPC:  9090 + offset,
+		API:   1317 + offset,
+		Pprof: 6060 + i,
+	}
+}
+
+// NewTestnetCmd creates a root testnet command with subcommands to run an
+// in-process testnet or initialize validator configuration files for running
+// a multi-validator testnet on the local machine.
+// reference: https://github.com/cosmos/cosmos-sdk/blob/v0.50.12/simapp/simd/cmd/testnet.go
+func NewTestnetCmd(mbm module.BasicManager, genBalIterator banktypes.GenesisBalancesIterator) *cobra.Command {
+	testnetCmd := &cobra.Command{
+		Use:                        "testnet",
+		Short:                      "subcommands for starting or configuring local testnets",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	testnetCmd.AddCommand(testnetStartCmd(mbm))
+	testnetCmd.AddCommand(testnetInitFilesCmd(mbm, genBalIterator))
+
+	return testnetCmd
+}
+
+func addTestnetProfileFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagProfile, ProfileLocalnet, fmt.Sprintf("network profile of the genesis parameters, one of %s or a path to a YAML/JSON profile file", strings.Join(ProfileNames(), ", ")))
+}
+
+// testnetInitFilesCmd returns a cmd to initialize all files for cometbft
+// testnet and application
+func testnetInitFilesCmd(mbm module.BasicManager, genBalIterator banktypes.GenesisBalancesIterator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-files",
+		Short: "Initialize config directories & files for a multi-validator testnet running locally",
+		Long: `init-files will setup "v" number of directories and populate each with
+necessary files (private validator, genesis, config, etc.) for running "v" validator nodes.
+
+All nodes listen on the same IP address, node i using the default ports
+shifted by 10*i (p2p 26656, rpc 26657, abci 26658, grpc 9090, api 1317), and
+every node has the other nodes as persistent peers. The genesis applies the
+given network profile, localnet by default.
+
+Example:
+	hippod testnet init-files --v 4 --output-dir ./.testnets
+	hippod start --home ./.testnets/node0/hippod
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			args := testnetInitArgs{}
+			args.outputDir, _ = cmd.Flags().GetString(flagOutputDir)
+			args.keyringBackend, _ = cmd.Flags().GetString(flags.FlagKeyringBackend)
+			args.chainID, _ = cmd.Flags().GetString(flags.FlagChainID)
+			args.minGasPrices, _ = cmd.Flags().GetString(server.FlagMinGasPrices)
+			args.nodeDirPrefix, _ = cmd.Flags().GetString(flagNodeDirPrefix)
+			args.nodeDaemonHome, _ = cmd.Flags().GetString(flagNodeDaemonHome)
+			args.listenIP, _ = cmd.Flags().GetString(flagListenIPAddress)
+			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
+			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyType)
+
+			if args.numValidators < 1 {
+				return fmt.Errorf("testnet needs at least one validator, got %d", args.numValidators)
+			}
+			if net.ParseIP(args.listenIP) == nil {
+				return fmt.Errorf("invalid listen ip address %q", args.listenIP)
+			}
+			// Load the network profile before writing any file
+			profileName, _ := cmd.Flags().GetString(FlagProfile)
+			if args.profile, err = LoadNetworkProfile(profileName); err != nil {
+				return err
+			}
+
+			return initTestnetFiles(clientCtx, cmd, config, mbm, genBalIterator, args)
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./.testnets", "Directory to store initialization data for the testnet")
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(server.FlagMinGasPrices, consensus.MinGasPrices, "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 500000000000ahp)")
+	cmd.Flags().String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix the directory name for each node with (node results in node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, app.Name+"d", "Home directory of the node's daemon configuration")
+	cmd.Flags().String(flagListenIPAddress, "127.0.0.1", "IP address every node listens on")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	addTestnetProfileFlag(cmd)
+
+	return cmd
+}
+
+// testnetStartCmd returns a cmd to start multi validator in-process testnet
+func testnetStartCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Launch an in-process multi-validator testnet",
+		Long: `testnet will launch an in-process multi-validator testnet,
+and generate "v" directories, populated with necessary validator configuration files
+(private validator, genesis, config, etc.).
+
+The validators are created with the commission rates of the SDK test network,
+so the genesis raises the valpolicy limits to allow them. Every other
+parameter comes from the given network profile, localnet by default.
+
+Example:
+	hippod testnet start --v 4 --output-dir ./.testnets
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			args := testnetStartArgs{}
+			args.outputDir, _ = cmd.Flags().GetString(flagOutputDir)
+			args.chainID, _ = cmd.Flags().GetString(flags.FlagChainID)
+			args.minGasPrices, _ = cmd.Flags().GetString(server.FlagMinGasPrices)
+			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
+			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyType)
+			args.enableLogging, _ = cmd.Flags().GetBool(flagEnableLogging)
+			args.rpcAddress, _ = cmd.Flags().GetString(flagRPCAddress)
+			args.apiAddress, _ = cmd.Flags().GetString(flagAPIAddress)
+			args.grpcAddress, _ = cmd.Flags().GetString(flagGRPCAddress)
+			args.printMnemonic, _ = cmd.Flags().GetBool(flagPrintMnemonic)
+
+			profileName, _ := cmd.Flags().GetString(FlagProfile)
+			profile, err := LoadNetworkProfile(profileName)
+			if err != nil {
+				return err
+			}
+			args.profile = profile
+
+			return startTestnet(cmd, mbm, args)
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./.testnets", "Directory to store initialization data for the testnet")
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(server.FlagMinGasPrices, consensus.MinGasPrices, "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 500000000000ahp)")
+	cmd.Flags().String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	cmd.Flags().Bool(flagEnableLogging, false, "Enable INFO logging of CometBFT validator nodes")
+	cmd.Flags().String(flagRPCAddress, "tcp://0.0.0.0:26657", "the RPC address to listen on")
+	cmd.Flags().String(flagAPIAddress, "tcp://0.0.0.0:1317", "the address to listen on for REST API")
+	cmd.Flags().String(flagGRPCAddress, "0.0.0.0:9090", "the gRPC server address to listen on")
+	cmd.Flags().Bool(flagPrintMnemonic, true, "print mnemonic of first validator to stdout for manual testing")
+	addTestnetProfileFlag(cmd)
+
+	return cmd
+}
+
+// initTestnetFiles initializes testnet files for a testnet to be run in a
+// separate process
+func initTestnetFiles(
+	clientCtx client.Context,
+	cmd *cobra.Command,
+	nodeConfig *cmtconfig.Config,
+	mbm module.BasicManager,
+	genBalIterator banktypes.GenesisBalancesIterator,
+	args testnetInitArgs,
+) error {
+	if args.chainID == "" {
+		args.chainID = "hippo-testnet-" + strings.ToLower(cmttime.Now().Format("20060102150405"))
+	}
+	nodeIDs := make([]string, args.numValidators)
+	valPubKeys := make([]cryptotypes.PubKey, args.numValidators)
+	valAddrCodec := clientCtx.TxConfig.SigningContext().ValidatorAddressCodec()
+	gentxsDir := filepath.Join(args.outputDir, "gentxs")
+
+	appTemplate, defaultAppConfig := initAppConfig()
+	appConfig, ok := defaultAppConfig.(CustomAppConfig)
+	if !ok {
+		return fmt.Errorf("unexpected app config type %T", defaultAppConfig)
+	}
+	appConfig.MinGasPrices = args.minGasPrices
+	appConfig.API.Enable = true
+	srvconfig.SetConfigTemplate(appTemplate)
+
+	var (
+		genAccounts []authtypes.GenesisAccount
+		genBalances []banktypes.Balance
+		genFiles    []string
+	)
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	// generate private keys, node IDs, and initial transactions
+	for i := 0; i < args.numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", args.nodeDirPrefix, i)
+		nodeDir := filepath.Join(args.outputDir, nodeDirName, args.nodeDaemonHome)
+		ports := nodePorts(i)
+
+		nodeConfig.SetRoot(nodeDir)
+		nodeConfig.Moniker = nodeDirName
+		setTestnetNodeConfig(nodeConfig, args.listenIP, ports)
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), testnetNodeDirPerm); err != nil {
+			_ = os.RemoveAll(args.outputDir)
+			return err
+		}
+
+		var err error
+		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFiles(nodeConfig)
+		if err != nil {
+			_ = os.RemoveAll(args.outputDir)
+			return err
+		}
+
+		memo := fmt.Sprintf("%s@%s:%d", nodeIDs[i], args.listenIP, ports.P2P)
+		genFiles = append(genFiles, nodeConfig.GenesisFile())
+
+		kb, err := keyring.New(sdk.KeyringServiceName(), args.keyringBackend, nodeDir, inBuf, clientCtx.Codec)
+		if err != nil {
+			return err
+		}
+
+		keyringAlgos, _ := kb.SupportedAlgorithms()
+		algo, err := keyring.NewSigningAlgoFromString(args.algo, keyringAlgos)
+		if err != nil {
+			return err
+		}
+
+		addr, secret, err := testutil.GenerateSaveCoinKey(kb, nodeDirName, "", true, algo)
+		if err != nil {
+			_ = os.RemoveAll(args.outputDir)
+			return err
+		}
+
+		info := map[string]string{"secret": secret}
+
+		cliPrint, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+
+		// save private key seed words
+		if err := writeTestnetFile("key_seed.json", nodeDir, cliPrint); err != nil {
+			return err
+		}
+
+		accTokens := sdk.TokensFromConsensusPower(testnetAccountPower, sdk.DefaultPowerReduction)
+		coins := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, accTokens))
+
+		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: coins})
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
+
+		valStr, err := valAddrCodec.BytesToString(sdk.ValAddress(addr))
+		if err != nil {
+			return err
+		}
+		valTokens := sdk.TokensFromConsensusPower(testnetValidatorPower, sdk.DefaultPowerReduction)
+		// the commission rates are within the limits of valpolicy
+		createValMsg, err := stakingtypes.NewMsgCreateValidator(
+			valStr,
+			valPubKeys[i],
+			sdk.NewCoin(consensus.DefaultHippoDenom, valTokens),
+			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
+			stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+			math.OneInt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		txBuilder := clientCtx.TxConfig.NewTxBuilder()
+		if err := txBuilder.SetMsgs(createValMsg); err != nil {
+			return err
+		}
+
+		txBuilder.SetMemo(memo)
+
+		txFactory := tx.Factory{}
+		txFactory = txFactory.
+			WithChainID(args.chainID).
+			WithMemo(memo).
+			WithKeybase(kb).
+			WithTxConfig(clientCtx.TxConfig)
+
+		if err := tx.Sign(cmd.Context(), txFactory, nodeDirName, txBuilder, true); err != nil {
+			return err
+		}
+
+		txBz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		if err := writeTestnetFile(fmt.Sprintf("%v.json", nodeDirName), gentxsDir, txBz); err != nil {
+			return err
+		}
+
+		appConfig.GRPC.Address = fmt.Sprintf("%s:%d", args.listenIP, ports.GRPC)
+		appConfig.API.Address = fmt.Sprintf("tcp://%s:%d", args.listenIP, ports.API)
+		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), appConfig)
+	}
+
+	if err := initTestnetGenFiles(clientCtx, mbm, args, genAccounts, genBalances, genFiles); err != nil {
+		return err
+	}
+
+	err := collectTestnetGenFiles(clientCtx, nodeConfig, args, nodeIDs, valPubKeys, genBalIterator)
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("Successfully initialized %d node directories\n", args.numValidators)
+	return nil
+}
+
+// setTestnetNodeConfig makes the node listen on its own ports of ip and
+// accept peers sharing its ip.
+func setTestnetNodeConfig(config *cmtconfig.Config, ip string, ports testnetNodePorts) {
+	config.P2P.ListenAddress = fmt.Sprintf("tcp://%s:%d", ip, ports.P2P)
+	config.P2P.AllowDuplicateIP = true
+	config.P2P.AddrBookStrict = false
+	config.RPC.ListenAddress = fmt.Sprintf("tcp://%s:%d", ip, ports.RPC)
+	config.RPC.PprofListenAddress = fmt.Sprintf("%s:%d", ip, ports.Pprof)
+	config.ProxyApp = fmt.Sprintf("tcp://127.0.0.1:%d", ports.ABCI)
+}
+
+// initTestnetGenFiles writes the same genesis, without gentxs, to every node.
+func initTestnetGenFiles(
+	clientCtx client.Context, mbm module.BasicManager, args testnetInitArgs,
+	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
+	genFiles []string,
+) error {
+	cdc := clientCtx.Codec
+	appGenState := mbm.DefaultGenesis(cdc)
+
+	// set the accounts in the genesis state
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return err
+	}
+
+	authGenState.Accounts = accounts
+	appGenState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+
+	// set the balances in the genesis state
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(genBalances)
+	for _, bal := range bankGenState.Balances {
+		bankGenState.Supply = bankGenState.Supply.Add(bal.Coins...)
+	}
+	appGenState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+	genDoc := &cmttypes.GenesisDoc{
+		ConsensusParams: &cmttypes.ConsensusParams{
+			Block:     cmttypes.DefaultBlockParams(),
+			Evidence:  cmttypes.DefaultEvidenceParams(),
+			Validator: cmttypes.DefaultValidatorParams(),
+			Version:   cmttypes.DefaultVersionParams(),
+		},
+	}
+	appState, err := overrideGenesisWithProfile(cdc, genDoc, appGenState, args.profile)
+	if err != nil {
+		return err
+	}
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(args.chainID, appState)
+	appGenesis.AppName = app.Name
+	appGenesis.AppVersion = app.Version
+	appGenesis.Consensus.Params = genDoc.ConsensusParams
+
+	// generate empty genesis files for each validator and save
+	for _, genFile := range genFiles {
+		if err := appGenesis.SaveAs(genFile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectTestnetGenFiles collects the gentxs into the genesis of every node,
+// wiring each node to the others as persistent peers.
+func collectTestnetGenFiles(
+	clientCtx client.Context, nodeConfig *cmtconfig.Config, args testnetInitArgs,
+	nodeIDs []string, valPubKeys []cryptotypes.PubKey, genBalIterator banktypes.GenesisBalancesIterator,
+) error {
+	var appState json.RawMessage
+	genTime := cmttime.Now()
+	gentxsDir := filepath.Join(args.outputDir, "gentxs")
+	valAddrCodec := clientCtx.TxConfig.SigningContext().ValidatorAddressCodec()
+
+	for i := 0; i < args.numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", args.nodeDirPrefix, i)
+		nodeDir := filepath.Join(args.outputDir, nodeDirName, args.nodeDaemonHome)
+		nodeConfig.Moniker = nodeDirName
+		nodeConfig.SetRoot(nodeDir)
+		setTestnetNodeConfig(nodeConfig, args.listenIP, nodePorts(i))
+
+		initCfg := genutiltypes.NewInitConfig(args.chainID, gentxsDir, nodeIDs[i], valPubKeys[i])
+
+		appGenesis, err := genutiltypes.AppGenesisFromFile(nodeConfig.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(clientCtx.Codec, clientCtx.TxConfig, nodeConfig, initCfg, appGenesis, genBalIterator, genutiltypes.DefaultMessageValidator, valAddrCodec)
+		if err != nil {
+			return err
+		}
+
+		if appState == nil {
+			// set the canonical application state (they should not differ)
+			appState = nodeAppState
+		}
+
+		// overwrite each validator's genesis file to have a canonical genesis
+		// time, keeping the consensus params of the profile
+		appGenesis.AppState = appState
+		appGenesis.GenesisTime = genTime
+		if err := genutil.ExportGenesisFile(appGenesis, nodeConfig.GenesisFile()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeTestnetFile(name, dir string, contents []byte) error {
+	file := filepath.Join(dir, name)
+
+	if err := os.MkdirAll(dir, testnetNodeDirPerm); err != nil {
+		return fmt.Errorf("could not create directory %q: %w", dir, err)
+	}
+
+	if err := os.WriteFile(file, contents, 0o600); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// testnetGenesisState returns the default genesis of mbm with profile
+// applied, for the in-process testnet.
+func testnetGenesisState(cdc codec.JSONCodec, mbm module.BasicManager, profile NetworkProfile) (map[string]json.RawMessage, error) {
+	appGenState := mbm.DefaultGenesis(cdc)
+	appState, err := overrideGenesisWithProfile(cdc, &cmttypes.GenesisDoc{
+		ConsensusParams: cmttypes.DefaultConsensusParams(),
+	}, appGenState, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisState := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(appState, &genesisState); err != nil {
+		return nil, err
+	}
+
+	// the SDK test network creates its validators with a max commission rate
+	// and change rate of 1
+	valpolicyGenState := valpolicytypes.DefaultGenesisState()
+	valpolicyGenState.Params.MaxCommissionRate = math.LegacyOneDec()
+	valpolicyGenState.Params.MaxCommissionChangeRate = math.LegacyOneDec()
+	genesisState[valpolicytypes.ModuleName] = cdc.MustMarshalJSON(valpolicyGenState)
+	return genesisState, nil
+}
+
+// startTestnet starts an in-process testnet
+func startTestnet(cmd *cobra.Command, mbm module.BasicManager, args testnetStartArgs) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	genesisState, err := testnetGenesisState(clientCtx.Codec, mbm, args.profile)
+	if err != nil {
+		return err
+	}
+
+	networkConfig := network.DefaultConfig(func() network.TestFixture {
+		return network.TestFixture{
+			GenesisState: genesisState,
+			EncodingConfig: moduletestutil.TestEncodingConfig{
+				InterfaceRegistry: clientCtx.InterfaceRegistry,
+				Codec:             clientCtx.Codec,
+				TxConfig:          clientCtx.TxConfig,
+				Amino:             clientCtx.LegacyAmino,
+			},
+		}
+	})
+
+	// Default networkConfig.ChainID is random, and we should only override it if chainID provided
+	// is non-empty
+	if args.chainID != "" {
+		networkConfig.ChainID = args.chainID
+	}
+	networkConfig.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		return app.New(
+			val.GetCtx().Logger, dbm.NewMemDB(), nil, true,
+			simtestutil.NewAppOptionsWithFlagHome(val.GetCtx().Config.RootDir),
+			app.EmptyWasmOptions,
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(networkConfig.ChainID),
+		)
+	}
+	networkConfig.SigningAlgo = args.algo
+	networkConfig.BondDenom = consensus.DefaultHippoDenom
+	networkConfig.MinGasPrices = args.minGasPrices
+	networkConfig.NumValidators = args.numValidators
+	networkConfig.EnableLogging = args.enableLogging
+	networkConfig.RPCAddress = args.rpcAddress
+	networkConfig.APIAddress = args.apiAddress
+	networkConfig.GRPCAddress = args.grpcAddress
+	networkConfig.PrintMnemonic = args.printMnemonic
+	networkLogger := network.NewCLILogger(cmd)
+
+	baseDir := fmt.Sprintf("%s/%s", args.outputDir, networkConfig.ChainID)
+	if _, err := os.Stat(baseDir); err == nil {
+		return fmt.Errorf(
+			"testnet directory already exists for chain-id '%s': %s, please remove or select a new --chain-id",
+			networkConfig.ChainID, baseDir)
+	}
+
+	testnet, err := network.New(networkLogger, baseDir, networkConfig)
+	if err != nil {
+		return err
+	}
+
+	if _, err := testnet.WaitForHeight(1); err != nil {
+		return err
+	}
+	cmd.Println("press the Enter Key to terminate")
+	_, _ = fmt.Scanln() // wait for Enter Key
+	testnet.Cleanup()
+
+	return nil
+}
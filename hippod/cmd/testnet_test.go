@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestnetInitFilesCmd(t *testing.T) {
+	hippoApp := newTestApp(t)
+	cdc := hippoApp.AppCodec()
+	outputDir := t.TempDir()
+
+	cmd := testnetInitFilesCmd(hippoApp.BasicModuleManager, banktypes.GenesisBalancesIterator{})
+	cmd.SetArgs([]string{"--v", "2", "--output-dir", outputDir, "--chain-id", "hippo-local-1", "--keyring-backend", "test"})
+	srvCtx := server.NewDefaultContext()
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(hippoApp.InterfaceRegistry()).
+		WithTxConfig(hippoApp.TxConfig()).
+		WithLegacyAmino(hippoApp.LegacyAmino())
+	cmd.SetContext(context.WithValue(
+		context.WithValue(context.Background(), server.ServerContextKey, srvCtx),
+		client.ClientContextKey, &clientCtx,
+	))
+	cmd.SetErr(new(bytes.Buffer))
+	require.NoError(t, cmd.Execute())
+
+	var genesis []byte
+	peers := make([]string, 2)
+	for i := range peers {
+		nodeDir := filepath.Join(outputDir, fmt.Sprintf("node%d", i), "hippod")
+
+		appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(nodeDir, "config", "genesis.json"))
+		require.NoError(t, err)
+		require.Equal(t, "hippo-local-1", appGenesis.ChainID)
+		deviations, err := CheckGenesisPolicy(cdc, appGenesis, LocalnetProfile())
+		require.NoError(t, err)
+		require.Empty(t, deviations)
+
+		// every node has the same genesis with the gentxs of both validators
+		bz, err := json.Marshal(appGenesis)
+		require.NoError(t, err)
+		if genesis == nil {
+			genesis = bz
+		}
+		require.Equal(t, genesis, bz)
+		appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+		require.NoError(t, err)
+		require.Len(t, genutiltypes.GetGenesisStateFromAppState(cdc, appState).GenTxs, 2)
+
+		v := viper.New()
+		v.SetConfigFile(filepath.Join(nodeDir, "config", "config.toml"))
+		require.NoError(t, v.ReadInConfig())
+		config := cmtconfig.DefaultConfig()
+		require.NoError(t, v.Unmarshal(config))
+		ports := nodePorts(i)
+		require.Equal(t, fmt.Sprintf("tcp://127.0.0.1:%d", ports.P2P), config.P2P.ListenAddress)
+		require.Equal(t, fmt.Sprintf("tcp://127.0.0.1:%d", ports.RPC), config.RPC.ListenAddress)
+		require.Equal(t, fmt.Sprintf("tcp://127.0.0.1:%d", ports.ABCI), config.ProxyApp)
+		require.True(t, config.P2P.AllowDuplicateIP)
+		peers[i] = config.P2P.PersistentPeers
+
+		v = viper.New()
+		v.SetConfigFile(filepath.Join(nodeDir, "config", "app.toml"))
+		require.NoError(t, v.ReadInConfig())
+		require.Equal(t, fmt.Sprintf("127.0.0.1:%d", ports.GRPC), v.GetString("grpc.address"))
+		require.Equal(t, fmt.Sprintf("tcp://127.0.0.1:%d", ports.API), v.GetString("api.address"))
+		require.NotEmpty(t, v.GetString("lanes.priority-mempool"))
+	}
+
+	// each node peers with the other one
+	require.Contains(t, peers[0], fmt.Sprintf("@127.0.0.1:%d", nodePorts(1).P2P))
+	require.Contains(t, peers[1], fmt.Sprintf("@127.0.0.1:%d", nodePorts(0).P2P))
+}
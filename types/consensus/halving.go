@@ -0,0 +1,16 @@
+package consensus
+
+// HalvingInterval is the number of years after which the yearly inflated
+// tokens halve.
+const HalvingInterval = 2
+
+// Year returns the year, counted from 1, a block height falls in.
+func Year(height int64, blocksPerYear uint64) int64 {
+	return 1 + height/int64(blocksPerYear)
+}
+
+// IsHalvingYear reports whether the yearly inflated tokens halve at the start
+// of year, which happens every HalvingInterval years after the first.
+func IsHalvingYear(year int64) bool {
+	return year != 1 && year%HalvingInterval == 1
+}
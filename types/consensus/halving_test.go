@@ -0,0 +1,25 @@
+package consensus_test
+
+import (
+	"testing"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYear(t *testing.T) {
+	require.Equal(t, int64(1), consensus.Year(0, consensus.BlocksPerYear))
+	require.Equal(t, int64(1), consensus.Year(int64(consensus.BlocksPerYear)-1, consensus.BlocksPerYear))
+	require.Equal(t, int64(2), consensus.Year(int64(consensus.BlocksPerYear), consensus.BlocksPerYear))
+	require.Equal(t, int64(3), consensus.Year(2*int64(consensus.BlocksPerYear), consensus.BlocksPerYear))
+}
+
+func TestIsHalvingYear(t *testing.T) {
+	var halvings []int64
+	for year := int64(1); year <= 9; year++ {
+		if consensus.IsHalvingYear(year) {
+			halvings = append(halvings, year)
+		}
+	}
+	require.Equal(t, []int64{3, 5, 7, 9}, halvings)
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              "hippo.audit.v1.Query",
+			EnhanceCustomCommand: true, // adds the generated queries next to export-history
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the audit module parameters",
+				},
+				{
+					RpcMethod:      "Accessor",
+					Use:            "accessor [address]",
+					Short:          "Query an accredited accessor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Accessors",
+					Use:       "accessors",
+					Short:     "Query all accredited accessors",
+				},
+				{
+					RpcMethod:      "AccessRecord",
+					Use:            "record [record-id]",
+					Short:          "Query an access record by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "record_id"}},
+				},
+				{
+					RpcMethod:      "AccessRecordsBySubject",
+					Use:            "records-by-subject [subject]",
+					Short:          "Query the access records of a subject",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject"}},
+				},
+				{
+					RpcMethod:      "AccessRecordsByAccessor",
+					Use:            "records-by-accessor [accessor]",
+					Short:          "Query the access records written by an accessor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "accessor"}},
+				},
+				{
+					RpcMethod:      "AccessRecordsByDataset",
+					Use:            "records-by-dataset [dataset-id]",
+					Short:          "Query the access records of a dataset",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dataset_id"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.audit.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RecordAccess",
+					Use:       "record-access [subject] [dataset-id] [basis] [basis-ref]",
+					Short:     "Record an access to a subject's dataset as an accredited accessor",
+					Example:   "hippod tx audit record-access hippo1... dataset-42 ACCESS_BASIS_CONSENT consent-7 --purpose \"clinical study\"",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subject"},
+						{ProtoField: "dataset_id"},
+						{ProtoField: "basis"},
+						{ProtoField: "basis_ref"},
+					},
+				},
+				{
+					RpcMethod: "AccreditAccessor",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RevokeAccessor",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+const (
+	flagOutputDocument = "output-document"
+	flagPageLimit      = "page-limit"
+
+	defaultPageLimit = 100
+)
+
+// GetQueryCmd returns the query commands of the audit module that are not
+// generated by AutoCLI.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the audit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(ExportHistoryCmd())
+
+	return cmd
+}
+
+// ExportHistoryCmd returns a command that dumps the full access history of a
+// subject as JSON, for answering compliance requests.
+func ExportHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-history [subject]",
+		Short: "Export the full access history of a subject as JSON",
+		Long: `Export every retained access record of a subject as a single JSON document.
+Records are fetched page by page and written in the order they were recorded.`,
+		Example: fmt.Sprintf("hippod query %s export-history hippo1... --%s history.json", types.ModuleName, flagOutputDocument),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("invalid subject address: %w", err)
+			}
+
+			limit, _ := cmd.Flags().GetUint64(flagPageLimit)
+			if limit == 0 {
+				return fmt.Errorf("--%s must be positive", flagPageLimit)
+			}
+
+			history, err := fetchAccessHistory(cmd, types.NewQueryClient(clientCtx), args[0], limit)
+			if err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.Codec.MarshalJSON(history)
+			if err != nil {
+				return err
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flagOutputDocument)
+			if outputDocument == "" {
+				cmd.Println(string(bz))
+				return nil
+			}
+			return os.WriteFile(outputDocument, bz, 0o600)
+		},
+	}
+
+	cmd.Flags().String(flagOutputDocument, "", "Write the history to the given file instead of stdout")
+	cmd.Flags().Uint64(flagPageLimit, defaultPageLimit, "Number of records fetched per query")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// fetchAccessHistory follows the pagination of the subject index until all
+// records are collected.
+func fetchAccessHistory(cmd *cobra.Command, queryClient types.QueryClient, subject string, limit uint64) (*types.AccessHistory, error) {
+	history := &types.AccessHistory{Subject: subject}
+
+	var nextKey []byte
+	for {
+		res, err := queryClient.AccessRecordsBySubject(cmd.Context(), &types.QueryAccessRecordsBySubjectRequest{
+			Subject:    subject,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: limit},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		history.Records = append(history.Records, res.Records...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return history, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+// pagedQueryClient serves a fixed set of records one page at a time.
+type pagedQueryClient struct {
+	types.QueryClient
+
+	records []types.AccessRecord
+	calls   int
+}
+
+func (c *pagedQueryClient) AccessRecordsBySubject(_ context.Context, req *types.QueryAccessRecordsBySubjectRequest, _ ...grpc.CallOption) (*types.QueryAccessRecordsResponse, error) {
+	c.calls++
+
+	start := 0
+	if len(req.Pagination.Key) > 0 {
+		start = int(req.Pagination.Key[0])
+	}
+	end := start + int(req.Pagination.Limit)
+	if end > len(c.records) {
+		end = len(c.records)
+	}
+
+	res := &types.QueryAccessRecordsResponse{Records: c.records[start:end], Pagination: &query.PageResponse{}}
+	if end < len(c.records) {
+		res.Pagination.NextKey = []byte{byte(end)}
+	}
+	return res, nil
+}
+
+func TestFetchAccessHistory(t *testing.T) {
+	client := &pagedQueryClient{}
+	for i := uint64(1); i <= 5; i++ {
+		client.records = append(client.records, types.AccessRecord{Id: i})
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	history, err := fetchAccessHistory(cmd, client, "hippo1subject", 2)
+	require.NoError(t, err)
+	require.Equal(t, "hippo1subject", history.Subject)
+	require.Equal(t, client.records, history.Records)
+	require.Equal(t, 3, client.calls)
+}
+
+func TestExportHistoryCmdArgs(t *testing.T) {
+	cmd := ExportHistoryCmd()
+	require.Error(t, cmd.Args(cmd, []string{}))
+	require.NoError(t, cmd.Args(cmd, []string{"hippo1subject"}))
+	require.NotNil(t, cmd.Flags().Lookup(flagOutputDocument))
+	require.NotNil(t, cmd.Flags().Lookup(flagPageLimit))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+// EndBlocker prunes access records older than the retention period.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	pruned, err := k.pruneRecords(ctx, sdkCtx.BlockTime().Add(-params.RetentionPeriod), params.MaxPrunedPerBlock)
+	if err != nil {
+		return err
+	}
+	if pruned == 0 {
+		return nil
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePruneRecords,
+			sdk.NewAttribute(types.AttributeKeyCount, strconv.Itoa(pruned)),
+		),
+	)
+	return nil
+}
+
+// pruneRecords removes at most limit records recorded at or before cutoff and
+// returns the number of records removed. Records left over because of the limit
+// are pruned in the following blocks.
+func (k Keeper) pruneRecords(ctx context.Context, cutoff time.Time, limit uint32) (int, error) {
+	iter, err := k.RetentionQueue.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](cutoff))
+	if err != nil {
+		return 0, err
+	}
+
+	var due []uint64
+	for ; iter.Valid() && len(due) < int(limit); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return 0, err
+		}
+		due = append(due, key.K2())
+	}
+	if err := iter.Close(); err != nil {
+		return 0, err
+	}
+
+	for _, id := range due {
+		record, err := k.GetRecord(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		if err := k.removeRecord(ctx, record); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(due), nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+// InitGenesis initializes the audit module state from a genesis state and
+// rebuilds the record indexes.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	if err := k.RecordSeq.Set(ctx, gs.NextRecordId); err != nil {
+		return err
+	}
+
+	for _, accessor := range gs.Accessors {
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(accessor.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.Accessors.Set(ctx, addr, accessor); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range gs.Records {
+		if err := k.SetRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the audit module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextRecordID, err := k.RecordSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{
+		Params:       params,
+		NextRecordId: nextRecordID,
+	}
+
+	if err := k.Accessors.Walk(ctx, nil, func(_ sdk.AccAddress, accessor types.Accessor) (bool, error) {
+		gs.Accessors = append(gs.Accessors, accessor)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Records.Walk(ctx, nil, func(_ uint64, record types.AccessRecord) (bool, error) {
+		gs.Records = append(gs.Records, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the audit QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Accessor implements types.QueryServer.
+func (k queryServer) Accessor(ctx context.Context, req *types.QueryAccessorRequest) (*types.QueryAccessorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	accessor, err := k.Keeper.Accessors.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "accessor %s is not accredited", req.Address)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAccessorResponse{Accessor: accessor}, nil
+}
+
+// Accessors implements types.QueryServer.
+func (k queryServer) Accessors(ctx context.Context, req *types.QueryAccessorsRequest) (*types.QueryAccessorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	accessors, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Accessors, req.Pagination,
+		func(_ sdk.AccAddress, accessor types.Accessor) (types.Accessor, error) {
+			return accessor, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAccessorsResponse{Accessors: accessors, Pagination: pageRes}, nil
+}
+
+// AccessRecord implements types.QueryServer.
+func (k queryServer) AccessRecord(ctx context.Context, req *types.QueryAccessRecordRequest) (*types.QueryAccessRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	record, err := k.GetRecord(ctx, req.RecordId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryAccessRecordResponse{Record: record}, nil
+}
+
+// AccessRecordsBySubject implements types.QueryServer.
+func (k queryServer) AccessRecordsBySubject(ctx context.Context, req *types.QueryAccessRecordsBySubjectRequest) (*types.QueryAccessRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	subject, err := k.accountKeeper.AddressCodec().StringToBytes(req.Subject)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return paginateRecords(ctx, k.Keeper, k.Keeper.SubjectIndex, sdk.AccAddress(subject), req.Pagination)
+}
+
+// AccessRecordsByAccessor implements types.QueryServer.
+func (k queryServer) AccessRecordsByAccessor(ctx context.Context, req *types.QueryAccessRecordsByAccessorRequest) (*types.QueryAccessRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	accessor, err := k.accountKeeper.AddressCodec().StringToBytes(req.Accessor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return paginateRecords(ctx, k.Keeper, k.Keeper.AccessorIndex, sdk.AccAddress(accessor), req.Pagination)
+}
+
+// AccessRecordsByDataset implements types.QueryServer.
+func (k queryServer) AccessRecordsByDataset(ctx context.Context, req *types.QueryAccessRecordsByDatasetRequest) (*types.QueryAccessRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DatasetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty dataset id")
+	}
+	return paginateRecords(ctx, k.Keeper, k.Keeper.DatasetIndex, req.DatasetId, req.Pagination)
+}
+
+// paginateRecords pages through the records referenced by an index under the
+// given prefix, in the order they were recorded.
+func paginateRecords[K any](
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[collections.Pair[K, uint64]],
+	prefix K,
+	pagination *query.PageRequest,
+) (*types.QueryAccessRecordsResponse, error) {
+	records, pageRes, err := query.CollectionPaginate(ctx, index, pagination,
+		func(key collections.Pair[K, uint64], _ collections.NoValue) (types.AccessRecord, error) {
+			return k.Records.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[K, uint64](prefix),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAccessRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
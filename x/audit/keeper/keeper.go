@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+// Keeper manages accredited accessors and the data access audit log.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+	authzKeeper   types.AuthzKeeper
+
+	// the address capable of accrediting accessors and executing
+	// MsgUpdateParams, typically the x/gov module account.
+	authority string
+
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	Accessors collections.Map[sdk.AccAddress, types.Accessor]
+	RecordSeq collections.Sequence
+	Records   collections.Map[uint64, types.AccessRecord]
+	// SubjectIndex, AccessorIndex and DatasetIndex map their key to record ids.
+	SubjectIndex  collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	AccessorIndex collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	DatasetIndex  collections.KeySet[collections.Pair[string, uint64]]
+	// RetentionQueue orders records by the time they were recorded.
+	RetentionQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+}
+
+// NewKeeper creates a new audit Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	authzKeeper types.AuthzKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid audit authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:            cdc,
+		storeService:   storeService,
+		accountKeeper:  accountKeeper,
+		authzKeeper:    authzKeeper,
+		authority:      authority,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Accessors:      collections.NewMap(sb, types.AccessorsKey, "accessors", sdk.AccAddressKey, codec.CollValue[types.Accessor](cdc)),
+		RecordSeq:      collections.NewSequence(sb, types.RecordSeqKey, "record_seq"),
+		Records:        collections.NewMap(sb, types.RecordsKey, "records", collections.Uint64Key, codec.CollValue[types.AccessRecord](cdc)),
+		SubjectIndex:   collections.NewKeySet(sb, types.SubjectIndexKey, "subject_index", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		AccessorIndex:  collections.NewKeySet(sb, types.AccessorIndexKey, "accessor_index", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		DatasetIndex:   collections.NewKeySet(sb, types.DatasetIndexKey, "dataset_index", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		RetentionQueue: collections.NewKeySet(sb, types.RetentionQueueKey, "retention_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,321 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	accessor sdk.AccAddress
+	subject  sdk.AccAddress
+	other    sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.AuditKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.AuditKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.AuditKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.accessor = sdk.AccAddress([]byte("accessor____________"))
+	s.subject = sdk.AccAddress([]byte("subject_____________"))
+	s.other = sdk.AccAddress([]byte("other_______________"))
+
+	_, err := s.msgServer.AccreditAccessor(s.ctx, &types.MsgAccreditAccessor{
+		Authority: s.authority,
+		Accessor:  s.accessor.String(),
+		Name:      "General Hospital",
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) recordAccess(subject sdk.AccAddress, datasetID string) uint64 {
+	res, err := s.msgServer.RecordAccess(s.ctx, &types.MsgRecordAccess{
+		Accessor:  s.accessor.String(),
+		Subject:   subject.String(),
+		DatasetId: datasetID,
+		Basis:     types.ACCESS_BASIS_CONSENT,
+		BasisRef:  "consent-1",
+		Purpose:   "treatment",
+	})
+	s.Require().NoError(err)
+	return res.RecordId
+}
+
+func (s *KeeperTestSuite) advance(d time.Duration) {
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(d)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.Require().NoError(s.app.AuditKeeper.EndBlocker(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestRecordAccess() {
+	id := s.recordAccess(s.subject, "dataset-1")
+	s.Require().Equal(uint64(1), id)
+
+	record, err := s.app.AuditKeeper.GetRecord(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.subject.String(), record.Subject)
+	s.Require().Equal(s.ctx.BlockTime(), record.RecordedAt)
+	s.Require().Equal(s.ctx.BlockHeight(), record.Height)
+
+	var found bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRecordAccess {
+			found = true
+		}
+	}
+	s.Require().True(found, "record access event should be emitted")
+}
+
+func (s *KeeperTestSuite) TestRecordAccessInvalid() {
+	valid := types.MsgRecordAccess{
+		Accessor:  s.accessor.String(),
+		Subject:   s.subject.String(),
+		DatasetId: "dataset-1",
+		Basis:     types.ACCESS_BASIS_CONSENT,
+		BasisRef:  "consent-1",
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *types.MsgRecordAccess)
+		expErr   error
+	}{
+		{"not accredited", func(msg *types.MsgRecordAccess) { msg.Accessor = s.other.String() }, types.ErrNotAccredited},
+		{"invalid subject", func(msg *types.MsgRecordAccess) { msg.Subject = "invalid" }, types.ErrInvalidRecord},
+		{"empty dataset", func(msg *types.MsgRecordAccess) { msg.DatasetId = "" }, types.ErrInvalidRecord},
+		{"unspecified basis", func(msg *types.MsgRecordAccess) { msg.Basis = types.ACCESS_BASIS_UNSPECIFIED }, types.ErrInvalidRecord},
+		{"empty basis reference", func(msg *types.MsgRecordAccess) { msg.BasisRef = "" }, types.ErrInvalidRecord},
+		{"missing grant", func(msg *types.MsgRecordAccess) {
+			msg.Basis = types.ACCESS_BASIS_AUTHZ_GRANT
+			msg.BasisRef = sdk.MsgTypeURL(&banktypes.MsgSend{})
+		}, types.ErrGrantNotFound},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg := valid
+			tc.malleate(&msg)
+			_, err := s.msgServer.RecordAccess(s.ctx, &msg)
+			s.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRecordAccessWithGrant() {
+	msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(s.ctx, s.accessor, s.subject, authz.NewGenericAuthorization(msgType), &expiration))
+
+	_, err := s.msgServer.RecordAccess(s.ctx, &types.MsgRecordAccess{
+		Accessor:  s.accessor.String(),
+		Subject:   s.subject.String(),
+		DatasetId: "dataset-1",
+		Basis:     types.ACCESS_BASIS_AUTHZ_GRANT,
+		BasisRef:  msgType,
+	})
+	s.Require().NoError(err)
+
+	// the grant is not valid once it has expired
+	s.ctx = s.ctx.WithBlockTime(expiration.Add(time.Second))
+	_, err = s.msgServer.RecordAccess(s.ctx, &types.MsgRecordAccess{
+		Accessor:  s.accessor.String(),
+		Subject:   s.subject.String(),
+		DatasetId: "dataset-1",
+		Basis:     types.ACCESS_BASIS_AUTHZ_GRANT,
+		BasisRef:  msgType,
+	})
+	s.Require().ErrorIs(err, types.ErrGrantNotFound)
+}
+
+func (s *KeeperTestSuite) TestAccreditation() {
+	_, err := s.msgServer.AccreditAccessor(s.ctx, &types.MsgAccreditAccessor{Authority: s.accessor.String(), Accessor: s.other.String(), Name: "Lab"})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.AccreditAccessor(s.ctx, &types.MsgAccreditAccessor{Authority: s.authority, Accessor: s.accessor.String(), Name: "Lab"})
+	s.Require().ErrorIs(err, types.ErrAlreadyAccredited)
+
+	_, err = s.msgServer.AccreditAccessor(s.ctx, &types.MsgAccreditAccessor{Authority: s.authority, Accessor: s.other.String()})
+	s.Require().ErrorIs(err, types.ErrInvalidAccessor)
+
+	id := s.recordAccess(s.subject, "dataset-1")
+
+	_, err = s.msgServer.RevokeAccessor(s.ctx, &types.MsgRevokeAccessor{Authority: s.authority, Accessor: s.other.String()})
+	s.Require().ErrorIs(err, types.ErrAccessorNotFound)
+	_, err = s.msgServer.RevokeAccessor(s.ctx, &types.MsgRevokeAccessor{Authority: s.authority, Accessor: s.accessor.String()})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RecordAccess(s.ctx, &types.MsgRecordAccess{
+		Accessor:  s.accessor.String(),
+		Subject:   s.subject.String(),
+		DatasetId: "dataset-1",
+		Basis:     types.ACCESS_BASIS_CONSENT,
+		BasisRef:  "consent-1",
+	})
+	s.Require().ErrorIs(err, types.ErrNotAccredited)
+
+	// records written before the revocation are kept
+	_, err = s.app.AuditKeeper.GetRecord(s.ctx, id)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestIndexedQueries() {
+	s.recordAccess(s.subject, "dataset-1")
+	s.recordAccess(s.subject, "dataset-2")
+	s.recordAccess(s.other, "dataset-1")
+
+	bySubject, err := s.queryServer.AccessRecordsBySubject(s.ctx, &types.QueryAccessRecordsBySubjectRequest{Subject: s.subject.String()})
+	s.Require().NoError(err)
+	s.Require().Len(bySubject.Records, 2)
+	s.Require().Equal(uint64(1), bySubject.Records[0].Id)
+	s.Require().Equal(uint64(2), bySubject.Records[1].Id)
+
+	byAccessor, err := s.queryServer.AccessRecordsByAccessor(s.ctx, &types.QueryAccessRecordsByAccessorRequest{Accessor: s.accessor.String()})
+	s.Require().NoError(err)
+	s.Require().Len(byAccessor.Records, 3)
+
+	byDataset, err := s.queryServer.AccessRecordsByDataset(s.ctx, &types.QueryAccessRecordsByDatasetRequest{DatasetId: "dataset-1"})
+	s.Require().NoError(err)
+	s.Require().Len(byDataset.Records, 2)
+
+	paged, err := s.queryServer.AccessRecordsBySubject(s.ctx, &types.QueryAccessRecordsBySubjectRequest{
+		Subject:    s.subject.String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(paged.Records, 1)
+	s.Require().NotEmpty(paged.Pagination.NextKey)
+
+	_, err = s.queryServer.AccessRecordsBySubject(s.ctx, &types.QueryAccessRecordsBySubjectRequest{Subject: "invalid"})
+	s.Require().Error(err)
+
+	record, err := s.queryServer.AccessRecord(s.ctx, &types.QueryAccessRecordRequest{RecordId: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(s.other.String(), record.Record.Subject)
+
+	_, err = s.queryServer.AccessRecord(s.ctx, &types.QueryAccessRecordRequest{RecordId: 4})
+	s.Require().Error(err)
+
+	accessor, err := s.queryServer.Accessor(s.ctx, &types.QueryAccessorRequest{Address: s.accessor.String()})
+	s.Require().NoError(err)
+	s.Require().Equal("General Hospital", accessor.Accessor.Name)
+
+	_, err = s.queryServer.Accessor(s.ctx, &types.QueryAccessorRequest{Address: s.other.String()})
+	s.Require().Error(err)
+
+	accessors, err := s.queryServer.Accessors(s.ctx, &types.QueryAccessorsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(accessors.Accessors, 1)
+}
+
+func (s *KeeperTestSuite) TestPruning() {
+	params := types.DefaultParams()
+	params.RetentionPeriod = 24 * time.Hour
+	params.MaxPrunedPerBlock = 2
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		s.recordAccess(s.subject, "dataset-1")
+	}
+	s.advance(12 * time.Hour)
+	recent := s.recordAccess(s.subject, "dataset-1")
+
+	// the first three records pass the retention period, two are pruned per block
+	s.advance(12 * time.Hour)
+	res, err := s.queryServer.AccessRecordsBySubject(s.ctx, &types.QueryAccessRecordsBySubjectRequest{Subject: s.subject.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 2)
+
+	s.advance(time.Second)
+	res, err = s.queryServer.AccessRecordsBySubject(s.ctx, &types.QueryAccessRecordsBySubjectRequest{Subject: s.subject.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(recent, res.Records[0].Id)
+
+	byDataset, err := s.queryServer.AccessRecordsByDataset(s.ctx, &types.QueryAccessRecordsByDatasetRequest{DatasetId: "dataset-1"})
+	s.Require().NoError(err)
+	s.Require().Len(byDataset.Records, 1)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.accessor.String(), Params: types.DefaultParams()})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{}})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	old := s.recordAccess(s.subject, "dataset-1")
+	s.advance(2 * time.Hour)
+	recent := s.recordAccess(s.subject, "dataset-1")
+
+	// a shorter retention period also applies to the records kept so far
+	params := types.NewParams(time.Hour, 10)
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+
+	s.advance(time.Second)
+	_, err = s.app.AuditKeeper.GetRecord(s.ctx, old)
+	s.Require().Error(err)
+	_, err = s.app.AuditKeeper.GetRecord(s.ctx, recent)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	s.recordAccess(s.subject, "dataset-1")
+	s.recordAccess(s.other, "dataset-2")
+
+	exported, err := s.app.AuditKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate(s.app.AccountKeeper.AddressCodec()))
+	s.Require().Len(exported.Accessors, 1)
+	s.Require().Len(exported.Records, 2)
+	s.Require().Equal(uint64(3), exported.NextRecordId)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.AuditKeeper.InitGenesis(s.ctx, exported))
+
+	res, err := s.queryServer.AccessRecordsByDataset(s.ctx, &types.QueryAccessRecordsByDatasetRequest{DatasetId: "dataset-2"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(uint64(3), s.recordAccess(s.subject, "dataset-1"))
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the audit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RecordAccess implements types.MsgServer.
+func (k msgServer) RecordAccess(goCtx context.Context, msg *types.MsgRecordAccess) (*types.MsgRecordAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accessor, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Accessor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRecord, "invalid accessor address: %s", err)
+	}
+	subject, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Subject)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRecord, "invalid subject address: %s", err)
+	}
+
+	accredited, err := k.IsAccredited(ctx, accessor)
+	if err != nil {
+		return nil, err
+	}
+	if !accredited {
+		return nil, errorsmod.Wrap(types.ErrNotAccredited, msg.Accessor)
+	}
+
+	record := types.AccessRecord{
+		Accessor:   msg.Accessor,
+		Subject:    msg.Subject,
+		DatasetId:  msg.DatasetId,
+		Basis:      msg.Basis,
+		BasisRef:   msg.BasisRef,
+		Purpose:    msg.Purpose,
+		RecordedAt: ctx.BlockTime(),
+		Height:     ctx.BlockHeight(),
+	}
+	if err := record.Validate(k.accountKeeper.AddressCodec()); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRecord, err.Error())
+	}
+
+	// grant based access must be backed by a live authorization from the
+	// subject to the accessor for the referenced message type.
+	if msg.Basis == types.ACCESS_BASIS_AUTHZ_GRANT {
+		if authorization, _ := k.authzKeeper.GetAuthorization(ctx, accessor, subject, msg.BasisRef); authorization == nil {
+			return nil, errorsmod.Wrapf(types.ErrGrantNotFound, "no %s grant from %s to %s", msg.BasisRef, msg.Subject, msg.Accessor)
+		}
+	}
+
+	record.Id, err = k.RecordSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SetRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordAccess,
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccessor, record.Accessor),
+			sdk.NewAttribute(types.AttributeKeySubject, record.Subject),
+			sdk.NewAttribute(types.AttributeKeyDatasetID, record.DatasetId),
+			sdk.NewAttribute(types.AttributeKeyBasis, record.Basis.String()),
+			sdk.NewAttribute(types.AttributeKeyBasisRef, record.BasisRef),
+		),
+	)
+
+	return &types.MsgRecordAccessResponse{RecordId: record.Id}, nil
+}
+
+// AccreditAccessor implements types.MsgServer.
+func (k msgServer) AccreditAccessor(goCtx context.Context, msg *types.MsgAccreditAccessor) (*types.MsgAccreditAccessorResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accessor := types.Accessor{
+		Address:      msg.Accessor,
+		Name:         msg.Name,
+		AccreditedAt: ctx.BlockTime(),
+	}
+	if err := accessor.Validate(k.accountKeeper.AddressCodec()); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAccessor, err.Error())
+	}
+
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Accessor)
+	if err != nil {
+		return nil, err
+	}
+	accredited, err := k.IsAccredited(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if accredited {
+		return nil, errorsmod.Wrap(types.ErrAlreadyAccredited, msg.Accessor)
+	}
+
+	if err := k.Accessors.Set(ctx, addr, accessor); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccreditAccessor,
+			sdk.NewAttribute(types.AttributeKeyAccessor, accessor.Address),
+			sdk.NewAttribute(types.AttributeKeyName, accessor.Name),
+		),
+	)
+
+	return &types.MsgAccreditAccessorResponse{}, nil
+}
+
+// RevokeAccessor implements types.MsgServer.
+func (k msgServer) RevokeAccessor(goCtx context.Context, msg *types.MsgRevokeAccessor) (*types.MsgRevokeAccessorResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Accessor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAccessor, "invalid accessor address: %s", err)
+	}
+	accredited, err := k.IsAccredited(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if !accredited {
+		return nil, errorsmod.Wrap(types.ErrAccessorNotFound, msg.Accessor)
+	}
+
+	if err := k.Accessors.Remove(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAccessor,
+			sdk.NewAttribute(types.AttributeKeyAccessor, msg.Accessor),
+		),
+	)
+
+	return &types.MsgRevokeAccessorResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
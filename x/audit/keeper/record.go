@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+// IsAccredited reports whether addr is an accredited accessor.
+func (k Keeper) IsAccredited(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.Accessors.Has(ctx, addr)
+}
+
+// GetRecord returns the access record with the given id.
+func (k Keeper) GetRecord(ctx context.Context, id uint64) (types.AccessRecord, error) {
+	record, err := k.Records.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.AccessRecord{}, errorsmod.Wrapf(types.ErrRecordNotFound, "record %d", id)
+	}
+	return record, err
+}
+
+// SetRecord stores an access record and updates its indexes.
+func (k Keeper) SetRecord(ctx context.Context, record types.AccessRecord) error {
+	accessor, err := k.accountKeeper.AddressCodec().StringToBytes(record.Accessor)
+	if err != nil {
+		return err
+	}
+	subject, err := k.accountKeeper.AddressCodec().StringToBytes(record.Subject)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Records.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+	if err := k.SubjectIndex.Set(ctx, collections.Join(sdk.AccAddress(subject), record.Id)); err != nil {
+		return err
+	}
+	if err := k.AccessorIndex.Set(ctx, collections.Join(sdk.AccAddress(accessor), record.Id)); err != nil {
+		return err
+	}
+	if err := k.DatasetIndex.Set(ctx, collections.Join(record.DatasetId, record.Id)); err != nil {
+		return err
+	}
+	return k.RetentionQueue.Set(ctx, collections.Join(record.RecordedAt, record.Id))
+}
+
+// removeRecord deletes an access record together with its index entries.
+func (k Keeper) removeRecord(ctx context.Context, record types.AccessRecord) error {
+	accessor, err := k.accountKeeper.AddressCodec().StringToBytes(record.Accessor)
+	if err != nil {
+		return err
+	}
+	subject, err := k.accountKeeper.AddressCodec().StringToBytes(record.Subject)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Records.Remove(ctx, record.Id); err != nil {
+		return err
+	}
+	if err := k.SubjectIndex.Remove(ctx, collections.Join(sdk.AccAddress(subject), record.Id)); err != nil {
+		return err
+	}
+	if err := k.AccessorIndex.Remove(ctx, collections.Join(sdk.AccAddress(accessor), record.Id)); err != nil {
+		return err
+	}
+	if err := k.DatasetIndex.Remove(ctx, collections.Join(record.DatasetId, record.Id)); err != nil {
+		return err
+	}
+	return k.RetentionQueue.Remove(ctx, collections.Join(record.RecordedAt, record.Id))
+}
@@ -0,0 +1,1469 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/audit/v1/audit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccessBasis is the legal basis under which a dataset was accessed.
+type AccessBasis int32
+
+const (
+	// ACCESS_BASIS_UNSPECIFIED is invalid.
+	ACCESS_BASIS_UNSPECIFIED AccessBasis = 0
+	// ACCESS_BASIS_CONSENT references a consent identifier managed off-chain or
+	// by a contract.
+	ACCESS_BASIS_CONSENT AccessBasis = 1
+	// ACCESS_BASIS_AUTHZ_GRANT references an x/authz grant from the subject to
+	// the accessor. The basis reference is the granted message type URL.
+	ACCESS_BASIS_AUTHZ_GRANT AccessBasis = 2
+)
+
+var AccessBasis_name = map[int32]string{
+	0: "ACCESS_BASIS_UNSPECIFIED",
+	1: "ACCESS_BASIS_CONSENT",
+	2: "ACCESS_BASIS_AUTHZ_GRANT",
+}
+
+var AccessBasis_value = map[string]int32{
+	"ACCESS_BASIS_UNSPECIFIED": 0,
+	"ACCESS_BASIS_CONSENT":     1,
+	"ACCESS_BASIS_AUTHZ_GRANT": 2,
+}
+
+func (x AccessBasis) String() string {
+	return proto.EnumName(AccessBasis_name, int32(x))
+}
+
+func (AccessBasis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bae3a879aa7e88f5, []int{0}
+}
+
+// Params defines the parameters of the audit module.
+type Params struct {
+	// retention_period is how long access records are kept before they are
+	// pruned.
+	RetentionPeriod time.Duration `protobuf:"bytes,1,opt,name=retention_period,json=retentionPeriod,proto3,stdduration" json:"retention_period"`
+	// max_pruned_per_block bounds the number of records pruned in a single
+	// block.
+	MaxPrunedPerBlock uint32 `protobuf:"varint,2,opt,name=max_pruned_per_block,json=maxPrunedPerBlock,proto3" json:"max_pruned_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae3a879aa7e88f5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedPerBlock() uint32 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
+// Accessor is an entity accredited to record access events.
+type Accessor struct {
+	Address      string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name         string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccreditedAt time.Time `protobuf:"bytes,3,opt,name=accredited_at,json=accreditedAt,proto3,stdtime" json:"accredited_at"`
+}
+
+func (m *Accessor) Reset()         { *m = Accessor{} }
+func (m *Accessor) String() string { return proto.CompactTextString(m) }
+func (*Accessor) ProtoMessage()    {}
+func (*Accessor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae3a879aa7e88f5, []int{1}
+}
+func (m *Accessor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Accessor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Accessor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Accessor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Accessor.Merge(m, src)
+}
+func (m *Accessor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Accessor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Accessor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Accessor proto.InternalMessageInfo
+
+func (m *Accessor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Accessor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Accessor) GetAccreditedAt() time.Time {
+	if m != nil {
+		return m.AccreditedAt
+	}
+	return time.Time{}
+}
+
+// AccessRecord is a single access of a subject's dataset.
+type AccessRecord struct {
+	Id        uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Accessor  string      `protobuf:"bytes,2,opt,name=accessor,proto3" json:"accessor,omitempty"`
+	Subject   string      `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	DatasetId string      `protobuf:"bytes,4,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	Basis     AccessBasis `protobuf:"varint,5,opt,name=basis,proto3,enum=hippo.audit.v1.AccessBasis" json:"basis,omitempty"`
+	// basis_ref identifies the consent or grant the access relied on.
+	BasisRef   string    `protobuf:"bytes,6,opt,name=basis_ref,json=basisRef,proto3" json:"basis_ref,omitempty"`
+	Purpose    string    `protobuf:"bytes,7,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	RecordedAt time.Time `protobuf:"bytes,8,opt,name=recorded_at,json=recordedAt,proto3,stdtime" json:"recorded_at"`
+	Height     int64     `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AccessRecord) Reset()         { *m = AccessRecord{} }
+func (m *AccessRecord) String() string { return proto.CompactTextString(m) }
+func (*AccessRecord) ProtoMessage()    {}
+func (*AccessRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae3a879aa7e88f5, []int{2}
+}
+func (m *AccessRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessRecord.Merge(m, src)
+}
+func (m *AccessRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessRecord proto.InternalMessageInfo
+
+func (m *AccessRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AccessRecord) GetAccessor() string {
+	if m != nil {
+		return m.Accessor
+	}
+	return ""
+}
+
+func (m *AccessRecord) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *AccessRecord) GetDatasetId() string {
+	if m != nil {
+		return m.DatasetId
+	}
+	return ""
+}
+
+func (m *AccessRecord) GetBasis() AccessBasis {
+	if m != nil {
+		return m.Basis
+	}
+	return ACCESS_BASIS_UNSPECIFIED
+}
+
+func (m *AccessRecord) GetBasisRef() string {
+	if m != nil {
+		return m.BasisRef
+	}
+	return ""
+}
+
+func (m *AccessRecord) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
+	}
+	return ""
+}
+
+func (m *AccessRecord) GetRecordedAt() time.Time {
+	if m != nil {
+		return m.RecordedAt
+	}
+	return time.Time{}
+}
+
+func (m *AccessRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// AccessHistory is the full access history of a subject, as produced by the
+// export command for compliance requests.
+type AccessHistory struct {
+	Subject string         `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Records []AccessRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *AccessHistory) Reset()         { *m = AccessHistory{} }
+func (m *AccessHistory) String() string { return proto.CompactTextString(m) }
+func (*AccessHistory) ProtoMessage()    {}
+func (*AccessHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae3a879aa7e88f5, []int{3}
+}
+func (m *AccessHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessHistory.Merge(m, src)
+}
+func (m *AccessHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessHistory proto.InternalMessageInfo
+
+func (m *AccessHistory) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *AccessHistory) GetRecords() []AccessRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("hippo.audit.v1.AccessBasis", AccessBasis_name, AccessBasis_value)
+	proto.RegisterType((*Params)(nil), "hippo.audit.v1.Params")
+	proto.RegisterType((*Accessor)(nil), "hippo.audit.v1.Accessor")
+	proto.RegisterType((*AccessRecord)(nil), "hippo.audit.v1.AccessRecord")
+	proto.RegisterType((*AccessHistory)(nil), "hippo.audit.v1.AccessHistory")
+}
+
+func init() { proto.RegisterFile("hippo/audit/v1/audit.proto", fileDescriptor_bae3a879aa7e88f5) }
+
+var fileDescriptor_bae3a879aa7e88f5 = []byte{
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x4f, 0x1b, 0x49,
+	0x14, 0xf6, 0x18, 0xe3, 0x1f, 0x63, 0xcc, 0x99, 0x91, 0x75, 0x5a, 0x0c, 0xb7, 0x58, 0xae, 0x2c,
+	0x24, 0x76, 0x85, 0xef, 0xae, 0xb9, 0x6e, 0x6d, 0x7c, 0x87, 0xaf, 0x30, 0xd6, 0xae, 0x69, 0x68,
+	0x56, 0xe3, 0xdd, 0xc1, 0x9e, 0x3b, 0xd6, 0xb3, 0x9a, 0x19, 0x23, 0xf8, 0x07, 0x4e, 0xa7, 0x54,
+	0x94, 0xa9, 0x93, 0x26, 0xe9, 0x28, 0xf2, 0x47, 0x50, 0xa2, 0x54, 0xa9, 0x92, 0x08, 0x0a, 0xfe,
+	0x82, 0xf4, 0x91, 0x67, 0xd6, 0x0e, 0x84, 0x08, 0x25, 0x8d, 0xf5, 0xde, 0xfb, 0xbe, 0xf7, 0xe6,
+	0xfb, 0x3e, 0xdb, 0xb0, 0x3a, 0xa6, 0x71, 0xcc, 0x6c, 0x3c, 0x0d, 0xa9, 0xb4, 0x4f, 0x77, 0x75,
+	0x61, 0xc5, 0x9c, 0x49, 0x86, 0x56, 0x15, 0x66, 0xe9, 0xd1, 0xe9, 0x6e, 0x75, 0x0d, 0x47, 0x74,
+	0xc2, 0x6c, 0xf5, 0xa9, 0x29, 0xd5, 0xf5, 0x80, 0x89, 0x88, 0x09, 0x5f, 0x75, 0xb6, 0x6e, 0x12,
+	0xa8, 0x32, 0x62, 0x23, 0xa6, 0xe7, 0xb3, 0x2a, 0x99, 0x9a, 0x23, 0xc6, 0x46, 0x27, 0xc4, 0x56,
+	0xdd, 0x70, 0x7a, 0x6c, 0x87, 0x53, 0x8e, 0x25, 0x65, 0x93, 0x04, 0xdf, 0xfa, 0x1a, 0x97, 0x34,
+	0x22, 0x42, 0xe2, 0x28, 0xd6, 0x84, 0xfa, 0x6b, 0x00, 0xb3, 0x7d, 0xcc, 0x71, 0x24, 0x90, 0x07,
+	0xcb, 0x9c, 0x48, 0x32, 0x99, 0xad, 0xfb, 0x31, 0xe1, 0x94, 0x85, 0x06, 0xa8, 0x81, 0x46, 0xb1,
+	0xb9, 0x6e, 0xe9, 0x33, 0xd6, 0xfc, 0x8c, 0xb5, 0x97, 0x3c, 0xd3, 0x2a, 0x5d, 0xbd, 0xdf, 0x4a,
+	0x3d, 0xff, 0xb0, 0x05, 0x5e, 0xdd, 0x5d, 0x6e, 0x03, 0xf7, 0xa7, 0xc5, 0x85, 0xbe, 0x3a, 0x80,
+	0x6c, 0x58, 0x89, 0xf0, 0x99, 0x1f, 0xf3, 0xe9, 0x84, 0x84, 0xb3, 0xab, 0xfe, 0xf0, 0x84, 0x05,
+	0xff, 0x1a, 0xe9, 0x1a, 0x68, 0x94, 0xdc, 0xb5, 0x08, 0x9f, 0xf5, 0x15, 0xd4, 0x27, 0xbc, 0x35,
+	0x03, 0xfe, 0x58, 0x7f, 0x76, 0x77, 0xb9, 0x5d, 0xd1, 0x31, 0x9e, 0x25, 0x41, 0x6a, 0x81, 0xf5,
+	0x17, 0x00, 0xe6, 0x9d, 0x20, 0x20, 0x42, 0x30, 0x8e, 0x9a, 0x30, 0x87, 0xc3, 0x90, 0x13, 0x21,
+	0x94, 0xc8, 0x42, 0xcb, 0x78, 0xfb, 0x66, 0xa7, 0x92, 0x44, 0xe6, 0x68, 0xc4, 0x93, 0x9c, 0x4e,
+	0x46, 0xee, 0x9c, 0x88, 0x10, 0xcc, 0x4c, 0x70, 0x44, 0xd4, 0xe3, 0x05, 0x57, 0xd5, 0xa8, 0x07,
+	0x4b, 0x38, 0x08, 0x38, 0x09, 0xa9, 0x24, 0xa1, 0x8f, 0xa5, 0xb1, 0xa4, 0x2c, 0x57, 0x1f, 0x59,
+	0x1e, 0xcc, 0x93, 0xd3, 0x9e, 0x2f, 0x16, 0x9e, 0x57, 0xbe, 0xec, 0x3b, 0xb2, 0xfe, 0x29, 0x0d,
+	0x57, 0xb4, 0x48, 0x97, 0x04, 0x8c, 0x87, 0x68, 0x15, 0xa6, 0xa9, 0x0e, 0x32, 0xe3, 0xa6, 0x69,
+	0x88, 0x7e, 0x83, 0x79, 0x9c, 0x98, 0xd0, 0x42, 0x9e, 0x50, 0xbe, 0x60, 0xce, 0xec, 0x8a, 0xe9,
+	0xf0, 0x1f, 0x12, 0x68, 0x81, 0x4f, 0xda, 0x4d, 0x88, 0xe8, 0x17, 0x08, 0x43, 0x2c, 0xb1, 0x20,
+	0xd2, 0xa7, 0xa1, 0x91, 0x51, 0xa6, 0x0b, 0xc9, 0xa4, 0x1b, 0xa2, 0x5d, 0xb8, 0x3c, 0xc4, 0x82,
+	0x0a, 0x63, 0xb9, 0x06, 0x1a, 0xab, 0xcd, 0x0d, 0xeb, 0xe1, 0xef, 0xd3, 0xd2, 0x2e, 0x5a, 0x33,
+	0x8a, 0xab, 0x99, 0x68, 0x03, 0x16, 0x54, 0xe1, 0x73, 0x72, 0x6c, 0x64, 0xd5, 0xc1, 0xbc, 0x1a,
+	0xb8, 0xe4, 0x18, 0x19, 0x30, 0x17, 0x4f, 0x79, 0xcc, 0x04, 0x31, 0x72, 0x0a, 0x9a, 0xb7, 0xe8,
+	0x6f, 0x58, 0xe4, 0x2a, 0x0c, 0x9d, 0x70, 0xfe, 0x47, 0x13, 0x86, 0xf3, 0x6d, 0x47, 0xa2, 0x9f,
+	0x61, 0x76, 0x4c, 0xe8, 0x68, 0x2c, 0x8d, 0x42, 0x0d, 0x34, 0x96, 0xdc, 0xa4, 0xab, 0xff, 0x07,
+	0x60, 0x49, 0x2b, 0xde, 0xa7, 0x42, 0x32, 0x7e, 0x7e, 0x3f, 0x32, 0xf0, 0xbd, 0x91, 0x39, 0x30,
+	0xa7, 0xdf, 0x12, 0x46, 0xba, 0xb6, 0xd4, 0x28, 0x36, 0x37, 0xbf, 0x9d, 0x8a, 0xfe, 0x6e, 0x5b,
+	0x85, 0x99, 0x4e, 0xad, 0x71, 0xbe, 0xb7, 0x4d, 0x61, 0xf1, 0x5e, 0x72, 0x68, 0x13, 0x1a, 0x4e,
+	0xbb, 0xdd, 0xf1, 0x3c, 0xbf, 0xe5, 0x78, 0x5d, 0xcf, 0x3f, 0xec, 0x79, 0xfd, 0x4e, 0xbb, 0xfb,
+	0x67, 0xb7, 0xb3, 0x57, 0x4e, 0x21, 0x03, 0x56, 0x1e, 0xa0, 0xed, 0x83, 0x9e, 0xd7, 0xe9, 0x0d,
+	0xca, 0xe0, 0xd1, 0x9e, 0x73, 0x38, 0xd8, 0x3f, 0xf2, 0xff, 0x72, 0x9d, 0xde, 0xa0, 0x9c, 0xae,
+	0x66, 0xfe, 0x7f, 0x69, 0xa6, 0x5a, 0x07, 0x57, 0x37, 0x26, 0xb8, 0xbe, 0x31, 0xc1, 0xc7, 0x1b,
+	0x13, 0x5c, 0xdc, 0x9a, 0xa9, 0xeb, 0x5b, 0x33, 0xf5, 0xee, 0xd6, 0x4c, 0x1d, 0xfd, 0x3e, 0xa2,
+	0x72, 0x3c, 0x1d, 0x5a, 0x01, 0x8b, 0x6c, 0x65, 0x20, 0xe0, 0x58, 0xee, 0x84, 0x98, 0xe9, 0x6e,
+	0x47, 0x65, 0x1e, 0xb0, 0x93, 0xc5, 0x5f, 0x4c, 0x9e, 0xc7, 0x44, 0x0c, 0xb3, 0x6a, 0xfe, 0xeb,
+	0xe7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0xa8, 0xbd, 0x1c, 0xc7, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAudit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Accessor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Accessor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Accessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AccreditedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AccreditedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAudit(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecordedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecordedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAudit(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BasisRef) > 0 {
+		i -= len(m.BasisRef)
+		copy(dAtA[i:], m.BasisRef)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.BasisRef)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Basis != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.Basis))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DatasetId) > 0 {
+		i -= len(m.DatasetId)
+		copy(dAtA[i:], m.DatasetId)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.DatasetId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accessor) > 0 {
+		i -= len(m.Accessor)
+		copy(dAtA[i:], m.Accessor)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Accessor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAudit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAudit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAudit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionPeriod)
+	n += 1 + l + sovAudit(uint64(l))
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovAudit(uint64(m.MaxPrunedPerBlock))
+	}
+	return n
+}
+
+func (m *Accessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AccreditedAt)
+	n += 1 + l + sovAudit(uint64(l))
+	return n
+}
+
+func (m *AccessRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAudit(uint64(m.Id))
+	}
+	l = len(m.Accessor)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.DatasetId)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	if m.Basis != 0 {
+		n += 1 + sovAudit(uint64(m.Basis))
+	}
+	l = len(m.BasisRef)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecordedAt)
+	n += 1 + l + sovAudit(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAudit(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AccessHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovAudit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAudit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAudit(x uint64) (n int) {
+	return sovAudit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+			}
+			m.MaxPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Accessor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Accessor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Accessor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AccreditedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accessor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accessor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatasetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatasetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basis", wireType)
+			}
+			m.Basis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Basis |= AccessBasis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasisRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecordedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, AccessRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAudit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAudit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAudit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAudit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAudit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAudit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAudit = fmt.Errorf("proto: unexpected end of group")
+)
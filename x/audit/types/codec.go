@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the audit messages on the amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRecordAccess{}, "hippo/x/audit/MsgRecordAccess")
+	legacy.RegisterAminoMsg(cdc, &MsgAccreditAccessor{}, "hippo/x/audit/MsgAccreditAccessor")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAccessor{}, "hippo/x/audit/MsgRevokeAccessor")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/audit/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/audit/Params", nil)
+}
+
+// RegisterInterfaces registers the audit messages on the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRecordAccess{},
+		&MsgAccreditAccessor{},
+		&MsgRevokeAccessor{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
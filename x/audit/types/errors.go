@@ -0,0 +1,15 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/audit module sentinel errors
+var (
+	ErrNotAccredited     = errorsmod.Register(ModuleName, 2, "accessor is not accredited")
+	ErrAccessorNotFound  = errorsmod.Register(ModuleName, 3, "accessor not found")
+	ErrInvalidAccessor   = errorsmod.Register(ModuleName, 4, "invalid accessor")
+	ErrInvalidRecord     = errorsmod.Register(ModuleName, 5, "invalid access record")
+	ErrRecordNotFound    = errorsmod.Register(ModuleName, 6, "access record not found")
+	ErrGrantNotFound     = errorsmod.Register(ModuleName, 7, "authorization grant not found")
+	ErrInvalidParams     = errorsmod.Register(ModuleName, 8, "invalid params")
+	ErrAlreadyAccredited = errorsmod.Register(ModuleName, 9, "accessor is already accredited")
+)
@@ -0,0 +1,18 @@
+package types
+
+// audit module event types and attributes
+const (
+	EventTypeRecordAccess     = "record_access"
+	EventTypeAccreditAccessor = "accredit_accessor"
+	EventTypeRevokeAccessor   = "revoke_accessor"
+	EventTypePruneRecords     = "prune_access_records"
+
+	AttributeKeyRecordID  = "record_id"
+	AttributeKeyAccessor  = "accessor"
+	AttributeKeySubject   = "subject"
+	AttributeKeyDatasetID = "dataset_id"
+	AttributeKeyBasis     = "basis"
+	AttributeKeyBasisRef  = "basis_ref"
+	AttributeKeyName      = "name"
+	AttributeKeyCount     = "count"
+)
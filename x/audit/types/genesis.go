@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default audit genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:       DefaultParams(),
+		NextRecordId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextRecordId == 0 {
+		return fmt.Errorf("next record id must be positive")
+	}
+
+	accessors := make(map[string]bool, len(gs.Accessors))
+	for _, a := range gs.Accessors {
+		if accessors[a.Address] {
+			return fmt.Errorf("duplicate accessor %s", a.Address)
+		}
+		accessors[a.Address] = true
+		if err := a.Validate(ac); err != nil {
+			return err
+		}
+	}
+
+	records := make(map[uint64]bool, len(gs.Records))
+	for _, r := range gs.Records {
+		if r.Id == 0 || r.Id >= gs.NextRecordId {
+			return fmt.Errorf("access record id %d out of range", r.Id)
+		}
+		if records[r.Id] {
+			return fmt.Errorf("duplicate access record id %d", r.Id)
+		}
+		records[r.Id] = true
+		if err := r.Validate(ac); err != nil {
+			return fmt.Errorf("access record %d: %w", r.Id, err)
+		}
+	}
+
+	return nil
+}
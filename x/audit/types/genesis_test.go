@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	ac := address.NewBech32Codec("hippo")
+	addr, err := ac.BytesToString([]byte("accessor____________"))
+	require.NoError(t, err)
+
+	accessor := types.Accessor{Address: addr, Name: "General Hospital"}
+	record := types.AccessRecord{
+		Id:        1,
+		Accessor:  addr,
+		Subject:   addr,
+		DatasetId: "dataset-1",
+		Basis:     types.ACCESS_BASIS_CONSENT,
+		BasisRef:  "consent-1",
+	}
+	withRecord := func(malleate func(r *types.AccessRecord)) types.GenesisState {
+		r := record
+		malleate(&r)
+		return types.GenesisState{Params: types.DefaultParams(), Records: []types.AccessRecord{r}, NextRecordId: 2}
+	}
+
+	testCases := []struct {
+		name     string
+		genesis  types.GenesisState
+		expError bool
+	}{
+		{"default", *types.DefaultGenesisState(), false},
+		{"valid", types.GenesisState{Params: types.DefaultParams(), Accessors: []types.Accessor{accessor}, Records: []types.AccessRecord{record}, NextRecordId: 2}, false},
+		{"zero next record id", types.GenesisState{Params: types.DefaultParams()}, true},
+		{"duplicate accessor", types.GenesisState{Params: types.DefaultParams(), Accessors: []types.Accessor{accessor, accessor}, NextRecordId: 1}, true},
+		{"unnamed accessor", types.GenesisState{Params: types.DefaultParams(), Accessors: []types.Accessor{{Address: addr}}, NextRecordId: 1}, true},
+		{"record id not below next id", types.GenesisState{Params: types.DefaultParams(), Records: []types.AccessRecord{record}, NextRecordId: 1}, true},
+		{"duplicate record", types.GenesisState{Params: types.DefaultParams(), Records: []types.AccessRecord{record, record}, NextRecordId: 2}, true},
+		{"invalid subject", withRecord(func(r *types.AccessRecord) { r.Subject = "invalid" }), true},
+		{"unspecified basis", withRecord(func(r *types.AccessRecord) { r.Basis = types.ACCESS_BASIS_UNSPECIFIED }), true},
+		{"purpose too long", withRecord(func(r *types.AccessRecord) { r.Purpose = strings.Repeat("a", types.MaxPurposeLength+1) }), true},
+		{"invalid params", types.GenesisState{NextRecordId: 1}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(ac)
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
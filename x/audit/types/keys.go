@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "audit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey         = collections.NewPrefix(0)
+	AccessorsKey      = collections.NewPrefix(1)
+	RecordSeqKey      = collections.NewPrefix(2)
+	RecordsKey        = collections.NewPrefix(3)
+	SubjectIndexKey   = collections.NewPrefix(4)
+	AccessorIndexKey  = collections.NewPrefix(5)
+	DatasetIndexKey   = collections.NewPrefix(6)
+	RetentionQueueKey = collections.NewPrefix(7)
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultRetentionPeriod keeps access records for six years, the retention
+	// period most health data regulations require for access logs.
+	DefaultRetentionPeriod = 6 * 365 * 24 * time.Hour
+	// DefaultMaxPrunedPerBlock bounds the pruning work done in EndBlock.
+	DefaultMaxPrunedPerBlock uint32 = 1000
+)
+
+// NewParams creates a new Params instance.
+func NewParams(retentionPeriod time.Duration, maxPrunedPerBlock uint32) Params {
+	return Params{
+		RetentionPeriod:   retentionPeriod,
+		MaxPrunedPerBlock: maxPrunedPerBlock,
+	}
+}
+
+// DefaultParams returns the default audit parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultRetentionPeriod, DefaultMaxPrunedPerBlock)
+}
+
+// Validate performs basic validation of the audit parameters.
+func (p Params) Validate() error {
+	if p.RetentionPeriod <= 0 {
+		return fmt.Errorf("retention period must be positive: %s", p.RetentionPeriod)
+	}
+	if p.MaxPrunedPerBlock == 0 {
+		return fmt.Errorf("max pruned per block must be positive")
+	}
+	return nil
+}
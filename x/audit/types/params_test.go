@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/audit/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.RetentionPeriod = 0
+	require.Error(t, params.Validate(), "zero retention period should be rejected")
+
+	params = types.DefaultParams()
+	params.MaxPrunedPerBlock = 0
+	require.Error(t, params.Validate(), "zero prune limit should be rejected")
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+const (
+	// MaxDatasetIDLength bounds the length of a dataset identifier.
+	MaxDatasetIDLength = 256
+	// MaxBasisRefLength bounds the length of a consent or grant reference.
+	MaxBasisRefLength = 256
+	// MaxPurposeLength bounds the length of the stated access purpose.
+	MaxPurposeLength = 512
+	// MaxAccessorNameLength bounds the length of an accessor name.
+	MaxAccessorNameLength = 128
+)
+
+// Validate performs stateless validation of an access record.
+func (r AccessRecord) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(r.Accessor); err != nil {
+		return fmt.Errorf("invalid accessor address: %w", err)
+	}
+	if _, err := ac.StringToBytes(r.Subject); err != nil {
+		return fmt.Errorf("invalid subject address: %w", err)
+	}
+	if r.DatasetId == "" || len(r.DatasetId) > MaxDatasetIDLength {
+		return fmt.Errorf("dataset id must be between 1 and %d characters", MaxDatasetIDLength)
+	}
+	if r.Basis != ACCESS_BASIS_CONSENT && r.Basis != ACCESS_BASIS_AUTHZ_GRANT {
+		return fmt.Errorf("invalid access basis %s", r.Basis)
+	}
+	if r.BasisRef == "" || len(r.BasisRef) > MaxBasisRefLength {
+		return fmt.Errorf("basis reference must be between 1 and %d characters", MaxBasisRefLength)
+	}
+	if len(r.Purpose) > MaxPurposeLength {
+		return fmt.Errorf("purpose exceeds %d characters", MaxPurposeLength)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of an accessor.
+func (a Accessor) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(a.Address); err != nil {
+		return fmt.Errorf("invalid accessor address: %w", err)
+	}
+	if a.Name == "" || len(a.Name) > MaxAccessorNameLength {
+		return fmt.Errorf("accessor name must be between 1 and %d characters", MaxAccessorNameLength)
+	}
+	return nil
+}
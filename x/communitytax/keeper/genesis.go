@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+)
+
+// InitGenesis initializes the communitytax module state from a genesis state.
+// The schedule is applied from the first year starting after it.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	return k.AppliedYear.Set(ctx, gs.AppliedYear)
+}
+
+// ExportGenesis exports the communitytax module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appliedYear, err := k.AppliedYear.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{Params: params, AppliedYear: appliedYear}, nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the communitytax
+// QueryServer interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CommunityTax implements types.QueryServer.
+func (k queryServer) CommunityTax(ctx context.Context, _ *types.QueryCommunityTaxRequest) (*types.QueryCommunityTaxResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	distrParams, err := k.distrParams.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	year, err := k.CurrentYear(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryCommunityTaxResponse{
+		Year:                  year,
+		CommunityTax:          distrParams.CommunityTax,
+		ScheduledCommunityTax: params.StepAt(year).CommunityTax,
+	}
+	if next, ok := params.NextStep(year); ok {
+		res.NextStep = &next
+		if res.NextStepHeight, err = k.YearStartHeight(ctx, next.StartYear); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return res, nil
+}
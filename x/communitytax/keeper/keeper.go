@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+)
+
+// Keeper holds the community tax schedule and applies it to the
+// distribution params.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	distrParams types.DistributionParams
+	mintParams  types.MintParams
+
+	// the address capable of executing MsgUpdateParams, typically the x/gov
+	// module account.
+	authority string
+
+	Schema      collections.Schema
+	Params      collections.Item[types.Params]
+	AppliedYear collections.Item[uint64]
+}
+
+// NewKeeper creates a new communitytax Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	distrParams types.DistributionParams,
+	mintParams types.MintParams,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid communitytax authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		distrParams:  distrParams,
+		mintParams:   mintParams,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AppliedYear:  collections.NewItem(sb, types.AppliedYearKey, "applied_year", collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+)
+
+const blocksPerYear = 100
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.CommunityTaxKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.BlocksPerYear = blocksPerYear
+	s.Require().NoError(s.app.MintKeeper.Params.Set(s.ctx, mintParams))
+	s.Require().NoError(s.app.DistrKeeper.Params.Set(s.ctx, distrtypes.DefaultParams()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.CommunityTaxKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.CommunityTaxKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func (s *KeeperTestSuite) beginBlock(height int64) {
+	s.ctx = s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.app.CommunityTaxKeeper.BeginBlocker(s.ctx))
+}
+
+func (s *KeeperTestSuite) communityTax() math.LegacyDec {
+	tax, err := s.app.DistrKeeper.GetCommunityTax(s.ctx)
+	s.Require().NoError(err)
+	return tax
+}
+
+func (s *KeeperTestSuite) applied() bool {
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeApplyCommunityTax {
+			return true
+		}
+	}
+	return false
+}
+
+// setSchedule sets a schedule lowering the community tax at the first two
+// halvings, starting from the community tax of the distribution params.
+func (s *KeeperTestSuite) setSchedule() {
+	s.Require().NoError(s.app.CommunityTaxKeeper.Params.Set(s.ctx, types.NewParams([]types.Step{
+		{StartYear: 1, CommunityTax: math.LegacyNewDecWithPrec(92, 2)},
+		{StartYear: 3, CommunityTax: math.LegacyNewDecWithPrec(80, 2)},
+		{StartYear: 5, CommunityTax: math.LegacyNewDecWithPrec(65, 2)},
+	})))
+	s.setCommunityTax(math.LegacyNewDecWithPrec(92, 2))
+}
+
+func (s *KeeperTestSuite) setCommunityTax(tax math.LegacyDec) {
+	distrParams, err := s.app.DistrKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	distrParams.CommunityTax = tax
+	s.Require().NoError(s.app.DistrKeeper.Params.Set(s.ctx, distrParams))
+}
+
+func (s *KeeperTestSuite) TestBeginBlocker() {
+	s.setSchedule()
+	s.beginBlock(1)
+	s.Require().Equal(math.LegacyNewDecWithPrec(92, 2), s.communityTax())
+	s.Require().False(s.applied())
+
+	s.beginBlock(blocksPerYear)
+	s.Require().Equal(math.LegacyNewDecWithPrec(92, 2), s.communityTax())
+	s.Require().False(s.applied())
+
+	// the first step change is at the first halving
+	s.beginBlock(2*blocksPerYear - 1)
+	s.Require().Equal(math.LegacyNewDecWithPrec(92, 2), s.communityTax())
+	s.Require().False(s.applied())
+
+	s.beginBlock(2 * blocksPerYear)
+	s.Require().Equal(math.LegacyNewDecWithPrec(80, 2), s.communityTax())
+	s.Require().True(s.applied())
+
+	// a community tax set through the distribution params holds over the
+	// following steps
+	s.setCommunityTax(math.LegacyNewDecWithPrec(5, 1))
+	s.beginBlock(4 * blocksPerYear)
+	s.Require().Equal(math.LegacyNewDecWithPrec(5, 1), s.communityTax())
+	s.Require().False(s.applied())
+
+	s.beginBlock(20 * blocksPerYear)
+	s.Require().Equal(math.LegacyNewDecWithPrec(5, 1), s.communityTax())
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerFirstBlock() {
+	// the schedule does not replace the community tax it starts from
+	s.beginBlock(1)
+	s.Require().Equal(distrtypes.DefaultParams().CommunityTax, s.communityTax())
+	s.Require().False(s.applied())
+
+	s.beginBlock(blocksPerYear)
+	s.Require().Equal(distrtypes.DefaultParams().CommunityTax, s.communityTax())
+	s.Require().False(s.applied())
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	s.beginBlock(1)
+	params := types.NewParams([]types.Step{
+		{StartYear: 1, CommunityTax: math.LegacyNewDecWithPrec(70, 2)},
+		{StartYear: 3, CommunityTax: math.LegacyNewDecWithPrec(40, 2)},
+	})
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress([]byte("user________________")).String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := types.NewParams([]types.Step{{StartYear: 2, CommunityTax: math.LegacyNewDecWithPrec(70, 2)}})
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	// the step of the current year applies right away
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(70, 2), s.communityTax())
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestQueryCommunityTax() {
+	s.setSchedule()
+	s.beginBlock(blocksPerYear + 5)
+
+	res, err := s.queryServer.CommunityTax(s.ctx, &types.QueryCommunityTaxRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Year)
+	s.Require().Equal(math.LegacyNewDecWithPrec(92, 2), res.CommunityTax)
+	s.Require().Equal(math.LegacyNewDecWithPrec(92, 2), res.ScheduledCommunityTax)
+	s.Require().NotNil(res.NextStep)
+	s.Require().Equal(uint64(3), res.NextStep.StartYear)
+	s.Require().Equal(int64(2*blocksPerYear), res.NextStepHeight)
+
+	s.beginBlock(10 * blocksPerYear)
+	res, err = s.queryServer.CommunityTax(s.ctx, &types.QueryCommunityTaxRequest{})
+	s.Require().NoError(err)
+	s.Require().Nil(res.NextStep)
+	s.Require().Zero(res.NextStepHeight)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.setSchedule()
+	s.beginBlock(2 * blocksPerYear)
+
+	gs, err := s.app.CommunityTaxKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal(uint64(3), gs.AppliedYear)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.CommunityTaxKeeper.InitGenesis(s.ctx, gs))
+	exported, err := s.app.CommunityTaxKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
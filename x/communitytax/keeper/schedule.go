@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+)
+
+// CurrentYear returns the year, counted from 1, of the current block as the
+// inflation function counts it.
+func (k Keeper) CurrentYear(ctx context.Context) (uint64, error) {
+	mintParams, err := k.mintParams.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(consensus.Year(sdk.UnwrapSDKContext(ctx).BlockHeight(), mintParams.BlocksPerYear)), nil
+}
+
+// YearStartHeight returns the height year starts at.
+func (k Keeper) YearStartHeight(ctx context.Context, year uint64) (int64, error) {
+	mintParams, err := k.mintParams.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(year-1) * int64(mintParams.BlocksPerYear), nil
+}
+
+// BeginBlocker applies the step of the schedule at the first block of every
+// year. The community tax of the distribution params is only replaced while it
+// is the tax of the step applied last: once governance sets another one
+// through the distribution params, it holds until the schedule is updated. The
+// first block takes the community tax it finds as applied.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	year, err := k.CurrentYear(ctx)
+	if err != nil {
+		return err
+	}
+	applied, err := k.AppliedYear.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if applied == year {
+		return nil
+	}
+	if applied == 0 {
+		return k.AppliedYear.Set(ctx, year)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	distrParams, err := k.distrParams.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if appliedTax := params.StepAt(applied).CommunityTax; !distrParams.CommunityTax.Equal(appliedTax) {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Info("community tax changed through governance, schedule not applied",
+			"year", year, "community_tax", distrParams.CommunityTax.String(), "scheduled_community_tax", params.StepAt(year).CommunityTax.String())
+		return k.AppliedYear.Set(ctx, year)
+	}
+	return k.ApplySchedule(ctx, year)
+}
+
+// ApplySchedule sets the distribution community tax to the step of the
+// schedule that holds in year, whatever the current community tax.
+func (k Keeper) ApplySchedule(ctx context.Context, year uint64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	distrParams, err := k.distrParams.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	tax := params.StepAt(year).CommunityTax
+	if !distrParams.CommunityTax.Equal(tax) {
+		distrParams.CommunityTax = tax
+		if err := k.distrParams.Set(ctx, distrParams); err != nil {
+			return err
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		k.Logger(sdkCtx).Info("applied community tax schedule", "year", year, "community_tax", tax.String())
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeApplyCommunityTax,
+				sdk.NewAttribute(types.AttributeKeyYear, strconv.FormatUint(year, 10)),
+				sdk.NewAttribute(types.AttributeKeyCommunityTax, tax.String()),
+			),
+		)
+	}
+	return k.AppliedYear.Set(ctx, year)
+}
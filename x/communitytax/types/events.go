@@ -0,0 +1,9 @@
+package types
+
+// communitytax module event types
+const (
+	EventTypeApplyCommunityTax = "apply_community_tax"
+
+	AttributeKeyYear         = "year"
+	AttributeKeyCommunityTax = "community_tax"
+)
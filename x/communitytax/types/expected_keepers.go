@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// DistributionParams defines the expected store of the distribution params
+// the community tax is set in, satisfied by the Params collection of the
+// distribution keeper.
+type DistributionParams interface {
+	Get(ctx context.Context) (distrtypes.Params, error)
+	Set(ctx context.Context, params distrtypes.Params) error
+}
+
+// MintParams defines the expected store of the mint params, satisfied by the
+// Params collection of the mint keeper. Years are counted with its blocks per
+// year, the same way the inflation function counts them.
+type MintParams interface {
+	Get(ctx context.Context) (minttypes.Params, error)
+}
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/communitytax/types"
+)
+
+func step(year uint64, tax int64) types.Step {
+	return types.Step{StartYear: year, CommunityTax: math.LegacyNewDecWithPrec(tax, 2)}
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr string
+	}{
+		{"default", types.DefaultParams(), ""},
+		{"single step", types.NewParams([]types.Step{step(1, 50)}), ""},
+		{"empty", types.NewParams(nil), "must not be empty"},
+		{"late start", types.NewParams([]types.Step{step(3, 50)}), "must start in year 1"},
+		{"not ascending", types.NewParams([]types.Step{step(1, 90), step(5, 80), step(3, 70)}), "ascending"},
+		{"duplicate year", types.NewParams([]types.Step{step(1, 90), step(3, 80), step(3, 70)}), "ascending"},
+		{"not a halving year", types.NewParams([]types.Step{step(1, 90), step(4, 80)}), "halving year"},
+		{"tax above one", types.NewParams([]types.Step{step(1, 101)}), "between 0 and 1"},
+		{"negative tax", types.NewParams([]types.Step{step(1, -1)}), "between 0 and 1"},
+		{"nil tax", types.NewParams([]types.Step{{StartYear: 1}}), "between 0 and 1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Params: tc.params}.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
+
+func TestParamsSteps(t *testing.T) {
+	params := types.NewParams([]types.Step{step(1, 90), step(3, 80), step(7, 60)})
+
+	require.Equal(t, step(1, 90), params.StepAt(1))
+	require.Equal(t, step(1, 90), params.StepAt(2))
+	require.Equal(t, step(3, 80), params.StepAt(5))
+	require.Equal(t, step(7, 60), params.StepAt(20))
+
+	next, ok := params.NextStep(3)
+	require.True(t, ok)
+	require.Equal(t, step(7, 60), next)
+
+	_, ok = params.NextStep(7)
+	require.False(t, ok)
+}
@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "communitytax"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey      = collections.NewPrefix(0)
+	AppliedYearKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+// DefaultSchedule keeps the community tax of the consensus policy. Further
+// steps are set through governance.
+var DefaultSchedule = []Step{
+	{StartYear: 1, CommunityTax: math.LegacyNewDecWithPrec(consensus.CommunityTax, 2)},
+}
+
+// NewParams creates a new Params instance.
+func NewParams(schedule []Step) Params {
+	return Params{Schedule: schedule}
+}
+
+// DefaultParams returns the default communitytax parameters.
+func DefaultParams() Params {
+	schedule := make([]Step, len(DefaultSchedule))
+	copy(schedule, DefaultSchedule)
+	return NewParams(schedule)
+}
+
+// Validate performs basic validation of the communitytax parameters.
+func (p Params) Validate() error {
+	if len(p.Schedule) == 0 {
+		return fmt.Errorf("schedule must not be empty")
+	}
+	if p.Schedule[0].StartYear != 1 {
+		return fmt.Errorf("schedule must start in year 1, not %d", p.Schedule[0].StartYear)
+	}
+	for i, step := range p.Schedule {
+		if i > 0 && step.StartYear <= p.Schedule[i-1].StartYear {
+			return fmt.Errorf("start years must be ascending: %d after %d", step.StartYear, p.Schedule[i-1].StartYear)
+		}
+		if step.StartYear != 1 && !consensus.IsHalvingYear(int64(step.StartYear)) {
+			return fmt.Errorf("step must start in year 1 or a halving year, not %d", step.StartYear)
+		}
+		if step.CommunityTax.IsNil() || step.CommunityTax.IsNegative() || step.CommunityTax.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("community tax of year %d must be between 0 and 1: %s", step.StartYear, step.CommunityTax)
+		}
+	}
+	return nil
+}
+
+// StepAt returns the step that holds in year, the last one starting no later
+// than year.
+func (p Params) StepAt(year uint64) Step {
+	var current Step
+	for _, step := range p.Schedule {
+		if step.StartYear > year {
+			break
+		}
+		current = step
+	}
+	return current
+}
+
+// NextStep returns the first step starting after year, false if there is
+// none.
+func (p Params) NextStep(year uint64) (Step, bool) {
+	for _, step := range p.Schedule {
+		if step.StartYear > year {
+			return step, true
+		}
+	}
+	return Step{}, false
+}
@@ -0,0 +1,1060 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/communitytax/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687151e145e5c58b, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687151e145e5c58b, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryCommunityTaxRequest struct {
+}
+
+func (m *QueryCommunityTaxRequest) Reset()         { *m = QueryCommunityTaxRequest{} }
+func (m *QueryCommunityTaxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityTaxRequest) ProtoMessage()    {}
+func (*QueryCommunityTaxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687151e145e5c58b, []int{2}
+}
+func (m *QueryCommunityTaxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityTaxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityTaxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityTaxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityTaxRequest.Merge(m, src)
+}
+func (m *QueryCommunityTaxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityTaxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityTaxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityTaxRequest proto.InternalMessageInfo
+
+type QueryCommunityTaxResponse struct {
+	// year is the current year, counted from 1.
+	Year uint64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// community_tax is the community tax the distribution module applies.
+	CommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax"`
+	// scheduled_community_tax is the community tax the schedule sets for the
+	// current year.
+	ScheduledCommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=scheduled_community_tax,json=scheduledCommunityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"scheduled_community_tax"`
+	// next_step is the next step of the schedule, unset after the last step.
+	NextStep *Step `protobuf:"bytes,4,opt,name=next_step,json=nextStep,proto3" json:"next_step,omitempty"`
+	// next_step_height is the height the next step starts at.
+	NextStepHeight int64 `protobuf:"varint,5,opt,name=next_step_height,json=nextStepHeight,proto3" json:"next_step_height,omitempty"`
+}
+
+func (m *QueryCommunityTaxResponse) Reset()         { *m = QueryCommunityTaxResponse{} }
+func (m *QueryCommunityTaxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityTaxResponse) ProtoMessage()    {}
+func (*QueryCommunityTaxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687151e145e5c58b, []int{3}
+}
+func (m *QueryCommunityTaxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityTaxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityTaxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityTaxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityTaxResponse.Merge(m, src)
+}
+func (m *QueryCommunityTaxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityTaxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityTaxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityTaxResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityTaxResponse) GetYear() uint64 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *QueryCommunityTaxResponse) GetNextStep() *Step {
+	if m != nil {
+		return m.NextStep
+	}
+	return nil
+}
+
+func (m *QueryCommunityTaxResponse) GetNextStepHeight() int64 {
+	if m != nil {
+		return m.NextStepHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "hippo.communitytax.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hippo.communitytax.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCommunityTaxRequest)(nil), "hippo.communitytax.v1.QueryCommunityTaxRequest")
+	proto.RegisterType((*QueryCommunityTaxResponse)(nil), "hippo.communitytax.v1.QueryCommunityTaxResponse")
+}
+
+func init() { proto.RegisterFile("hippo/communitytax/v1/query.proto", fileDescriptor_687151e145e5c58b) }
+
+var fileDescriptor_687151e145e5c58b = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xae, 0xab, 0xa8, 0x19, 0x08, 0xcc, 0x26, 0xba, 0x8c, 0xa5, 0xa5, 0x02, 0x14,
+	0x26, 0x1a, 0xb3, 0x21, 0x21, 0x0e, 0x1c, 0x50, 0xd9, 0x81, 0x03, 0x07, 0x08, 0x08, 0x24, 0x38,
+	0x44, 0x5e, 0x6a, 0x25, 0x11, 0x4d, 0x9c, 0xc5, 0xce, 0x94, 0x5c, 0x39, 0x70, 0x46, 0xe2, 0x03,
+	0x70, 0xe5, 0x88, 0x10, 0x1f, 0x62, 0xc7, 0x09, 0x2e, 0x88, 0xc3, 0x34, 0xb5, 0x48, 0x7c, 0x0d,
+	0x14, 0x3b, 0xad, 0x1a, 0x91, 0xc1, 0xa4, 0x5d, 0x22, 0xfb, 0xf9, 0xff, 0xfc, 0x7b, 0xf9, 0xbf,
+	0x67, 0x78, 0xd5, 0xf3, 0xa3, 0x88, 0x61, 0x87, 0x05, 0x41, 0x12, 0xfa, 0x22, 0x13, 0x24, 0xc5,
+	0x7b, 0x9b, 0x78, 0x37, 0xa1, 0x71, 0x66, 0x46, 0x31, 0x13, 0x0c, 0xad, 0x48, 0x89, 0x39, 0x2f,
+	0x31, 0xf7, 0x36, 0xb5, 0x8b, 0x24, 0xf0, 0x43, 0x86, 0xe5, 0x57, 0x29, 0xb5, 0x55, 0x87, 0xf1,
+	0x80, 0x71, 0x5b, 0xee, 0xb0, 0xda, 0x14, 0x47, 0xcb, 0x2e, 0x73, 0x99, 0x8a, 0xe7, 0xab, 0x22,
+	0x7a, 0xc5, 0x65, 0xcc, 0x1d, 0x51, 0x4c, 0x22, 0x1f, 0x93, 0x30, 0x64, 0x82, 0x08, 0x9f, 0x85,
+	0xd3, 0x1c, 0xa3, 0xba, 0xb6, 0x52, 0x21, 0x52, 0xd9, 0x5b, 0x86, 0xe8, 0x69, 0x5e, 0xf1, 0x13,
+	0x12, 0x93, 0x80, 0x5b, 0x74, 0x37, 0xa1, 0x5c, 0xf4, 0x5e, 0xc2, 0x4b, 0xa5, 0x28, 0x8f, 0x58,
+	0xc8, 0x29, 0x7a, 0x00, 0x9b, 0x91, 0x8c, 0xb4, 0x41, 0x17, 0x18, 0x67, 0xb7, 0xd6, 0xcd, 0xca,
+	0x1f, 0x34, 0x55, 0xda, 0xa0, 0xb5, 0x7f, 0xd8, 0xa9, 0x7d, 0xfa, 0xfd, 0x79, 0x03, 0x58, 0x45,
+	0x5e, 0x4f, 0x83, 0x6d, 0x79, 0xf1, 0xc3, 0x69, 0xc6, 0x73, 0x92, 0x4e, 0xa1, 0x47, 0x75, 0xb8,
+	0x5a, 0x71, 0x58, 0xb0, 0x11, 0x6c, 0x64, 0x94, 0xc4, 0x92, 0xdc, 0xb0, 0xe4, 0x1a, 0xbd, 0x86,
+	0xe7, 0x66, 0x68, 0x5b, 0x90, 0xb4, 0x5d, 0xef, 0x02, 0xa3, 0x35, 0xb8, 0x9b, 0x73, 0x7f, 0x1e,
+	0x76, 0xd6, 0x94, 0x8f, 0x7c, 0xf8, 0xc6, 0xf4, 0x19, 0x0e, 0x88, 0xf0, 0xcc, 0xc7, 0xd4, 0x25,
+	0x4e, 0xb6, 0x4d, 0x9d, 0x6f, 0x5f, 0xfb, 0xb0, 0xb0, 0x79, 0x9b, 0x3a, 0xaa, 0xc8, 0x25, 0x67,
+	0x0e, 0x8c, 0x42, 0x78, 0x99, 0x3b, 0x1e, 0x1d, 0x26, 0x23, 0x3a, 0xb4, 0xcb, 0x98, 0x85, 0x53,
+	0x61, 0x56, 0x66, 0xd7, 0xce, 0xff, 0x28, 0xba, 0x07, 0x5b, 0x21, 0x4d, 0x85, 0xcd, 0x05, 0x8d,
+	0xda, 0x0d, 0xe9, 0xef, 0xda, 0x31, 0xfe, 0x3e, 0x13, 0x34, 0xb2, 0xce, 0xe4, 0xea, 0x7c, 0x85,
+	0x0c, 0x78, 0x61, 0x96, 0x69, 0x7b, 0xd4, 0x77, 0x3d, 0xd1, 0x5e, 0xec, 0x02, 0x63, 0xc1, 0x3a,
+	0x3f, 0xd5, 0x3c, 0x92, 0xd1, 0xad, 0x2f, 0x75, 0xb8, 0x28, 0x2d, 0x46, 0xef, 0x00, 0x6c, 0xaa,
+	0x36, 0xa1, 0x9b, 0xc7, 0x50, 0xfe, 0x9e, 0x0b, 0x6d, 0xe3, 0x24, 0x52, 0xd5, 0xb0, 0xde, 0xf5,
+	0xb7, 0xdf, 0x7f, 0x7d, 0xa8, 0x77, 0xd0, 0x3a, 0xae, 0x1e, 0x46, 0x35, 0x11, 0xe8, 0x23, 0x80,
+	0x4b, 0x25, 0x1f, 0xf0, 0xbf, 0x18, 0x15, 0x73, 0xa3, 0xdd, 0x3e, 0x79, 0x42, 0x51, 0xda, 0x2d,
+	0x59, 0xda, 0x0d, 0x74, 0x0d, 0xff, 0xe7, 0x9d, 0xe4, 0xdd, 0x1e, 0xbc, 0xd8, 0x1f, 0xeb, 0xe0,
+	0x60, 0xac, 0x83, 0xa3, 0xb1, 0x0e, 0xde, 0x4f, 0xf4, 0xda, 0xc1, 0x44, 0xaf, 0xfd, 0x98, 0xe8,
+	0xb5, 0x57, 0xf7, 0x5d, 0x5f, 0x78, 0xc9, 0x4e, 0x4e, 0x56, 0x37, 0x39, 0x31, 0x11, 0xfd, 0x21,
+	0x61, 0x6a, 0xd7, 0x97, 0x4f, 0xcc, 0x61, 0x23, 0x9c, 0x96, 0x11, 0x22, 0x8b, 0x28, 0xdf, 0x69,
+	0xca, 0xe3, 0x3b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0x3d, 0xd7, 0x71, 0x49, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the community tax schedule.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CommunityTax returns the community tax of the current year and the next
+	// step of the schedule.
+	CommunityTax(ctx context.Context, in *QueryCommunityTaxRequest, opts ...grpc.CallOption) (*QueryCommunityTaxResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hippo.communitytax.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityTax(ctx context.Context, in *QueryCommunityTaxRequest, opts ...grpc.CallOption) (*QueryCommunityTaxResponse, error) {
+	out := new(QueryCommunityTaxResponse)
+	err := c.cc.Invoke(ctx, "/hippo.communitytax.v1.Query/CommunityTax", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the community tax schedule.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CommunityTax returns the community tax of the current year and the next
+	// step of the schedule.
+	CommunityTax(context.Context, *QueryCommunityTaxRequest) (*QueryCommunityTaxResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CommunityTax(ctx context.Context, req *QueryCommunityTaxRequest) (*QueryCommunityTaxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityTax not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.communitytax.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityTax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityTaxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityTax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.communitytax.v1.Query/CommunityTax",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityTax(ctx, req.(*QueryCommunityTaxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hippo.communitytax.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CommunityTax",
+			Handler:    _Query_CommunityTax_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hippo/communitytax/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityTaxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityTaxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityTaxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityTaxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityTaxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextStepHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextStepHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextStep != nil {
+		{
+			size, err := m.NextStep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ScheduledCommunityTax.Size()
+		i -= size
+		if _, err := m.ScheduledCommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommunityTaxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommunityTaxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovQuery(uint64(m.Year))
+	}
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ScheduledCommunityTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextStep != nil {
+		l = m.NextStep.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextStepHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextStepHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityTaxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityTaxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityTaxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityTaxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityTaxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityTaxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledCommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextStep == nil {
+				m.NextStep = &Step{}
+			}
+			if err := m.NextStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStepHeight", wireType)
+			}
+			m.NextStepHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStepHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,105 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+// DeductFeeDecorator pays the fee of txs that only call a sponsoring
+// contract out of the contract's fee balance. Every other tx, and sponsored
+// txs the contract cannot cover, are handed to the wrapped decorator, which
+// charges the fee payer as usual.
+type DeductFeeDecorator struct {
+	keeper   keeper.Keeper
+	fallback sdk.AnteDecorator
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator wrapping the default
+// fee deduction.
+func NewDeductFeeDecorator(k keeper.Keeper, fallback sdk.AnteDecorator) DeductFeeDecorator {
+	return DeductFeeDecorator{keeper: k, fallback: fallback}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	contract, sponsored, err := d.keeper.SponsoredContract(ctx, feeTx)
+	if err != nil {
+		return ctx, err
+	}
+	if !sponsored {
+		return d.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+	priority, err := checkTxFee(ctx, feeTx)
+	if err != nil {
+		return ctx, err
+	}
+
+	user := sdk.AccAddress(feeTx.FeePayer())
+	if err := d.keeper.DeductSponsoredFee(ctx, contract, user, feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+		),
+	)
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// checkTxFee mirrors the default fee checker of the auth module: in CheckTx
+// the fee must meet the validator's minimum gas prices, and the priority is
+// the lowest gas price paid.
+func checkTxFee(ctx sdk.Context, tx sdk.FeeTx) (int64, error) {
+	fee := tx.GetFee()
+	gas := tx.GetGas()
+
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+			}
+			if !fee.IsAnyGTE(requiredFees) {
+				return 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, requiredFees)
+			}
+		}
+	}
+
+	var priority int64
+	if gas == 0 {
+		return priority, nil
+	}
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		if gasPrice := c.Amount.QuoRaw(int64(gas)); gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+	return priority, nil
+}
@@ -0,0 +1,102 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/ante"
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+func TestDeductFeeDecorator(t *testing.T) {
+	consensus.SetWalletConfig()
+	hippo := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+	ctx := hippo.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, hippo.ContractSponsorKeeper.InitGenesis(ctx, types.DefaultGenesisState()))
+	require.NoError(t, hippo.WasmKeeper.SetParams(ctx, wasmtypes.DefaultParams()))
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(amount)))
+	}
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	user := sdk.AccAddress([]byte("user________________"))
+	require.NoError(t, hippo.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins(1000)))
+	require.NoError(t, hippo.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, admin, coins(1000)))
+
+	permissioned := wasmkeeper.NewDefaultPermissionKeeper(hippo.WasmKeeper)
+	codeID, _, err := permissioned.Create(ctx, admin, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	contract, _, err := permissioned.Instantiate(ctx, codeID, admin, admin, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(hippo.ContractSponsorKeeper)
+	_, err = msgServer.RegisterContract(ctx, &types.MsgRegisterContract{
+		Sender:       admin.String(),
+		Contract:     contract.String(),
+		MaxFeePerTx:  coins(100),
+		DefaultQuota: 1,
+		QuotaWindow:  time.Hour,
+	})
+	require.NoError(t, err)
+	_, err = msgServer.DepositFees(ctx, &types.MsgDepositFees{Depositor: admin.String(), Contract: contract.String(), Amount: coins(500)})
+	require.NoError(t, err)
+
+	buildTx := func(fee sdk.Coins) sdk.Tx {
+		builder := hippo.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&wasmtypes.MsgExecuteContract{
+			Sender:   user.String(),
+			Contract: contract.String(),
+			Msg:      []byte(`{"reflect_msg":{"msgs":[]}}`),
+		}))
+		builder.SetFeeAmount(fee)
+		builder.SetGasLimit(100)
+		return builder.GetTx()
+	}
+	nextCalled := false
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+	decorator := ante.NewDeductFeeDecorator(
+		hippo.ContractSponsorKeeper,
+		sdkante.NewDeductFeeDecorator(hippo.AccountKeeper, hippo.BankKeeper, hippo.FeeGrantKeeper, nil),
+	)
+
+	// the minimum gas price still applies to sponsored txs in CheckTx
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(consensus.DefaultHippoDenom, math.NewInt(1))))
+	_, err = decorator.AnteHandle(checkCtx, buildTx(coins(10)), false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.False(t, nextCalled)
+
+	collector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	newCtx, err := decorator.AnteHandle(ctx, buildTx(coins(100)), false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+	require.Equal(t, int64(1), newCtx.Priority())
+	require.Equal(t, coins(100), hippo.BankKeeper.GetAllBalances(ctx, collector))
+	require.True(t, hippo.BankKeeper.GetAllBalances(ctx, user).IsZero())
+
+	// once the quota is used up the user pays, and has no funds to do so
+	nextCalled = false
+	_, err = decorator.AnteHandle(ctx, buildTx(coins(100)), false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.False(t, nextCalled)
+}
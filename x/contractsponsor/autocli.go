@@ -0,0 +1,102 @@
+package contractsponsor
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.contractsponsor.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the contractsponsor module parameters",
+				},
+				{
+					RpcMethod:      "Sponsorship",
+					Use:            "sponsorship [contract]",
+					Short:          "Query the fee sponsorship of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract"}},
+				},
+				{
+					RpcMethod: "Sponsorships",
+					Use:       "sponsorships",
+					Short:     "Query all contract fee sponsorships",
+				},
+				{
+					RpcMethod: "UserQuota",
+					Use:       "user-quota [contract] [user]",
+					Short:     "Query the sponsored tx quota of a user for a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "user"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.contractsponsor.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RegisterContract",
+					Use:       "register-contract [contract] [max-fee-per-tx] [default-quota] [quota-window]",
+					Short:     "Opt a contract in to paying the fees of the txs calling it, as its admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "max_fee_per_tx"},
+						{ProtoField: "default_quota"},
+						{ProtoField: "quota_window"},
+					},
+				},
+				{
+					RpcMethod: "UpdateContract",
+					Use:       "update-contract [contract] [max-fee-per-tx] [default-quota] [quota-window] [active]",
+					Short:     "Change the fee sponsorship policy of a contract or pause it, as its admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "max_fee_per_tx"},
+						{ProtoField: "default_quota"},
+						{ProtoField: "quota_window"},
+						{ProtoField: "active"},
+					},
+				},
+				{
+					RpcMethod: "DepositFees",
+					Use:       "deposit-fees [contract] [amount]",
+					Short:     "Add to the fee balance of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "amount", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "WithdrawFees",
+					Use:       "withdraw-fees [contract] [recipient] [amount]",
+					Short:     "Withdraw unspent fee balance of a contract, as its admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "recipient"},
+						{ProtoField: "amount", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SetUserQuota",
+					Use:       "set-user-quota [contract] [user] [quota]",
+					Short:     "Set the sponsored tx quota of a user, as the contract admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "user"},
+						{ProtoField: "quota"},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+// SponsoredContract returns the contract that pays the fee of tx, if any. A
+// tx is sponsored when it carries no fee granter, a positive fee and only
+// MsgExecuteContract calls from the fee payer to a single contract whose
+// active sponsorship covers the fee and whose quota for the fee payer is not
+// used up. The contract must also be under the per block limit of the params
+// and its own per window limit, which fresh addresses do not reset.
+func (k Keeper) SponsoredContract(ctx context.Context, tx sdk.FeeTx) (sdk.AccAddress, bool, error) {
+	fee := tx.GetFee()
+	if fee.IsZero() || len(tx.FeeGranter()) != 0 {
+		return nil, false, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 || len(msgs) > int(params.MaxMsgsPerTx) {
+		return nil, false, nil
+	}
+
+	payer := sdk.AccAddress(tx.FeePayer())
+	var contract sdk.AccAddress
+	for _, msg := range msgs {
+		execute, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok {
+			return nil, false, nil
+		}
+		sender, err := k.accountKeeper.AddressCodec().StringToBytes(execute.Sender)
+		if err != nil || !payer.Equals(sdk.AccAddress(sender)) {
+			return nil, false, nil
+		}
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(execute.Contract)
+		if err != nil || (contract != nil && !contract.Equals(sdk.AccAddress(addr))) {
+			return nil, false, nil
+		}
+		contract = addr
+	}
+
+	sponsorship, err := k.Sponsorships.Get(ctx, contract)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	if !sponsorship.Covers(fee) {
+		return nil, false, nil
+	}
+
+	quota, usage, err := k.UserQuota(ctx, contract, payer, sponsorship)
+	if err != nil {
+		return nil, false, err
+	}
+	if usage.Used >= quota {
+		return nil, false, nil
+	}
+
+	sponsorUsage, err := k.SponsorUsage(ctx, contract, sponsorship)
+	if err != nil {
+		return nil, false, err
+	}
+	if sponsorUsage.BlockTxs >= uint64(params.MaxTxsPerBlock) ||
+		(sponsorship.MaxTxsPerWindow > 0 && sponsorUsage.WindowTxs >= sponsorship.MaxTxsPerWindow) {
+		return nil, false, nil
+	}
+
+	return contract, true, nil
+}
+
+// DeductSponsoredFee pays the fee of user out of the balance of contract into
+// the fee collector and counts it against the user quota and the limits of
+// the contract. The account of
+// user is created if needed so the signature checks that follow can run.
+func (k Keeper) DeductSponsoredFee(ctx context.Context, contract, user sdk.AccAddress, fee sdk.Coins) error {
+	sponsorship, err := k.GetSponsorship(ctx, contract)
+	if err != nil {
+		return err
+	}
+	balance, anyNegative := sponsorship.Balance.SafeSub(fee...)
+	if anyNegative {
+		return types.ErrInsufficientBalance
+	}
+	_, usage, err := k.UserQuota(ctx, contract, user, sponsorship)
+	if err != nil {
+		return err
+	}
+	sponsorUsage, err := k.SponsorUsage(ctx, contract, sponsorship)
+	if err != nil {
+		return err
+	}
+
+	if k.accountKeeper.GetAccount(ctx, user) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, user))
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fee); err != nil {
+		return err
+	}
+
+	sponsorship.Balance = balance
+	sponsorship.TotalSpent = sponsorship.TotalSpent.Add(fee...)
+	sponsorship.TxsSponsored++
+	if err := k.Sponsorships.Set(ctx, contract, sponsorship); err != nil {
+		return err
+	}
+
+	usage.Used++
+	if err := k.UserUsages.Set(ctx, collections.Join(contract, user), usage); err != nil {
+		return err
+	}
+
+	sponsorUsage.WindowTxs++
+	sponsorUsage.BlockTxs++
+	if err := k.SponsorUsages.Set(ctx, contract, sponsorUsage); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsorFee,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+			sdk.NewAttribute(types.AttributeKeyUser, usage.User),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+// InitGenesis initializes the contractsponsor module state from a genesis
+// state. The deposited balances are held by the module account in the bank
+// genesis.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	ac := k.accountKeeper.AddressCodec()
+	for _, sponsorship := range gs.Sponsorships {
+		contract, err := ac.StringToBytes(sponsorship.Contract)
+		if err != nil {
+			return err
+		}
+		if err := k.Sponsorships.Set(ctx, contract, sponsorship); err != nil {
+			return err
+		}
+	}
+
+	for _, quota := range gs.UserQuotas {
+		key, err := k.contractUserKey(quota.Contract, quota.User)
+		if err != nil {
+			return err
+		}
+		if err := k.UserQuotas.Set(ctx, key, quota.Quota); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range gs.UserUsages {
+		key, err := k.contractUserKey(usage.Contract, usage.User)
+		if err != nil {
+			return err
+		}
+		if err := k.UserUsages.Set(ctx, key, usage); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range gs.SponsorUsages {
+		contract, err := ac.StringToBytes(usage.Contract)
+		if err != nil {
+			return err
+		}
+		if err := k.SponsorUsages.Set(ctx, contract, usage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the contractsponsor module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+
+	if err := k.Sponsorships.Walk(ctx, nil, func(_ sdk.AccAddress, sponsorship types.Sponsorship) (bool, error) {
+		gs.Sponsorships = append(gs.Sponsorships, sponsorship)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.UserQuotas.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], quota uint64) (bool, error) {
+		gs.UserQuotas = append(gs.UserQuotas, types.UserQuota{
+			Contract: key.K1().String(),
+			User:     key.K2().String(),
+			Quota:    quota,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.UserUsages.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage types.UserUsage) (bool, error) {
+		gs.UserUsages = append(gs.UserUsages, usage)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.SponsorUsages.Walk(ctx, nil, func(_ sdk.AccAddress, usage types.SponsorUsage) (bool, error) {
+		gs.SponsorUsages = append(gs.SponsorUsages, usage)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
+
+func (k Keeper) contractUserKey(contract, user string) (collections.Pair[sdk.AccAddress, sdk.AccAddress], error) {
+	contractAddr, err := k.accountKeeper.AddressCodec().StringToBytes(contract)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, sdk.AccAddress]{}, err
+	}
+	userAddr, err := k.accountKeeper.AddressCodec().StringToBytes(user)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, sdk.AccAddress]{}, err
+	}
+	return collections.Join(sdk.AccAddress(contractAddr), sdk.AccAddress(userAddr)), nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the contractsponsor
+// QueryServer interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Sponsorship implements types.QueryServer.
+func (k queryServer) Sponsorship(ctx context.Context, req *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contract, err := k.accountKeeper.AddressCodec().StringToBytes(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sponsorship, err := k.GetSponsorship(ctx, contract)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QuerySponsorshipResponse{Sponsorship: sponsorship}, nil
+}
+
+// Sponsorships implements types.QueryServer.
+func (k queryServer) Sponsorships(ctx context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sponsorships, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Sponsorships, req.Pagination,
+		func(_ sdk.AccAddress, sponsorship types.Sponsorship) (types.Sponsorship, error) {
+			return sponsorship, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsorshipsResponse{Sponsorships: sponsorships, Pagination: pageRes}, nil
+}
+
+// UserQuota implements types.QueryServer.
+func (k queryServer) UserQuota(ctx context.Context, req *types.QueryUserQuotaRequest) (*types.QueryUserQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contract, err := k.accountKeeper.AddressCodec().StringToBytes(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err := k.accountKeeper.AddressCodec().StringToBytes(req.User)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, err := k.QueryUserQuota(ctx, contract, user)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+// Keeper manages the fee balances contracts deposit to pay for the txs that
+// call them.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	wasmKeeper    types.WasmKeeper
+
+	// the address capable of executing MsgUpdateParams, typically the x/gov
+	// module account.
+	authority string
+
+	Schema       collections.Schema
+	Params       collections.Item[types.Params]
+	Sponsorships collections.Map[sdk.AccAddress, types.Sponsorship]
+	// UserQuotas and UserUsages are keyed by (contract, user).
+	UserQuotas collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], uint64]
+	UserUsages collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.UserUsage]
+	// SponsorUsages is keyed by contract.
+	SponsorUsages collections.Map[sdk.AccAddress, types.SponsorUsage]
+}
+
+// NewKeeper creates a new contractsponsor Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	wasmKeeper types.WasmKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid contractsponsor authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		wasmKeeper:    wasmKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Sponsorships:  collections.NewMap(sb, types.SponsorshipsKey, "sponsorships", sdk.AccAddressKey, codec.CollValue[types.Sponsorship](cdc)),
+		UserQuotas: collections.NewMap(sb, types.UserQuotasKey, "user_quotas",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey), collections.Uint64Value),
+		UserUsages: collections.NewMap(sb, types.UserUsagesKey, "user_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey), codec.CollValue[types.UserUsage](cdc)),
+		SponsorUsages: collections.NewMap(sb, types.SponsorUsageKey, "sponsor_usages", sdk.AccAddressKey, codec.CollValue[types.SponsorUsage](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
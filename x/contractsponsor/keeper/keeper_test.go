@@ -0,0 +1,423 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	admin    sdk.AccAddress
+	user     sdk.AccAddress
+	contract sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.ContractSponsorKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+	s.Require().NoError(s.app.WasmKeeper.SetParams(s.ctx, wasmtypes.DefaultParams()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.ContractSponsorKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.ContractSponsorKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.admin = sdk.AccAddress([]byte("admin_______________"))
+	s.user = sdk.AccAddress([]byte("user________________"))
+	s.contract = s.instantiate(s.admin)
+
+	s.fund(s.admin, coins(1000))
+	s.register(s.contract, s.admin)
+	_, err := s.msgServer.DepositFees(s.ctx, &types.MsgDepositFees{
+		Depositor: s.admin.String(),
+		Contract:  s.contract.String(),
+		Amount:    coins(500),
+	})
+	s.Require().NoError(err)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(amount)))
+}
+
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, amount sdk.Coins) {
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, amount))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, addr, amount))
+}
+
+func (s *KeeperTestSuite) instantiate(admin sdk.AccAddress) sdk.AccAddress {
+	permissioned := wasmkeeper.NewDefaultPermissionKeeper(s.app.WasmKeeper)
+	codeID, _, err := permissioned.Create(s.ctx, s.admin, testdata.ReflectContractWasm(), nil)
+	s.Require().NoError(err)
+	contract, _, err := permissioned.Instantiate(s.ctx, codeID, s.admin, admin, []byte("{}"), "reflect", nil)
+	s.Require().NoError(err)
+	return contract
+}
+
+func (s *KeeperTestSuite) register(contract, sender sdk.AccAddress) {
+	_, err := s.msgServer.RegisterContract(s.ctx, &types.MsgRegisterContract{
+		Sender:       sender.String(),
+		Contract:     contract.String(),
+		MaxFeePerTx:  coins(100),
+		DefaultQuota: 2,
+		QuotaWindow:  24 * time.Hour,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) buildTx(fee sdk.Coins, msgs ...sdk.Msg) sdk.FeeTx {
+	builder := s.app.TxConfig().NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(200_000)
+	return builder.GetTx()
+}
+
+func (s *KeeperTestSuite) execute(contract sdk.AccAddress) *wasmtypes.MsgExecuteContract {
+	return &wasmtypes.MsgExecuteContract{
+		Sender:   s.user.String(),
+		Contract: contract.String(),
+		Msg:      []byte(`{"reflect_msg":{"msgs":[]}}`),
+	}
+}
+
+func (s *KeeperTestSuite) TestRegisterContract() {
+	sponsorship, err := s.app.ContractSponsorKeeper.GetSponsorship(s.ctx, s.contract)
+	s.Require().NoError(err)
+	s.Require().True(sponsorship.Active)
+	s.Require().Equal(coins(500), sponsorship.Balance)
+	s.Require().Equal(coins(500), s.app.BankKeeper.GetAllBalances(s.ctx, authtypes.NewModuleAddress(types.ModuleName)))
+
+	_, err = s.msgServer.RegisterContract(s.ctx, &types.MsgRegisterContract{
+		Sender:      s.admin.String(),
+		Contract:    s.contract.String(),
+		MaxFeePerTx: coins(100),
+		QuotaWindow: time.Hour,
+	})
+	s.Require().ErrorIs(err, types.ErrSponsorshipExists)
+
+	// only the contract or its admin controls the sponsorship
+	other := s.instantiate(nil)
+	_, err = s.msgServer.RegisterContract(s.ctx, &types.MsgRegisterContract{
+		Sender:      s.admin.String(),
+		Contract:    other.String(),
+		MaxFeePerTx: coins(100),
+		QuotaWindow: time.Hour,
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	s.register(other, other)
+
+	_, err = s.msgServer.RegisterContract(s.ctx, &types.MsgRegisterContract{
+		Sender:      s.user.String(),
+		Contract:    s.user.String(),
+		MaxFeePerTx: coins(100),
+		QuotaWindow: time.Hour,
+	})
+	s.Require().ErrorIs(err, types.ErrContractNotFound)
+
+	// the quota window is bounded by the params
+	_, err = s.msgServer.UpdateContract(s.ctx, &types.MsgUpdateContract{
+		Sender:      s.admin.String(),
+		Contract:    s.contract.String(),
+		MaxFeePerTx: coins(100),
+		QuotaWindow: types.DefaultMaxQuotaWindow + time.Second,
+		Active:      true,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSponsorship)
+}
+
+func (s *KeeperTestSuite) TestWithdrawFees() {
+	_, err := s.msgServer.WithdrawFees(s.ctx, &types.MsgWithdrawFees{
+		Sender:    s.user.String(),
+		Contract:  s.contract.String(),
+		Recipient: s.user.String(),
+		Amount:    coins(100),
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.WithdrawFees(s.ctx, &types.MsgWithdrawFees{
+		Sender:    s.admin.String(),
+		Contract:  s.contract.String(),
+		Recipient: s.admin.String(),
+		Amount:    coins(501),
+	})
+	s.Require().ErrorIs(err, types.ErrInsufficientBalance)
+
+	_, err = s.msgServer.WithdrawFees(s.ctx, &types.MsgWithdrawFees{
+		Sender:    s.admin.String(),
+		Contract:  s.contract.String(),
+		Recipient: s.admin.String(),
+		Amount:    coins(200),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(coins(700), s.app.BankKeeper.GetAllBalances(s.ctx, s.admin))
+
+	sponsorship, err := s.app.ContractSponsorKeeper.GetSponsorship(s.ctx, s.contract)
+	s.Require().NoError(err)
+	s.Require().Equal(coins(300), sponsorship.Balance)
+}
+
+func (s *KeeperTestSuite) TestSponsoredContract() {
+	other := s.instantiate(s.admin)
+	s.register(other, s.admin)
+
+	testCases := []struct {
+		name      string
+		tx        func() sdk.FeeTx
+		sponsored bool
+	}{
+		{"single contract call", func() sdk.FeeTx { return s.buildTx(coins(10), s.execute(s.contract)) }, true},
+		{"batched calls", func() sdk.FeeTx { return s.buildTx(coins(10), s.execute(s.contract), s.execute(s.contract)) }, true},
+		{"zero fee", func() sdk.FeeTx { return s.buildTx(nil, s.execute(s.contract)) }, false},
+		{"fee above cap", func() sdk.FeeTx { return s.buildTx(coins(101), s.execute(s.contract)) }, false},
+		{"other denom", func() sdk.FeeTx {
+			return s.buildTx(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1))), s.execute(s.contract))
+		}, false},
+		{"two contracts", func() sdk.FeeTx { return s.buildTx(coins(10), s.execute(s.contract), s.execute(other)) }, false},
+		{"non contract msg", func() sdk.FeeTx {
+			return s.buildTx(coins(10), s.execute(s.contract), banktypes.NewMsgSend(s.user, s.admin, coins(1)))
+		}, false},
+		{"unregistered contract", func() sdk.FeeTx { return s.buildTx(coins(10), s.execute(s.instantiate(s.admin))) }, false},
+		{"fee granter", func() sdk.FeeTx {
+			builder := s.app.TxConfig().NewTxBuilder()
+			s.Require().NoError(builder.SetMsgs(s.execute(s.contract)))
+			builder.SetFeeAmount(coins(10))
+			builder.SetFeeGranter(s.admin)
+			return builder.GetTx()
+		}, false},
+		{"too many msgs", func() sdk.FeeTx {
+			msgs := make([]sdk.Msg, types.DefaultMaxMsgsPerTx+1)
+			for i := range msgs {
+				msgs[i] = s.execute(s.contract)
+			}
+			return s.buildTx(coins(10), msgs...)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		contract, sponsored, err := s.app.ContractSponsorKeeper.SponsoredContract(s.ctx, tc.tx())
+		s.Require().NoError(err, tc.name)
+		s.Require().Equal(tc.sponsored, sponsored, tc.name)
+		if tc.sponsored {
+			s.Require().Equal(s.contract, contract, tc.name)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestDeductSponsoredFee() {
+	collector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	before := s.app.BankKeeper.GetAllBalances(s.ctx, collector)
+
+	tx := s.buildTx(coins(10), s.execute(s.contract))
+	for i := 0; i < 2; i++ {
+		_, sponsored, err := s.app.ContractSponsorKeeper.SponsoredContract(s.ctx, tx)
+		s.Require().NoError(err)
+		s.Require().True(sponsored)
+		s.Require().NoError(s.app.ContractSponsorKeeper.DeductSponsoredFee(s.ctx, s.contract, s.user, coins(10)))
+	}
+
+	s.Require().Equal(before.Add(coins(20)...), s.app.BankKeeper.GetAllBalances(s.ctx, collector))
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, s.user))
+
+	sponsorship, err := s.app.ContractSponsorKeeper.GetSponsorship(s.ctx, s.contract)
+	s.Require().NoError(err)
+	s.Require().Equal(coins(480), sponsorship.Balance)
+	s.Require().Equal(coins(20), sponsorship.TotalSpent)
+	s.Require().Equal(uint64(2), sponsorship.TxsSponsored)
+
+	// the default quota of two txs per window is used up
+	_, sponsored, err := s.app.ContractSponsorKeeper.SponsoredContract(s.ctx, tx)
+	s.Require().NoError(err)
+	s.Require().False(sponsored)
+
+	res, err := s.queryServer.UserQuota(s.ctx, &types.QueryUserQuotaRequest{Contract: s.contract.String(), User: s.user.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Quota)
+	s.Require().Equal(uint64(2), res.Used)
+	s.Require().Zero(res.Remaining)
+	s.Require().Equal(s.ctx.BlockTime().Add(24*time.Hour), *res.WindowEnd)
+
+	// the window resets
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour))
+	_, sponsored, err = s.app.ContractSponsorKeeper.SponsoredContract(s.ctx, tx)
+	s.Require().NoError(err)
+	s.Require().True(sponsored)
+}
+
+// sponsor sponsors a tx of user for contract if it can, the way the ante
+// handler does.
+func (s *KeeperTestSuite) sponsor(contract, user sdk.AccAddress) bool {
+	msg := s.execute(contract)
+	msg.Sender = user.String()
+	tx := s.buildTx(coins(1), msg)
+	_, sponsored, err := s.app.ContractSponsorKeeper.SponsoredContract(s.ctx, tx)
+	s.Require().NoError(err)
+	if sponsored {
+		s.Require().NoError(s.app.ContractSponsorKeeper.DeductSponsoredFee(s.ctx, contract, user, coins(1)))
+	}
+	return sponsored
+}
+
+func (s *KeeperTestSuite) TestSponsorLimits() {
+	_, err := s.msgServer.UpdateContract(s.ctx, &types.MsgUpdateContract{
+		Sender:          s.admin.String(),
+		Contract:        s.contract.String(),
+		MaxFeePerTx:     coins(100),
+		DefaultQuota:    2,
+		QuotaWindow:     24 * time.Hour,
+		Active:          true,
+		MaxTxsPerWindow: 30,
+	})
+	s.Require().NoError(err)
+	params := types.DefaultParams()
+	params.MaxTxsPerBlock = 10
+	s.Require().NoError(s.app.ContractSponsorKeeper.Params.Set(s.ctx, params))
+
+	// fresh addresses each get the default quota, but the contract sponsors
+	// no more than the per block limit in a block and its own limit in a
+	// window
+	sponsored := func(height int64) int {
+		s.ctx = s.ctx.WithBlockHeight(height)
+		var n int
+		for i := 0; i < 20; i++ {
+			user := sdk.AccAddress(append([]byte("fresh"), byte(height), byte(i)))
+			if s.sponsor(s.contract, user) {
+				n++
+			}
+		}
+		return n
+	}
+	s.Require().Equal(10, sponsored(2))
+	s.Require().Equal(10, sponsored(3))
+	s.Require().Equal(10, sponsored(4))
+	s.Require().Zero(sponsored(5))
+
+	sponsorship, err := s.app.ContractSponsorKeeper.GetSponsorship(s.ctx, s.contract)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(30), sponsorship.TxsSponsored)
+	s.Require().Equal(coins(470), sponsorship.Balance)
+
+	// the window resets
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour))
+	s.Require().Equal(10, sponsored(6))
+}
+
+func (s *KeeperTestSuite) TestSetUserQuota() {
+	// the contract itself may set quotas
+	_, err := s.msgServer.SetUserQuota(s.ctx, &types.MsgSetUserQuota{
+		Sender:   s.contract.String(),
+		Contract: s.contract.String(),
+		User:     s.user.String(),
+		Quota:    0,
+	})
+	s.Require().NoError(err)
+
+	_, sponsored, err := s.app.ContractSponsorKeeper.SponsoredContract(s.ctx, s.buildTx(coins(10), s.execute(s.contract)))
+	s.Require().NoError(err)
+	s.Require().False(sponsored)
+
+	_, err = s.msgServer.SetUserQuota(s.ctx, &types.MsgSetUserQuota{
+		Sender:     s.admin.String(),
+		Contract:   s.contract.String(),
+		User:       s.user.String(),
+		UseDefault: true,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.UserQuota(s.ctx, &types.QueryUserQuotaRequest{Contract: s.contract.String(), User: s.user.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Remaining)
+	s.Require().Nil(res.WindowEnd)
+
+	_, err = s.msgServer.SetUserQuota(s.ctx, &types.MsgSetUserQuota{
+		Sender:   s.user.String(),
+		Contract: s.contract.String(),
+		User:     s.user.String(),
+		Quota:    100,
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestInactiveSponsorship() {
+	_, err := s.msgServer.UpdateContract(s.ctx, &types.MsgUpdateContract{
+		Sender:       s.admin.String(),
+		Contract:     s.contract.String(),
+		MaxFeePerTx:  coins(100),
+		DefaultQuota: 2,
+		QuotaWindow:  time.Hour,
+		Active:       false,
+	})
+	s.Require().NoError(err)
+
+	_, sponsored, err := s.app.ContractSponsorKeeper.SponsoredContract(s.ctx, s.buildTx(coins(10), s.execute(s.contract)))
+	s.Require().NoError(err)
+	s.Require().False(sponsored)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.admin.String(), Params: types.DefaultParams()})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(0, time.Hour, 1)})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	_, err := s.msgServer.SetUserQuota(s.ctx, &types.MsgSetUserQuota{
+		Sender:   s.admin.String(),
+		Contract: s.contract.String(),
+		User:     s.user.String(),
+		Quota:    5,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.ContractSponsorKeeper.DeductSponsoredFee(s.ctx, s.contract, s.user, coins(10)))
+
+	gs, err := s.app.ContractSponsorKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate(s.app.AccountKeeper.AddressCodec()))
+	s.Require().Len(gs.Sponsorships, 1)
+	s.Require().Len(gs.UserQuotas, 1)
+	s.Require().Len(gs.UserUsages, 1)
+	s.Require().Len(gs.SponsorUsages, 1)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.ContractSponsorKeeper.InitGenesis(s.ctx, gs))
+	exported, err := s.app.ContractSponsorKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the contractsponsor MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterContract implements types.MsgServer.
+func (k msgServer) RegisterContract(goCtx context.Context, msg *types.MsgRegisterContract) (*types.MsgRegisterContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.parseSenderContract(msg.Sender, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorize(ctx, sender, contract); err != nil {
+		return nil, err
+	}
+	if err := k.checkPolicy(ctx, msg.MaxFeePerTx, msg.QuotaWindow); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Sponsorships.Get(ctx, contract); err == nil {
+		return nil, errorsmod.Wrapf(types.ErrSponsorshipExists, "%s", msg.Contract)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	sponsorship := types.Sponsorship{
+		Contract:        msg.Contract,
+		Active:          true,
+		MaxFeePerTx:     msg.MaxFeePerTx,
+		DefaultQuota:    msg.DefaultQuota,
+		QuotaWindow:     msg.QuotaWindow,
+		MaxTxsPerWindow: msg.MaxTxsPerWindow,
+	}
+	if err := k.Sponsorships.Set(ctx, contract, sponsorship); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterContract,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgRegisterContractResponse{}, nil
+}
+
+// UpdateContract implements types.MsgServer.
+func (k msgServer) UpdateContract(goCtx context.Context, msg *types.MsgUpdateContract) (*types.MsgUpdateContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.parseSenderContract(msg.Sender, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorize(ctx, sender, contract); err != nil {
+		return nil, err
+	}
+	if err := k.checkPolicy(ctx, msg.MaxFeePerTx, msg.QuotaWindow); err != nil {
+		return nil, err
+	}
+
+	sponsorship, err := k.GetSponsorship(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	sponsorship.Active = msg.Active
+	sponsorship.MaxFeePerTx = msg.MaxFeePerTx
+	sponsorship.DefaultQuota = msg.DefaultQuota
+	sponsorship.QuotaWindow = msg.QuotaWindow
+	sponsorship.MaxTxsPerWindow = msg.MaxTxsPerWindow
+	if err := k.Sponsorships.Set(ctx, contract, sponsorship); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateContract,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyActive, strconv.FormatBool(msg.Active)),
+		),
+	)
+
+	return &types.MsgUpdateContractResponse{}, nil
+}
+
+// DepositFees implements types.MsgServer.
+func (k msgServer) DepositFees(goCtx context.Context, msg *types.MsgDepositFees) (*types.MsgDepositFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, contract, err := k.parseSenderContract(msg.Depositor, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.Deposit(ctx, depositor, contract, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositFees,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgDepositFeesResponse{}, nil
+}
+
+// WithdrawFees implements types.MsgServer.
+func (k msgServer) WithdrawFees(goCtx context.Context, msg *types.MsgWithdrawFees) (*types.MsgWithdrawFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.parseSenderContract(msg.Sender, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsorship, "invalid recipient address: %s", err)
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "invalid amount: %s", msg.Amount)
+	}
+	if err := k.authorize(ctx, sender, contract); err != nil {
+		return nil, err
+	}
+
+	if err := k.Withdraw(ctx, contract, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawFees,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawFeesResponse{}, nil
+}
+
+// SetUserQuota implements types.MsgServer.
+func (k msgServer) SetUserQuota(goCtx context.Context, msg *types.MsgSetUserQuota) (*types.MsgSetUserQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.parseSenderContract(msg.Sender, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	user, err := k.accountKeeper.AddressCodec().StringToBytes(msg.User)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsorship, "invalid user address: %s", err)
+	}
+	if err := k.authorize(ctx, sender, contract); err != nil {
+		return nil, err
+	}
+	if _, err := k.GetSponsorship(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(contract, sdk.AccAddress(user))
+	if msg.UseDefault {
+		err = k.UserQuotas.Remove(ctx, key)
+	} else {
+		err = k.UserQuotas.Set(ctx, key, msg.Quota)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	quota := strconv.FormatUint(msg.Quota, 10)
+	if msg.UseDefault {
+		quota = "default"
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetUserQuota,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.User),
+			sdk.NewAttribute(types.AttributeKeyQuota, quota),
+		),
+	)
+
+	return &types.MsgSetUserQuotaResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// parseSenderContract decodes the signer and contract addresses of a msg.
+func (k msgServer) parseSenderContract(sender, contract string) (sdk.AccAddress, sdk.AccAddress, error) {
+	senderAddr, err := k.accountKeeper.AddressCodec().StringToBytes(sender)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidSponsorship, "invalid sender address: %s", err)
+	}
+	contractAddr, err := k.accountKeeper.AddressCodec().StringToBytes(contract)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidSponsorship, "invalid contract address: %s", err)
+	}
+	return senderAddr, contractAddr, nil
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+// GetSponsorship returns the sponsorship of a contract.
+func (k Keeper) GetSponsorship(ctx context.Context, contract sdk.AccAddress) (types.Sponsorship, error) {
+	sponsorship, err := k.Sponsorships.Get(ctx, contract)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Sponsorship{}, errorsmod.Wrapf(types.ErrSponsorshipNotFound, "%s", contract)
+	}
+	return sponsorship, err
+}
+
+// authorize checks that sender controls the sponsorship of contract, that is
+// sender is the contract itself or its admin.
+func (k Keeper) authorize(ctx context.Context, sender, contract sdk.AccAddress) error {
+	info := k.wasmKeeper.GetContractInfo(ctx, contract)
+	if info == nil {
+		return errorsmod.Wrapf(types.ErrContractNotFound, "%s", contract)
+	}
+	if sender.Equals(contract) {
+		return nil
+	}
+	if info.Admin != "" {
+		admin, err := k.accountKeeper.AddressCodec().StringToBytes(info.Admin)
+		if err == nil && sender.Equals(sdk.AccAddress(admin)) {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(types.ErrUnauthorized, "%s is neither the contract nor its admin", sender)
+}
+
+// Deposit adds amount from depositor to the fee balance of contract.
+func (k Keeper) Deposit(ctx context.Context, depositor, contract sdk.AccAddress, amount sdk.Coins) error {
+	sponsorship, err := k.GetSponsorship(ctx, contract)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+	sponsorship.Balance = sponsorship.Balance.Add(amount...)
+	return k.Sponsorships.Set(ctx, contract, sponsorship)
+}
+
+// Withdraw pays amount out of the fee balance of contract to recipient.
+func (k Keeper) Withdraw(ctx context.Context, contract, recipient sdk.AccAddress, amount sdk.Coins) error {
+	sponsorship, err := k.GetSponsorship(ctx, contract)
+	if err != nil {
+		return err
+	}
+	balance, anyNegative := sponsorship.Balance.SafeSub(amount...)
+	if anyNegative {
+		return errorsmod.Wrapf(types.ErrInsufficientBalance, "%s is smaller than %s", sponsorship.Balance, amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+	sponsorship.Balance = balance
+	return k.Sponsorships.Set(ctx, contract, sponsorship)
+}
+
+// UserQuota returns the quota of user for the sponsorship of contract and its
+// usage in the current window.
+func (k Keeper) UserQuota(ctx context.Context, contract, user sdk.AccAddress, sponsorship types.Sponsorship) (uint64, types.UserUsage, error) {
+	key := collections.Join(contract, user)
+
+	quota, err := k.UserQuotas.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		quota = sponsorship.DefaultQuota
+	case err != nil:
+		return 0, types.UserUsage{}, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	usage, err := k.UserUsages.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		usage = types.UserUsage{Contract: sponsorship.Contract, User: user.String(), WindowStart: now}
+	case err != nil:
+		return 0, types.UserUsage{}, err
+	default:
+		usage = usage.Current(now, sponsorship.QuotaWindow)
+	}
+
+	return quota, usage, nil
+}
+
+// SponsorUsage returns the usage of the sponsorship of contract in the
+// current quota window and block.
+func (k Keeper) SponsorUsage(ctx context.Context, contract sdk.AccAddress, sponsorship types.Sponsorship) (types.SponsorUsage, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	usage, err := k.SponsorUsages.Get(ctx, contract)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.SponsorUsage{Contract: sponsorship.Contract, WindowStart: sdkCtx.BlockTime(), BlockHeight: sdkCtx.BlockHeight()}, nil
+	case err != nil:
+		return types.SponsorUsage{}, err
+	}
+	return usage.Current(sdkCtx.BlockTime(), sdkCtx.BlockHeight(), sponsorship.QuotaWindow), nil
+}
+
+// checkPolicy validates a sponsorship policy against the current parameters.
+func (k Keeper) checkPolicy(ctx context.Context, maxFeePerTx sdk.Coins, quotaWindow time.Duration) error {
+	if err := types.ValidatePolicy(maxFeePerTx, quotaWindow); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSponsorship, err.Error())
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if quotaWindow > params.MaxQuotaWindow {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "quota window %s exceeds %s", quotaWindow, params.MaxQuotaWindow)
+	}
+	return nil
+}
+
+// QueryUserQuota reports the quota of user for contract and what is left of
+// it in the current window. It backs both the gRPC and the wasm queries.
+func (k Keeper) QueryUserQuota(ctx context.Context, contract, user sdk.AccAddress) (*types.QueryUserQuotaResponse, error) {
+	sponsorship, err := k.GetSponsorship(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	quota, usage, err := k.UserQuota(ctx, contract, user, sponsorship)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryUserQuotaResponse{Quota: quota, Used: usage.Used}
+	if usage.Used < quota {
+		res.Remaining = quota - usage.Used
+	}
+	if usage.Used > 0 {
+		windowEnd := usage.WindowStart.Add(sponsorship.QuotaWindow)
+		res.WindowEnd = &windowEnd
+	}
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the contractsponsor messages on the amino
+// codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterContract{}, "hippo/x/contractsponsor/MsgRegister")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateContract{}, "hippo/x/contractsponsor/MsgUpdate")
+	legacy.RegisterAminoMsg(cdc, &MsgDepositFees{}, "hippo/x/contractsponsor/MsgDepositFees")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFees{}, "hippo/x/contractsponsor/MsgWithdrawFees")
+	legacy.RegisterAminoMsg(cdc, &MsgSetUserQuota{}, "hippo/x/contractsponsor/MsgSetUserQuota")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/contractsponsor/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/contractsponsor/Params", nil)
+}
+
+// RegisterInterfaces registers the contractsponsor messages on the interface
+// registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterContract{},
+		&MsgUpdateContract{},
+		&MsgDepositFees{},
+		&MsgWithdrawFees{},
+		&MsgSetUserQuota{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,1895 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/contractsponsor/v1/contractsponsor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the contractsponsor module.
+type Params struct {
+	// max_msgs_per_tx bounds how many contract executions a sponsored tx may
+	// carry.
+	MaxMsgsPerTx uint32 `protobuf:"varint,1,opt,name=max_msgs_per_tx,json=maxMsgsPerTx,proto3" json:"max_msgs_per_tx,omitempty"`
+	// max_quota_window bounds the window user quotas are counted over.
+	MaxQuotaWindow time.Duration `protobuf:"bytes,2,opt,name=max_quota_window,json=maxQuotaWindow,proto3,stdduration" json:"max_quota_window"`
+	// max_txs_per_block bounds how many txs a single contract sponsors in a
+	// block, whatever the number of users calling it.
+	MaxTxsPerBlock uint32 `protobuf:"varint,3,opt,name=max_txs_per_block,json=maxTxsPerBlock,proto3" json:"max_txs_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b0da1199733695, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxMsgsPerTx() uint32 {
+	if m != nil {
+		return m.MaxMsgsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMaxQuotaWindow() time.Duration {
+	if m != nil {
+		return m.MaxQuotaWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxTxsPerBlock
+	}
+	return 0
+}
+
+// Sponsorship is the fee balance and policy of a contract that pays the fees
+// of the transactions calling it.
+type Sponsorship struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Active   bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// max_fee_per_tx caps the fee the contract pays for a single tx.
+	MaxFeePerTx github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_fee_per_tx,json=maxFeePerTx,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee_per_tx"`
+	// default_quota is the number of sponsored txs a user gets per quota
+	// window unless the contract set a quota for that user.
+	DefaultQuota uint64        `protobuf:"varint,4,opt,name=default_quota,json=defaultQuota,proto3" json:"default_quota,omitempty"`
+	QuotaWindow  time.Duration `protobuf:"bytes,5,opt,name=quota_window,json=quotaWindow,proto3,stdduration" json:"quota_window"`
+	// balance is the unspent deposit, held by the module account.
+	Balance      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	TotalSpent   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_spent,json=totalSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spent"`
+	TxsSponsored uint64                                   `protobuf:"varint,8,opt,name=txs_sponsored,json=txsSponsored,proto3" json:"txs_sponsored,omitempty"`
+	// max_txs_per_window caps the txs the contract sponsors per quota window
+	// across all its users, zero meaning no cap. Unlike the user quotas it
+	// cannot be worked around with fresh addresses.
+	MaxTxsPerWindow uint64 `protobuf:"varint,9,opt,name=max_txs_per_window,json=maxTxsPerWindow,proto3" json:"max_txs_per_window,omitempty"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b0da1199733695, []int{1}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Sponsorship) GetMaxFeePerTx() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxFeePerTx
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetDefaultQuota() uint64 {
+	if m != nil {
+		return m.DefaultQuota
+	}
+	return 0
+}
+
+func (m *Sponsorship) GetQuotaWindow() time.Duration {
+	if m != nil {
+		return m.QuotaWindow
+	}
+	return 0
+}
+
+func (m *Sponsorship) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetTotalSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSpent
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetTxsSponsored() uint64 {
+	if m != nil {
+		return m.TxsSponsored
+	}
+	return 0
+}
+
+func (m *Sponsorship) GetMaxTxsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxTxsPerWindow
+	}
+	return 0
+}
+
+// UserQuota overrides the default quota of a contract for one user.
+type UserQuota struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	User     string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Quota    uint64 `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (m *UserQuota) Reset()         { *m = UserQuota{} }
+func (m *UserQuota) String() string { return proto.CompactTextString(m) }
+func (*UserQuota) ProtoMessage()    {}
+func (*UserQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b0da1199733695, []int{2}
+}
+func (m *UserQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserQuota.Merge(m, src)
+}
+func (m *UserQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserQuota proto.InternalMessageInfo
+
+func (m *UserQuota) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *UserQuota) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserQuota) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+// UserUsage counts the sponsored txs of a user in the current quota window.
+type UserUsage struct {
+	Contract    string    `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	User        string    `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	WindowStart time.Time `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	Used        uint64    `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (m *UserUsage) Reset()         { *m = UserUsage{} }
+func (m *UserUsage) String() string { return proto.CompactTextString(m) }
+func (*UserUsage) ProtoMessage()    {}
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b0da1199733695, []int{3}
+}
+func (m *UserUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserUsage.Merge(m, src)
+}
+func (m *UserUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserUsage proto.InternalMessageInfo
+
+func (m *UserUsage) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *UserUsage) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserUsage) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *UserUsage) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+// SponsorUsage counts the txs a contract sponsored in the current quota
+// window and in the current block.
+type SponsorUsage struct {
+	Contract    string    `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	WindowStart time.Time `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	WindowTxs   uint64    `protobuf:"varint,3,opt,name=window_txs,json=windowTxs,proto3" json:"window_txs,omitempty"`
+	BlockHeight int64     `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTxs    uint64    `protobuf:"varint,5,opt,name=block_txs,json=blockTxs,proto3" json:"block_txs,omitempty"`
+}
+
+func (m *SponsorUsage) Reset()         { *m = SponsorUsage{} }
+func (m *SponsorUsage) String() string { return proto.CompactTextString(m) }
+func (*SponsorUsage) ProtoMessage()    {}
+func (*SponsorUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b0da1199733695, []int{4}
+}
+func (m *SponsorUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorUsage.Merge(m, src)
+}
+func (m *SponsorUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorUsage proto.InternalMessageInfo
+
+func (m *SponsorUsage) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SponsorUsage) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *SponsorUsage) GetWindowTxs() uint64 {
+	if m != nil {
+		return m.WindowTxs
+	}
+	return 0
+}
+
+func (m *SponsorUsage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SponsorUsage) GetBlockTxs() uint64 {
+	if m != nil {
+		return m.BlockTxs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hippo.contractsponsor.v1.Params")
+	proto.RegisterType((*Sponsorship)(nil), "hippo.contractsponsor.v1.Sponsorship")
+	proto.RegisterType((*UserQuota)(nil), "hippo.contractsponsor.v1.UserQuota")
+	proto.RegisterType((*UserUsage)(nil), "hippo.contractsponsor.v1.UserUsage")
+	proto.RegisterType((*SponsorUsage)(nil), "hippo.contractsponsor.v1.SponsorUsage")
+}
+
+func init() {
+	proto.RegisterFile("hippo/contractsponsor/v1/contractsponsor.proto", fileDescriptor_14b0da1199733695)
+}
+
+var fileDescriptor_14b0da1199733695 = []byte{
+	// 753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x18, 0x8d, 0x9b, 0x34, 0x4d, 0x26, 0xe9, 0xed, 0xed, 0xa8, 0xba, 0x72, 0x7b, 0x75, 0x9d, 0xdc,
+	0x54, 0x48, 0xa1, 0x10, 0x5b, 0x2d, 0xb0, 0x61, 0x83, 0x08, 0x08, 0x21, 0x01, 0x52, 0x49, 0x52,
+	0x21, 0xd8, 0x58, 0x13, 0x7b, 0xea, 0x98, 0xc6, 0x1e, 0xd7, 0x33, 0x4e, 0xcd, 0x03, 0xb0, 0x61,
+	0xd5, 0x25, 0x8f, 0x80, 0x58, 0x75, 0xc1, 0x43, 0x74, 0x59, 0x60, 0xc3, 0x8a, 0xa2, 0x76, 0xd1,
+	0x35, 0x0b, 0xf6, 0x68, 0x7e, 0x5c, 0x4a, 0x8a, 0x90, 0xa0, 0x2a, 0x9b, 0xd6, 0xdf, 0x37, 0x67,
+	0xbe, 0x9f, 0x73, 0x4e, 0x06, 0x98, 0x03, 0x3f, 0x8a, 0x88, 0xe5, 0x90, 0x90, 0xc5, 0xc8, 0x61,
+	0x34, 0x22, 0x21, 0x25, 0xb1, 0x35, 0x5a, 0x1e, 0x4f, 0x99, 0x51, 0x4c, 0x18, 0x81, 0xba, 0xc0,
+	0x9b, 0xe3, 0x87, 0xa3, 0xe5, 0x85, 0x59, 0x14, 0xf8, 0x21, 0xb1, 0xc4, 0x5f, 0x09, 0x5e, 0x30,
+	0x1c, 0x42, 0x03, 0x42, 0xad, 0x3e, 0xa2, 0xd8, 0x1a, 0x2d, 0xf7, 0x31, 0x43, 0xbc, 0xae, 0x1f,
+	0xaa, 0xf3, 0x79, 0x79, 0x6e, 0x8b, 0xc8, 0x92, 0x81, 0x3a, 0x9a, 0xf3, 0x88, 0x47, 0x64, 0x9e,
+	0x7f, 0x65, 0x05, 0x3d, 0x42, 0xbc, 0x21, 0xb6, 0x44, 0xd4, 0x4f, 0xd6, 0x2d, 0x37, 0x89, 0x11,
+	0xf3, 0x49, 0x56, 0xb0, 0x36, 0x7e, 0xce, 0xfc, 0x00, 0x53, 0x86, 0x82, 0x48, 0x02, 0x1a, 0xef,
+	0x35, 0x50, 0x5c, 0x45, 0x31, 0x0a, 0x28, 0xbc, 0x00, 0x66, 0x02, 0x94, 0xda, 0x01, 0xf5, 0xa8,
+	0x1d, 0xe1, 0xd8, 0x66, 0xa9, 0xae, 0xd5, 0xb5, 0xe6, 0x74, 0xa7, 0x1a, 0xa0, 0xf4, 0x01, 0xf5,
+	0xe8, 0x2a, 0x8e, 0x7b, 0x29, 0xec, 0x80, 0xbf, 0x39, 0x6c, 0x33, 0x21, 0x0c, 0xd9, 0x5b, 0x7e,
+	0xe8, 0x92, 0x2d, 0x7d, 0xa2, 0xae, 0x35, 0x2b, 0x2b, 0xf3, 0xa6, 0xec, 0x66, 0x66, 0xdd, 0xcc,
+	0xdb, 0x6a, 0x9a, 0xf6, 0xf4, 0xee, 0xc7, 0x5a, 0xee, 0xe5, 0x7e, 0x4d, 0x7b, 0x75, 0xb4, 0xb3,
+	0xa4, 0x75, 0xfe, 0x0a, 0x50, 0xfa, 0x90, 0x17, 0x78, 0x24, 0xee, 0xc3, 0x8b, 0x60, 0x96, 0xd7,
+	0x64, 0xa9, 0xec, 0xdc, 0x1f, 0x12, 0x67, 0x43, 0xcf, 0x8b, 0xe6, 0x1c, 0xda, 0x4b, 0x79, 0xef,
+	0x36, 0xcf, 0x5e, 0x5f, 0x7c, 0x71, 0xb4, 0xb3, 0x64, 0x48, 0x91, 0xd2, 0x53, 0x32, 0xc9, 0x55,
+	0x1a, 0x5f, 0x0a, 0xa0, 0xd2, 0x95, 0x29, 0x3a, 0xf0, 0x23, 0x78, 0x15, 0x94, 0x32, 0xa4, 0xd8,
+	0xa9, 0xdc, 0xd6, 0xdf, 0xbd, 0x69, 0xcd, 0x29, 0x82, 0x6f, 0xba, 0x6e, 0x8c, 0x29, 0xed, 0xb2,
+	0xd8, 0x0f, 0xbd, 0xce, 0x31, 0x12, 0xfe, 0x03, 0x8a, 0xc8, 0x61, 0xfe, 0x08, 0x8b, 0xfd, 0x4a,
+	0x1d, 0x15, 0xc1, 0x04, 0xf0, 0xa1, 0xec, 0x75, 0x8c, 0x33, 0x9e, 0xf2, 0xf5, 0xbc, 0xd8, 0x5f,
+	0x15, 0xe4, 0xf2, 0x9a, 0x4a, 0x5e, 0xf3, 0x16, 0xf1, 0xc3, 0xf6, 0x35, 0xbe, 0xff, 0xeb, 0xfd,
+	0x5a, 0xd3, 0xf3, 0xd9, 0x20, 0xe9, 0x9b, 0x0e, 0x09, 0x94, 0xbc, 0xea, 0x5f, 0x8b, 0xba, 0x1b,
+	0x16, 0x7b, 0x16, 0x61, 0x2a, 0x2e, 0x50, 0xc9, 0x53, 0x25, 0x40, 0xe9, 0x1d, 0x8c, 0x25, 0xf1,
+	0x8b, 0x60, 0xda, 0xc5, 0xeb, 0x28, 0x19, 0x32, 0x49, 0xbe, 0x5e, 0xa8, 0x6b, 0xcd, 0x42, 0xa7,
+	0xaa, 0x92, 0x82, 0x4f, 0x78, 0x0f, 0x54, 0xbf, 0x53, 0x66, 0xf2, 0x17, 0x95, 0xa9, 0x6c, 0x9e,
+	0x90, 0xe5, 0x29, 0x98, 0xea, 0xa3, 0x21, 0x0a, 0x1d, 0xac, 0x17, 0xcf, 0x69, 0xc3, 0xac, 0x01,
+	0xdc, 0x04, 0x15, 0x46, 0x18, 0x1a, 0xda, 0x34, 0xc2, 0x21, 0xd3, 0xa7, 0xce, 0xa9, 0x1f, 0x10,
+	0x4d, 0xba, 0xbc, 0x07, 0x27, 0x94, 0x3b, 0x4e, 0x79, 0x07, 0xbb, 0x7a, 0x49, 0x12, 0xca, 0x52,
+	0xda, 0xcd, 0x72, 0xf0, 0x12, 0x80, 0x27, 0xad, 0xa9, 0x68, 0x2d, 0x0b, 0xe4, 0xcc, 0xb1, 0x37,
+	0x25, 0x61, 0x8d, 0xe7, 0x1a, 0x28, 0xaf, 0x51, 0x1c, 0x4b, 0x2d, 0x7e, 0xcf, 0x75, 0x97, 0x41,
+	0x21, 0xa1, 0x38, 0x16, 0x9e, 0xfb, 0xd9, 0x0d, 0x81, 0x82, 0x73, 0x60, 0x52, 0x9a, 0x21, 0x2f,
+	0x26, 0x92, 0x41, 0xe3, 0xad, 0x9a, 0x63, 0x8d, 0x22, 0x0f, 0xff, 0x91, 0x39, 0xee, 0x83, 0xaa,
+	0xa4, 0xc6, 0xa6, 0x0c, 0xc5, 0x4c, 0x8c, 0x53, 0x59, 0x59, 0x38, 0xe5, 0xbb, 0x5e, 0xf6, 0xfe,
+	0x48, 0xe3, 0x6d, 0x7f, 0x33, 0x9e, 0xbc, 0xde, 0xe5, 0xb7, 0x21, 0x14, 0xbd, 0x5d, 0xe5, 0x70,
+	0xf1, 0xdd, 0xf8, 0xac, 0x81, 0xaa, 0x92, 0xe5, 0x2c, 0x6b, 0x8d, 0x0f, 0x3a, 0x71, 0xa6, 0x41,
+	0xff, 0x03, 0x40, 0x55, 0x63, 0x29, 0x55, 0x1a, 0x94, 0x65, 0xa6, 0x97, 0x52, 0xf8, 0x3f, 0xa8,
+	0x8a, 0xb7, 0xcc, 0x1e, 0x60, 0xdf, 0x1b, 0x30, 0xb1, 0x4f, 0xbe, 0x53, 0x11, 0xb9, 0xbb, 0x22,
+	0x05, 0xff, 0x05, 0x65, 0x09, 0xe1, 0x05, 0x26, 0x45, 0x81, 0x92, 0x48, 0xf4, 0x52, 0xda, 0x7e,
+	0xbc, 0x7b, 0x60, 0x68, 0x7b, 0x07, 0x86, 0xf6, 0xe9, 0xc0, 0xd0, 0xb6, 0x0f, 0x8d, 0xdc, 0xde,
+	0xa1, 0x91, 0xfb, 0x70, 0x68, 0xe4, 0x9e, 0xdc, 0x38, 0x61, 0x7b, 0xf1, 0x18, 0x3a, 0x31, 0x62,
+	0x2d, 0x17, 0x11, 0x19, 0xb5, 0xc4, 0x1e, 0x0e, 0x19, 0xfe, 0xe0, 0x8d, 0x14, 0xbf, 0x89, 0x7e,
+	0x51, 0x20, 0xae, 0x7c, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x91, 0x8b, 0x80, 0xd2, 0xf0, 0x06, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxsPerBlock != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.MaxTxsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxQuotaWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxQuotaWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintContractsponsor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxMsgsPerTx != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.MaxMsgsPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxsPerWindow != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.MaxTxsPerWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TxsSponsored != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.TxsSponsored))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TotalSpent) > 0 {
+		for iNdEx := len(m.TotalSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintContractsponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintContractsponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.QuotaWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.QuotaWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintContractsponsor(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.DefaultQuota != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.DefaultQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MaxFeePerTx) > 0 {
+		for iNdEx := len(m.MaxFeePerTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFeePerTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintContractsponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintContractsponsor(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quota != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintContractsponsor(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintContractsponsor(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Used != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintContractsponsor(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintContractsponsor(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintContractsponsor(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTxs != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.BlockTxs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowTxs != 0 {
+		i = encodeVarintContractsponsor(dAtA, i, uint64(m.WindowTxs))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintContractsponsor(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintContractsponsor(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintContractsponsor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovContractsponsor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMsgsPerTx != 0 {
+		n += 1 + sovContractsponsor(uint64(m.MaxMsgsPerTx))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxQuotaWindow)
+	n += 1 + l + sovContractsponsor(uint64(l))
+	if m.MaxTxsPerBlock != 0 {
+		n += 1 + sovContractsponsor(uint64(m.MaxTxsPerBlock))
+	}
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovContractsponsor(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.MaxFeePerTx) > 0 {
+		for _, e := range m.MaxFeePerTx {
+			l = e.Size()
+			n += 1 + l + sovContractsponsor(uint64(l))
+		}
+	}
+	if m.DefaultQuota != 0 {
+		n += 1 + sovContractsponsor(uint64(m.DefaultQuota))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.QuotaWindow)
+	n += 1 + l + sovContractsponsor(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovContractsponsor(uint64(l))
+		}
+	}
+	if len(m.TotalSpent) > 0 {
+		for _, e := range m.TotalSpent {
+			l = e.Size()
+			n += 1 + l + sovContractsponsor(uint64(l))
+		}
+	}
+	if m.TxsSponsored != 0 {
+		n += 1 + sovContractsponsor(uint64(m.TxsSponsored))
+	}
+	if m.MaxTxsPerWindow != 0 {
+		n += 1 + sovContractsponsor(uint64(m.MaxTxsPerWindow))
+	}
+	return n
+}
+
+func (m *UserQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovContractsponsor(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovContractsponsor(uint64(l))
+	}
+	if m.Quota != 0 {
+		n += 1 + sovContractsponsor(uint64(m.Quota))
+	}
+	return n
+}
+
+func (m *UserUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovContractsponsor(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovContractsponsor(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovContractsponsor(uint64(l))
+	if m.Used != 0 {
+		n += 1 + sovContractsponsor(uint64(m.Used))
+	}
+	return n
+}
+
+func (m *SponsorUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovContractsponsor(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovContractsponsor(uint64(l))
+	if m.WindowTxs != 0 {
+		n += 1 + sovContractsponsor(uint64(m.WindowTxs))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovContractsponsor(uint64(m.BlockHeight))
+	}
+	if m.BlockTxs != 0 {
+		n += 1 + sovContractsponsor(uint64(m.BlockTxs))
+	}
+	return n
+}
+
+func sovContractsponsor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozContractsponsor(x uint64) (n int) {
+	return sovContractsponsor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractsponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerTx", wireType)
+			}
+			m.MaxMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerTx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuotaWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxQuotaWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerBlock", wireType)
+			}
+			m.MaxTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractsponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractsponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFeePerTx = append(m.MaxFeePerTx, types.Coin{})
+			if err := m.MaxFeePerTx[len(m.MaxFeePerTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultQuota", wireType)
+			}
+			m.DefaultQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.QuotaWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSpent = append(m.TotalSpent, types.Coin{})
+			if err := m.TotalSpent[len(m.TotalSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsSponsored", wireType)
+			}
+			m.TxsSponsored = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxsSponsored |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerWindow", wireType)
+			}
+			m.MaxTxsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractsponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractsponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractsponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractsponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractsponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractsponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowTxs", wireType)
+			}
+			m.WindowTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTxs", wireType)
+			}
+			m.BlockTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractsponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractsponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipContractsponsor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowContractsponsor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractsponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthContractsponsor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupContractsponsor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthContractsponsor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthContractsponsor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowContractsponsor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupContractsponsor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,15 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/contractsponsor module sentinel errors
+var (
+	ErrInvalidSponsorship  = errorsmod.Register(ModuleName, 2, "invalid sponsorship")
+	ErrSponsorshipNotFound = errorsmod.Register(ModuleName, 3, "sponsorship not found")
+	ErrSponsorshipExists   = errorsmod.Register(ModuleName, 4, "contract already registered")
+	ErrContractNotFound    = errorsmod.Register(ModuleName, 5, "contract not found")
+	ErrUnauthorized        = errorsmod.Register(ModuleName, 6, "unauthorized")
+	ErrInvalidAmount       = errorsmod.Register(ModuleName, 7, "invalid amount")
+	ErrInsufficientBalance = errorsmod.Register(ModuleName, 8, "insufficient sponsorship balance")
+	ErrInvalidParams       = errorsmod.Register(ModuleName, 9, "invalid params")
+)
@@ -0,0 +1,21 @@
+package types
+
+// contractsponsor module event types and attributes
+const (
+	EventTypeRegisterContract = "register_contract_sponsorship"
+	EventTypeUpdateContract   = "update_contract_sponsorship"
+	EventTypeDepositFees      = "deposit_contract_fees"
+	EventTypeWithdrawFees     = "withdraw_contract_fees"
+	EventTypeSetUserQuota     = "set_user_quota"
+	EventTypeSponsorFee       = "contract_sponsored_fee"
+
+	AttributeKeyContract  = "contract"
+	AttributeKeySender    = "sender"
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyUser      = "user"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyFee       = "fee"
+	AttributeKeyQuota     = "quota"
+	AttributeKeyActive    = "active"
+)
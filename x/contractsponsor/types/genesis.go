@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default contractsponsor genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	contracts := make(map[string]bool, len(gs.Sponsorships))
+	for _, s := range gs.Sponsorships {
+		if contracts[s.Contract] {
+			return fmt.Errorf("duplicate sponsorship for %s", s.Contract)
+		}
+		contracts[s.Contract] = true
+		if err := s.Validate(ac); err != nil {
+			return fmt.Errorf("sponsorship of %s: %w", s.Contract, err)
+		}
+	}
+
+	quotas := make(map[[2]string]bool, len(gs.UserQuotas))
+	for _, q := range gs.UserQuotas {
+		key := [2]string{q.Contract, q.User}
+		if quotas[key] {
+			return fmt.Errorf("duplicate quota of %s for %s", q.User, q.Contract)
+		}
+		quotas[key] = true
+		if !contracts[q.Contract] {
+			return fmt.Errorf("quota of %s for unregistered contract %s", q.User, q.Contract)
+		}
+		if err := q.Validate(ac); err != nil {
+			return fmt.Errorf("quota of %s for %s: %w", q.User, q.Contract, err)
+		}
+	}
+
+	usages := make(map[[2]string]bool, len(gs.UserUsages))
+	for _, u := range gs.UserUsages {
+		key := [2]string{u.Contract, u.User}
+		if usages[key] {
+			return fmt.Errorf("duplicate usage of %s for %s", u.User, u.Contract)
+		}
+		usages[key] = true
+		if !contracts[u.Contract] {
+			return fmt.Errorf("usage of %s for unregistered contract %s", u.User, u.Contract)
+		}
+		if err := u.Validate(ac); err != nil {
+			return fmt.Errorf("usage of %s for %s: %w", u.User, u.Contract, err)
+		}
+	}
+
+	sponsorUsages := make(map[string]bool, len(gs.SponsorUsages))
+	for _, u := range gs.SponsorUsages {
+		if sponsorUsages[u.Contract] {
+			return fmt.Errorf("duplicate usage of %s", u.Contract)
+		}
+		sponsorUsages[u.Contract] = true
+		if !contracts[u.Contract] {
+			return fmt.Errorf("usage of unregistered contract %s", u.Contract)
+		}
+		if err := u.Validate(ac); err != nil {
+			return fmt.Errorf("usage of %s: %w", u.Contract, err)
+		}
+	}
+
+	return nil
+}
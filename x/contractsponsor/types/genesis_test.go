@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/contractsponsor/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	ac := address.NewBech32Codec("hippo")
+	contract, err := ac.BytesToString([]byte("contract____________"))
+	require.NoError(t, err)
+	user, err := ac.BytesToString([]byte("user________________"))
+	require.NoError(t, err)
+
+	sponsorship := types.Sponsorship{
+		Contract:     contract,
+		Active:       true,
+		MaxFeePerTx:  sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(100))),
+		DefaultQuota: 10,
+		QuotaWindow:  time.Hour,
+		Balance:      sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(1000))),
+	}
+	quota := types.UserQuota{Contract: contract, User: user, Quota: 5}
+	usage := types.UserUsage{Contract: contract, User: user, Used: 1}
+	sponsorUsage := types.SponsorUsage{Contract: contract, WindowTxs: 1, BlockHeight: 1, BlockTxs: 1}
+	withSponsorship := func(malleate func(s *types.Sponsorship)) types.GenesisState {
+		s := sponsorship
+		malleate(&s)
+		return types.GenesisState{Params: types.DefaultParams(), Sponsorships: []types.Sponsorship{s}}
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", types.GenesisState{
+			Params:        types.DefaultParams(),
+			Sponsorships:  []types.Sponsorship{sponsorship},
+			UserQuotas:    []types.UserQuota{quota},
+			UserUsages:    []types.UserUsage{usage},
+			SponsorUsages: []types.SponsorUsage{sponsorUsage},
+		}, ""},
+		{"invalid params", types.GenesisState{Params: types.NewParams(0, time.Hour, 1)}, "max msgs per tx"},
+		{"zero max txs per block", types.GenesisState{Params: types.NewParams(1, time.Hour, 0)}, "max txs per block"},
+		{"duplicate sponsorship", types.GenesisState{
+			Params:       types.DefaultParams(),
+			Sponsorships: []types.Sponsorship{sponsorship, sponsorship},
+		}, "duplicate sponsorship"},
+		{"invalid contract", withSponsorship(func(s *types.Sponsorship) { s.Contract = "contract" }), "invalid contract address"},
+		{"empty max fee", withSponsorship(func(s *types.Sponsorship) { s.MaxFeePerTx = nil }), "max fee per tx"},
+		{"zero quota window", withSponsorship(func(s *types.Sponsorship) { s.QuotaWindow = 0 }), "quota window"},
+		{"duplicate quota", types.GenesisState{
+			Params:       types.DefaultParams(),
+			Sponsorships: []types.Sponsorship{sponsorship},
+			UserQuotas:   []types.UserQuota{quota, quota},
+		}, "duplicate quota"},
+		{"quota for unregistered contract", types.GenesisState{
+			Params:     types.DefaultParams(),
+			UserQuotas: []types.UserQuota{quota},
+		}, "unregistered contract"},
+		{"duplicate usage", types.GenesisState{
+			Params:       types.DefaultParams(),
+			Sponsorships: []types.Sponsorship{sponsorship},
+			UserUsages:   []types.UserUsage{usage, usage},
+		}, "duplicate usage"},
+		{"usage with invalid user", types.GenesisState{
+			Params:       types.DefaultParams(),
+			Sponsorships: []types.Sponsorship{sponsorship},
+			UserUsages:   []types.UserUsage{{Contract: contract, User: "user"}},
+		}, "invalid user address"},
+		{"duplicate sponsor usage", types.GenesisState{
+			Params:        types.DefaultParams(),
+			Sponsorships:  []types.Sponsorship{sponsorship},
+			SponsorUsages: []types.SponsorUsage{sponsorUsage, sponsorUsage},
+		}, "duplicate usage"},
+		{"sponsor usage for unregistered contract", types.GenesisState{
+			Params:        types.DefaultParams(),
+			SponsorUsages: []types.SponsorUsage{sponsorUsage},
+		}, "unregistered contract"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(ac)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+		})
+	}
+}
+
+func TestSponsorshipCovers(t *testing.T) {
+	fee := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(amount))) }
+	s := types.Sponsorship{Active: true, MaxFeePerTx: fee(100), Balance: fee(150)}
+
+	require.True(t, s.Covers(fee(100)))
+	require.False(t, s.Covers(fee(101)), "fees above the cap should not be covered")
+	require.False(t, s.Covers(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1)))), "other denoms should not be covered")
+
+	s.Balance = fee(50)
+	require.False(t, s.Covers(fee(100)), "fees above the balance should not be covered")
+
+	s.Balance = fee(150)
+	s.Active = false
+	require.False(t, s.Covers(fee(10)), "inactive sponsorships should not cover fees")
+}
+
+func TestUserUsageCurrent(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := types.UserUsage{WindowStart: start, Used: 3}
+
+	require.Equal(t, u, u.Current(start.Add(time.Hour-1), time.Hour))
+	reset := u.Current(start.Add(time.Hour), time.Hour)
+	require.Zero(t, reset.Used)
+	require.Equal(t, start.Add(time.Hour), reset.WindowStart)
+}
+
+func TestSponsorUsageCurrent(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := types.SponsorUsage{WindowStart: start, WindowTxs: 3, BlockHeight: 10, BlockTxs: 2}
+
+	require.Equal(t, u, u.Current(start.Add(time.Hour-1), 10, time.Hour))
+
+	nextBlock := u.Current(start.Add(time.Hour-1), 11, time.Hour)
+	require.Equal(t, uint64(3), nextBlock.WindowTxs)
+	require.Zero(t, nextBlock.BlockTxs)
+	require.Equal(t, int64(11), nextBlock.BlockHeight)
+
+	nextWindow := u.Current(start.Add(time.Hour), 10, time.Hour)
+	require.Zero(t, nextWindow.WindowTxs)
+	require.Equal(t, uint64(2), nextWindow.BlockTxs)
+	require.Equal(t, start.Add(time.Hour), nextWindow.WindowStart)
+}
@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "contractsponsor"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey       = collections.NewPrefix(0)
+	SponsorshipsKey = collections.NewPrefix(1)
+	UserQuotasKey   = collections.NewPrefix(2)
+	UserUsagesKey   = collections.NewPrefix(3)
+	SponsorUsageKey = collections.NewPrefix(4)
+)
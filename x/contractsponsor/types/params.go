@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultMaxMsgsPerTx lets a sponsored tx batch a few contract calls.
+	DefaultMaxMsgsPerTx uint32 = 5
+	// DefaultMaxQuotaWindow is the default upper bound of a quota window.
+	DefaultMaxQuotaWindow = 30 * 24 * time.Hour
+	// DefaultMaxTxsPerBlock keeps a contract from filling blocks with the txs
+	// it sponsors.
+	DefaultMaxTxsPerBlock uint32 = 20
+)
+
+// NewParams creates a new Params instance.
+func NewParams(maxMsgsPerTx uint32, maxQuotaWindow time.Duration, maxTxsPerBlock uint32) Params {
+	return Params{
+		MaxMsgsPerTx:   maxMsgsPerTx,
+		MaxQuotaWindow: maxQuotaWindow,
+		MaxTxsPerBlock: maxTxsPerBlock,
+	}
+}
+
+// DefaultParams returns the default contractsponsor parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxMsgsPerTx, DefaultMaxQuotaWindow, DefaultMaxTxsPerBlock)
+}
+
+// Validate performs basic validation of the contractsponsor parameters.
+func (p Params) Validate() error {
+	if p.MaxMsgsPerTx == 0 {
+		return fmt.Errorf("max msgs per tx must be positive")
+	}
+	if p.MaxQuotaWindow <= 0 {
+		return fmt.Errorf("max quota window must be positive: %s", p.MaxQuotaWindow)
+	}
+	if p.MaxTxsPerBlock == 0 {
+		return fmt.Errorf("max txs per block must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatePolicy performs stateless validation of a sponsorship policy.
+func ValidatePolicy(maxFeePerTx sdk.Coins, quotaWindow time.Duration) error {
+	if maxFeePerTx.Empty() || !maxFeePerTx.IsValid() {
+		return fmt.Errorf("invalid max fee per tx: %s", maxFeePerTx)
+	}
+	if quotaWindow <= 0 {
+		return fmt.Errorf("quota window must be positive: %s", quotaWindow)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a sponsorship.
+func (s Sponsorship) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(s.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if !s.Balance.IsValid() {
+		return fmt.Errorf("invalid balance: %s", s.Balance)
+	}
+	if !s.TotalSpent.IsValid() {
+		return fmt.Errorf("invalid total spent: %s", s.TotalSpent)
+	}
+	return ValidatePolicy(s.MaxFeePerTx, s.QuotaWindow)
+}
+
+// Covers reports whether the sponsorship can pay fee: it is active, the fee
+// is within the per tx cap and the balance covers it.
+func (s Sponsorship) Covers(fee sdk.Coins) bool {
+	return s.Active && fee.IsAllLTE(s.MaxFeePerTx) && s.Balance.IsAllGTE(fee)
+}
+
+// Validate performs stateless validation of a user quota.
+func (q UserQuota) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(q.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if _, err := ac.StringToBytes(q.User); err != nil {
+		return fmt.Errorf("invalid user address: %w", err)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a user usage.
+func (u UserUsage) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(u.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if _, err := ac.StringToBytes(u.User); err != nil {
+		return fmt.Errorf("invalid user address: %w", err)
+	}
+	return nil
+}
+
+// Current returns the usage as of now, restarting the count once the window
+// has elapsed.
+func (u UserUsage) Current(now time.Time, window time.Duration) UserUsage {
+	if !now.Before(u.WindowStart.Add(window)) {
+		u.WindowStart = now
+		u.Used = 0
+	}
+	return u
+}
+
+// Validate performs stateless validation of a sponsor usage.
+func (u SponsorUsage) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(u.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if u.BlockHeight < 0 {
+		return fmt.Errorf("negative block height: %d", u.BlockHeight)
+	}
+	return nil
+}
+
+// Current returns the usage as of now and height, restarting the window
+// count once the window has elapsed and the block count on a new block.
+func (u SponsorUsage) Current(now time.Time, height int64, window time.Duration) SponsorUsage {
+	if !now.Before(u.WindowStart.Add(window)) {
+		u.WindowStart = now
+		u.WindowTxs = 0
+	}
+	if u.BlockHeight != height {
+		u.BlockHeight = height
+		u.BlockTxs = 0
+	}
+	return u
+}
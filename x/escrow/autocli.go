@@ -0,0 +1,96 @@
+package escrow
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.escrow.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the escrow module parameters",
+				},
+				{
+					RpcMethod:      "Bounty",
+					Use:            "bounty [bounty-id]",
+					Short:          "Query a bounty by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "bounty_id"}},
+				},
+				{
+					RpcMethod: "Bounties",
+					Use:       "bounties",
+					Short:     "Query all bounties",
+				},
+				{
+					RpcMethod:      "Contribution",
+					Use:            "contribution [contribution-id]",
+					Short:          "Query a contribution by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contribution_id"}},
+				},
+				{
+					RpcMethod:      "BountyContributions",
+					Use:            "bounty-contributions [bounty-id]",
+					Short:          "Query the contributions submitted to a bounty",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "bounty_id"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.escrow.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateBounty",
+					Use:       "create-bounty [evaluator] [title] [reward-per-contribution] [max-contributions] [expires-at]",
+					Short:     "Create a bounty escrowing reward-per-contribution times max-contributions",
+					Example:   "hippod tx escrow create-bounty hippo1... \"HbA1c panel\" 1000000000000000000ahp 100 2026-01-01T00:00:00Z --criteria-uri ipfs://...",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "evaluator"},
+						{ProtoField: "title"},
+						{ProtoField: "reward_per_contribution"},
+						{ProtoField: "max_contributions"},
+						{ProtoField: "expires_at"},
+					},
+				},
+				{
+					RpcMethod: "SubmitContribution",
+					Use:       "submit-contribution [bounty-id] [data-hash]",
+					Short:     "Submit a dataset contribution to a bounty",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bounty_id"},
+						{ProtoField: "data_hash"},
+					},
+				},
+				{
+					RpcMethod: "AttestContribution",
+					Use:       "attest-contribution [contribution-id] [accepted]",
+					Short:     "Accept or reject a contribution as the bounty evaluator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contribution_id"},
+						{ProtoField: "accepted"},
+					},
+				},
+				{
+					RpcMethod: "DisputeContribution",
+					Use:       "dispute-contribution [contribution-id]",
+					Short:     "Dispute an attestation within the dispute window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contribution_id"},
+					},
+				},
+				{
+					RpcMethod: "ResolveDispute",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
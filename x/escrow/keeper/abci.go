@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+// EndBlocker settles contributions whose dispute window has elapsed and
+// expires bounties past their expiry time.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if err := k.settleContributions(ctx, blockTime); err != nil {
+		return err
+	}
+	return k.expireBounties(ctx, blockTime)
+}
+
+// settleContributions pays accepted and finalizes rejected contributions whose
+// dispute window ended at or before blockTime.
+func (k Keeper) settleContributions(ctx context.Context, blockTime time.Time) error {
+	due, err := k.dueKeys(ctx, k.SettlementQueue, blockTime)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.SettlementQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		contribution, err := k.GetContribution(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		switch contribution.Status {
+		case types.CONTRIBUTION_STATUS_ACCEPTED:
+			bounty, err := k.GetBounty(ctx, contribution.BountyId)
+			if err != nil {
+				return err
+			}
+			if err := k.payContribution(ctx, &bounty, &contribution); err != nil {
+				return err
+			}
+			if err := k.Bounties.Set(ctx, bounty.Id, bounty); err != nil {
+				return err
+			}
+		case types.CONTRIBUTION_STATUS_REJECTED:
+			contribution.SettlesAt = nil
+		default:
+			continue
+		}
+
+		if err := k.Contributions.Set(ctx, contribution.Id, contribution); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireBounties closes open bounties that expired at or before blockTime,
+// rejects their pending contributions and refunds the unreserved escrow.
+func (k Keeper) expireBounties(ctx context.Context, blockTime time.Time) error {
+	due, err := k.dueKeys(ctx, k.ExpiryQueue, blockTime)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.ExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		bounty, err := k.GetBounty(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if bounty.Status != types.BOUNTY_STATUS_OPEN {
+			continue
+		}
+
+		bounty.Status = types.BOUNTY_STATUS_EXPIRED
+		refund := bounty.RewardFor(bounty.MaxContributions - bounty.AcceptedContributions)
+		if err := k.refund(ctx, &bounty, refund); err != nil {
+			return err
+		}
+		if err := k.Bounties.Set(ctx, bounty.Id, bounty); err != nil {
+			return err
+		}
+
+		if err := k.rejectPendingContributions(ctx, bounty.Id); err != nil {
+			return err
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireBounty,
+				sdk.NewAttribute(types.AttributeKeyBountyID, strconv.FormatUint(bounty.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyCreator, bounty.Creator),
+				sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) rejectPendingContributions(ctx context.Context, bountyID uint64) error {
+	iter, err := k.BountyContributions.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](bountyID))
+	if err != nil {
+		return err
+	}
+	ids, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range ids {
+		contribution, err := k.GetContribution(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if contribution.Status != types.CONTRIBUTION_STATUS_PENDING {
+			continue
+		}
+		contribution.Status = types.CONTRIBUTION_STATUS_REJECTED
+		if err := k.Contributions.Set(ctx, contribution.Id, contribution); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dueKeys collects the queue entries scheduled at or before blockTime. Keys are
+// collected before they are processed so the queue is not mutated while it is
+// being iterated.
+func (k Keeper) dueKeys(ctx context.Context, queue collections.KeySet[collections.Pair[time.Time, uint64]], blockTime time.Time) ([]collections.Pair[time.Time, uint64], error) {
+	iter, err := queue.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](blockTime))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
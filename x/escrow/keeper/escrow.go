@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+// GetBounty returns the bounty with the given id.
+func (k Keeper) GetBounty(ctx context.Context, id uint64) (types.Bounty, error) {
+	bounty, err := k.Bounties.Get(ctx, id)
+	if err != nil {
+		return types.Bounty{}, errorsmod.Wrapf(types.ErrBountyNotFound, "bounty %d", id)
+	}
+	return bounty, nil
+}
+
+// GetContribution returns the contribution with the given id.
+func (k Keeper) GetContribution(ctx context.Context, id uint64) (types.Contribution, error) {
+	contribution, err := k.Contributions.Get(ctx, id)
+	if err != nil {
+		return types.Contribution{}, errorsmod.Wrapf(types.ErrContributionNotFound, "contribution %d", id)
+	}
+	return contribution, nil
+}
+
+// SetContribution stores a contribution and indexes it under its bounty.
+func (k Keeper) SetContribution(ctx context.Context, contribution types.Contribution) error {
+	if err := k.Contributions.Set(ctx, contribution.Id, contribution); err != nil {
+		return err
+	}
+	return k.BountyContributions.Set(ctx, collections.Join(contribution.BountyId, contribution.Id))
+}
+
+// payContribution releases the reward of an accepted contribution, routing the
+// protocol fee to the community pool.
+func (k Keeper) payContribution(ctx context.Context, bounty *types.Bounty, contribution *types.Contribution) error {
+	if bounty.EscrowBalance.IsLT(bounty.RewardPerContribution) {
+		return errorsmod.Wrapf(types.ErrInsufficientEscrow, "bounty %d", bounty.Id)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	contributor, err := k.accountKeeper.AddressCodec().StringToBytes(contribution.Contributor)
+	if err != nil {
+		return err
+	}
+
+	reward := bounty.RewardPerContribution
+	fee := sdk.NewCoin(reward.Denom, params.ProtocolFeeRate.MulInt(reward.Amount).TruncateInt())
+	payout := reward.Sub(fee)
+
+	if payout.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contributor, sdk.NewCoins(payout)); err != nil {
+			return err
+		}
+	}
+	if fee.IsPositive() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), k.accountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+	}
+
+	bounty.EscrowBalance = bounty.EscrowBalance.Sub(reward)
+	if bounty.Status == types.BOUNTY_STATUS_OPEN && bounty.EscrowBalance.IsZero() {
+		bounty.Status = types.BOUNTY_STATUS_COMPLETED
+		if err := k.ExpiryQueue.Remove(ctx, collections.Join(bounty.ExpiresAt, bounty.Id)); err != nil {
+			return err
+		}
+	}
+
+	contribution.Status = types.CONTRIBUTION_STATUS_PAID
+	contribution.SettlesAt = nil
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayContribution,
+			sdk.NewAttribute(types.AttributeKeyBountyID, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContributionID, strconv.FormatUint(contribution.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContributor, contribution.Contributor),
+			sdk.NewAttribute(types.AttributeKeyAmount, payout.String()),
+			sdk.NewAttribute(types.AttributeKeyProtocolFee, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// releaseSlot gives back the slot held by a contribution that was accepted and
+// is now finally rejected. If the bounty no longer accepts contributions the
+// reserved reward is refunded to the creator.
+func (k Keeper) releaseSlot(ctx context.Context, bounty *types.Bounty) error {
+	bounty.AcceptedContributions--
+	if bounty.Status == types.BOUNTY_STATUS_OPEN {
+		return nil
+	}
+	return k.refund(ctx, bounty, bounty.RewardPerContribution)
+}
+
+// refund returns part of the escrow balance of a bounty to its creator.
+func (k Keeper) refund(ctx context.Context, bounty *types.Bounty, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	if bounty.EscrowBalance.IsLT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientEscrow, "bounty %d", bounty.Id)
+	}
+	creator, err := k.accountKeeper.AddressCodec().StringToBytes(bounty.Creator)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	bounty.EscrowBalance = bounty.EscrowBalance.Sub(amount)
+	return nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+// InitGenesis initializes the escrow module state from a genesis state and
+// rebuilds the secondary indexes and queues. The escrow balances of the
+// bounties must be held by the module account in the bank genesis, which is
+// initialized first.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	if err := k.BountySeq.Set(ctx, gs.NextBountyId); err != nil {
+		return err
+	}
+	if err := k.ContributionSeq.Set(ctx, gs.NextContributionId); err != nil {
+		return err
+	}
+
+	escrowed := sdk.NewCoins()
+	for _, bounty := range gs.Bounties {
+		escrowed = escrowed.Add(bounty.EscrowBalance)
+		if err := k.Bounties.Set(ctx, bounty.Id, bounty); err != nil {
+			return err
+		}
+		if bounty.Status == types.BOUNTY_STATUS_OPEN {
+			if err := k.ExpiryQueue.Set(ctx, collections.Join(bounty.ExpiresAt, bounty.Id)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contribution := range gs.Contributions {
+		if err := k.SetContribution(ctx, contribution); err != nil {
+			return err
+		}
+		if contribution.SettlesAt != nil {
+			if err := k.SettlementQueue.Set(ctx, collections.Join(*contribution.SettlesAt, contribution.Id)); err != nil {
+				return err
+			}
+		}
+	}
+
+	held := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	if !held.IsAllGTE(escrowed) {
+		return errorsmod.Wrapf(types.ErrInsufficientEscrow, "module account holds %s, bounties escrow %s", held, escrowed)
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the escrow module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextBountyID, err := k.BountySeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextContributionID, err := k.ContributionSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{
+		Params:             params,
+		NextBountyId:       nextBountyID,
+		NextContributionId: nextContributionID,
+	}
+
+	if err := k.Bounties.Walk(ctx, nil, func(_ uint64, bounty types.Bounty) (bool, error) {
+		gs.Bounties = append(gs.Bounties, bounty)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Contributions.Walk(ctx, nil, func(_ uint64, contribution types.Contribution) (bool, error) {
+		gs.Contributions = append(gs.Contributions, contribution)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the escrow QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Bounty implements types.QueryServer.
+func (k queryServer) Bounty(ctx context.Context, req *types.QueryBountyRequest) (*types.QueryBountyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	bounty, err := k.GetBounty(ctx, req.BountyId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryBountyResponse{Bounty: bounty}, nil
+}
+
+// Bounties implements types.QueryServer.
+func (k queryServer) Bounties(ctx context.Context, req *types.QueryBountiesRequest) (*types.QueryBountiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	bounties, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Bounties, req.Pagination,
+		func(_ uint64, bounty types.Bounty) (types.Bounty, error) {
+			return bounty, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBountiesResponse{Bounties: bounties, Pagination: pageRes}, nil
+}
+
+// Contribution implements types.QueryServer.
+func (k queryServer) Contribution(ctx context.Context, req *types.QueryContributionRequest) (*types.QueryContributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contribution, err := k.GetContribution(ctx, req.ContributionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryContributionResponse{Contribution: contribution}, nil
+}
+
+// BountyContributions implements types.QueryServer.
+func (k queryServer) BountyContributions(ctx context.Context, req *types.QueryBountyContributionsRequest) (*types.QueryBountyContributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contributions, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.BountyContributions, req.Pagination,
+		func(key collections.Pair[uint64, uint64], _ collections.NoValue) (types.Contribution, error) {
+			return k.Keeper.Contributions.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.BountyId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBountyContributionsResponse{Contributions: contributions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+// Keeper manages bounties, contributions and the funds escrowed for them.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+
+	// the address capable of executing MsgUpdateParams and MsgResolveDispute,
+	// typically the x/gov module account.
+	authority string
+
+	Schema              collections.Schema
+	Params              collections.Item[types.Params]
+	BountySeq           collections.Sequence
+	Bounties            collections.Map[uint64, types.Bounty]
+	ContributionSeq     collections.Sequence
+	Contributions       collections.Map[uint64, types.Contribution]
+	BountyContributions collections.KeySet[collections.Pair[uint64, uint64]]
+	// SettlementQueue orders attested contributions by the end of their dispute window.
+	SettlementQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// ExpiryQueue orders open bounties by expiry time.
+	ExpiryQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+}
+
+// NewKeeper creates a new escrow Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid escrow authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:                 cdc,
+		storeService:        storeService,
+		accountKeeper:       accountKeeper,
+		bankKeeper:          bankKeeper,
+		distrKeeper:         distrKeeper,
+		authority:           authority,
+		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BountySeq:           collections.NewSequence(sb, types.BountySeqKey, "bounty_seq"),
+		Bounties:            collections.NewMap(sb, types.BountiesKey, "bounties", collections.Uint64Key, codec.CollValue[types.Bounty](cdc)),
+		ContributionSeq:     collections.NewSequence(sb, types.ContributionSeqKey, "contribution_seq"),
+		Contributions:       collections.NewMap(sb, types.ContributionsKey, "contributions", collections.Uint64Key, codec.CollValue[types.Contribution](cdc)),
+		BountyContributions: collections.NewKeySet(sb, types.BountyContributionsKey, "bounty_contributions", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		SettlementQueue:     collections.NewKeySet(sb, types.SettlementQueueKey, "settlement_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		ExpiryQueue:         collections.NewKeySet(sb, types.ExpiryQueueKey, "expiry_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,371 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+const denom = consensus.DefaultHippoDenom
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	creator     sdk.AccAddress
+	evaluator   sdk.AccAddress
+	contributor sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.DistrKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()))
+	s.Require().NoError(s.app.EscrowKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.EscrowKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.EscrowKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.creator = sdk.AccAddress([]byte("creator_____________"))
+	s.evaluator = sdk.AccAddress([]byte("evaluator___________"))
+	s.contributor = sdk.AccAddress([]byte("contributor_________"))
+	s.fund(s.creator, math.NewInt(10_000))
+}
+
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, amount math.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, addr, coins))
+}
+
+func (s *KeeperTestSuite) balance(addr sdk.AccAddress) math.Int {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, denom).Amount
+}
+
+func (s *KeeperTestSuite) communityPool() math.LegacyDec {
+	pool, err := s.app.DistrKeeper.FeePool.Get(s.ctx)
+	s.Require().NoError(err)
+	return pool.CommunityPool.AmountOf(denom)
+}
+
+func (s *KeeperTestSuite) createBounty(reward int64, maxContributions uint64) uint64 {
+	res, err := s.msgServer.CreateBounty(s.ctx, &types.MsgCreateBounty{
+		Creator:               s.creator.String(),
+		Evaluator:             s.evaluator.String(),
+		Title:                 "HbA1c measurements",
+		CriteriaUri:           "ipfs://criteria",
+		RewardPerContribution: sdk.NewInt64Coin(denom, reward),
+		MaxContributions:      maxContributions,
+		ExpiresAt:             s.ctx.BlockTime().Add(30 * 24 * time.Hour),
+	})
+	s.Require().NoError(err)
+	return res.BountyId
+}
+
+func (s *KeeperTestSuite) submit(bountyID uint64) uint64 {
+	res, err := s.msgServer.SubmitContribution(s.ctx, &types.MsgSubmitContribution{
+		Contributor: s.contributor.String(),
+		BountyId:    bountyID,
+		DataHash:    "deadbeef",
+		DataUri:     "ipfs://data",
+	})
+	s.Require().NoError(err)
+	return res.ContributionId
+}
+
+func (s *KeeperTestSuite) attest(contributionID uint64, accepted bool) {
+	_, err := s.msgServer.AttestContribution(s.ctx, &types.MsgAttestContribution{
+		Evaluator:      s.evaluator.String(),
+		ContributionId: contributionID,
+		Accepted:       accepted,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) advance(d time.Duration) {
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(d)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.Require().NoError(s.app.EscrowKeeper.EndBlocker(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestCreateBounty() {
+	id := s.createBounty(1_000, 3)
+	s.Require().Equal(uint64(1), id)
+
+	bounty, err := s.app.EscrowKeeper.GetBounty(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.BOUNTY_STATUS_OPEN, bounty.Status)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 3_000), bounty.EscrowBalance)
+	s.Require().Equal(math.NewInt(7_000), s.balance(s.creator))
+
+	escrowAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().Equal(math.NewInt(3_000), s.balance(escrowAddr))
+}
+
+func (s *KeeperTestSuite) TestCreateBountyInvalid() {
+	valid := types.MsgCreateBounty{
+		Creator:               s.creator.String(),
+		Evaluator:             s.evaluator.String(),
+		Title:                 "title",
+		RewardPerContribution: sdk.NewInt64Coin(denom, 10),
+		MaxContributions:      1,
+		ExpiresAt:             s.ctx.BlockTime().Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *types.MsgCreateBounty)
+	}{
+		{"invalid evaluator", func(msg *types.MsgCreateBounty) { msg.Evaluator = "invalid" }},
+		{"empty title", func(msg *types.MsgCreateBounty) { msg.Title = "" }},
+		{"zero reward", func(msg *types.MsgCreateBounty) { msg.RewardPerContribution = sdk.NewInt64Coin(denom, 0) }},
+		{"zero contributions", func(msg *types.MsgCreateBounty) { msg.MaxContributions = 0 }},
+		{"past expiry", func(msg *types.MsgCreateBounty) { msg.ExpiresAt = s.ctx.BlockTime() }},
+		{"expiry too far", func(msg *types.MsgCreateBounty) {
+			msg.ExpiresAt = s.ctx.BlockTime().Add(2 * types.DefaultMaxBountyDuration)
+		}},
+		{"insufficient funds", func(msg *types.MsgCreateBounty) { msg.MaxContributions = 10_000 }},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg := valid
+			tc.malleate(&msg)
+			_, err := s.msgServer.CreateBounty(s.ctx, &msg)
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAcceptedContributionIsPaidAfterDisputeWindow() {
+	bountyID := s.createBounty(1_000, 1)
+	contributionID := s.submit(bountyID)
+	s.attest(contributionID, true)
+
+	// the bounty is full once its only slot is reserved
+	_, err := s.msgServer.SubmitContribution(s.ctx, &types.MsgSubmitContribution{
+		Contributor: s.contributor.String(),
+		BountyId:    bountyID,
+		DataHash:    "cafe",
+	})
+	s.Require().ErrorIs(err, types.ErrBountyFull)
+
+	// nothing is paid while the dispute window is open
+	s.advance(time.Hour)
+	s.Require().True(s.balance(s.contributor).IsZero())
+
+	s.advance(types.DefaultDisputeWindow)
+	s.Require().Equal(math.NewInt(980), s.balance(s.contributor))
+	s.Require().Equal(math.LegacyNewDec(20), s.communityPool())
+
+	contribution, err := s.app.EscrowKeeper.GetContribution(s.ctx, contributionID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.CONTRIBUTION_STATUS_PAID, contribution.Status)
+	s.Require().Nil(contribution.SettlesAt)
+
+	bounty, err := s.app.EscrowKeeper.GetBounty(s.ctx, bountyID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.BOUNTY_STATUS_COMPLETED, bounty.Status)
+	s.Require().True(bounty.EscrowBalance.IsZero())
+}
+
+func (s *KeeperTestSuite) TestOnlyEvaluatorCanAttest() {
+	bountyID := s.createBounty(1_000, 1)
+	contributionID := s.submit(bountyID)
+
+	_, err := s.msgServer.AttestContribution(s.ctx, &types.MsgAttestContribution{
+		Evaluator:      s.creator.String(),
+		ContributionId: contributionID,
+		Accepted:       true,
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.attest(contributionID, false)
+	_, err = s.msgServer.AttestContribution(s.ctx, &types.MsgAttestContribution{
+		Evaluator:      s.evaluator.String(),
+		ContributionId: contributionID,
+		Accepted:       true,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidStatus)
+}
+
+func (s *KeeperTestSuite) TestDisputedAcceptanceOverturned() {
+	bountyID := s.createBounty(1_000, 1)
+	contributionID := s.submit(bountyID)
+	s.attest(contributionID, true)
+
+	// only the creator may dispute an acceptance
+	_, err := s.msgServer.DisputeContribution(s.ctx, &types.MsgDisputeContribution{Sender: s.contributor.String(), ContributionId: contributionID})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.DisputeContribution(s.ctx, &types.MsgDisputeContribution{Sender: s.creator.String(), ContributionId: contributionID})
+	s.Require().NoError(err)
+
+	// disputed contributions are not settled by the end blocker
+	s.advance(2 * types.DefaultDisputeWindow)
+	s.Require().True(s.balance(s.contributor).IsZero())
+
+	_, err = s.msgServer.ResolveDispute(s.ctx, &types.MsgResolveDispute{Authority: s.creator.String(), ContributionId: contributionID})
+	s.Require().Error(err)
+	_, err = s.msgServer.ResolveDispute(s.ctx, &types.MsgResolveDispute{Authority: s.authority, ContributionId: contributionID, Accepted: false})
+	s.Require().NoError(err)
+
+	bounty, err := s.app.EscrowKeeper.GetBounty(s.ctx, bountyID)
+	s.Require().NoError(err)
+	s.Require().Zero(bounty.AcceptedContributions)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 1_000), bounty.EscrowBalance)
+}
+
+func (s *KeeperTestSuite) TestDisputedRejectionOverturned() {
+	bountyID := s.createBounty(1_000, 1)
+	contributionID := s.submit(bountyID)
+	s.attest(contributionID, false)
+
+	_, err := s.msgServer.DisputeContribution(s.ctx, &types.MsgDisputeContribution{Sender: s.creator.String(), ContributionId: contributionID})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.DisputeContribution(s.ctx, &types.MsgDisputeContribution{Sender: s.contributor.String(), ContributionId: contributionID})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ResolveDispute(s.ctx, &types.MsgResolveDispute{Authority: s.authority, ContributionId: contributionID, Accepted: true})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(980), s.balance(s.contributor))
+}
+
+func (s *KeeperTestSuite) TestDisputeAfterWindow() {
+	bountyID := s.createBounty(1_000, 2)
+	contributionID := s.submit(bountyID)
+	s.attest(contributionID, false)
+	s.advance(types.DefaultDisputeWindow)
+
+	_, err := s.msgServer.DisputeContribution(s.ctx, &types.MsgDisputeContribution{Sender: s.contributor.String(), ContributionId: contributionID})
+	s.Require().ErrorIs(err, types.ErrDisputeWindowElapsed)
+}
+
+func (s *KeeperTestSuite) TestExpiredBountyRefundsUnusedEscrow() {
+	bountyID := s.createBounty(1_000, 3)
+	accepted := s.submit(bountyID)
+	pending := s.submit(bountyID)
+	s.attest(accepted, true)
+
+	s.advance(30*24*time.Hour + time.Second)
+
+	bounty, err := s.app.EscrowKeeper.GetBounty(s.ctx, bountyID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.BOUNTY_STATUS_EXPIRED, bounty.Status)
+	s.Require().True(bounty.EscrowBalance.IsZero())
+
+	// two unused slots are refunded, the accepted one was paid out
+	s.Require().Equal(math.NewInt(9_000), s.balance(s.creator))
+	s.Require().Equal(math.NewInt(980), s.balance(s.contributor))
+
+	contribution, err := s.app.EscrowKeeper.GetContribution(s.ctx, pending)
+	s.Require().NoError(err)
+	s.Require().Equal(types.CONTRIBUTION_STATUS_REJECTED, contribution.Status)
+
+	_, err = s.msgServer.SubmitContribution(s.ctx, &types.MsgSubmitContribution{
+		Contributor: s.contributor.String(),
+		BountyId:    bountyID,
+		DataHash:    "cafe",
+	})
+	s.Require().ErrorIs(err, types.ErrBountyNotOpen)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.ProtocolFeeRate = math.LegacyNewDecWithPrec(5, 2)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.creator.String(), Params: params})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{}})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestQueries() {
+	bountyID := s.createBounty(1_000, 2)
+	contributionID := s.submit(bountyID)
+
+	bountyRes, err := s.queryServer.Bounty(s.ctx, &types.QueryBountyRequest{BountyId: bountyID})
+	s.Require().NoError(err)
+	s.Require().Equal(bountyID, bountyRes.Bounty.Id)
+
+	_, err = s.queryServer.Bounty(s.ctx, &types.QueryBountyRequest{BountyId: 99})
+	s.Require().Error(err)
+
+	bountiesRes, err := s.queryServer.Bounties(s.ctx, &types.QueryBountiesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(bountiesRes.Bounties, 1)
+
+	contributionRes, err := s.queryServer.Contribution(s.ctx, &types.QueryContributionRequest{ContributionId: contributionID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.contributor.String(), contributionRes.Contribution.Contributor)
+
+	listRes, err := s.queryServer.BountyContributions(s.ctx, &types.QueryBountyContributionsRequest{BountyId: bountyID})
+	s.Require().NoError(err)
+	s.Require().Len(listRes.Contributions, 1)
+
+	listRes, err = s.queryServer.BountyContributions(s.ctx, &types.QueryBountyContributionsRequest{BountyId: bountyID + 1})
+	s.Require().NoError(err)
+	s.Require().Empty(listRes.Contributions)
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	bountyID := s.createBounty(1_000, 2)
+	contributionID := s.submit(bountyID)
+	s.attest(contributionID, true)
+
+	exported, err := s.app.EscrowKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate(s.app.AccountKeeper.AddressCodec()))
+	s.Require().Len(exported.Bounties, 1)
+	s.Require().Len(exported.Contributions, 1)
+	s.Require().Equal(uint64(2), exported.NextBountyId)
+
+	// the module account must hold the escrowed amounts
+	s.SetupTest()
+	s.Require().ErrorIs(s.app.EscrowKeeper.InitGenesis(s.ctx, exported), types.ErrInsufficientEscrow)
+
+	// re-importing rebuilds the settlement queue so the contribution is still paid
+	s.SetupTest()
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin(denom, 2_000))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, escrowed))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, minttypes.ModuleName, types.ModuleName, escrowed))
+	s.Require().NoError(s.app.EscrowKeeper.InitGenesis(s.ctx, exported))
+	s.advance(types.DefaultDisputeWindow + time.Second)
+	s.Require().Equal(math.NewInt(980), s.balance(s.contributor))
+}
@@ -0,0 +1,368 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+const (
+	// MaxTitleLength bounds the length of a bounty title.
+	MaxTitleLength = 256
+	// MaxURILength bounds the length of criteria and data URIs.
+	MaxURILength = 2048
+	// MaxDataHashLength bounds the length of a hex encoded data hash.
+	MaxDataHashLength = 128
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the escrow MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// CreateBounty implements types.MsgServer.
+func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBounty) (*types.MsgCreateBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBounty, "invalid creator address: %s", err)
+	}
+	if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Evaluator); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBounty, "invalid evaluator address: %s", err)
+	}
+	if msg.Title == "" || len(msg.Title) > MaxTitleLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBounty, "title must be between 1 and %d characters", MaxTitleLength)
+	}
+	if len(msg.CriteriaUri) > MaxURILength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBounty, "criteria uri exceeds %d characters", MaxURILength)
+	}
+	if !msg.RewardPerContribution.IsValid() || !msg.RewardPerContribution.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBounty, "invalid reward %s", msg.RewardPerContribution)
+	}
+	if msg.MaxContributions == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBounty, "max contributions must be positive")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !msg.ExpiresAt.After(ctx.BlockTime()) {
+		return nil, errorsmod.Wrap(types.ErrInvalidBounty, "expiry must be in the future")
+	}
+	if msg.ExpiresAt.After(ctx.BlockTime().Add(params.MaxBountyDuration)) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBounty, "expiry exceeds max bounty duration %s", params.MaxBountyDuration)
+	}
+
+	id, err := k.BountySeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bounty := types.Bounty{
+		Id:                    id,
+		Creator:               msg.Creator,
+		Evaluator:             msg.Evaluator,
+		Title:                 msg.Title,
+		CriteriaUri:           msg.CriteriaUri,
+		RewardPerContribution: msg.RewardPerContribution,
+		MaxContributions:      msg.MaxContributions,
+		ExpiresAt:             msg.ExpiresAt,
+		Status:                types.BOUNTY_STATUS_OPEN,
+	}
+	bounty.EscrowBalance = bounty.TotalEscrow()
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(bounty.EscrowBalance)); err != nil {
+		return nil, err
+	}
+	if err := k.Bounties.Set(ctx, id, bounty); err != nil {
+		return nil, err
+	}
+	if err := k.ExpiryQueue.Set(ctx, collections.Join(bounty.ExpiresAt, id)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateBounty,
+			sdk.NewAttribute(types.AttributeKeyBountyID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyEvaluator, msg.Evaluator),
+			sdk.NewAttribute(types.AttributeKeyAmount, bounty.EscrowBalance.String()),
+		),
+	)
+
+	return &types.MsgCreateBountyResponse{BountyId: id}, nil
+}
+
+// SubmitContribution implements types.MsgServer.
+func (k msgServer) SubmitContribution(goCtx context.Context, msg *types.MsgSubmitContribution) (*types.MsgSubmitContributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Contributor); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContribution, "invalid contributor address: %s", err)
+	}
+	if msg.DataHash == "" || len(msg.DataHash) > MaxDataHashLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContribution, "data hash must be between 1 and %d characters", MaxDataHashLength)
+	}
+	if _, err := hex.DecodeString(msg.DataHash); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContribution, "data hash must be hex encoded: %s", err)
+	}
+	if len(msg.DataUri) > MaxURILength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContribution, "data uri exceeds %d characters", MaxURILength)
+	}
+
+	bounty, err := k.GetBounty(ctx, msg.BountyId)
+	if err != nil {
+		return nil, err
+	}
+	if bounty.Status != types.BOUNTY_STATUS_OPEN || !ctx.BlockTime().Before(bounty.ExpiresAt) {
+		return nil, errorsmod.Wrapf(types.ErrBountyNotOpen, "bounty %d", bounty.Id)
+	}
+	if bounty.AcceptedContributions >= bounty.MaxContributions {
+		return nil, errorsmod.Wrapf(types.ErrBountyFull, "bounty %d", bounty.Id)
+	}
+	if msg.Contributor == bounty.Creator || msg.Contributor == bounty.Evaluator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "bounty creator and evaluator cannot contribute")
+	}
+
+	id, err := k.ContributionSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contribution := types.Contribution{
+		Id:          id,
+		BountyId:    bounty.Id,
+		Contributor: msg.Contributor,
+		DataHash:    msg.DataHash,
+		DataUri:     msg.DataUri,
+		Status:      types.CONTRIBUTION_STATUS_PENDING,
+	}
+	if err := k.SetContribution(ctx, contribution); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitContribution,
+			sdk.NewAttribute(types.AttributeKeyBountyID, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContributionID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContributor, msg.Contributor),
+		),
+	)
+
+	return &types.MsgSubmitContributionResponse{ContributionId: id}, nil
+}
+
+// AttestContribution implements types.MsgServer.
+func (k msgServer) AttestContribution(goCtx context.Context, msg *types.MsgAttestContribution) (*types.MsgAttestContributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contribution, err := k.GetContribution(ctx, msg.ContributionId)
+	if err != nil {
+		return nil, err
+	}
+	if contribution.Status != types.CONTRIBUTION_STATUS_PENDING {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "contribution %d is %s", contribution.Id, contribution.Status)
+	}
+
+	bounty, err := k.GetBounty(ctx, contribution.BountyId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Evaluator != bounty.Evaluator {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the evaluator of bounty %d", msg.Evaluator, bounty.Id)
+	}
+	if bounty.Status != types.BOUNTY_STATUS_OPEN {
+		return nil, errorsmod.Wrapf(types.ErrBountyNotOpen, "bounty %d", bounty.Id)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Accepted {
+		if bounty.AcceptedContributions >= bounty.MaxContributions {
+			return nil, errorsmod.Wrapf(types.ErrBountyFull, "bounty %d", bounty.Id)
+		}
+		bounty.AcceptedContributions++
+		contribution.Status = types.CONTRIBUTION_STATUS_ACCEPTED
+	} else {
+		contribution.Status = types.CONTRIBUTION_STATUS_REJECTED
+	}
+
+	settlesAt := ctx.BlockTime().Add(params.DisputeWindow)
+	contribution.Accepted = msg.Accepted
+	contribution.SettlesAt = &settlesAt
+
+	if err := k.Bounties.Set(ctx, bounty.Id, bounty); err != nil {
+		return nil, err
+	}
+	if err := k.Contributions.Set(ctx, contribution.Id, contribution); err != nil {
+		return nil, err
+	}
+	if err := k.SettlementQueue.Set(ctx, collections.Join(settlesAt, contribution.Id)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestContribution,
+			sdk.NewAttribute(types.AttributeKeyBountyID, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContributionID, strconv.FormatUint(contribution.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEvaluator, msg.Evaluator),
+			sdk.NewAttribute(types.AttributeKeyAccepted, strconv.FormatBool(msg.Accepted)),
+		),
+	)
+
+	return &types.MsgAttestContributionResponse{}, nil
+}
+
+// DisputeContribution implements types.MsgServer.
+func (k msgServer) DisputeContribution(goCtx context.Context, msg *types.MsgDisputeContribution) (*types.MsgDisputeContributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contribution, err := k.GetContribution(ctx, msg.ContributionId)
+	if err != nil {
+		return nil, err
+	}
+	if contribution.Status != types.CONTRIBUTION_STATUS_ACCEPTED && contribution.Status != types.CONTRIBUTION_STATUS_REJECTED {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "contribution %d is %s", contribution.Id, contribution.Status)
+	}
+	if contribution.SettlesAt == nil || !ctx.BlockTime().Before(*contribution.SettlesAt) {
+		return nil, errorsmod.Wrapf(types.ErrDisputeWindowElapsed, "contribution %d", contribution.Id)
+	}
+
+	bounty, err := k.GetBounty(ctx, contribution.BountyId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the party that loses by the attestation is the one allowed to dispute it
+	disputer := contribution.Contributor
+	if contribution.Accepted {
+		disputer = bounty.Creator
+	}
+	if msg.Sender != disputer {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only %s can dispute contribution %d", disputer, contribution.Id)
+	}
+
+	if err := k.SettlementQueue.Remove(ctx, collections.Join(*contribution.SettlesAt, contribution.Id)); err != nil {
+		return nil, err
+	}
+	contribution.Status = types.CONTRIBUTION_STATUS_DISPUTED
+	contribution.SettlesAt = nil
+	if err := k.Contributions.Set(ctx, contribution.Id, contribution); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeContribution,
+			sdk.NewAttribute(types.AttributeKeyBountyID, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContributionID, strconv.FormatUint(contribution.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgDisputeContributionResponse{}, nil
+}
+
+// ResolveDispute implements types.MsgServer.
+func (k msgServer) ResolveDispute(goCtx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contribution, err := k.GetContribution(ctx, msg.ContributionId)
+	if err != nil {
+		return nil, err
+	}
+	if contribution.Status != types.CONTRIBUTION_STATUS_DISPUTED {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "contribution %d is %s", contribution.Id, contribution.Status)
+	}
+
+	bounty, err := k.GetBounty(ctx, contribution.BountyId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case msg.Accepted:
+		if !contribution.Accepted {
+			// overturning a rejection needs a free, still escrowed slot
+			if bounty.Status != types.BOUNTY_STATUS_OPEN {
+				return nil, errorsmod.Wrapf(types.ErrBountyNotOpen, "bounty %d", bounty.Id)
+			}
+			if bounty.AcceptedContributions >= bounty.MaxContributions {
+				return nil, errorsmod.Wrapf(types.ErrBountyFull, "bounty %d", bounty.Id)
+			}
+			bounty.AcceptedContributions++
+		}
+		if err := k.payContribution(ctx, &bounty, &contribution); err != nil {
+			return nil, err
+		}
+	default:
+		if contribution.Accepted {
+			if err := k.releaseSlot(ctx, &bounty); err != nil {
+				return nil, err
+			}
+		}
+		contribution.Status = types.CONTRIBUTION_STATUS_REJECTED
+	}
+	contribution.Accepted = msg.Accepted
+
+	if err := k.Bounties.Set(ctx, bounty.Id, bounty); err != nil {
+		return nil, err
+	}
+	if err := k.Contributions.Set(ctx, contribution.Id, contribution); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResolveDispute,
+			sdk.NewAttribute(types.AttributeKeyBountyID, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContributionID, strconv.FormatUint(contribution.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccepted, strconv.FormatBool(msg.Accepted)),
+		),
+	)
+
+	return &types.MsgResolveDisputeResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of a bounty.
+func (b Bounty) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(b.Creator); err != nil {
+		return fmt.Errorf("bounty %d: invalid creator address: %w", b.Id, err)
+	}
+	if _, err := ac.StringToBytes(b.Evaluator); err != nil {
+		return fmt.Errorf("bounty %d: invalid evaluator address: %w", b.Id, err)
+	}
+	if !b.RewardPerContribution.IsValid() || !b.RewardPerContribution.IsPositive() {
+		return fmt.Errorf("bounty %d: reward must be positive", b.Id)
+	}
+	if b.MaxContributions == 0 {
+		return fmt.Errorf("bounty %d: max contributions must be positive", b.Id)
+	}
+	if b.AcceptedContributions > b.MaxContributions {
+		return fmt.Errorf("bounty %d: accepted contributions exceed maximum", b.Id)
+	}
+	if !b.EscrowBalance.IsValid() || b.EscrowBalance.Denom != b.RewardPerContribution.Denom {
+		return fmt.Errorf("bounty %d: invalid escrow balance %s", b.Id, b.EscrowBalance)
+	}
+	return nil
+}
+
+// TotalEscrow returns the amount escrowed when the bounty is created.
+func (b Bounty) TotalEscrow() sdk.Coin {
+	return b.RewardFor(b.MaxContributions)
+}
+
+// RewardFor returns the reward owed for n contributions.
+func (b Bounty) RewardFor(n uint64) sdk.Coin {
+	return sdk.NewCoin(b.RewardPerContribution.Denom, b.RewardPerContribution.Amount.Mul(math.NewIntFromUint64(n)))
+}
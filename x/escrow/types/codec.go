@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the escrow messages on the amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateBounty{}, "hippo/x/escrow/MsgCreateBounty")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitContribution{}, "hippo/x/escrow/MsgSubmitContribution")
+	legacy.RegisterAminoMsg(cdc, &MsgAttestContribution{}, "hippo/x/escrow/MsgAttestContribution")
+	legacy.RegisterAminoMsg(cdc, &MsgDisputeContribution{}, "hippo/x/escrow/MsgDisputeContribution")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveDispute{}, "hippo/x/escrow/MsgResolveDispute")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/escrow/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/escrow/Params", nil)
+}
+
+// RegisterInterfaces registers the escrow messages on the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateBounty{},
+		&MsgSubmitContribution{},
+		&MsgAttestContribution{},
+		&MsgDisputeContribution{},
+		&MsgResolveDispute{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,19 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/escrow module sentinel errors
+var (
+	ErrInvalidBounty        = errorsmod.Register(ModuleName, 2, "invalid bounty")
+	ErrBountyNotFound       = errorsmod.Register(ModuleName, 3, "bounty not found")
+	ErrBountyNotOpen        = errorsmod.Register(ModuleName, 4, "bounty is not open")
+	ErrBountyFull           = errorsmod.Register(ModuleName, 5, "bounty has no free contribution slots")
+	ErrContributionNotFound = errorsmod.Register(ModuleName, 6, "contribution not found")
+	ErrInvalidContribution  = errorsmod.Register(ModuleName, 7, "invalid contribution")
+	ErrUnauthorized         = errorsmod.Register(ModuleName, 8, "unauthorized")
+	ErrInvalidStatus        = errorsmod.Register(ModuleName, 9, "invalid contribution status")
+	ErrDisputeWindowElapsed = errorsmod.Register(ModuleName, 10, "dispute window has elapsed")
+	ErrInvalidParams        = errorsmod.Register(ModuleName, 11, "invalid params")
+	ErrInvalidGenesis       = errorsmod.Register(ModuleName, 12, "invalid genesis state")
+	ErrInsufficientEscrow   = errorsmod.Register(ModuleName, 13, "insufficient escrow balance")
+)
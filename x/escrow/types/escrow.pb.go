@@ -0,0 +1,1665 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/escrow/v1/escrow.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BountyStatus is the lifecycle state of a bounty.
+type BountyStatus int32
+
+const (
+	BOUNTY_STATUS_UNSPECIFIED BountyStatus = 0
+	// BOUNTY_STATUS_OPEN accepts new contributions.
+	BOUNTY_STATUS_OPEN BountyStatus = 1
+	// BOUNTY_STATUS_COMPLETED has paid out every contribution slot.
+	BOUNTY_STATUS_COMPLETED BountyStatus = 2
+	// BOUNTY_STATUS_EXPIRED passed its expiry and refunded unused escrow.
+	BOUNTY_STATUS_EXPIRED BountyStatus = 3
+)
+
+var BountyStatus_name = map[int32]string{
+	0: "BOUNTY_STATUS_UNSPECIFIED",
+	1: "BOUNTY_STATUS_OPEN",
+	2: "BOUNTY_STATUS_COMPLETED",
+	3: "BOUNTY_STATUS_EXPIRED",
+}
+
+var BountyStatus_value = map[string]int32{
+	"BOUNTY_STATUS_UNSPECIFIED": 0,
+	"BOUNTY_STATUS_OPEN":        1,
+	"BOUNTY_STATUS_COMPLETED":   2,
+	"BOUNTY_STATUS_EXPIRED":     3,
+}
+
+func (x BountyStatus) String() string {
+	return proto.EnumName(BountyStatus_name, int32(x))
+}
+
+func (BountyStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7dea341fe958f7e5, []int{0}
+}
+
+// ContributionStatus is the lifecycle state of a contribution.
+type ContributionStatus int32
+
+const (
+	CONTRIBUTION_STATUS_UNSPECIFIED ContributionStatus = 0
+	// CONTRIBUTION_STATUS_PENDING awaits an evaluator attestation.
+	CONTRIBUTION_STATUS_PENDING ContributionStatus = 1
+	// CONTRIBUTION_STATUS_ACCEPTED is attested and waits out the dispute window.
+	CONTRIBUTION_STATUS_ACCEPTED ContributionStatus = 2
+	// CONTRIBUTION_STATUS_REJECTED was rejected, possibly still disputable.
+	CONTRIBUTION_STATUS_REJECTED ContributionStatus = 3
+	// CONTRIBUTION_STATUS_DISPUTED awaits resolution by the authority.
+	CONTRIBUTION_STATUS_DISPUTED ContributionStatus = 4
+	// CONTRIBUTION_STATUS_PAID has received its reward.
+	CONTRIBUTION_STATUS_PAID ContributionStatus = 5
+)
+
+var ContributionStatus_name = map[int32]string{
+	0: "CONTRIBUTION_STATUS_UNSPECIFIED",
+	1: "CONTRIBUTION_STATUS_PENDING",
+	2: "CONTRIBUTION_STATUS_ACCEPTED",
+	3: "CONTRIBUTION_STATUS_REJECTED",
+	4: "CONTRIBUTION_STATUS_DISPUTED",
+	5: "CONTRIBUTION_STATUS_PAID",
+}
+
+var ContributionStatus_value = map[string]int32{
+	"CONTRIBUTION_STATUS_UNSPECIFIED": 0,
+	"CONTRIBUTION_STATUS_PENDING":     1,
+	"CONTRIBUTION_STATUS_ACCEPTED":    2,
+	"CONTRIBUTION_STATUS_REJECTED":    3,
+	"CONTRIBUTION_STATUS_DISPUTED":    4,
+	"CONTRIBUTION_STATUS_PAID":        5,
+}
+
+func (x ContributionStatus) String() string {
+	return proto.EnumName(ContributionStatus_name, int32(x))
+}
+
+func (ContributionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7dea341fe958f7e5, []int{1}
+}
+
+// Params defines the parameters of the escrow module.
+type Params struct {
+	// protocol_fee_rate is the share of every payout sent to the community pool.
+	ProtocolFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=protocol_fee_rate,json=protocolFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"protocol_fee_rate"`
+	// dispute_window is how long an attestation can be disputed before it is
+	// settled.
+	DisputeWindow time.Duration `protobuf:"bytes,2,opt,name=dispute_window,json=disputeWindow,proto3,stdduration" json:"dispute_window"`
+	// max_bounty_duration bounds how far in the future a bounty may expire.
+	MaxBountyDuration time.Duration `protobuf:"bytes,3,opt,name=max_bounty_duration,json=maxBountyDuration,proto3,stdduration" json:"max_bounty_duration"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dea341fe958f7e5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDisputeWindow() time.Duration {
+	if m != nil {
+		return m.DisputeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBountyDuration() time.Duration {
+	if m != nil {
+		return m.MaxBountyDuration
+	}
+	return 0
+}
+
+// Bounty is an escrowed offer paying contributors for qualifying data.
+type Bounty struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator funded the bounty and receives refunds.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// evaluator attests whether contributions qualify.
+	Evaluator string `protobuf:"bytes,3,opt,name=evaluator,proto3" json:"evaluator,omitempty"`
+	Title     string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// criteria_uri points to the description of qualifying data.
+	CriteriaUri           string     `protobuf:"bytes,5,opt,name=criteria_uri,json=criteriaUri,proto3" json:"criteria_uri,omitempty"`
+	RewardPerContribution types.Coin `protobuf:"bytes,6,opt,name=reward_per_contribution,json=rewardPerContribution,proto3" json:"reward_per_contribution"`
+	MaxContributions      uint64     `protobuf:"varint,7,opt,name=max_contributions,json=maxContributions,proto3" json:"max_contributions,omitempty"`
+	// accepted_contributions counts slots reserved by accepted or paid
+	// contributions.
+	AcceptedContributions uint64 `protobuf:"varint,8,opt,name=accepted_contributions,json=acceptedContributions,proto3" json:"accepted_contributions,omitempty"`
+	// escrow_balance is the amount still held by the module for this bounty.
+	EscrowBalance types.Coin   `protobuf:"bytes,9,opt,name=escrow_balance,json=escrowBalance,proto3" json:"escrow_balance"`
+	ExpiresAt     time.Time    `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	Status        BountyStatus `protobuf:"varint,11,opt,name=status,proto3,enum=hippo.escrow.v1.BountyStatus" json:"status,omitempty"`
+}
+
+func (m *Bounty) Reset()         { *m = Bounty{} }
+func (m *Bounty) String() string { return proto.CompactTextString(m) }
+func (*Bounty) ProtoMessage()    {}
+func (*Bounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dea341fe958f7e5, []int{1}
+}
+func (m *Bounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bounty.Merge(m, src)
+}
+func (m *Bounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bounty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bounty proto.InternalMessageInfo
+
+func (m *Bounty) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Bounty) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Bounty) GetEvaluator() string {
+	if m != nil {
+		return m.Evaluator
+	}
+	return ""
+}
+
+func (m *Bounty) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Bounty) GetCriteriaUri() string {
+	if m != nil {
+		return m.CriteriaUri
+	}
+	return ""
+}
+
+func (m *Bounty) GetRewardPerContribution() types.Coin {
+	if m != nil {
+		return m.RewardPerContribution
+	}
+	return types.Coin{}
+}
+
+func (m *Bounty) GetMaxContributions() uint64 {
+	if m != nil {
+		return m.MaxContributions
+	}
+	return 0
+}
+
+func (m *Bounty) GetAcceptedContributions() uint64 {
+	if m != nil {
+		return m.AcceptedContributions
+	}
+	return 0
+}
+
+func (m *Bounty) GetEscrowBalance() types.Coin {
+	if m != nil {
+		return m.EscrowBalance
+	}
+	return types.Coin{}
+}
+
+func (m *Bounty) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *Bounty) GetStatus() BountyStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BOUNTY_STATUS_UNSPECIFIED
+}
+
+// Contribution is a contributor's claim against a bounty.
+type Contribution struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BountyId    uint64 `protobuf:"varint,2,opt,name=bounty_id,json=bountyId,proto3" json:"bounty_id,omitempty"`
+	Contributor string `protobuf:"bytes,3,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	// data_hash is the hex encoded hash of the contributed dataset.
+	DataHash string             `protobuf:"bytes,4,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	DataUri  string             `protobuf:"bytes,5,opt,name=data_uri,json=dataUri,proto3" json:"data_uri,omitempty"`
+	Status   ContributionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=hippo.escrow.v1.ContributionStatus" json:"status,omitempty"`
+	// accepted is the evaluator's verdict, kept while the contribution is
+	// disputed.
+	Accepted bool `protobuf:"varint,7,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// settles_at is the end of the dispute window of an attested contribution.
+	SettlesAt *time.Time `protobuf:"bytes,8,opt,name=settles_at,json=settlesAt,proto3,stdtime" json:"settles_at,omitempty"`
+}
+
+func (m *Contribution) Reset()         { *m = Contribution{} }
+func (m *Contribution) String() string { return proto.CompactTextString(m) }
+func (*Contribution) ProtoMessage()    {}
+func (*Contribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dea341fe958f7e5, []int{2}
+}
+func (m *Contribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Contribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Contribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Contribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contribution.Merge(m, src)
+}
+func (m *Contribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Contribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contribution proto.InternalMessageInfo
+
+func (m *Contribution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Contribution) GetBountyId() uint64 {
+	if m != nil {
+		return m.BountyId
+	}
+	return 0
+}
+
+func (m *Contribution) GetContributor() string {
+	if m != nil {
+		return m.Contributor
+	}
+	return ""
+}
+
+func (m *Contribution) GetDataHash() string {
+	if m != nil {
+		return m.DataHash
+	}
+	return ""
+}
+
+func (m *Contribution) GetDataUri() string {
+	if m != nil {
+		return m.DataUri
+	}
+	return ""
+}
+
+func (m *Contribution) GetStatus() ContributionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CONTRIBUTION_STATUS_UNSPECIFIED
+}
+
+func (m *Contribution) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *Contribution) GetSettlesAt() *time.Time {
+	if m != nil {
+		return m.SettlesAt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("hippo.escrow.v1.BountyStatus", BountyStatus_name, BountyStatus_value)
+	proto.RegisterEnum("hippo.escrow.v1.ContributionStatus", ContributionStatus_name, ContributionStatus_value)
+	proto.RegisterType((*Params)(nil), "hippo.escrow.v1.Params")
+	proto.RegisterType((*Bounty)(nil), "hippo.escrow.v1.Bounty")
+	proto.RegisterType((*Contribution)(nil), "hippo.escrow.v1.Contribution")
+}
+
+func init() { proto.RegisterFile("hippo/escrow/v1/escrow.proto", fileDescriptor_7dea341fe958f7e5) }
+
+var fileDescriptor_7dea341fe958f7e5 = []byte{
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x65, 0x59, 0x96, 0xd6, 0x1f, 0x91, 0xb7, 0x76, 0x42, 0xcb, 0x8e, 0xe4, 0x3a, 0x17,
+	0xc3, 0x85, 0x49, 0xd8, 0x45, 0x7c, 0x48, 0x0f, 0x85, 0x3e, 0x98, 0x46, 0x6d, 0x2a, 0x11, 0x94,
+	0x84, 0xa6, 0x45, 0x01, 0x62, 0x45, 0x6e, 0xa4, 0x45, 0x45, 0xad, 0xb0, 0xbb, 0xb4, 0xe5, 0x73,
+	0x2f, 0x45, 0x4f, 0x39, 0xf6, 0xde, 0x4b, 0x8f, 0x01, 0x9a, 0x1f, 0x91, 0x1e, 0x0a, 0x04, 0x39,
+	0x15, 0x3d, 0xa4, 0x85, 0x5d, 0x20, 0x7f, 0xa3, 0xe0, 0x2e, 0xe9, 0x48, 0xb6, 0x83, 0x24, 0x17,
+	0x83, 0x33, 0xf3, 0xde, 0x70, 0xe6, 0xcd, 0x33, 0x05, 0xb6, 0x06, 0x64, 0x3c, 0xa6, 0x26, 0xe6,
+	0x1e, 0xa3, 0x27, 0xe6, 0xf1, 0x41, 0xfc, 0x64, 0x8c, 0x19, 0x15, 0x14, 0xde, 0x90, 0x55, 0x23,
+	0xce, 0x1d, 0x1f, 0x14, 0x57, 0x51, 0x40, 0x46, 0xd4, 0x94, 0x7f, 0x15, 0xa6, 0x58, 0xf2, 0x28,
+	0x0f, 0x28, 0x37, 0x7b, 0x88, 0x63, 0xf3, 0xf8, 0xa0, 0x87, 0x05, 0x3a, 0x30, 0x3d, 0x4a, 0x46,
+	0x71, 0x7d, 0x43, 0xd5, 0x5d, 0x19, 0x99, 0x2a, 0x88, 0x4b, 0x6b, 0x7d, 0xda, 0xa7, 0x2a, 0x1f,
+	0x3d, 0x25, 0x0d, 0xfb, 0x94, 0xf6, 0x87, 0xd8, 0x94, 0x51, 0x2f, 0x7c, 0x6c, 0xfa, 0x21, 0x43,
+	0x82, 0xd0, 0xa4, 0x61, 0xf9, 0x72, 0x5d, 0x90, 0x00, 0x73, 0x81, 0x82, 0xb1, 0x02, 0xec, 0xfc,
+	0x9e, 0x06, 0x59, 0x1b, 0x31, 0x14, 0x70, 0xd8, 0x03, 0xab, 0x32, 0xe7, 0xd1, 0xa1, 0xfb, 0x18,
+	0x63, 0x97, 0x21, 0x81, 0x75, 0x6d, 0x5b, 0xdb, 0xcd, 0x57, 0x8f, 0x9e, 0xbf, 0x2a, 0xa7, 0xfe,
+	0x7e, 0x55, 0xde, 0x54, 0x23, 0x71, 0xff, 0x07, 0x83, 0x50, 0x33, 0x40, 0x62, 0x60, 0x3c, 0xc4,
+	0x7d, 0xe4, 0x9d, 0xd6, 0xb1, 0xf7, 0xf2, 0xd9, 0x3e, 0x88, 0x27, 0xae, 0x63, 0xef, 0xb7, 0xd7,
+	0x4f, 0xf7, 0x34, 0xe7, 0x46, 0xd2, 0xf0, 0x3e, 0xc6, 0x0e, 0x12, 0x18, 0xb6, 0xc0, 0x8a, 0x4f,
+	0xf8, 0x38, 0x14, 0xd8, 0x3d, 0x21, 0x23, 0x9f, 0x9e, 0xe8, 0xe9, 0x6d, 0x6d, 0x77, 0xf1, 0x70,
+	0xc3, 0x50, 0x83, 0x1a, 0xc9, 0xa0, 0x46, 0x3d, 0x5e, 0xa4, 0xba, 0x1c, 0xbd, 0xfb, 0x97, 0x7f,
+	0xca, 0x9a, 0x6a, 0xb9, 0x1c, 0xf3, 0xbf, 0x91, 0x74, 0xf8, 0x08, 0x7c, 0x14, 0xa0, 0x89, 0xdb,
+	0xa3, 0xe1, 0x48, 0x9c, 0xba, 0xc9, 0xf6, 0xfa, 0xdc, 0x07, 0x76, 0x5d, 0x0d, 0xd0, 0xa4, 0x2a,
+	0x7b, 0x24, 0x88, 0x7b, 0xc5, 0x9f, 0x5f, 0x3f, 0xdd, 0x5b, 0x57, 0x27, 0x9f, 0x24, 0x47, 0x57,
+	0x52, 0xed, 0xfc, 0x99, 0x01, 0x59, 0x05, 0x87, 0x2b, 0x20, 0x4d, 0x7c, 0x29, 0x53, 0xc6, 0x49,
+	0x13, 0x1f, 0x1e, 0x82, 0x05, 0x8f, 0x61, 0x24, 0x28, 0x93, 0xab, 0xe5, 0xab, 0xfa, 0xcb, 0x67,
+	0xfb, 0x6b, 0xb1, 0x30, 0x15, 0xdf, 0x67, 0x98, 0xf3, 0xb6, 0x60, 0x64, 0xd4, 0x77, 0x12, 0x20,
+	0x3c, 0x02, 0x79, 0x7c, 0x8c, 0x86, 0xa1, 0x64, 0xcd, 0xbd, 0x83, 0xf5, 0x06, 0x0a, 0xd7, 0xc0,
+	0xbc, 0x20, 0x62, 0x88, 0xf5, 0x4c, 0xc4, 0x71, 0x54, 0x00, 0x3f, 0x06, 0x4b, 0x1e, 0x23, 0x02,
+	0x33, 0x82, 0xdc, 0x90, 0x11, 0x7d, 0x5e, 0x16, 0x17, 0x93, 0x5c, 0x97, 0x11, 0xf8, 0x3d, 0xb8,
+	0xc5, 0xf0, 0x09, 0x62, 0xbe, 0x3b, 0xc6, 0xcc, 0xf5, 0xe8, 0x48, 0x30, 0xd2, 0x0b, 0xa5, 0x72,
+	0xd9, 0x58, 0xb9, 0xf8, 0xdd, 0x91, 0x53, 0x8d, 0xd8, 0xa9, 0x46, 0x8d, 0x92, 0x51, 0x35, 0x1f,
+	0x29, 0xa7, 0x54, 0x5b, 0x57, 0x4d, 0x6c, 0xcc, 0x6a, 0x53, 0x2d, 0xe0, 0x27, 0x20, 0x92, 0x73,
+	0xa6, 0x2d, 0xd7, 0x17, 0xa4, 0x42, 0x85, 0x00, 0x4d, 0xa6, 0xb1, 0x1c, 0xde, 0x05, 0x37, 0x91,
+	0xe7, 0xe1, 0xb1, 0xc0, 0xfe, 0x25, 0x46, 0x4e, 0x32, 0xd6, 0x93, 0xea, 0x2c, 0xed, 0x2b, 0xb0,
+	0xa2, 0x4e, 0xe2, 0xf6, 0xd0, 0x10, 0x8d, 0x3c, 0xac, 0xe7, 0x3f, 0x60, 0xf0, 0x65, 0xc5, 0xad,
+	0x2a, 0x2a, 0x7c, 0x00, 0x00, 0x9e, 0x8c, 0x09, 0xc3, 0xdc, 0x45, 0x42, 0x07, 0xb2, 0x51, 0xf1,
+	0x8a, 0x77, 0x3a, 0xc9, 0xbf, 0x8e, 0x32, 0xcf, 0x93, 0x0b, 0xf3, 0xe4, 0x63, 0x72, 0x45, 0xc0,
+	0xbb, 0x20, 0xcb, 0x05, 0x12, 0x21, 0xd7, 0x17, 0xb7, 0xb5, 0xdd, 0x95, 0xc3, 0xdb, 0xc6, 0xa5,
+	0xaf, 0x82, 0xa1, 0x6c, 0xd3, 0x96, 0x20, 0x27, 0x06, 0xef, 0xfc, 0x91, 0x06, 0x4b, 0x33, 0x12,
+	0x5e, 0x76, 0xd5, 0x26, 0xc8, 0xc7, 0x16, 0x27, 0xbe, 0xf4, 0x55, 0xc6, 0xc9, 0xa9, 0x44, 0xc3,
+	0x87, 0xf7, 0xc0, 0xe2, 0x85, 0x72, 0xef, 0x61, 0xa0, 0x69, 0x70, 0xd4, 0xd8, 0x47, 0x02, 0xb9,
+	0x03, 0xc4, 0x07, 0xb1, 0x8d, 0x72, 0x51, 0xe2, 0x01, 0xe2, 0x03, 0xb8, 0x01, 0xe4, 0xf3, 0x94,
+	0x8b, 0x16, 0xa2, 0x38, 0x72, 0xd0, 0x67, 0x17, 0x8b, 0x66, 0xe5, 0xa2, 0x77, 0xae, 0x2c, 0x3a,
+	0xbd, 0xcf, 0xec, 0xba, 0xb0, 0x08, 0x72, 0xc9, 0x55, 0xa5, 0x2f, 0x72, 0xce, 0x45, 0x0c, 0x3f,
+	0x07, 0x80, 0x63, 0x21, 0x86, 0xea, 0x16, 0xb9, 0x77, 0xde, 0x22, 0x13, 0xdd, 0xc1, 0xc9, 0xc7,
+	0x9c, 0x8a, 0xd8, 0xfb, 0x51, 0x03, 0x4b, 0xd3, 0x22, 0xc3, 0xdb, 0x60, 0xa3, 0xda, 0xea, 0x36,
+	0x3b, 0xdf, 0xba, 0xed, 0x4e, 0xa5, 0xd3, 0x6d, 0xbb, 0xdd, 0x66, 0xdb, 0xb6, 0x6a, 0x8d, 0xfb,
+	0x0d, 0xab, 0x5e, 0x48, 0xc1, 0x9b, 0x00, 0xce, 0x96, 0x5b, 0xb6, 0xd5, 0x2c, 0x68, 0x70, 0x13,
+	0xdc, 0x9a, 0xcd, 0xd7, 0x5a, 0x5f, 0xdb, 0x0f, 0xad, 0x8e, 0x55, 0x2f, 0xa4, 0xe1, 0x06, 0x58,
+	0x9f, 0x2d, 0x5a, 0x8f, 0xec, 0x86, 0x63, 0xd5, 0x0b, 0x73, 0xc5, 0xcc, 0x4f, 0xbf, 0x96, 0x52,
+	0x7b, 0xff, 0x69, 0x00, 0x5e, 0x55, 0x00, 0xde, 0x01, 0xe5, 0x5a, 0xab, 0xd9, 0x71, 0x1a, 0xd5,
+	0x6e, 0xa7, 0xd1, 0x6a, 0x5e, 0x3f, 0x51, 0x19, 0x6c, 0x5e, 0x07, 0xb2, 0xad, 0x66, 0xbd, 0xd1,
+	0xfc, 0xa2, 0xa0, 0xc1, 0x6d, 0xb0, 0x75, 0x1d, 0xa0, 0x52, 0xab, 0x59, 0xb6, 0x9a, 0xef, 0x2d,
+	0x08, 0xc7, 0xfa, 0xd2, 0xaa, 0x45, 0x88, 0xb9, 0xb7, 0x21, 0xea, 0x8d, 0xb6, 0xdd, 0x8d, 0x10,
+	0x19, 0xb8, 0x05, 0xf4, 0x6b, 0xc7, 0xa8, 0x34, 0xea, 0x85, 0x79, 0xb5, 0x66, 0xd5, 0x7e, 0x7e,
+	0x56, 0xd2, 0x5e, 0x9c, 0x95, 0xb4, 0x7f, 0xcf, 0x4a, 0xda, 0x93, 0xf3, 0x52, 0xea, 0xc5, 0x79,
+	0x29, 0xf5, 0xd7, 0x79, 0x29, 0xf5, 0xdd, 0x51, 0x9f, 0x88, 0x41, 0xd8, 0x33, 0x3c, 0x1a, 0x98,
+	0xd2, 0x1a, 0x1e, 0x43, 0x62, 0xdf, 0x47, 0x54, 0x45, 0xfb, 0xc9, 0x2f, 0xc3, 0x9b, 0x6f, 0xab,
+	0x38, 0x1d, 0x63, 0xde, 0xcb, 0xca, 0xc2, 0xa7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00,
+	0x30, 0xff, 0x6d, 0x07, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxBountyDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBountyDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEscrow(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DisputeWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputeWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEscrow(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ProtocolFeeRate.Size()
+		i -= size
+		if _, err := m.ProtocolFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Bounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEscrow(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.EscrowBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.AcceptedContributions != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.AcceptedContributions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxContributions != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.MaxContributions))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.RewardPerContribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.CriteriaUri) > 0 {
+		i -= len(m.CriteriaUri)
+		copy(dAtA[i:], m.CriteriaUri)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.CriteriaUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Evaluator) > 0 {
+		i -= len(m.Evaluator)
+		copy(dAtA[i:], m.Evaluator)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Evaluator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Contribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Contribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Contribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlesAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SettlesAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SettlesAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintEscrow(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DataUri) > 0 {
+		i -= len(m.DataUri)
+		copy(dAtA[i:], m.DataUri)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.DataUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.DataHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BountyId != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.BountyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtocolFeeRate.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputeWindow)
+	n += 1 + l + sovEscrow(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBountyDuration)
+	n += 1 + l + sovEscrow(uint64(l))
+	return n
+}
+
+func (m *Bounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEscrow(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Evaluator)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.CriteriaUri)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = m.RewardPerContribution.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	if m.MaxContributions != 0 {
+		n += 1 + sovEscrow(uint64(m.MaxContributions))
+	}
+	if m.AcceptedContributions != 0 {
+		n += 1 + sovEscrow(uint64(m.AcceptedContributions))
+	}
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovEscrow(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEscrow(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *Contribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEscrow(uint64(m.Id))
+	}
+	if m.BountyId != 0 {
+		n += 1 + sovEscrow(uint64(m.BountyId))
+	}
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.DataUri)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEscrow(uint64(m.Status))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if m.SettlesAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SettlesAt)
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	return n
+}
+
+func sovEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrow(x uint64) (n int) {
+	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DisputeWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBountyDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxBountyDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bounty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bounty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bounty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evaluator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evaluator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriteriaUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CriteriaUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerContribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerContribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContributions", wireType)
+			}
+			m.MaxContributions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContributions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedContributions", wireType)
+			}
+			m.AcceptedContributions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptedContributions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BountyStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyId", wireType)
+			}
+			m.BountyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BountyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ContributionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlesAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettlesAt == nil {
+				m.SettlesAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SettlesAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEscrow = fmt.Errorf("proto: unexpected end of group")
+)
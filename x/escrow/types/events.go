@@ -0,0 +1,23 @@
+package types
+
+// escrow module event types and attributes
+const (
+	EventTypeCreateBounty        = "create_bounty"
+	EventTypeSubmitContribution  = "submit_contribution"
+	EventTypeAttestContribution  = "attest_contribution"
+	EventTypeDisputeContribution = "dispute_contribution"
+	EventTypeResolveDispute      = "resolve_dispute"
+	EventTypePayContribution     = "pay_contribution"
+	EventTypeExpireBounty        = "expire_bounty"
+
+	AttributeKeyBountyID       = "bounty_id"
+	AttributeKeyContributionID = "contribution_id"
+	AttributeKeyCreator        = "creator"
+	AttributeKeyContributor    = "contributor"
+	AttributeKeyEvaluator      = "evaluator"
+	AttributeKeyAccepted       = "accepted"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyProtocolFee    = "protocol_fee"
+	AttributeKeyRefund         = "refund"
+	AttributeKeySender         = "sender"
+)
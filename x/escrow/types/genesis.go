@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default escrow genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:             DefaultParams(),
+		NextBountyId:       1,
+		NextContributionId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	bounties := make(map[uint64]bool, len(gs.Bounties))
+	for _, b := range gs.Bounties {
+		if b.Id == 0 || b.Id >= gs.NextBountyId {
+			return fmt.Errorf("bounty id %d out of range", b.Id)
+		}
+		if bounties[b.Id] {
+			return fmt.Errorf("duplicate bounty id %d", b.Id)
+		}
+		bounties[b.Id] = true
+		if err := b.Validate(ac); err != nil {
+			return err
+		}
+	}
+
+	contributions := make(map[uint64]bool, len(gs.Contributions))
+	for _, c := range gs.Contributions {
+		if c.Id == 0 || c.Id >= gs.NextContributionId {
+			return fmt.Errorf("contribution id %d out of range", c.Id)
+		}
+		if contributions[c.Id] {
+			return fmt.Errorf("duplicate contribution id %d", c.Id)
+		}
+		contributions[c.Id] = true
+		if !bounties[c.BountyId] {
+			return fmt.Errorf("contribution %d references unknown bounty %d", c.Id, c.BountyId)
+		}
+		if _, err := ac.StringToBytes(c.Contributor); err != nil {
+			return fmt.Errorf("contribution %d: invalid contributor address: %w", c.Id, err)
+		}
+		if c.DataHash == "" {
+			return fmt.Errorf("contribution %d: empty data hash", c.Id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	ac := address.NewBech32Codec("hippo")
+	creator, err := ac.BytesToString([]byte("creator_____________"))
+	require.NoError(t, err)
+
+	bounty := types.Bounty{
+		Id:                    1,
+		Creator:               creator,
+		Evaluator:             creator,
+		Title:                 "title",
+		RewardPerContribution: sdk.NewInt64Coin("ahp", 10),
+		MaxContributions:      1,
+		EscrowBalance:         sdk.NewInt64Coin("ahp", 10),
+		Status:                types.BOUNTY_STATUS_OPEN,
+	}
+	contribution := types.Contribution{Id: 1, BountyId: 1, Contributor: creator, DataHash: "ab"}
+
+	testCases := []struct {
+		name     string
+		genesis  types.GenesisState
+		expError bool
+	}{
+		{"default", *types.DefaultGenesisState(), false},
+		{"valid", types.GenesisState{Params: types.DefaultParams(), Bounties: []types.Bounty{bounty}, Contributions: []types.Contribution{contribution}, NextBountyId: 2, NextContributionId: 2}, false},
+		{"bounty id not below next id", types.GenesisState{Params: types.DefaultParams(), Bounties: []types.Bounty{bounty}, NextBountyId: 1, NextContributionId: 1}, true},
+		{"duplicate bounty", types.GenesisState{Params: types.DefaultParams(), Bounties: []types.Bounty{bounty, bounty}, NextBountyId: 2, NextContributionId: 1}, true},
+		{"contribution of unknown bounty", types.GenesisState{Params: types.DefaultParams(), Contributions: []types.Contribution{contribution}, NextBountyId: 1, NextContributionId: 2}, true},
+		{"invalid params", types.GenesisState{NextBountyId: 1, NextContributionId: 1}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(ac)
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
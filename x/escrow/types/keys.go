@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "escrow"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey              = collections.NewPrefix(0)
+	BountySeqKey           = collections.NewPrefix(1)
+	BountiesKey            = collections.NewPrefix(2)
+	ContributionSeqKey     = collections.NewPrefix(3)
+	ContributionsKey       = collections.NewPrefix(4)
+	BountyContributionsKey = collections.NewPrefix(5)
+	SettlementQueueKey     = collections.NewPrefix(6)
+	ExpiryQueueKey         = collections.NewPrefix(7)
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DefaultDisputeWindow is the default period during which attestations can be disputed.
+	DefaultDisputeWindow = 3 * 24 * time.Hour
+	// DefaultMaxBountyDuration is the default upper bound of a bounty lifetime.
+	DefaultMaxBountyDuration = 365 * 24 * time.Hour
+)
+
+// DefaultProtocolFeeRate is the default share of payouts sent to the community pool.
+var DefaultProtocolFeeRate = math.LegacyNewDecWithPrec(2, 2) // 2%
+
+// NewParams creates a new Params instance.
+func NewParams(protocolFeeRate math.LegacyDec, disputeWindow, maxBountyDuration time.Duration) Params {
+	return Params{
+		ProtocolFeeRate:   protocolFeeRate,
+		DisputeWindow:     disputeWindow,
+		MaxBountyDuration: maxBountyDuration,
+	}
+}
+
+// DefaultParams returns the default escrow parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultProtocolFeeRate, DefaultDisputeWindow, DefaultMaxBountyDuration)
+}
+
+// Validate performs basic validation of the escrow parameters.
+func (p Params) Validate() error {
+	if p.ProtocolFeeRate.IsNil() || p.ProtocolFeeRate.IsNegative() || p.ProtocolFeeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("protocol fee rate must be between 0 and 1: %s", p.ProtocolFeeRate)
+	}
+	if p.DisputeWindow <= 0 {
+		return fmt.Errorf("dispute window must be positive: %s", p.DisputeWindow)
+	}
+	if p.MaxBountyDuration <= 0 {
+		return fmt.Errorf("max bounty duration must be positive: %s", p.MaxBountyDuration)
+	}
+	return nil
+}
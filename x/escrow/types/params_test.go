@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/escrow/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.ProtocolFeeRate = math.LegacyNewDec(2)
+	require.Error(t, params.Validate(), "fee rate above 100% should be rejected")
+
+	params = types.DefaultParams()
+	params.ProtocolFeeRate = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate(), "negative fee rate should be rejected")
+
+	params = types.DefaultParams()
+	params.DisputeWindow = 0
+	require.Error(t, params.Validate(), "zero dispute window should be rejected")
+
+	params = types.DefaultParams()
+	params.MaxBountyDuration = 0
+	require.Error(t, params.Validate(), "zero max bounty duration should be rejected")
+}
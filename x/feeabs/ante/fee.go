@@ -0,0 +1,132 @@
+package ante
+
+import (
+	"bytes"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+// DeductFeeDecorator charges fees paid in a governance approved denom. The
+// fee is converted to the native denom at the denom's TWAP to check it
+// against the minimum gas prices and to set the tx priority, and is then
+// collected in the denom it was paid in. Txs paying in any other way are
+// handed to the wrapped decorator.
+type DeductFeeDecorator struct {
+	keeper         keeper.Keeper
+	accountKeeper  ante.AccountKeeper
+	feegrantKeeper ante.FeegrantKeeper
+	fallback       sdk.AnteDecorator
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator wrapping the default
+// fee deduction.
+func NewDeductFeeDecorator(k keeper.Keeper, ak ante.AccountKeeper, fk ante.FeegrantKeeper, fallback sdk.AnteDecorator) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		keeper:         k,
+		accountKeeper:  ak,
+		feegrantKeeper: fk,
+		fallback:       fallback,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if len(fee) != 1 {
+		return d.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+	isFeeDenom, err := d.keeper.IsFeeDenom(ctx, fee[0].Denom)
+	if err != nil {
+		return ctx, err
+	}
+	if !isFeeDenom {
+		return d.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+	nativeFee, err := d.keeper.ConvertFee(ctx, fee[0])
+	if err != nil {
+		return ctx, err
+	}
+	priority, err := checkNativeFee(ctx, nativeFee, feeTx.GetGas())
+	if err != nil {
+		return ctx, err
+	}
+
+	deductFeesFrom := sdk.AccAddress(feeTx.FeePayer())
+	if granter := feeTx.FeeGranter(); granter != nil {
+		if d.feegrantKeeper == nil {
+			return ctx, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		}
+		if !bytes.Equal(granter, deductFeesFrom) {
+			if err := d.feegrantKeeper.UseGrantedFees(ctx, granter, deductFeesFrom, fee, tx.GetMsgs()); err != nil {
+				return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", sdk.AccAddress(granter), deductFeesFrom)
+			}
+		}
+		deductFeesFrom = granter
+	}
+	if d.accountKeeper.GetAccount(ctx, deductFeesFrom) == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if err := d.keeper.CollectFee(ctx, deductFeesFrom, fee); err != nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeConvertFee,
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeFee, nativeFee.String()),
+		),
+	})
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// checkNativeFee applies the default fee checker of the auth module to the
+// native value of a fee: in CheckTx it must meet the validator's minimum gas
+// prices, and the priority is the native gas price paid.
+func checkNativeFee(ctx sdk.Context, nativeFee sdk.Coin, gas uint64) (int64, error) {
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+			}
+			if !sdk.NewCoins(nativeFee).IsAnyGTE(requiredFees) {
+				return 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", nativeFee, requiredFees)
+			}
+		}
+	}
+
+	if gas == 0 {
+		return 0, nil
+	}
+	if gasPrice := nativeFee.Amount.QuoRaw(int64(gas)); gasPrice.IsInt64() {
+		return gasPrice.Int64(), nil
+	}
+	return math.MaxInt64, nil
+}
@@ -0,0 +1,90 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/ante"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+func TestDeductFeeDecorator(t *testing.T) {
+	consensus.SetWalletConfig()
+	hippo := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+	ctx := hippo.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, hippo.FeeAbsKeeper.InitGenesis(ctx, types.DefaultGenesisState()))
+
+	const usdc = "ibc/usdc"
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := keeper.NewMsgServerImpl(hippo.FeeAbsKeeper)
+	_, err := msgServer.SetFeeDenom(ctx, &types.MsgSetFeeDenom{Authority: authority, FeeDenom: types.FeeDenom{Denom: usdc, Enabled: true}})
+	require.NoError(t, err)
+	_, err = msgServer.SetPrice(ctx, &types.MsgSetPrice{Authority: authority, Denom: usdc, Price: math.LegacyNewDec(10)})
+	require.NoError(t, err)
+
+	user := sdk.AccAddress([]byte("user________________"))
+	funds := sdk.NewCoins(sdk.NewCoin(usdc, math.NewInt(1000)), sdk.NewCoin("uatom", math.NewInt(1000)))
+	require.NoError(t, hippo.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, hippo.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, user, funds))
+
+	buildTx := func(fee sdk.Coin) sdk.Tx {
+		builder := hippo.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(user, user, sdk.NewCoins(sdk.NewCoin(usdc, math.NewInt(1))))))
+		builder.SetFeeAmount(sdk.NewCoins(fee))
+		builder.SetGasLimit(100)
+		return builder.GetTx()
+	}
+	nextCalled := false
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+	decorator := ante.NewDeductFeeDecorator(hippo.FeeAbsKeeper, hippo.AccountKeeper, hippo.FeeGrantKeeper,
+		sdkante.NewDeductFeeDecorator(hippo.AccountKeeper, hippo.BankKeeper, hippo.FeeGrantKeeper, nil))
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(consensus.DefaultHippoDenom, math.NewInt(2))))
+
+	// 10 usdc is worth 100 ahp, below the 200 ahp the minimum gas price asks for
+	_, err = decorator.AnteHandle(checkCtx, buildTx(sdk.NewCoin(usdc, math.NewInt(10))), false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.False(t, nextCalled)
+
+	newCtx, err := decorator.AnteHandle(checkCtx, buildTx(sdk.NewCoin(usdc, math.NewInt(20))), false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+	require.Equal(t, int64(2), newCtx.Priority())
+	require.Equal(t, math.NewInt(980), hippo.BankKeeper.GetBalance(ctx, user, usdc).Amount)
+	require.Equal(t, math.NewInt(20), hippo.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), usdc).Amount)
+
+	// denoms that are not approved are left to the default fee checker
+	nextCalled = false
+	_, err = decorator.AnteHandle(checkCtx, buildTx(sdk.NewCoin("uatom", math.NewInt(1000))), false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.False(t, nextCalled)
+
+	_, err = decorator.AnteHandle(ctx, buildTx(sdk.NewCoin("uatom", math.NewInt(10))), false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+	require.Equal(t, math.NewInt(10), hippo.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), "uatom").Amount)
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(ctx, buildTx(sdk.NewCoin(usdc, math.NewInt(2000))), false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.False(t, nextCalled)
+}
@@ -0,0 +1,59 @@
+package feeabs
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.feeabs.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the feeabs module parameters",
+				},
+				{
+					RpcMethod:      "FeeDenom",
+					Use:            "fee-denom [denom]",
+					Short:          "Query an approved fee denom and its price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "FeeDenoms",
+					Use:       "fee-denoms",
+					Short:     "Query all approved fee denoms",
+				},
+				{
+					RpcMethod:      "ConvertFee",
+					Use:            "convert-fee [fee]",
+					Short:          "Query the native denom amount a fee is charged as",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fee"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.feeabs.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SetFeeDenom",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveFeeDenom",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetPrice",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+// EndBlocker records the prices the oracle aggregated in the block and
+// forwards the collected fees to the fee collector every forward interval
+// blocks.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.RecordOraclePrices(ctx); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if uint64(sdkCtx.BlockHeight())%params.ForwardInterval != 0 {
+		return nil
+	}
+
+	forwarded, err := k.ForwardFees(ctx)
+	if err != nil {
+		return err
+	}
+	if forwarded.IsZero() {
+		return nil
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardFees,
+			sdk.NewAttribute(types.AttributeKeyAmount, forwarded.String()),
+		),
+	)
+	return nil
+}
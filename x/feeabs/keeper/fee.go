@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+// IsFeeDenom reports whether denom is an enabled fee denom.
+func (k Keeper) IsFeeDenom(ctx context.Context, denom string) (bool, error) {
+	feeDenom, err := k.GetFeeDenom(ctx, denom)
+	if errors.Is(err, types.ErrFeeDenomNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return feeDenom.Enabled, nil
+}
+
+// ConvertFee returns the native denom amount fee is worth at the TWAP of its
+// denom. Native fees are returned as they are. Fees in a denom whose oracle
+// price is older than the max price age are rejected.
+func (k Keeper) ConvertFee(ctx context.Context, fee sdk.Coin) (sdk.Coin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if fee.Denom == params.NativeDenom {
+		return fee, nil
+	}
+
+	feeDenom, err := k.GetFeeDenom(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !feeDenom.Enabled {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeDenomDisabled, "%s", fee.Denom)
+	}
+	if err := k.checkPriceAge(ctx, feeDenom, params.MaxPriceAge); err != nil {
+		return sdk.Coin{}, err
+	}
+	_, twap, err := k.Prices(ctx, feeDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(params.NativeDenom, twap.MulInt(fee.Amount).TruncateInt()), nil
+}
+
+// CollectFee takes a fee paid in approved denoms from payer. The fee is held
+// by the module account until the next forward to the fee collector.
+func (k Keeper) CollectFee(ctx context.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	if !fee.IsValid() {
+		return errorsmod.Wrapf(types.ErrInvalidFee, "%s", fee)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee); err != nil {
+		return errorsmod.Wrap(err, "failed to collect fee")
+	}
+	return nil
+}
+
+// ForwardFees sends all fees collected since the last forward to the fee
+// collector, which distributes them like native fees, and returns the amount
+// forwarded.
+func (k Keeper) ForwardFees(ctx context.Context) (sdk.Coins, error) {
+	collected := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	if collected.IsZero() {
+		return nil, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, collected); err != nil {
+		return nil, err
+	}
+	return collected, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+// InitGenesis initializes the feeabs module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, feeDenom := range gs.FeeDenoms {
+		if err := k.FeeDenoms.Set(ctx, feeDenom.Denom, feeDenom); err != nil {
+			return err
+		}
+	}
+
+	for _, o := range gs.Observations {
+		if err := k.Observations.Set(ctx, collections.Join(o.Denom, o.Time), o.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the feeabs module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+
+	if err := k.FeeDenoms.Walk(ctx, nil, func(_ string, feeDenom types.FeeDenom) (bool, error) {
+		gs.FeeDenoms = append(gs.FeeDenoms, feeDenom)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Observations.Walk(ctx, nil, func(key collections.Pair[string, time.Time], price math.LegacyDec) (bool, error) {
+		gs.Observations = append(gs.Observations, types.PriceObservation{Denom: key.K1(), Time: key.K2(), Price: price})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
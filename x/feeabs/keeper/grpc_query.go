@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the feeabs QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// FeeDenom implements types.QueryServer.
+func (k queryServer) FeeDenom(ctx context.Context, req *types.QueryFeeDenomRequest) (*types.QueryFeeDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	feeDenom, err := k.GetFeeDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	latest, twap, err := k.Prices(ctx, feeDenom)
+	if errors.Is(err, types.ErrNoPrice) {
+		latest, twap = math.LegacyZeroDec(), math.LegacyZeroDec()
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeDenomResponse{FeeDenom: feeDenom, Price: latest, Twap: twap}, nil
+}
+
+// FeeDenoms implements types.QueryServer.
+func (k queryServer) FeeDenoms(ctx context.Context, req *types.QueryFeeDenomsRequest) (*types.QueryFeeDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	feeDenoms, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.FeeDenoms, req.Pagination,
+		func(_ string, feeDenom types.FeeDenom) (types.FeeDenom, error) {
+			return feeDenom, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFeeDenomsResponse{FeeDenoms: feeDenoms, Pagination: pageRes}, nil
+}
+
+// ConvertFee implements types.QueryServer.
+func (k queryServer) ConvertFee(ctx context.Context, req *types.QueryConvertFeeRequest) (*types.QueryConvertFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	fee, err := sdk.ParseCoinNormalized(req.Fee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	nativeFee, err := k.Keeper.ConvertFee(ctx, fee)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &types.QueryConvertFeeResponse{NativeFee: nativeFee}, nil
+}
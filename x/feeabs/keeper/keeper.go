@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+// Keeper manages the denoms governance approved for paying fees and their
+// price history.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	oracleKeeper  types.OracleKeeper
+
+	// the address capable of executing authority msgs, typically the x/gov
+	// module account.
+	authority string
+
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	FeeDenoms collections.Map[string, types.FeeDenom]
+	// Observations holds the price of each fee denom keyed by (denom, time).
+	Observations collections.Map[collections.Pair[string, time.Time], math.LegacyDec]
+}
+
+// NewKeeper creates a new feeabs Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	oracleKeeper types.OracleKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid feeabs authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		oracleKeeper:  oracleKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeeDenoms:     collections.NewMap(sb, types.FeeDenomsKey, "fee_denoms", collections.StringKey, codec.CollValue[types.FeeDenom](cdc)),
+		Observations: collections.NewMap(sb, types.ObservationsKey, "observations",
+			collections.PairKeyCodec(collections.StringKey, sdk.TimeKey), sdk.LegacyDecValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.FeeAbsKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.FeeAbsKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.FeeAbsKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, err := s.msgServer.SetFeeDenom(s.ctx, &types.MsgSetFeeDenom{
+		Authority: s.authority,
+		FeeDenom:  types.FeeDenom{Denom: ibcDenom, Enabled: true, TwapWindow: time.Hour},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) setPrice(price string) {
+	_, err := s.msgServer.SetPrice(s.ctx, &types.MsgSetPrice{
+		Authority: s.authority,
+		Denom:     ibcDenom,
+		Price:     math.LegacyMustNewDecFromStr(price),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) advance(d time.Duration) {
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(d))
+}
+
+func (s *KeeperTestSuite) TestSetFeeDenom() {
+	_, err := s.msgServer.SetFeeDenom(s.ctx, &types.MsgSetFeeDenom{
+		Authority: sdk.AccAddress([]byte("user________________")).String(),
+		FeeDenom:  types.FeeDenom{Denom: "uusdc"},
+	})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.SetFeeDenom(s.ctx, &types.MsgSetFeeDenom{
+		Authority: s.authority,
+		FeeDenom:  types.FeeDenom{Denom: consensus.DefaultHippoDenom, Enabled: true},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidFeeDenom)
+
+	_, err = s.msgServer.SetFeeDenom(s.ctx, &types.MsgSetFeeDenom{
+		Authority: s.authority,
+		FeeDenom:  types.FeeDenom{Denom: "uusdc", TwapWindow: -time.Second},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidFeeDenom)
+
+	_, err = s.msgServer.SetPrice(s.ctx, &types.MsgSetPrice{Authority: s.authority, Denom: "uusdc", Price: math.LegacyOneDec()})
+	s.Require().ErrorIs(err, types.ErrFeeDenomNotFound)
+
+	_, err = s.msgServer.SetPrice(s.ctx, &types.MsgSetPrice{Authority: s.authority, Denom: ibcDenom, Price: math.LegacyZeroDec()})
+	s.Require().ErrorIs(err, types.ErrInvalidPrice)
+
+	// the native denom cannot be switched to an approved fee denom
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(ibcDenom, 10, 0)})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+}
+
+func (s *KeeperTestSuite) TestConvertFee() {
+	fee := sdk.NewCoin(ibcDenom, math.NewInt(100))
+
+	_, err := s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().ErrorIs(err, types.ErrNoPrice)
+
+	// a single price applies as it is
+	s.setPrice("2")
+	native, err := s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(200)), native)
+
+	// the price is averaged over the window: 2 for 45 minutes, 6 for 15
+	s.advance(45 * time.Minute)
+	s.setPrice("6")
+	s.advance(15 * time.Minute)
+	native, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(300)), native)
+
+	res, err := s.queryServer.FeeDenom(s.ctx, &types.QueryFeeDenomRequest{Denom: ibcDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(6), res.Price)
+	s.Require().Equal(math.LegacyNewDec(3), res.Twap)
+
+	// once the first price leaves the window only the second one counts
+	s.advance(time.Hour)
+	native, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(600)), native)
+
+	converted, err := s.queryServer.ConvertFee(s.ctx, &types.QueryConvertFeeRequest{Fee: fee.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(native, converted.NativeFee)
+
+	// native fees are not converted
+	native, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(5)))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(5), native.Amount)
+
+	_, err = s.msgServer.SetFeeDenom(s.ctx, &types.MsgSetFeeDenom{
+		Authority: s.authority,
+		FeeDenom:  types.FeeDenom{Denom: ibcDenom, Enabled: false, TwapWindow: time.Hour},
+	})
+	s.Require().NoError(err)
+	_, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().ErrorIs(err, types.ErrFeeDenomDisabled)
+}
+
+func (s *KeeperTestSuite) TestOraclePrices() {
+	const usdc = "uusdc"
+	fee := sdk.NewCoin(usdc, math.NewInt(100))
+	_, err := s.msgServer.SetFeeDenom(s.ctx, &types.MsgSetFeeDenom{
+		Authority: s.authority,
+		FeeDenom:  types.FeeDenom{Denom: usdc, Enabled: true, OracleAsset: "usdc"},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidFeeDenom)
+	_, err = s.msgServer.SetFeeDenom(s.ctx, &types.MsgSetFeeDenom{
+		Authority: s.authority,
+		FeeDenom:  types.FeeDenom{Denom: usdc, Enabled: true, OracleAsset: "USDC/HP"},
+	})
+	s.Require().NoError(err)
+	setOraclePrice := func(price int64) {
+		s.Require().NoError(s.app.OracleKeeper.Prices.Set(s.ctx, "USDC/HP", oracletypes.Price{
+			Asset:       "USDC/HP",
+			Price:       math.LegacyNewDec(price),
+			BlockHeight: s.ctx.BlockHeight(),
+			BlockTime:   s.ctx.BlockTime(),
+		}))
+	}
+	nextBlock := func(d time.Duration) {
+		s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+		s.advance(d)
+	}
+
+	s.Require().NoError(s.app.FeeAbsKeeper.EndBlocker(s.ctx))
+	_, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().ErrorIs(err, types.ErrNoPrice)
+
+	setOraclePrice(2)
+	s.Require().NoError(s.app.FeeAbsKeeper.EndBlocker(s.ctx))
+	native, err := s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(200)), native)
+
+	// a price the oracle did not update is not recorded again and ages
+	nextBlock(types.DefaultMaxPriceAge)
+	s.Require().NoError(s.app.FeeAbsKeeper.EndBlocker(s.ctx))
+	observations, err := s.app.FeeAbsKeeper.GetObservations(s.ctx, usdc)
+	s.Require().NoError(err)
+	s.Require().Len(observations, 1)
+	_, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().NoError(err)
+
+	nextBlock(time.Second)
+	_, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().ErrorIs(err, types.ErrStalePrice)
+
+	setOraclePrice(3)
+	s.Require().NoError(s.app.FeeAbsKeeper.EndBlocker(s.ctx))
+	native, err = s.app.FeeAbsKeeper.ConvertFee(s.ctx, fee)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(300)), native)
+}
+
+func (s *KeeperTestSuite) TestPruneObservations() {
+	for i := 0; i < 5; i++ {
+		s.setPrice("1")
+		s.advance(30 * time.Minute)
+	}
+	s.setPrice("1")
+
+	// only the prices within the window and the one in effect at its start
+	// are kept
+	observations, err := s.app.FeeAbsKeeper.GetObservations(s.ctx, ibcDenom)
+	s.Require().NoError(err)
+	s.Require().Len(observations, 3)
+
+	_, err = s.msgServer.RemoveFeeDenom(s.ctx, &types.MsgRemoveFeeDenom{Authority: s.authority, Denom: ibcDenom})
+	s.Require().NoError(err)
+	observations, err = s.app.FeeAbsKeeper.GetObservations(s.ctx, ibcDenom)
+	s.Require().NoError(err)
+	s.Require().Empty(observations)
+
+	_, err = s.msgServer.RemoveFeeDenom(s.ctx, &types.MsgRemoveFeeDenom{Authority: s.authority, Denom: ibcDenom})
+	s.Require().ErrorIs(err, types.ErrFeeDenomNotFound)
+}
+
+func (s *KeeperTestSuite) TestForwardFees() {
+	payer := sdk.AccAddress([]byte("payer_______________"))
+	fee := sdk.NewCoins(sdk.NewCoin(ibcDenom, math.NewInt(100)))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, fee))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, payer, fee))
+	s.Require().NoError(s.app.FeeAbsKeeper.CollectFee(s.ctx, payer, fee))
+
+	collector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	s.Require().Equal(fee, s.app.BankKeeper.GetAllBalances(s.ctx, moduleAddr))
+
+	// fees are held until the forward interval
+	s.Require().NoError(s.app.FeeAbsKeeper.EndBlocker(s.ctx))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, collector).IsZero())
+
+	s.ctx = s.ctx.WithBlockHeight(int64(types.DefaultForwardInterval))
+	s.Require().NoError(s.app.FeeAbsKeeper.EndBlocker(s.ctx))
+	s.Require().Equal(fee, s.app.BankKeeper.GetAllBalances(s.ctx, collector))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, moduleAddr).IsZero())
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	s.setPrice("2")
+	s.advance(time.Minute)
+	s.setPrice("3")
+
+	gs, err := s.app.FeeAbsKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.FeeDenoms, 1)
+	s.Require().Len(gs.Observations, 2)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.FeeAbsKeeper.InitGenesis(s.ctx, gs))
+	exported, err := s.app.FeeAbsKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
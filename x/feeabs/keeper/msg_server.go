@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the feeabs MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SetFeeDenom implements types.MsgServer.
+func (k msgServer) SetFeeDenom(goCtx context.Context, msg *types.MsgSetFeeDenom) (*types.MsgSetFeeDenomResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.FeeDenom.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidFeeDenom, err.Error())
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.FeeDenom.Denom == params.NativeDenom {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "%s is the native denom", msg.FeeDenom.Denom)
+	}
+
+	if err := k.FeeDenoms.Set(ctx, msg.FeeDenom.Denom, msg.FeeDenom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.FeeDenom.Denom),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.FeeDenom.Enabled)),
+		),
+	)
+
+	return &types.MsgSetFeeDenomResponse{}, nil
+}
+
+// RemoveFeeDenom implements types.MsgServer.
+func (k msgServer) RemoveFeeDenom(goCtx context.Context, msg *types.MsgRemoveFeeDenom) (*types.MsgRemoveFeeDenomResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetFeeDenom(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.RemoveFeeDenom(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveFeeDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	)
+
+	return &types.MsgRemoveFeeDenomResponse{}, nil
+}
+
+// SetPrice implements types.MsgServer.
+func (k msgServer) SetPrice(goCtx context.Context, msg *types.MsgSetPrice) (*types.MsgSetPriceResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.RecordPrice(ctx, msg.Denom, msg.Price); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPrice,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		),
+	)
+
+	return &types.MsgSetPriceResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if _, err := k.GetFeeDenom(goCtx, msg.Params.NativeDenom); err == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidParams, "native denom %s is a fee denom", msg.Params.NativeDenom)
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) checkAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
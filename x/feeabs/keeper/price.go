@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// GetFeeDenom returns the settings of an approved fee denom.
+func (k Keeper) GetFeeDenom(ctx context.Context, denom string) (types.FeeDenom, error) {
+	feeDenom, err := k.FeeDenoms.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FeeDenom{}, errorsmod.Wrapf(types.ErrFeeDenomNotFound, "%s", denom)
+	}
+	return feeDenom, err
+}
+
+// RemoveFeeDenom deletes a fee denom together with its price history.
+func (k Keeper) RemoveFeeDenom(ctx context.Context, denom string) error {
+	if err := k.FeeDenoms.Remove(ctx, denom); err != nil {
+		return err
+	}
+	return k.Observations.Clear(ctx, collections.NewPrefixedPairRange[string, time.Time](denom))
+}
+
+// RecordPrice records the price of a fee denom at the current block time.
+// Governance sets prices through MsgSetPrice; the oracle feeds them at the end
+// of every block. Observations no longer needed for the TWAP are pruned.
+func (k Keeper) RecordPrice(ctx context.Context, denom string, price math.LegacyDec) error {
+	feeDenom, err := k.GetFeeDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if err := types.ValidatePrice(price); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPrice, err.Error())
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if err := k.Observations.Set(ctx, collections.Join(denom, now), price); err != nil {
+		return err
+	}
+	return k.pruneObservations(ctx, denom, now.Add(-feeDenom.TwapWindow))
+}
+
+// RecordOraclePrices records the price of every fee denom priced by an oracle
+// asset the oracle aggregated a new price for in the current block. Prices the
+// oracle did not update are not recorded again, so they age.
+func (k Keeper) RecordOraclePrices(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	iter, err := k.FeeDenoms.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	feeDenoms, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, feeDenom := range feeDenoms {
+		if feeDenom.OracleAsset == "" {
+			continue
+		}
+		price, err := k.oracleKeeper.GetPrice(ctx, feeDenom.OracleAsset)
+		if errors.Is(err, oracletypes.ErrPriceNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if price.BlockHeight != height {
+			continue
+		}
+		if err := k.RecordPrice(ctx, feeDenom.Denom, price.Price); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPriceAge fails when feeDenom is priced by the oracle and its latest
+// price is older than the max price age.
+func (k Keeper) checkPriceAge(ctx context.Context, feeDenom types.FeeDenom, maxPriceAge time.Duration) error {
+	if feeDenom.OracleAsset == "" || maxPriceAge == 0 {
+		return nil
+	}
+	iter, err := k.Observations.Iterate(ctx, collections.NewPrefixedPairRange[string, time.Time](feeDenom.Denom).Descending())
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return errorsmod.Wrapf(types.ErrNoPrice, "%s", feeDenom.Denom)
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return err
+	}
+
+	if age := sdk.UnwrapSDKContext(ctx).BlockTime().Sub(key.K2()); age > maxPriceAge {
+		return errorsmod.Wrapf(types.ErrStalePrice, "price of %s is %s old, max %s", feeDenom.Denom, age, maxPriceAge)
+	}
+	return nil
+}
+
+// pruneObservations removes the observations of denom at or before cutoff
+// except the last one, which is the price in effect at cutoff.
+func (k Keeper) pruneObservations(ctx context.Context, denom string, cutoff time.Time) error {
+	iter, err := k.Observations.Iterate(ctx, collections.NewPrefixedPairRange[string, time.Time](denom).EndInclusive(cutoff))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(keys)-1; i++ {
+		if err := k.Observations.Remove(ctx, keys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetObservations returns the recorded prices of denom, oldest first.
+func (k Keeper) GetObservations(ctx context.Context, denom string) ([]types.PriceObservation, error) {
+	iter, err := k.Observations.Iterate(ctx, collections.NewPrefixedPairRange[string, time.Time](denom))
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	observations := make([]types.PriceObservation, len(kvs))
+	for i, kv := range kvs {
+		observations[i] = types.PriceObservation{Denom: denom, Time: kv.Key.K2(), Price: kv.Value}
+	}
+	return observations, nil
+}
+
+// Prices returns the latest price of a fee denom and its time weighted
+// average over the denom's window ending at the current block time.
+func (k Keeper) Prices(ctx context.Context, feeDenom types.FeeDenom) (latest, twap math.LegacyDec, err error) {
+	observations, err := k.GetObservations(ctx, feeDenom.Denom)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	twap, ok := types.TWAP(observations, now.Add(-feeDenom.TwapWindow), now)
+	if !ok {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrapf(types.ErrNoPrice, "%s", feeDenom.Denom)
+	}
+	return observations[len(observations)-1].Price, twap, nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the feeabs messages on the amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgSetFeeDenom{}, "hippo/x/feeabs/MsgSetFeeDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFeeDenom{}, "hippo/x/feeabs/MsgRemoveFeeDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPrice{}, "hippo/x/feeabs/MsgSetPrice")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/feeabs/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/feeabs/Params", nil)
+}
+
+// RegisterInterfaces registers the feeabs messages on the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetFeeDenom{},
+		&MsgRemoveFeeDenom{},
+		&MsgSetPrice{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,15 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/feeabs module sentinel errors
+var (
+	ErrInvalidFeeDenom  = errorsmod.Register(ModuleName, 2, "invalid fee denom")
+	ErrFeeDenomNotFound = errorsmod.Register(ModuleName, 3, "fee denom not found")
+	ErrFeeDenomDisabled = errorsmod.Register(ModuleName, 4, "fee denom disabled")
+	ErrInvalidPrice     = errorsmod.Register(ModuleName, 5, "invalid price")
+	ErrNoPrice          = errorsmod.Register(ModuleName, 6, "no price recorded")
+	ErrInvalidFee       = errorsmod.Register(ModuleName, 7, "invalid fee")
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 8, "invalid params")
+	ErrStalePrice       = errorsmod.Register(ModuleName, 9, "stale price")
+)
@@ -0,0 +1,17 @@
+package types
+
+// feeabs module event types and attributes
+const (
+	EventTypeSetFeeDenom    = "set_fee_denom"
+	EventTypeRemoveFeeDenom = "remove_fee_denom"
+	EventTypeSetPrice       = "set_fee_denom_price"
+	EventTypeConvertFee     = "convert_fee"
+	EventTypeForwardFees    = "forward_fees"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyEnabled   = "enabled"
+	AttributeKeyPrice     = "price"
+	AttributeKeyFee       = "fee"
+	AttributeKeyNativeFee = "native_fee"
+	AttributeKeyAmount    = "amount"
+)
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// Validate performs stateless validation of a fee denom.
+func (d FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+	if d.TwapWindow < 0 {
+		return fmt.Errorf("twap window cannot be negative: %s", d.TwapWindow)
+	}
+	if d.OracleAsset != "" {
+		if err := oracletypes.ValidateAsset(d.OracleAsset); err != nil {
+			return fmt.Errorf("invalid oracle asset: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidatePrice checks that price is a usable conversion rate.
+func ValidatePrice(price math.LegacyDec) error {
+	if price.IsNil() || !price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", price)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a price observation.
+func (o PriceObservation) Validate() error {
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return err
+	}
+	return ValidatePrice(o.Price)
+}
+
+// TWAP returns the time weighted average of the prices in effect during
+// [start, end]. observations must be sorted by time; the price of the last
+// observation at or before start is in effect until the next one. Prices
+// recorded after end are ignored. The second return value is false when no
+// price was in effect during the period.
+func TWAP(observations []PriceObservation, start, end time.Time) (math.LegacyDec, bool) {
+	var (
+		sum      = math.LegacyZeroDec()
+		total    time.Duration
+		last     *PriceObservation
+		lastFrom time.Time
+	)
+	for i := range observations {
+		o := &observations[i]
+		if o.Time.After(end) {
+			break
+		}
+		from := o.Time
+		if from.Before(start) {
+			from = start
+		}
+		if last != nil && from.After(lastFrom) {
+			sum = sum.Add(last.Price.MulInt64(int64(from.Sub(lastFrom))))
+			total += from.Sub(lastFrom)
+		}
+		last, lastFrom = o, from
+	}
+	if last == nil {
+		return math.LegacyDec{}, false
+	}
+	if end.After(lastFrom) {
+		sum = sum.Add(last.Price.MulInt64(int64(end.Sub(lastFrom))))
+		total += end.Sub(lastFrom)
+	}
+	if total == 0 {
+		return last.Price, true
+	}
+	return sum.QuoInt64(int64(total)), true
+}
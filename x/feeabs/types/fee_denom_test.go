@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+func TestTWAP(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration, price int64) types.PriceObservation {
+		return types.PriceObservation{Denom: "uusdc", Time: start.Add(d), Price: math.LegacyNewDec(price)}
+	}
+
+	testCases := []struct {
+		name         string
+		observations []types.PriceObservation
+		start, end   time.Duration
+		exp          int64
+		ok           bool
+	}{
+		{"no observations", nil, 0, time.Hour, 0, false},
+		{"only future observations", []types.PriceObservation{at(2*time.Hour, 1)}, 0, time.Hour, 0, false},
+		{"single observation", []types.PriceObservation{at(0, 4)}, 0, time.Hour, 4, true},
+		{"observation before the window", []types.PriceObservation{at(-time.Hour, 4)}, 0, time.Hour, 4, true},
+		{"weighted", []types.PriceObservation{at(-time.Hour, 2), at(45*time.Minute, 6)}, 0, time.Hour, 3, true},
+		{"observation inside window only", []types.PriceObservation{at(30*time.Minute, 8)}, 0, time.Hour, 8, true},
+		{"zero window", []types.PriceObservation{at(0, 2), at(time.Hour, 5)}, time.Hour, time.Hour, 5, true},
+		{"later observations ignored", []types.PriceObservation{at(0, 2), at(2*time.Hour, 5)}, 0, time.Hour, 2, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, ok := types.TWAP(tc.observations, start.Add(tc.start), start.Add(tc.end))
+			require.Equal(t, tc.ok, ok)
+			if tc.ok {
+				require.Equal(t, math.LegacyNewDec(tc.exp), twap)
+			}
+		})
+	}
+}
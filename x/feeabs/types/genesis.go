@@ -0,0 +1,52 @@
+package types
+
+import "fmt"
+
+// DefaultGenesisState returns the default feeabs genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool, len(gs.FeeDenoms))
+	for _, d := range gs.FeeDenoms {
+		if denoms[d.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", d.Denom)
+		}
+		denoms[d.Denom] = true
+		if d.Denom == gs.Params.NativeDenom {
+			return fmt.Errorf("fee denom %s is the native denom", d.Denom)
+		}
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("fee denom %s: %w", d.Denom, err)
+		}
+	}
+
+	type key struct {
+		denom string
+		time  int64
+	}
+	observations := make(map[key]bool, len(gs.Observations))
+	for _, o := range gs.Observations {
+		k := key{o.Denom, o.Time.UnixNano()}
+		if observations[k] {
+			return fmt.Errorf("duplicate price of %s at %s", o.Denom, o.Time)
+		}
+		observations[k] = true
+		if !denoms[o.Denom] {
+			return fmt.Errorf("price of unknown fee denom %s", o.Denom)
+		}
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("price of %s at %s: %w", o.Denom, o.Time, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeabs/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	feeDenom := types.FeeDenom{Denom: "uusdc", Enabled: true, TwapWindow: time.Hour}
+	observation := types.PriceObservation{Denom: "uusdc", Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Price: math.LegacyOneDec()}
+	withObservation := func(malleate func(o *types.PriceObservation)) types.GenesisState {
+		o := observation
+		malleate(&o)
+		return types.GenesisState{
+			Params:       types.DefaultParams(),
+			FeeDenoms:    []types.FeeDenom{feeDenom},
+			Observations: []types.PriceObservation{o},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", withObservation(func(*types.PriceObservation) {}), ""},
+		{"invalid native denom", types.GenesisState{Params: types.NewParams("", 1, 0)}, "native denom"},
+		{"zero forward interval", types.GenesisState{Params: types.NewParams("ahp", 0, 0)}, "forward interval"},
+		{"negative max price age", types.GenesisState{Params: types.NewParams("ahp", 1, -time.Minute)}, "max price age"},
+		{"duplicate fee denom", types.GenesisState{
+			Params:    types.DefaultParams(),
+			FeeDenoms: []types.FeeDenom{feeDenom, feeDenom},
+		}, "duplicate fee denom"},
+		{"native fee denom", types.GenesisState{
+			Params:    types.DefaultParams(),
+			FeeDenoms: []types.FeeDenom{{Denom: types.DefaultParams().NativeDenom}},
+		}, "is the native denom"},
+		{"negative twap window", types.GenesisState{
+			Params:    types.DefaultParams(),
+			FeeDenoms: []types.FeeDenom{{Denom: "uusdc", TwapWindow: -time.Hour}},
+		}, "twap window"},
+		{"invalid oracle asset", types.GenesisState{
+			Params:    types.DefaultParams(),
+			FeeDenoms: []types.FeeDenom{{Denom: "uusdc", OracleAsset: "usdc"}},
+		}, "oracle asset"},
+		{"duplicate observation", types.GenesisState{
+			Params:       types.DefaultParams(),
+			FeeDenoms:    []types.FeeDenom{feeDenom},
+			Observations: []types.PriceObservation{observation, observation},
+		}, "duplicate price"},
+		{"unknown denom", withObservation(func(o *types.PriceObservation) { o.Denom = "uatom" }), "unknown fee denom"},
+		{"zero price", withObservation(func(o *types.PriceObservation) { o.Price = math.LegacyZeroDec() }), "price must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+		})
+	}
+}
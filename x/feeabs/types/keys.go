@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeabs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey       = collections.NewPrefix(0)
+	FeeDenomsKey    = collections.NewPrefix(1)
+	ObservationsKey = collections.NewPrefix(2)
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+const (
+	// DefaultForwardInterval forwards collected fees about every ten minutes.
+	DefaultForwardInterval uint64 = 100
+	// DefaultMaxPriceAge rejects fees in denoms the oracle has not priced for
+	// about a hundred blocks.
+	DefaultMaxPriceAge = 10 * time.Minute
+)
+
+// NewParams creates a new Params instance.
+func NewParams(nativeDenom string, forwardInterval uint64, maxPriceAge time.Duration) Params {
+	return Params{
+		NativeDenom:     nativeDenom,
+		ForwardInterval: forwardInterval,
+		MaxPriceAge:     maxPriceAge,
+	}
+}
+
+// DefaultParams returns the default feeabs parameters.
+func DefaultParams() Params {
+	return NewParams(consensus.DefaultHippoDenom, DefaultForwardInterval, DefaultMaxPriceAge)
+}
+
+// Validate performs basic validation of the feeabs parameters.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.NativeDenom); err != nil {
+		return fmt.Errorf("invalid native denom: %w", err)
+	}
+	if p.ForwardInterval == 0 {
+		return fmt.Errorf("forward interval must be positive")
+	}
+	if p.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age cannot be negative: %s", p.MaxPriceAge)
+	}
+	return nil
+}
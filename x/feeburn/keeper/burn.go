@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+)
+
+// BurnFees burns the burn rate share of the fees collected in the bond denom.
+// It runs before x/mint adds the block provision to the fee collector, so
+// only fees are burned.
+func (k Keeper) BurnFees(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.BurnRate.IsZero() {
+		return nil
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	fees := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(k.feeCollectorName), denom).Amount
+	amount := params.BurnRate.MulInt(fees).TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	burned, err := k.GetBurned(ctx)
+	if err != nil {
+		return err
+	}
+	burned = burned.Add(amount)
+	if err := k.Burned.Set(ctx, burned); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFees,
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalBurned, sdk.NewCoin(denom, burned).String()),
+		),
+	)
+	return nil
+}
+
+// GetBurned returns the cumulative amount of fees burned.
+func (k Keeper) GetBurned(ctx context.Context) (math.Int, error) {
+	burned, err := k.Burned.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return burned, err
+}
+
+// CirculatingSupply returns the total supply of the bond denom, the part of it
+// held by the community pool and the circulating rest.
+func (k Keeper) CirculatingSupply(ctx context.Context) (total, communityPool, circulating sdk.Coin, err error) {
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	res, err := k.distrKeeper.CommunityPool(ctx, &distrtypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	total = k.bankKeeper.GetSupply(ctx, denom)
+	communityPool = sdk.NewCoin(denom, res.Pool.AmountOf(denom).TruncateInt())
+	circulating = sdk.NewCoin(denom, math.MaxInt(total.Amount.Sub(communityPool.Amount), math.ZeroInt()))
+	return total, communityPool, circulating, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+)
+
+// InitGenesis initializes the feeburn module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	return k.Burned.Set(ctx, gs.Burned)
+}
+
+// ExportGenesis exports the feeburn module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	burned, err := k.GetBurned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{Params: params, Burned: burned}, nil
+}
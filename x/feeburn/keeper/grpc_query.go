@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the feeburn QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Burned implements types.QueryServer.
+func (k queryServer) Burned(ctx context.Context, _ *types.QueryBurnedRequest) (*types.QueryBurnedResponse, error) {
+	burned, err := k.burnedCoin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBurnedResponse{Burned: burned}, nil
+}
+
+// CirculatingSupply implements types.QueryServer.
+func (k queryServer) CirculatingSupply(ctx context.Context, _ *types.QueryCirculatingSupplyRequest) (*types.QueryCirculatingSupplyResponse, error) {
+	total, communityPool, circulating, err := k.Keeper.CirculatingSupply(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	burned, err := k.burnedCoin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryCirculatingSupplyResponse{
+		TotalSupply:       total,
+		CommunityPool:     communityPool,
+		Burned:            burned,
+		CirculatingSupply: circulating,
+	}, nil
+}
+
+func (k queryServer) burnedCoin(ctx context.Context) (sdk.Coin, error) {
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	burned, err := k.GetBurned(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, burned), nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeburn/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+)
+
+var user = sdk.AccAddress([]byte("user________________"))
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.FeeBurnKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+	s.Require().NoError(s.app.DistrKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()))
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = consensus.DefaultHippoDenom
+	s.Require().NoError(s.app.StakingKeeper.SetParams(s.ctx, stakingParams))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.FeeBurnKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.FeeBurnKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func coins(denom string, amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+}
+
+// collectFees mints coins into the fee collector, as the ante handler does
+// when it deducts fees.
+func (s *KeeperTestSuite) collectFees(fees sdk.Coins) {
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, fees))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+}
+
+func (s *KeeperTestSuite) feeCollectorBalance(denom string) math.Int {
+	return s.app.BankKeeper.GetBalance(s.ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), denom).Amount
+}
+
+func (s *KeeperTestSuite) setBurnRate(rate math.LegacyDec) {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(rate)})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestBurnFees() {
+	denom := consensus.DefaultHippoDenom
+	s.collectFees(coins(denom, 1000).Add(sdk.NewInt64Coin("uatom", 1000)))
+
+	// nothing is burned by default
+	s.Require().NoError(s.app.FeeBurnKeeper.BurnFees(s.ctx))
+	s.Require().Equal(math.NewInt(1000), s.feeCollectorBalance(denom))
+
+	s.setBurnRate(math.LegacyNewDecWithPrec(25, 2))
+	s.Require().NoError(s.app.FeeBurnKeeper.BurnFees(s.ctx))
+	s.Require().Equal(math.NewInt(750), s.feeCollectorBalance(denom))
+	s.Require().Equal(math.NewInt(1000), s.feeCollectorBalance("uatom"))
+	s.Require().Equal(math.NewInt(750), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount)
+
+	var burnEvent bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBurnFees {
+			burnEvent = true
+		}
+	}
+	s.Require().True(burnEvent)
+
+	// the burned amount accumulates
+	s.Require().NoError(s.app.FeeBurnKeeper.BurnFees(s.ctx))
+	s.Require().Equal(math.NewInt(563), s.feeCollectorBalance(denom))
+
+	res, err := s.queryServer.Burned(s.ctx, &types.QueryBurnedRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 437), res.Burned)
+}
+
+func (s *KeeperTestSuite) TestBurnFeesRoundsDown() {
+	s.setBurnRate(math.LegacyNewDecWithPrec(5, 1))
+	s.collectFees(coins(consensus.DefaultHippoDenom, 1))
+
+	s.Require().NoError(s.app.FeeBurnKeeper.BurnFees(s.ctx))
+	s.Require().Equal(math.OneInt(), s.feeCollectorBalance(consensus.DefaultHippoDenom))
+
+	burned, err := s.app.FeeBurnKeeper.GetBurned(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(burned.IsZero())
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: user.String(), Params: types.DefaultParams()})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(math.LegacyNewDec(2))})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	s.setBurnRate(math.LegacyNewDecWithPrec(1, 1))
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 1), res.Params.BurnRate)
+}
+
+func (s *KeeperTestSuite) TestCirculatingSupply() {
+	denom := consensus.DefaultHippoDenom
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins(denom, 10_000)))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, user, coins(denom, 10_000)))
+	s.Require().NoError(s.app.DistrKeeper.FundCommunityPool(s.ctx, coins(denom, 3000), user))
+
+	s.setBurnRate(math.LegacyOneDec())
+	s.collectFees(coins(denom, 500))
+	s.Require().NoError(s.app.FeeBurnKeeper.BurnFees(s.ctx))
+
+	res, err := s.queryServer.CirculatingSupply(s.ctx, &types.QueryCirculatingSupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 10_000), res.TotalSupply)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 3000), res.CommunityPool)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 500), res.Burned)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 7000), res.CirculatingSupply)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.setBurnRate(math.LegacyNewDecWithPrec(5, 1))
+	s.collectFees(coins(consensus.DefaultHippoDenom, 100))
+	s.Require().NoError(s.app.FeeBurnKeeper.BurnFees(s.ctx))
+
+	gs, err := s.app.FeeBurnKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal(math.NewInt(50), gs.Burned)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.FeeBurnKeeper.InitGenesis(s.ctx, gs))
+	exported, err := s.app.FeeBurnKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
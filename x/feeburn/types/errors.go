@@ -0,0 +1,8 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/feeburn module sentinel errors
+var (
+	ErrInvalidParams = errorsmod.Register(ModuleName, 2, "invalid params")
+)
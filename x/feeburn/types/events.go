@@ -0,0 +1,9 @@
+package types
+
+// feeburn module event types
+const (
+	EventTypeBurnFees = "burn_fees"
+
+	AttributeKeyAmount      = "amount"
+	AttributeKeyTotalBurned = "total_burned"
+)
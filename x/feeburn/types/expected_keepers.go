@@ -0,0 +1,34 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// BankKeeper defines the expected bank keeper used to burn fees.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper used to look up the bond
+// denom.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+}
+
+// DistributionKeeper defines the expected distribution keeper used to look up
+// the community pool, satisfied by the distribution querier.
+type DistributionKeeper interface {
+	CommunityPool(ctx context.Context, req *distrtypes.QueryCommunityPoolRequest) (*distrtypes.QueryCommunityPoolResponse, error)
+}
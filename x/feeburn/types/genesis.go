@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultGenesisState returns the default feeburn genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Burned: math.ZeroInt(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.Burned.IsNil() || gs.Burned.IsNegative() {
+		return fmt.Errorf("burned must not be negative: %s", gs.Burned)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/feeburn/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		gs     types.GenesisState
+		expErr string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", types.GenesisState{Params: types.NewParams(math.LegacyNewDecWithPrec(3, 1)), Burned: math.NewInt(100)}, ""},
+		{"burn everything", types.GenesisState{Params: types.NewParams(math.LegacyOneDec()), Burned: math.ZeroInt()}, ""},
+		{"burn rate above one", types.GenesisState{Params: types.NewParams(math.LegacyNewDec(2)), Burned: math.ZeroInt()}, "burn rate"},
+		{"negative burn rate", types.GenesisState{Params: types.NewParams(math.LegacyNewDec(-1)), Burned: math.ZeroInt()}, "burn rate"},
+		{"nil burn rate", types.GenesisState{Burned: math.ZeroInt()}, "burn rate"},
+		{"negative burned", types.GenesisState{Params: types.DefaultParams(), Burned: math.NewInt(-1)}, "burned"},
+		{"nil burned", types.GenesisState{Params: types.DefaultParams()}, "burned"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.gs.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
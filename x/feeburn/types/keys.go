@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeburn"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey = collections.NewPrefix(0)
+	BurnedKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultBurnRate burns no fees until governance sets a rate.
+var DefaultBurnRate = math.LegacyZeroDec()
+
+// NewParams creates a new Params instance.
+func NewParams(burnRate math.LegacyDec) Params {
+	return Params{BurnRate: burnRate}
+}
+
+// DefaultParams returns the default feeburn parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultBurnRate)
+}
+
+// Validate performs basic validation of the feeburn parameters.
+func (p Params) Validate() error {
+	if p.BurnRate.IsNil() || p.BurnRate.IsNegative() || p.BurnRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("burn rate must be between 0 and 1: %s", p.BurnRate)
+	}
+	return nil
+}
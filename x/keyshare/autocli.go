@@ -0,0 +1,92 @@
+package keyshare
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.keyshare.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the keyshare module parameters",
+				},
+				{
+					RpcMethod:      "EncryptionKey",
+					Use:            "encryption-key [owner]",
+					Short:          "Query the encryption key registered by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "WrappedKey",
+					Use:            "wrapped-key [wrapped-key-id]",
+					Short:          "Query a wrapped key by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "wrapped_key_id"}},
+				},
+				{
+					RpcMethod:      "WrappedKeysByOwner",
+					Use:            "wrapped-keys-by-owner [owner]",
+					Short:          "Query the wrapped keys an owner has shared",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "WrappedKeysByGrantee",
+					Use:            "wrapped-keys-by-grantee [grantee]",
+					Short:          "Query the wrapped keys shared with a grantee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "grantee"}},
+				},
+				{
+					RpcMethod: "WrappedKeysByConsent",
+					Use:       "wrapped-keys-by-consent [owner] [consent-id]",
+					Short:     "Query the wrapped keys an owner shared under a consent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+						{ProtoField: "consent_id"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.keyshare.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "RegisterEncryptionKey",
+					Use:            "register-encryption-key [public-key]",
+					Short:          "Publish or rotate the X25519 public key data keys are wrapped to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "public_key"}},
+				},
+				{
+					RpcMethod: "ShareKey",
+					Use:       "share-key [grantee] [dataset-id] [consent-id] [wrapped-key]",
+					Short:     "Post a data key wrapped to a grantee's encryption key under a consent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "grantee"},
+						{ProtoField: "dataset_id"},
+						{ProtoField: "consent_id"},
+						{ProtoField: "wrapped_key"},
+					},
+				},
+				{
+					RpcMethod:      "RevokeKey",
+					Use:            "revoke-key [wrapped-key-id]",
+					Short:          "Delete a wrapped key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "wrapped_key_id"}},
+				},
+				{
+					RpcMethod:      "RevokeConsent",
+					Use:            "revoke-consent [consent-id]",
+					Short:          "Delete every wrapped key shared under a consent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consent_id"}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
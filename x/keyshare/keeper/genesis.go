@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+// InitGenesis initializes the keyshare module state from a genesis state and
+// rebuilds the wrapped key indexes.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	if err := k.WrappedKeySeq.Set(ctx, gs.NextWrappedKeyId); err != nil {
+		return err
+	}
+
+	for _, key := range gs.EncryptionKeys {
+		owner, err := k.accountKeeper.AddressCodec().StringToBytes(key.Owner)
+		if err != nil {
+			return err
+		}
+		if err := k.EncryptionKeys.Set(ctx, owner, key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range gs.WrappedKeys {
+		if err := k.SetWrappedKey(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the keyshare module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextWrappedKeyID, err := k.WrappedKeySeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{
+		Params:           params,
+		NextWrappedKeyId: nextWrappedKeyID,
+	}
+
+	if err := k.EncryptionKeys.Walk(ctx, nil, func(_ sdk.AccAddress, key types.EncryptionKey) (bool, error) {
+		gs.EncryptionKeys = append(gs.EncryptionKeys, key)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.WrappedKeys.Walk(ctx, nil, func(_ uint64, key types.WrappedKey) (bool, error) {
+		gs.WrappedKeys = append(gs.WrappedKeys, key)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the keyshare QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// EncryptionKey implements types.QueryServer.
+func (k queryServer) EncryptionKey(ctx context.Context, req *types.QueryEncryptionKeyRequest) (*types.QueryEncryptionKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	key, err := k.GetEncryptionKey(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryEncryptionKeyResponse{EncryptionKey: key}, nil
+}
+
+// WrappedKey implements types.QueryServer.
+func (k queryServer) WrappedKey(ctx context.Context, req *types.QueryWrappedKeyRequest) (*types.QueryWrappedKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	key, err := k.GetWrappedKey(ctx, req.WrappedKeyId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryWrappedKeyResponse{WrappedKey: key}, nil
+}
+
+// WrappedKeysByOwner implements types.QueryServer.
+func (k queryServer) WrappedKeysByOwner(ctx context.Context, req *types.QueryWrappedKeysByOwnerRequest) (*types.QueryWrappedKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return paginateWrappedKeys(ctx, k.Keeper, k.Keeper.OwnerIndex, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64]) uint64 { return key.K2() },
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+}
+
+// WrappedKeysByGrantee implements types.QueryServer.
+func (k queryServer) WrappedKeysByGrantee(ctx context.Context, req *types.QueryWrappedKeysByGranteeRequest) (*types.QueryWrappedKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	grantee, err := k.accountKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return paginateWrappedKeys(ctx, k.Keeper, k.Keeper.GranteeIndex, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64]) uint64 { return key.K2() },
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](grantee),
+	)
+}
+
+// WrappedKeysByConsent implements types.QueryServer.
+func (k queryServer) WrappedKeysByConsent(ctx context.Context, req *types.QueryWrappedKeysByConsentRequest) (*types.QueryWrappedKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.ConsentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty consent id")
+	}
+	return paginateWrappedKeys(ctx, k.Keeper, k.Keeper.ConsentIndex, req.Pagination,
+		func(key collections.Triple[sdk.AccAddress, string, uint64]) uint64 { return key.K3() },
+		func(o *query.CollectionsPaginateOptions[collections.Triple[sdk.AccAddress, string, uint64]]) {
+			prefix := collections.TripleSuperPrefix[sdk.AccAddress, string, uint64](owner, req.ConsentId)
+			o.Prefix = &prefix
+		},
+	)
+}
+
+// paginateWrappedKeys pages through the wrapped keys referenced by an index,
+// in the order they were first shared.
+func paginateWrappedKeys[K any](
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[K],
+	pagination *query.PageRequest,
+	id func(K) uint64,
+	prefix func(o *query.CollectionsPaginateOptions[K]),
+) (*types.QueryWrappedKeysResponse, error) {
+	keys, pageRes, err := query.CollectionPaginate(ctx, index, pagination,
+		func(key K, _ collections.NoValue) (types.WrappedKey, error) {
+			return k.WrappedKeys.Get(ctx, id(key))
+		},
+		prefix,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryWrappedKeysResponse{WrappedKeys: keys, Pagination: pageRes}, nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+// Keeper manages account encryption keys and the data keys wrapped to them.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+
+	// the address capable of executing MsgUpdateParams, typically the x/gov
+	// module account.
+	authority string
+
+	Schema         collections.Schema
+	Params         collections.Item[types.Params]
+	EncryptionKeys collections.Map[sdk.AccAddress, types.EncryptionKey]
+	WrappedKeySeq  collections.Sequence
+	WrappedKeys    collections.Map[uint64, types.WrappedKey]
+	// ShareIndex maps (owner, dataset id, grantee) to the wrapped key id, so
+	// a dataset is shared with a grantee at most once.
+	ShareIndex collections.Map[collections.Triple[sdk.AccAddress, string, sdk.AccAddress], uint64]
+	// OwnerIndex, GranteeIndex and ConsentIndex map their key to wrapped key
+	// ids.
+	OwnerIndex   collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	GranteeIndex collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	ConsentIndex collections.KeySet[collections.Triple[sdk.AccAddress, string, uint64]]
+}
+
+// NewKeeper creates a new keyshare Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid keyshare authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:            cdc,
+		storeService:   storeService,
+		accountKeeper:  accountKeeper,
+		authority:      authority,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		EncryptionKeys: collections.NewMap(sb, types.EncryptionKeysKey, "encryption_keys", sdk.AccAddressKey, codec.CollValue[types.EncryptionKey](cdc)),
+		WrappedKeySeq:  collections.NewSequence(sb, types.WrappedKeySeqKey, "wrapped_key_seq"),
+		WrappedKeys:    collections.NewMap(sb, types.WrappedKeysKey, "wrapped_keys", collections.Uint64Key, codec.CollValue[types.WrappedKey](cdc)),
+		ShareIndex: collections.NewMap(sb, types.ShareIndexKey, "share_index",
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, sdk.AccAddressKey), collections.Uint64Value),
+		OwnerIndex:   collections.NewKeySet(sb, types.OwnerIndexKey, "owner_index", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		GranteeIndex: collections.NewKeySet(sb, types.GranteeIndexKey, "grantee_index", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		ConsentIndex: collections.NewKeySet(sb, types.ConsentIndexKey, "consent_index",
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.Uint64Key)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	owner    sdk.AccAddress
+	grantee  sdk.AccAddress
+	grantee2 sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.KeyshareKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.KeyshareKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.KeyshareKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.owner = sdk.AccAddress([]byte("owner_______________"))
+	s.grantee = sdk.AccAddress([]byte("grantee_____________"))
+	s.grantee2 = sdk.AccAddress([]byte("grantee2____________"))
+
+	s.registerKey(s.grantee, 1)
+	s.registerKey(s.grantee2, 2)
+}
+
+// publicKey derives the X25519 public key of a deterministic test scalar.
+func (s *KeeperTestSuite) publicKey(seed byte) []byte {
+	pub, err := curve25519.X25519(bytes.Repeat([]byte{seed}, curve25519.ScalarSize), curve25519.Basepoint)
+	s.Require().NoError(err)
+	return pub
+}
+
+func (s *KeeperTestSuite) registerKey(owner sdk.AccAddress, seed byte) {
+	_, err := s.msgServer.RegisterEncryptionKey(s.ctx, &types.MsgRegisterEncryptionKey{
+		Owner:     owner.String(),
+		PublicKey: s.publicKey(seed),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) shareKey(grantee sdk.AccAddress, datasetID, consentID string) uint64 {
+	res, err := s.msgServer.ShareKey(s.ctx, &types.MsgShareKey{
+		Owner:      s.owner.String(),
+		Grantee:    grantee.String(),
+		DatasetId:  datasetID,
+		ConsentId:  consentID,
+		WrappedKey: bytes.Repeat([]byte{0xab}, 80),
+	})
+	s.Require().NoError(err)
+	return res.WrappedKeyId
+}
+
+func (s *KeeperTestSuite) TestRegisterEncryptionKey() {
+	res, err := s.queryServer.EncryptionKey(s.ctx, &types.QueryEncryptionKeyRequest{Owner: s.grantee.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.publicKey(1), res.EncryptionKey.PublicKey)
+
+	// rotation replaces the key
+	s.registerKey(s.grantee, 3)
+	res, err = s.queryServer.EncryptionKey(s.ctx, &types.QueryEncryptionKeyRequest{Owner: s.grantee.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.publicKey(3), res.EncryptionKey.PublicKey)
+
+	for name, pubKey := range map[string][]byte{
+		"short":     make([]byte, 31),
+		"zero":      make([]byte, 32),
+		"low order": append([]byte{1}, make([]byte, 31)...),
+	} {
+		_, err := s.msgServer.RegisterEncryptionKey(s.ctx, &types.MsgRegisterEncryptionKey{Owner: s.owner.String(), PublicKey: pubKey})
+		s.Require().ErrorIs(err, types.ErrInvalidEncryptionKey, name)
+	}
+
+	_, err = s.queryServer.EncryptionKey(s.ctx, &types.QueryEncryptionKeyRequest{Owner: s.owner.String()})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestShareKey() {
+	id := s.shareKey(s.grantee, "dataset-1", "consent-1")
+
+	key, err := s.app.KeyshareKeeper.GetWrappedKey(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.publicKey(1), key.GranteePublicKey)
+	s.Require().Equal(s.ctx.BlockTime(), key.SharedAt)
+
+	// the grantee rotates its key and the owner re-wraps in place
+	s.registerKey(s.grantee, 4)
+	s.Require().Equal(id, s.shareKey(s.grantee, "dataset-1", "consent-2"))
+	key, err = s.app.KeyshareKeeper.GetWrappedKey(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(s.publicKey(4), key.GranteePublicKey)
+	s.Require().Equal("consent-2", key.ConsentId)
+
+	res, err := s.queryServer.WrappedKeysByConsent(s.ctx, &types.QueryWrappedKeysByConsentRequest{Owner: s.owner.String(), ConsentId: "consent-1"})
+	s.Require().NoError(err)
+	s.Require().Empty(res.WrappedKeys, "the old consent index entry should be removed")
+}
+
+func (s *KeeperTestSuite) TestShareKeyRejects() {
+	valid := types.MsgShareKey{
+		Owner:      s.owner.String(),
+		Grantee:    s.grantee.String(),
+		DatasetId:  "dataset-1",
+		ConsentId:  "consent-1",
+		WrappedKey: bytes.Repeat([]byte{0xab}, 80),
+	}
+
+	msg := valid
+	msg.Grantee = s.owner.String()
+	_, err := s.msgServer.ShareKey(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrEncryptionKeyNotFound, "grantee without an encryption key")
+
+	msg = valid
+	msg.ConsentId = ""
+	_, err = s.msgServer.ShareKey(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidWrappedKey)
+
+	msg = valid
+	msg.WrappedKey = make([]byte, types.DefaultMaxWrappedKeySize+1)
+	_, err = s.msgServer.ShareKey(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidWrappedKey)
+
+	msg = valid
+	msg.Owner = s.grantee.String()
+	_, err = s.msgServer.ShareKey(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidWrappedKey, "sharing with oneself")
+}
+
+func (s *KeeperTestSuite) TestRevokeKey() {
+	id := s.shareKey(s.grantee, "dataset-1", "consent-1")
+
+	_, err := s.msgServer.RevokeKey(s.ctx, &types.MsgRevokeKey{Owner: s.grantee.String(), WrappedKeyId: id})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.RevokeKey(ctx, &types.MsgRevokeKey{Owner: s.owner.String(), WrappedKeyId: id})
+	s.Require().NoError(err)
+	s.Require().Len(revokeEvents(ctx), 1)
+
+	_, err = s.app.KeyshareKeeper.GetWrappedKey(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrWrappedKeyNotFound)
+
+	res, err := s.queryServer.WrappedKeysByGrantee(s.ctx, &types.QueryWrappedKeysByGranteeRequest{Grantee: s.grantee.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.WrappedKeys)
+
+	_, err = s.msgServer.RevokeKey(s.ctx, &types.MsgRevokeKey{Owner: s.owner.String(), WrappedKeyId: id})
+	s.Require().ErrorIs(err, types.ErrWrappedKeyNotFound)
+}
+
+func (s *KeeperTestSuite) TestRevokeConsent() {
+	s.shareKey(s.grantee, "dataset-1", "consent-1")
+	s.shareKey(s.grantee2, "dataset-1", "consent-1")
+	kept := s.shareKey(s.grantee, "dataset-2", "consent-2")
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	res, err := s.msgServer.RevokeConsent(ctx, &types.MsgRevokeConsent{Owner: s.owner.String(), ConsentId: "consent-1"})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Revoked)
+	s.Require().Len(revokeEvents(ctx), 2)
+
+	owned, err := s.queryServer.WrappedKeysByOwner(s.ctx, &types.QueryWrappedKeysByOwnerRequest{Owner: s.owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(owned.WrappedKeys, 1)
+	s.Require().Equal(kept, owned.WrappedKeys[0].Id)
+
+	// revoking an unknown consent is a no-op
+	res, err = s.msgServer.RevokeConsent(s.ctx, &types.MsgRevokeConsent{Owner: s.owner.String(), ConsentId: "consent-1"})
+	s.Require().NoError(err)
+	s.Require().Zero(res.Revoked)
+}
+
+func (s *KeeperTestSuite) TestWrappedKeyQueries() {
+	first := s.shareKey(s.grantee, "dataset-1", "consent-1")
+	second := s.shareKey(s.grantee, "dataset-2", "consent-1")
+	s.shareKey(s.grantee2, "dataset-1", "consent-1")
+
+	byGrantee, err := s.queryServer.WrappedKeysByGrantee(s.ctx, &types.QueryWrappedKeysByGranteeRequest{Grantee: s.grantee.String()})
+	s.Require().NoError(err)
+	s.Require().Len(byGrantee.WrappedKeys, 2)
+	s.Require().Equal(first, byGrantee.WrappedKeys[0].Id)
+	s.Require().Equal(second, byGrantee.WrappedKeys[1].Id)
+
+	byConsent, err := s.queryServer.WrappedKeysByConsent(s.ctx, &types.QueryWrappedKeysByConsentRequest{Owner: s.owner.String(), ConsentId: "consent-1"})
+	s.Require().NoError(err)
+	s.Require().Len(byConsent.WrappedKeys, 3)
+
+	_, err = s.queryServer.WrappedKeysByConsent(s.ctx, &types.QueryWrappedKeysByConsentRequest{Owner: s.owner.String()})
+	s.Require().Error(err)
+
+	key, err := s.queryServer.WrappedKey(s.ctx, &types.QueryWrappedKeyRequest{WrappedKeyId: first})
+	s.Require().NoError(err)
+	s.Require().Equal("dataset-1", key.WrappedKey.DatasetId)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(1024)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.owner.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(1)})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	s.shareKey(s.grantee, "dataset-1", "consent-1")
+	s.shareKey(s.grantee2, "dataset-1", "consent-1")
+
+	exported, err := s.app.KeyshareKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate(s.app.AccountKeeper.AddressCodec()))
+	s.Require().Len(exported.EncryptionKeys, 2)
+	s.Require().Len(exported.WrappedKeys, 2)
+	s.Require().Equal(uint64(3), exported.NextWrappedKeyId)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.KeyshareKeeper.InitGenesis(s.ctx, exported))
+
+	res, err := s.msgServer.RevokeConsent(s.ctx, &types.MsgRevokeConsent{Owner: s.owner.String(), ConsentId: "consent-1"})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Revoked, "indexes should be rebuilt from genesis")
+}
+
+func revokeEvents(ctx sdk.Context) []sdk.Event {
+	var events []sdk.Event
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeRevokeKey {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
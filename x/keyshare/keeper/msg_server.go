@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the keyshare MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterEncryptionKey implements types.MsgServer.
+func (k msgServer) RegisterEncryptionKey(goCtx context.Context, msg *types.MsgRegisterEncryptionKey) (*types.MsgRegisterEncryptionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEncryptionKey, "invalid owner address: %s", err)
+	}
+
+	key := types.EncryptionKey{
+		Owner:        msg.Owner,
+		PublicKey:    msg.PublicKey,
+		RegisteredAt: ctx.BlockTime(),
+	}
+	if err := key.Validate(k.accountKeeper.AddressCodec()); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEncryptionKey, err.Error())
+	}
+
+	if err := k.EncryptionKeys.Set(ctx, owner, key); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterEncryptionKey,
+			sdk.NewAttribute(types.AttributeKeyOwner, key.Owner),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(key.PublicKey)),
+		),
+	)
+
+	return &types.MsgRegisterEncryptionKeyResponse{}, nil
+}
+
+// ShareKey implements types.MsgServer.
+func (k msgServer) ShareKey(goCtx context.Context, msg *types.MsgShareKey) (*types.MsgShareKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidWrappedKey, "invalid owner address: %s", err)
+	}
+	grantee, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidWrappedKey, "invalid grantee address: %s", err)
+	}
+
+	granteeKey, err := k.GetEncryptionKey(ctx, grantee)
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := types.WrappedKey{
+		Owner:            msg.Owner,
+		Grantee:          msg.Grantee,
+		DatasetId:        msg.DatasetId,
+		ConsentId:        msg.ConsentId,
+		WrappedKey:       msg.WrappedKey,
+		GranteePublicKey: granteeKey.PublicKey,
+		SharedAt:         ctx.BlockTime(),
+	}
+	if err := key.Validate(k.accountKeeper.AddressCodec(), params.MaxWrappedKeySize); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWrappedKey, err.Error())
+	}
+
+	// sharing again, e.g. after the grantee rotated its key, replaces the
+	// previous wrapped key in place.
+	existingID, err := k.ShareIndex.Get(ctx, collections.Join3(sdk.AccAddress(owner), key.DatasetId, sdk.AccAddress(grantee)))
+	switch {
+	case err == nil:
+		existing, err := k.WrappedKeys.Get(ctx, existingID)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.removeWrappedKey(ctx, existing); err != nil {
+			return nil, err
+		}
+		key.Id = existingID
+	case errors.Is(err, collections.ErrNotFound):
+		key.Id, err = k.WrappedKeySeq.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if err := k.SetWrappedKey(ctx, key); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeShareKey,
+			sdk.NewAttribute(types.AttributeKeyWrappedKeyID, strconv.FormatUint(key.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, key.Owner),
+			sdk.NewAttribute(types.AttributeKeyGrantee, key.Grantee),
+			sdk.NewAttribute(types.AttributeKeyDatasetID, key.DatasetId),
+			sdk.NewAttribute(types.AttributeKeyConsentID, key.ConsentId),
+		),
+	)
+
+	return &types.MsgShareKeyResponse{WrappedKeyId: key.Id}, nil
+}
+
+// RevokeKey implements types.MsgServer.
+func (k msgServer) RevokeKey(goCtx context.Context, msg *types.MsgRevokeKey) (*types.MsgRevokeKeyResponse, error) {
+	key, err := k.GetWrappedKey(goCtx, msg.WrappedKeyId)
+	if err != nil {
+		return nil, err
+	}
+	if key.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only the owner %s can revoke wrapped key %d", key.Owner, key.Id)
+	}
+
+	if err := k.RevokeWrappedKey(goCtx, key); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeKeyResponse{}, nil
+}
+
+// RevokeConsent implements types.MsgServer.
+func (k msgServer) RevokeConsent(goCtx context.Context, msg *types.MsgRevokeConsent) (*types.MsgRevokeConsentResponse, error) {
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidWrappedKey, "invalid owner address: %s", err)
+	}
+	if msg.ConsentId == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidWrappedKey, "empty consent id")
+	}
+
+	revoked, err := k.Keeper.RevokeConsent(goCtx, owner, msg.ConsentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeConsentResponse{Revoked: revoked}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
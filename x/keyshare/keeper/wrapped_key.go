@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+// GetEncryptionKey returns the encryption key registered by owner.
+func (k Keeper) GetEncryptionKey(ctx context.Context, owner sdk.AccAddress) (types.EncryptionKey, error) {
+	key, err := k.EncryptionKeys.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EncryptionKey{}, errorsmod.Wrapf(types.ErrEncryptionKeyNotFound, "no encryption key registered for %s", owner)
+	}
+	return key, err
+}
+
+// GetWrappedKey returns the wrapped key with the given id.
+func (k Keeper) GetWrappedKey(ctx context.Context, id uint64) (types.WrappedKey, error) {
+	key, err := k.WrappedKeys.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.WrappedKey{}, errorsmod.Wrapf(types.ErrWrappedKeyNotFound, "wrapped key %d", id)
+	}
+	return key, err
+}
+
+// SetWrappedKey stores a wrapped key and updates its indexes.
+func (k Keeper) SetWrappedKey(ctx context.Context, key types.WrappedKey) error {
+	owner, grantee, err := k.parties(key)
+	if err != nil {
+		return err
+	}
+
+	if err := k.WrappedKeys.Set(ctx, key.Id, key); err != nil {
+		return err
+	}
+	if err := k.ShareIndex.Set(ctx, collections.Join3(owner, key.DatasetId, grantee), key.Id); err != nil {
+		return err
+	}
+	if err := k.OwnerIndex.Set(ctx, collections.Join(owner, key.Id)); err != nil {
+		return err
+	}
+	if err := k.GranteeIndex.Set(ctx, collections.Join(grantee, key.Id)); err != nil {
+		return err
+	}
+	return k.ConsentIndex.Set(ctx, collections.Join3(owner, key.ConsentId, key.Id))
+}
+
+// RevokeWrappedKey deletes a wrapped key together with its index entries and
+// emits a revoke_key event, telling storage gateways to stop serving the
+// dataset to the grantee.
+func (k Keeper) RevokeWrappedKey(ctx context.Context, key types.WrappedKey) error {
+	if err := k.removeWrappedKey(ctx, key); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeKey,
+			sdk.NewAttribute(types.AttributeKeyWrappedKeyID, strconv.FormatUint(key.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, key.Owner),
+			sdk.NewAttribute(types.AttributeKeyGrantee, key.Grantee),
+			sdk.NewAttribute(types.AttributeKeyDatasetID, key.DatasetId),
+			sdk.NewAttribute(types.AttributeKeyConsentID, key.ConsentId),
+		),
+	)
+	return nil
+}
+
+// RevokeConsent revokes every wrapped key owner shared under consentID and
+// returns the number of keys revoked.
+func (k Keeper) RevokeConsent(ctx context.Context, owner sdk.AccAddress, consentID string) (uint64, error) {
+	// collect first, the index cannot be modified while iterating it
+	var ids []uint64
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, uint64](owner, consentID)
+	if err := k.ConsentIndex.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, string, uint64]) (bool, error) {
+		ids = append(ids, key.K3())
+		return false, nil
+	}); err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		key, err := k.WrappedKeys.Get(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		if err := k.RevokeWrappedKey(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(ids)), nil
+}
+
+// removeWrappedKey deletes a wrapped key together with its index entries.
+func (k Keeper) removeWrappedKey(ctx context.Context, key types.WrappedKey) error {
+	owner, grantee, err := k.parties(key)
+	if err != nil {
+		return err
+	}
+
+	if err := k.WrappedKeys.Remove(ctx, key.Id); err != nil {
+		return err
+	}
+	if err := k.ShareIndex.Remove(ctx, collections.Join3(owner, key.DatasetId, grantee)); err != nil {
+		return err
+	}
+	if err := k.OwnerIndex.Remove(ctx, collections.Join(owner, key.Id)); err != nil {
+		return err
+	}
+	if err := k.GranteeIndex.Remove(ctx, collections.Join(grantee, key.Id)); err != nil {
+		return err
+	}
+	return k.ConsentIndex.Remove(ctx, collections.Join3(owner, key.ConsentId, key.Id))
+}
+
+// parties decodes the owner and grantee addresses of a wrapped key.
+func (k Keeper) parties(key types.WrappedKey) (owner, grantee sdk.AccAddress, err error) {
+	owner, err = k.accountKeeper.AddressCodec().StringToBytes(key.Owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	grantee, err = k.accountKeeper.AddressCodec().StringToBytes(key.Grantee)
+	if err != nil {
+		return nil, nil, err
+	}
+	return owner, grantee, nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the keyshare messages on the amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterEncryptionKey{}, "hippo/x/keyshare/MsgRegisterEncKey")
+	legacy.RegisterAminoMsg(cdc, &MsgShareKey{}, "hippo/x/keyshare/MsgShareKey")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeKey{}, "hippo/x/keyshare/MsgRevokeKey")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeConsent{}, "hippo/x/keyshare/MsgRevokeConsent")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/keyshare/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/keyshare/Params", nil)
+}
+
+// RegisterInterfaces registers the keyshare messages on the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterEncryptionKey{},
+		&MsgShareKey{},
+		&MsgRevokeKey{},
+		&MsgRevokeConsent{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
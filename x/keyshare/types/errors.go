@@ -0,0 +1,13 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/keyshare module sentinel errors
+var (
+	ErrInvalidEncryptionKey  = errorsmod.Register(ModuleName, 2, "invalid encryption key")
+	ErrEncryptionKeyNotFound = errorsmod.Register(ModuleName, 3, "encryption key not found")
+	ErrInvalidWrappedKey     = errorsmod.Register(ModuleName, 4, "invalid wrapped key")
+	ErrWrappedKeyNotFound    = errorsmod.Register(ModuleName, 5, "wrapped key not found")
+	ErrUnauthorized          = errorsmod.Register(ModuleName, 6, "unauthorized")
+	ErrInvalidParams         = errorsmod.Register(ModuleName, 7, "invalid params")
+)
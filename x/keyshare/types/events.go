@@ -0,0 +1,15 @@
+package types
+
+// keyshare module event types and attributes
+const (
+	EventTypeRegisterEncryptionKey = "register_encryption_key"
+	EventTypeShareKey              = "share_key"
+	EventTypeRevokeKey             = "revoke_key"
+
+	AttributeKeyOwner        = "owner"
+	AttributeKeyGrantee      = "grantee"
+	AttributeKeyPublicKey    = "public_key"
+	AttributeKeyWrappedKeyID = "wrapped_key_id"
+	AttributeKeyDatasetID    = "dataset_id"
+	AttributeKeyConsentID    = "consent_id"
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default keyshare genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:           DefaultParams(),
+		NextWrappedKeyId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextWrappedKeyId == 0 {
+		return fmt.Errorf("next wrapped key id must be positive")
+	}
+
+	owners := make(map[string]bool, len(gs.EncryptionKeys))
+	for _, k := range gs.EncryptionKeys {
+		if owners[k.Owner] {
+			return fmt.Errorf("duplicate encryption key for %s", k.Owner)
+		}
+		owners[k.Owner] = true
+		if err := k.Validate(ac); err != nil {
+			return fmt.Errorf("encryption key of %s: %w", k.Owner, err)
+		}
+	}
+
+	ids := make(map[uint64]bool, len(gs.WrappedKeys))
+	shares := make(map[[3]string]bool, len(gs.WrappedKeys))
+	for _, k := range gs.WrappedKeys {
+		if k.Id == 0 || k.Id >= gs.NextWrappedKeyId {
+			return fmt.Errorf("wrapped key id %d out of range", k.Id)
+		}
+		if ids[k.Id] {
+			return fmt.Errorf("duplicate wrapped key id %d", k.Id)
+		}
+		ids[k.Id] = true
+
+		share := [3]string{k.Owner, k.DatasetId, k.Grantee}
+		if shares[share] {
+			return fmt.Errorf("dataset %s of %s is shared with %s more than once", k.DatasetId, k.Owner, k.Grantee)
+		}
+		shares[share] = true
+
+		if err := k.Validate(ac, gs.Params.MaxWrappedKeySize); err != nil {
+			return fmt.Errorf("wrapped key %d: %w", k.Id, err)
+		}
+	}
+
+	return nil
+}
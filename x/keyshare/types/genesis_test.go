@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	ac := address.NewBech32Codec("hippo")
+	owner, err := ac.BytesToString([]byte("owner_______________"))
+	require.NoError(t, err)
+	grantee, err := ac.BytesToString([]byte("grantee_____________"))
+	require.NoError(t, err)
+	pubKey, err := curve25519.X25519(bytes.Repeat([]byte{1}, curve25519.ScalarSize), curve25519.Basepoint)
+	require.NoError(t, err)
+
+	wrapped := types.WrappedKey{
+		Id:               1,
+		Owner:            owner,
+		Grantee:          grantee,
+		DatasetId:        "dataset-1",
+		ConsentId:        "consent-1",
+		WrappedKey:       bytes.Repeat([]byte{0xab}, 80),
+		GranteePublicKey: pubKey,
+	}
+	withKey := func(malleate func(k *types.WrappedKey)) types.GenesisState {
+		k := wrapped
+		malleate(&k)
+		return types.GenesisState{Params: types.DefaultParams(), WrappedKeys: []types.WrappedKey{k}, NextWrappedKeyId: 2}
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", types.GenesisState{
+			Params:           types.DefaultParams(),
+			EncryptionKeys:   []types.EncryptionKey{{Owner: grantee, PublicKey: pubKey}},
+			WrappedKeys:      []types.WrappedKey{wrapped},
+			NextWrappedKeyId: 2,
+		}, ""},
+		{"zero next id", types.GenesisState{Params: types.DefaultParams()}, "next wrapped key id"},
+		{"duplicate encryption key", types.GenesisState{
+			Params:           types.DefaultParams(),
+			EncryptionKeys:   []types.EncryptionKey{{Owner: grantee, PublicKey: pubKey}, {Owner: grantee, PublicKey: pubKey}},
+			NextWrappedKeyId: 1,
+		}, "duplicate encryption key"},
+		{"invalid encryption key", types.GenesisState{
+			Params:           types.DefaultParams(),
+			EncryptionKeys:   []types.EncryptionKey{{Owner: grantee, PublicKey: make([]byte, 32)}},
+			NextWrappedKeyId: 1,
+		}, "low order"},
+		{"id out of range", withKey(func(k *types.WrappedKey) { k.Id = 2 }), "out of range"},
+		{"duplicate share", types.GenesisState{
+			Params:           types.DefaultParams(),
+			WrappedKeys:      []types.WrappedKey{wrapped, func() types.WrappedKey { k := wrapped; k.Id = 2; return k }()},
+			NextWrappedKeyId: 3,
+		}, "more than once"},
+		{"missing consent", withKey(func(k *types.WrappedKey) { k.ConsentId = "" }), "consent id"},
+		{"oversized wrapped key", withKey(func(k *types.WrappedKey) { k.WrappedKey = make([]byte, types.DefaultMaxWrappedKeySize+1) }), "wrapped key must be"},
+		{"self share", withKey(func(k *types.WrappedKey) { k.Grantee = owner }), "itself"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(ac)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	// MaxDatasetIDLength bounds the length of a dataset identifier.
+	MaxDatasetIDLength = 256
+	// MaxConsentIDLength bounds the length of a consent identifier.
+	MaxConsentIDLength = 256
+)
+
+// ValidateX25519PublicKey checks that pubKey is a 32 byte X25519 public key
+// outside the small subgroup. Low order points would let anyone derive the
+// shared secret, exposing every data key wrapped to them.
+func ValidateX25519PublicKey(pubKey []byte) error {
+	if len(pubKey) != curve25519.PointSize {
+		return fmt.Errorf("public key must be %d bytes, got %d", curve25519.PointSize, len(pubKey))
+	}
+	// X25519 fails when the result is the all zero point, which is exactly
+	// the case for low order inputs regardless of the scalar.
+	if _, err := curve25519.X25519(curve25519.Basepoint, pubKey); err != nil {
+		return fmt.Errorf("public key is a low order point")
+	}
+	return nil
+}
+
+// Validate performs stateless validation of an encryption key.
+func (k EncryptionKey) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(k.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return ValidateX25519PublicKey(k.PublicKey)
+}
+
+// Validate performs stateless validation of a wrapped key against the
+// maximum envelope size.
+func (k WrappedKey) Validate(ac address.Codec, maxWrappedKeySize uint32) error {
+	if _, err := ac.StringToBytes(k.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if _, err := ac.StringToBytes(k.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee address: %w", err)
+	}
+	if k.Owner == k.Grantee {
+		return fmt.Errorf("owner cannot share a key with itself")
+	}
+	if k.DatasetId == "" || len(k.DatasetId) > MaxDatasetIDLength {
+		return fmt.Errorf("dataset id must be between 1 and %d characters", MaxDatasetIDLength)
+	}
+	if k.ConsentId == "" || len(k.ConsentId) > MaxConsentIDLength {
+		return fmt.Errorf("consent id must be between 1 and %d characters", MaxConsentIDLength)
+	}
+	if len(k.WrappedKey) < MinWrappedKeySize || len(k.WrappedKey) > int(maxWrappedKeySize) {
+		return fmt.Errorf("wrapped key must be between %d and %d bytes, got %d", MinWrappedKeySize, maxWrappedKeySize, len(k.WrappedKey))
+	}
+	return ValidateX25519PublicKey(k.GranteePublicKey)
+}
@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "keyshare"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey         = collections.NewPrefix(0)
+	EncryptionKeysKey = collections.NewPrefix(1)
+	WrappedKeySeqKey  = collections.NewPrefix(2)
+	WrappedKeysKey    = collections.NewPrefix(3)
+	ShareIndexKey     = collections.NewPrefix(4)
+	OwnerIndexKey     = collections.NewPrefix(5)
+	GranteeIndexKey   = collections.NewPrefix(6)
+	ConsentIndexKey   = collections.NewPrefix(7)
+)
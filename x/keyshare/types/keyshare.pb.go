@@ -0,0 +1,1130 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/keyshare/v1/keyshare.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the keyshare module.
+type Params struct {
+	// max_wrapped_key_size bounds the size in bytes of a wrapped data key.
+	MaxWrappedKeySize uint32 `protobuf:"varint,1,opt,name=max_wrapped_key_size,json=maxWrappedKeySize,proto3" json:"max_wrapped_key_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1aae049ccd4120e5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxWrappedKeySize() uint32 {
+	if m != nil {
+		return m.MaxWrappedKeySize
+	}
+	return 0
+}
+
+// EncryptionKey is the X25519 public key an account receives data keys
+// under.
+type EncryptionKey struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// public_key is the 32 byte X25519 public key.
+	PublicKey    []byte    `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	RegisteredAt time.Time `protobuf:"bytes,3,opt,name=registered_at,json=registeredAt,proto3,stdtime" json:"registered_at"`
+}
+
+func (m *EncryptionKey) Reset()         { *m = EncryptionKey{} }
+func (m *EncryptionKey) String() string { return proto.CompactTextString(m) }
+func (*EncryptionKey) ProtoMessage()    {}
+func (*EncryptionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1aae049ccd4120e5, []int{1}
+}
+func (m *EncryptionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionKey.Merge(m, src)
+}
+func (m *EncryptionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionKey proto.InternalMessageInfo
+
+func (m *EncryptionKey) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EncryptionKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *EncryptionKey) GetRegisteredAt() time.Time {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return time.Time{}
+}
+
+// WrappedKey is a dataset's symmetric data key encrypted by its owner to a
+// grantee's encryption key under a consent.
+type WrappedKey struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Grantee   string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	DatasetId string `protobuf:"bytes,4,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	// consent_id references the consent the key was shared under, managed
+	// off-chain or by a contract. Revoking the consent deletes the key.
+	ConsentId string `protobuf:"bytes,5,opt,name=consent_id,json=consentId,proto3" json:"consent_id,omitempty"`
+	// wrapped_key is the data key encrypted to grantee_public_key. The
+	// envelope format, typically an ephemeral X25519 key followed by an AEAD
+	// ciphertext, is opaque to the chain.
+	WrappedKey []byte `protobuf:"bytes,6,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+	// grantee_public_key is the grantee encryption key the data key was
+	// wrapped to, so a grantee that rotated its key can detect stale entries.
+	GranteePublicKey []byte    `protobuf:"bytes,7,opt,name=grantee_public_key,json=granteePublicKey,proto3" json:"grantee_public_key,omitempty"`
+	SharedAt         time.Time `protobuf:"bytes,8,opt,name=shared_at,json=sharedAt,proto3,stdtime" json:"shared_at"`
+}
+
+func (m *WrappedKey) Reset()         { *m = WrappedKey{} }
+func (m *WrappedKey) String() string { return proto.CompactTextString(m) }
+func (*WrappedKey) ProtoMessage()    {}
+func (*WrappedKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1aae049ccd4120e5, []int{2}
+}
+func (m *WrappedKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WrappedKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WrappedKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WrappedKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WrappedKey.Merge(m, src)
+}
+func (m *WrappedKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WrappedKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WrappedKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WrappedKey proto.InternalMessageInfo
+
+func (m *WrappedKey) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *WrappedKey) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *WrappedKey) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *WrappedKey) GetDatasetId() string {
+	if m != nil {
+		return m.DatasetId
+	}
+	return ""
+}
+
+func (m *WrappedKey) GetConsentId() string {
+	if m != nil {
+		return m.ConsentId
+	}
+	return ""
+}
+
+func (m *WrappedKey) GetWrappedKey() []byte {
+	if m != nil {
+		return m.WrappedKey
+	}
+	return nil
+}
+
+func (m *WrappedKey) GetGranteePublicKey() []byte {
+	if m != nil {
+		return m.GranteePublicKey
+	}
+	return nil
+}
+
+func (m *WrappedKey) GetSharedAt() time.Time {
+	if m != nil {
+		return m.SharedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hippo.keyshare.v1.Params")
+	proto.RegisterType((*EncryptionKey)(nil), "hippo.keyshare.v1.EncryptionKey")
+	proto.RegisterType((*WrappedKey)(nil), "hippo.keyshare.v1.WrappedKey")
+}
+
+func init() { proto.RegisterFile("hippo/keyshare/v1/keyshare.proto", fileDescriptor_1aae049ccd4120e5) }
+
+var fileDescriptor_1aae049ccd4120e5 = []byte{
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0xf5, 0xba, 0x89, 0x13, 0x6f, 0xe2, 0x52, 0x0b, 0x43, 0x55, 0xd3, 0xca, 0xc6, 0x27, 0x13,
+	0x6a, 0x89, 0xa4, 0x97, 0xd2, 0x9b, 0x0d, 0x2d, 0x84, 0x40, 0x09, 0x4a, 0x21, 0xd0, 0x8b, 0x58,
+	0x6b, 0xa7, 0xf2, 0x12, 0x4b, 0x2b, 0x76, 0xd7, 0xb1, 0x95, 0x9f, 0xd0, 0x53, 0x7e, 0x46, 0x4f,
+	0x25, 0x87, 0xfe, 0x88, 0x1c, 0x43, 0xa1, 0xd0, 0x53, 0x5b, 0xec, 0x43, 0xfe, 0x46, 0xd1, 0xae,
+	0xfc, 0x71, 0x2a, 0xcd, 0x45, 0x68, 0xde, 0x7b, 0x33, 0x3b, 0xef, 0xed, 0xe2, 0xf6, 0x88, 0xa5,
+	0x29, 0xf7, 0x2e, 0x20, 0x93, 0x23, 0x22, 0xc0, 0xbb, 0x3c, 0x5c, 0xfd, 0xbb, 0xa9, 0xe0, 0x8a,
+	0x5b, 0x75, 0xad, 0x70, 0x57, 0xe8, 0xe5, 0x61, 0xb3, 0x4e, 0x62, 0x96, 0x70, 0x4f, 0x7f, 0x8d,
+	0xaa, 0xf9, 0x2c, 0xe4, 0x32, 0xe6, 0x32, 0xd0, 0x95, 0x67, 0x8a, 0x82, 0x6a, 0x44, 0x3c, 0xe2,
+	0x06, 0xcf, 0xff, 0x0a, 0xb4, 0x15, 0x71, 0x1e, 0x8d, 0xc1, 0xd3, 0xd5, 0x70, 0xf2, 0xc9, 0x53,
+	0x2c, 0x06, 0xa9, 0x48, 0x9c, 0x1a, 0x41, 0xe7, 0x1c, 0x57, 0x4e, 0x89, 0x20, 0xb1, 0xb4, 0x3c,
+	0xdc, 0x88, 0xc9, 0x2c, 0x98, 0x0a, 0x92, 0xa6, 0x40, 0x83, 0x0b, 0xc8, 0x02, 0xc9, 0xae, 0xc0,
+	0x46, 0x6d, 0xd4, 0xad, 0xf9, 0xf5, 0x98, 0xcc, 0xce, 0x0d, 0x75, 0x02, 0xd9, 0x19, 0xbb, 0x82,
+	0x37, 0xcf, 0x3f, 0xdf, 0xdf, 0x1c, 0x3c, 0x35, 0xce, 0x66, 0x6b, 0x6f, 0x66, 0x5c, 0xe7, 0x2b,
+	0xc2, 0xb5, 0xb7, 0x49, 0x28, 0xb2, 0x54, 0x31, 0x9e, 0x9c, 0x40, 0x66, 0xb9, 0x78, 0x9b, 0x4f,
+	0x13, 0x10, 0x7a, 0x62, 0x75, 0x60, 0x7f, 0xff, 0xd6, 0x6b, 0x14, 0x16, 0xfa, 0x94, 0x0a, 0x90,
+	0xf2, 0x4c, 0x09, 0x96, 0x44, 0xbe, 0x91, 0x59, 0x2f, 0x30, 0x4e, 0x27, 0xc3, 0x31, 0x0b, 0xf3,
+	0x5d, 0xec, 0x72, 0x1b, 0x75, 0xf7, 0xfd, 0xaa, 0x41, 0xf2, 0x71, 0xef, 0x71, 0x4d, 0x40, 0xc4,
+	0xa4, 0x02, 0x01, 0x34, 0x20, 0xca, 0x7e, 0xd4, 0x46, 0xdd, 0xbd, 0xa3, 0xa6, 0x6b, 0x2c, 0xbb,
+	0x4b, 0xcb, 0xee, 0x87, 0xa5, 0xe5, 0x41, 0xed, 0xf6, 0x57, 0xab, 0x74, 0xfd, 0xbb, 0x85, 0xbe,
+	0xdc, 0xdf, 0x1c, 0x20, 0x7f, 0x7f, 0xdd, 0xdf, 0x57, 0x9d, 0x1f, 0x65, 0x8c, 0xd7, 0x0e, 0xad,
+	0xc7, 0xb8, 0xcc, 0xa8, 0x5e, 0x75, 0xcb, 0x2f, 0x33, 0xba, 0xde, 0xbe, 0xfc, 0x7f, 0xdb, 0x1f,
+	0xe1, 0x9d, 0x48, 0x90, 0x44, 0x01, 0xe8, 0xc5, 0xfe, 0xd5, 0xb1, 0x14, 0xe6, 0x8e, 0x29, 0x51,
+	0x44, 0x82, 0x0a, 0x18, 0xb5, 0xb7, 0xf2, 0x36, 0xbf, 0x5a, 0x20, 0xc7, 0x34, 0xa7, 0x43, 0x9e,
+	0x48, 0x48, 0x34, 0xbd, 0x6d, 0xe8, 0x02, 0x39, 0xa6, 0x56, 0x0b, 0xef, 0x6d, 0x5c, 0x9e, 0x5d,
+	0xd1, 0x81, 0xe1, 0xe9, 0xda, 0xd2, 0x4b, 0x6c, 0x15, 0x27, 0x05, 0x1b, 0xc1, 0xee, 0x68, 0xdd,
+	0x93, 0x82, 0x39, 0x5d, 0xe5, 0xfb, 0x0e, 0x57, 0xf5, 0x85, 0xea, 0x6c, 0x77, 0x1f, 0x9a, 0xed,
+	0xae, 0xe9, 0xed, 0xab, 0x81, 0x7f, 0x3b, 0x77, 0xd0, 0xdd, 0xdc, 0x41, 0x7f, 0xe6, 0x0e, 0xba,
+	0x5e, 0x38, 0xa5, 0xbb, 0x85, 0x53, 0xfa, 0xb9, 0x70, 0x4a, 0x1f, 0x5f, 0x47, 0x4c, 0x8d, 0x26,
+	0x43, 0x37, 0xe4, 0xb1, 0xa7, 0x9f, 0x51, 0x28, 0x88, 0xea, 0x51, 0xc2, 0x4d, 0xd5, 0xd3, 0xa7,
+	0x84, 0x7c, 0xbc, 0xf9, 0xba, 0x54, 0x96, 0x82, 0x1c, 0x56, 0x34, 0xf5, 0xea, 0x6f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xf8, 0x45, 0xf8, 0xe5, 0x58, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxWrappedKeySize != 0 {
+		i = encodeVarintKeyshare(dAtA, i, uint64(m.MaxWrappedKeySize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RegisteredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintKeyshare(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WrappedKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WrappedKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WrappedKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SharedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SharedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintKeyshare(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if len(m.GranteePublicKey) > 0 {
+		i -= len(m.GranteePublicKey)
+		copy(dAtA[i:], m.GranteePublicKey)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.GranteePublicKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.WrappedKey) > 0 {
+		i -= len(m.WrappedKey)
+		copy(dAtA[i:], m.WrappedKey)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.WrappedKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConsentId) > 0 {
+		i -= len(m.ConsentId)
+		copy(dAtA[i:], m.ConsentId)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.ConsentId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DatasetId) > 0 {
+		i -= len(m.DatasetId)
+		copy(dAtA[i:], m.DatasetId)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.DatasetId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintKeyshare(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintKeyshare(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeyshare(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeyshare(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxWrappedKeySize != 0 {
+		n += 1 + sovKeyshare(uint64(m.MaxWrappedKeySize))
+	}
+	return n
+}
+
+func (m *EncryptionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredAt)
+	n += 1 + l + sovKeyshare(uint64(l))
+	return n
+}
+
+func (m *WrappedKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovKeyshare(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = len(m.DatasetId)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = len(m.ConsentId)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = len(m.WrappedKey)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = len(m.GranteePublicKey)
+	if l > 0 {
+		n += 1 + l + sovKeyshare(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SharedAt)
+	n += 1 + l + sovKeyshare(uint64(l))
+	return n
+}
+
+func sovKeyshare(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeyshare(x uint64) (n int) {
+	return sovKeyshare(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWrappedKeySize", wireType)
+			}
+			m.MaxWrappedKeySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWrappedKeySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegisteredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatasetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatasetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedKey = append(m.WrappedKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.WrappedKey == nil {
+				m.WrappedKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranteePublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GranteePublicKey = append(m.GranteePublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GranteePublicKey == nil {
+				m.GranteePublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SharedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeyshare(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeyshare
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshare
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeyshare
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeyshare
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeyshare
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeyshare        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeyshare          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeyshare = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,31 @@
+package types
+
+import "fmt"
+
+const (
+	// DefaultMaxWrappedKeySize leaves room for an ephemeral X25519 key, a
+	// nonce, a 256 bit data key and an authentication tag with plenty of
+	// headroom for envelope metadata.
+	DefaultMaxWrappedKeySize uint32 = 512
+	// MinWrappedKeySize is the smallest envelope that can carry a 256 bit
+	// data key.
+	MinWrappedKeySize = 32
+)
+
+// NewParams creates a new Params instance.
+func NewParams(maxWrappedKeySize uint32) Params {
+	return Params{MaxWrappedKeySize: maxWrappedKeySize}
+}
+
+// DefaultParams returns the default keyshare parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxWrappedKeySize)
+}
+
+// Validate performs basic validation of the keyshare parameters.
+func (p Params) Validate() error {
+	if p.MaxWrappedKeySize < MinWrappedKeySize {
+		return fmt.Errorf("max wrapped key size must be at least %d bytes: %d", MinWrappedKeySize, p.MaxWrappedKeySize)
+	}
+	return nil
+}
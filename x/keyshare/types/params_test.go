@@ -0,0 +1,16 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/keyshare/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.NewParams(types.MinWrappedKeySize - 1)
+	require.Error(t, params.Validate(), "max wrapped key size below a data key should be rejected")
+}
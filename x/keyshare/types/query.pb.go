@@ -0,0 +1,2410 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/keyshare/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryEncryptionKeyRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryEncryptionKeyRequest) Reset()         { *m = QueryEncryptionKeyRequest{} }
+func (m *QueryEncryptionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptionKeyRequest) ProtoMessage()    {}
+func (*QueryEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{2}
+}
+func (m *QueryEncryptionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptionKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptionKeyRequest.Merge(m, src)
+}
+func (m *QueryEncryptionKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptionKeyRequest proto.InternalMessageInfo
+
+func (m *QueryEncryptionKeyRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type QueryEncryptionKeyResponse struct {
+	EncryptionKey EncryptionKey `protobuf:"bytes,1,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key"`
+}
+
+func (m *QueryEncryptionKeyResponse) Reset()         { *m = QueryEncryptionKeyResponse{} }
+func (m *QueryEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptionKeyResponse) ProtoMessage()    {}
+func (*QueryEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{3}
+}
+func (m *QueryEncryptionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptionKeyResponse.Merge(m, src)
+}
+func (m *QueryEncryptionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptionKeyResponse proto.InternalMessageInfo
+
+func (m *QueryEncryptionKeyResponse) GetEncryptionKey() EncryptionKey {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return EncryptionKey{}
+}
+
+type QueryWrappedKeyRequest struct {
+	WrappedKeyId uint64 `protobuf:"varint,1,opt,name=wrapped_key_id,json=wrappedKeyId,proto3" json:"wrapped_key_id,omitempty"`
+}
+
+func (m *QueryWrappedKeyRequest) Reset()         { *m = QueryWrappedKeyRequest{} }
+func (m *QueryWrappedKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedKeyRequest) ProtoMessage()    {}
+func (*QueryWrappedKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{4}
+}
+func (m *QueryWrappedKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedKeyRequest.Merge(m, src)
+}
+func (m *QueryWrappedKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedKeyRequest proto.InternalMessageInfo
+
+func (m *QueryWrappedKeyRequest) GetWrappedKeyId() uint64 {
+	if m != nil {
+		return m.WrappedKeyId
+	}
+	return 0
+}
+
+type QueryWrappedKeyResponse struct {
+	WrappedKey WrappedKey `protobuf:"bytes,1,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key"`
+}
+
+func (m *QueryWrappedKeyResponse) Reset()         { *m = QueryWrappedKeyResponse{} }
+func (m *QueryWrappedKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedKeyResponse) ProtoMessage()    {}
+func (*QueryWrappedKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{5}
+}
+func (m *QueryWrappedKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedKeyResponse.Merge(m, src)
+}
+func (m *QueryWrappedKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedKeyResponse proto.InternalMessageInfo
+
+func (m *QueryWrappedKeyResponse) GetWrappedKey() WrappedKey {
+	if m != nil {
+		return m.WrappedKey
+	}
+	return WrappedKey{}
+}
+
+type QueryWrappedKeysByOwnerRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWrappedKeysByOwnerRequest) Reset()         { *m = QueryWrappedKeysByOwnerRequest{} }
+func (m *QueryWrappedKeysByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedKeysByOwnerRequest) ProtoMessage()    {}
+func (*QueryWrappedKeysByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{6}
+}
+func (m *QueryWrappedKeysByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedKeysByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedKeysByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedKeysByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedKeysByOwnerRequest.Merge(m, src)
+}
+func (m *QueryWrappedKeysByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedKeysByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedKeysByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedKeysByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryWrappedKeysByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryWrappedKeysByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryWrappedKeysByGranteeRequest struct {
+	Grantee    string             `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWrappedKeysByGranteeRequest) Reset()         { *m = QueryWrappedKeysByGranteeRequest{} }
+func (m *QueryWrappedKeysByGranteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedKeysByGranteeRequest) ProtoMessage()    {}
+func (*QueryWrappedKeysByGranteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{7}
+}
+func (m *QueryWrappedKeysByGranteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedKeysByGranteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedKeysByGranteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedKeysByGranteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedKeysByGranteeRequest.Merge(m, src)
+}
+func (m *QueryWrappedKeysByGranteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedKeysByGranteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedKeysByGranteeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedKeysByGranteeRequest proto.InternalMessageInfo
+
+func (m *QueryWrappedKeysByGranteeRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueryWrappedKeysByGranteeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryWrappedKeysByConsentRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConsentId  string             `protobuf:"bytes,2,opt,name=consent_id,json=consentId,proto3" json:"consent_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWrappedKeysByConsentRequest) Reset()         { *m = QueryWrappedKeysByConsentRequest{} }
+func (m *QueryWrappedKeysByConsentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedKeysByConsentRequest) ProtoMessage()    {}
+func (*QueryWrappedKeysByConsentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{8}
+}
+func (m *QueryWrappedKeysByConsentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedKeysByConsentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedKeysByConsentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedKeysByConsentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedKeysByConsentRequest.Merge(m, src)
+}
+func (m *QueryWrappedKeysByConsentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedKeysByConsentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedKeysByConsentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedKeysByConsentRequest proto.InternalMessageInfo
+
+func (m *QueryWrappedKeysByConsentRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryWrappedKeysByConsentRequest) GetConsentId() string {
+	if m != nil {
+		return m.ConsentId
+	}
+	return ""
+}
+
+func (m *QueryWrappedKeysByConsentRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWrappedKeysResponse is the response type shared by the indexed
+// wrapped key queries.
+type QueryWrappedKeysResponse struct {
+	WrappedKeys []WrappedKey        `protobuf:"bytes,1,rep,name=wrapped_keys,json=wrappedKeys,proto3" json:"wrapped_keys"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWrappedKeysResponse) Reset()         { *m = QueryWrappedKeysResponse{} }
+func (m *QueryWrappedKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedKeysResponse) ProtoMessage()    {}
+func (*QueryWrappedKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bd1fafdc59d869b, []int{9}
+}
+func (m *QueryWrappedKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedKeysResponse.Merge(m, src)
+}
+func (m *QueryWrappedKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedKeysResponse proto.InternalMessageInfo
+
+func (m *QueryWrappedKeysResponse) GetWrappedKeys() []WrappedKey {
+	if m != nil {
+		return m.WrappedKeys
+	}
+	return nil
+}
+
+func (m *QueryWrappedKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "hippo.keyshare.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hippo.keyshare.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEncryptionKeyRequest)(nil), "hippo.keyshare.v1.QueryEncryptionKeyRequest")
+	proto.RegisterType((*QueryEncryptionKeyResponse)(nil), "hippo.keyshare.v1.QueryEncryptionKeyResponse")
+	proto.RegisterType((*QueryWrappedKeyRequest)(nil), "hippo.keyshare.v1.QueryWrappedKeyRequest")
+	proto.RegisterType((*QueryWrappedKeyResponse)(nil), "hippo.keyshare.v1.QueryWrappedKeyResponse")
+	proto.RegisterType((*QueryWrappedKeysByOwnerRequest)(nil), "hippo.keyshare.v1.QueryWrappedKeysByOwnerRequest")
+	proto.RegisterType((*QueryWrappedKeysByGranteeRequest)(nil), "hippo.keyshare.v1.QueryWrappedKeysByGranteeRequest")
+	proto.RegisterType((*QueryWrappedKeysByConsentRequest)(nil), "hippo.keyshare.v1.QueryWrappedKeysByConsentRequest")
+	proto.RegisterType((*QueryWrappedKeysResponse)(nil), "hippo.keyshare.v1.QueryWrappedKeysResponse")
+}
+
+func init() { proto.RegisterFile("hippo/keyshare/v1/query.proto", fileDescriptor_3bd1fafdc59d869b) }
+
+var fileDescriptor_3bd1fafdc59d869b = []byte{
+	// 769 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xc7, 0xbb, 0xf0, 0x02, 0xe1, 0xe1, 0x47, 0xc2, 0xbc, 0xcd, 0xfb, 0x96, 0x2a, 0xb5, 0x6e,
+	0x14, 0xb5, 0xc2, 0x0e, 0x2d, 0xc6, 0x10, 0x63, 0x3c, 0xa0, 0x42, 0x08, 0x26, 0x62, 0x3d, 0x98,
+	0x78, 0x21, 0xd3, 0x76, 0xb2, 0x34, 0xd0, 0x9d, 0x65, 0x67, 0x01, 0xd7, 0x06, 0x0f, 0xc6, 0x3f,
+	0xc0, 0xc4, 0xb3, 0xd1, 0xa3, 0x07, 0x0f, 0x1c, 0xfc, 0x1b, 0x0c, 0x47, 0x12, 0x2f, 0x9e, 0x8c,
+	0x01, 0x13, 0xff, 0x02, 0xef, 0x66, 0x67, 0x86, 0xee, 0x6e, 0x77, 0xa1, 0xc5, 0x78, 0x69, 0x76,
+	0x9e, 0x9f, 0x9f, 0x7d, 0xf6, 0x99, 0x6f, 0x0a, 0x13, 0x6b, 0x75, 0xdb, 0x66, 0x78, 0x9d, 0x7a,
+	0x7c, 0x8d, 0x38, 0x14, 0x6f, 0x17, 0xf1, 0xe6, 0x16, 0x75, 0x3c, 0xc3, 0x76, 0x98, 0xcb, 0xd0,
+	0x98, 0x70, 0x1b, 0xc7, 0x6e, 0x63, 0xbb, 0x98, 0x1d, 0x23, 0x8d, 0xba, 0xc5, 0xb0, 0xf8, 0x95,
+	0x51, 0xd9, 0x42, 0x95, 0xf1, 0x06, 0xe3, 0xb8, 0x42, 0x38, 0x95, 0xe9, 0x78, 0xbb, 0x58, 0xa1,
+	0x2e, 0x29, 0x62, 0x9b, 0x98, 0x75, 0x8b, 0xb8, 0x75, 0x66, 0xa9, 0xd8, 0xb4, 0xc9, 0x4c, 0x26,
+	0x1e, 0xb1, 0xff, 0xa4, 0xac, 0xe7, 0x4d, 0xc6, 0xcc, 0x0d, 0x8a, 0x89, 0x5d, 0xc7, 0xc4, 0xb2,
+	0x98, 0x2b, 0x52, 0xb8, 0xf2, 0xe6, 0xe3, 0x90, 0x2d, 0x22, 0x11, 0xa1, 0xa7, 0x01, 0x3d, 0xf2,
+	0xfb, 0xae, 0x10, 0x87, 0x34, 0x78, 0x99, 0x6e, 0x6e, 0x51, 0xee, 0xea, 0x8f, 0xe1, 0xdf, 0x88,
+	0x95, 0xdb, 0xcc, 0xe2, 0x14, 0xdd, 0x86, 0x7e, 0x5b, 0x58, 0x32, 0x5a, 0x5e, 0xbb, 0x3a, 0x54,
+	0x1a, 0x37, 0x62, 0x6f, 0x69, 0xc8, 0x94, 0xf9, 0xc1, 0xfd, 0x6f, 0x17, 0x52, 0x1f, 0x7e, 0xee,
+	0x15, 0xb4, 0xb2, 0xca, 0xd1, 0x8b, 0x30, 0x2e, 0x8a, 0xde, 0xb7, 0xaa, 0x8e, 0x67, 0xfb, 0x98,
+	0xcb, 0xd4, 0x53, 0x1d, 0x51, 0x1a, 0xfa, 0xd8, 0x8e, 0x45, 0x1d, 0x51, 0x79, 0xb0, 0x2c, 0x0f,
+	0xba, 0x0d, 0xd9, 0xa4, 0x14, 0x85, 0x53, 0x86, 0x51, 0xda, 0x72, 0xac, 0xae, 0x53, 0x4f, 0x61,
+	0xe5, 0x13, 0xb0, 0x22, 0x15, 0xc2, 0x74, 0x23, 0x34, 0xec, 0xd1, 0xef, 0xc0, 0x7f, 0xa2, 0xe3,
+	0x13, 0x87, 0xd8, 0x36, 0xad, 0x85, 0x08, 0x2f, 0xc1, 0xe8, 0x8e, 0x34, 0xfa, 0xad, 0x56, 0xeb,
+	0x35, 0xd1, 0xed, 0x9f, 0xf2, 0xf0, 0x4e, 0x2b, 0x74, 0xa9, 0xa6, 0xd7, 0xe0, 0xff, 0x58, 0xbe,
+	0xc2, 0x5d, 0x82, 0xa1, 0x50, 0x01, 0xc5, 0x3a, 0x91, 0xc0, 0x1a, 0xe4, 0x86, 0x41, 0x21, 0xe8,
+	0xa3, 0xbf, 0x80, 0x5c, 0x5b, 0x17, 0x3e, 0xef, 0x3d, 0xf4, 0x47, 0x76, 0xea, 0x3c, 0xd1, 0x02,
+	0x40, 0xb0, 0x57, 0x99, 0x1e, 0x41, 0x30, 0x69, 0xc8, 0x25, 0x34, 0xfc, 0x25, 0x34, 0xe4, 0x0e,
+	0xab, 0x25, 0x34, 0x56, 0x88, 0x49, 0x55, 0xc5, 0x72, 0x28, 0x53, 0x7f, 0xa5, 0x41, 0x3e, 0x0e,
+	0xb0, 0xe8, 0x10, 0xcb, 0xa5, 0xc7, 0x09, 0x28, 0x03, 0x03, 0xa6, 0xb4, 0x28, 0x88, 0xe3, 0xe3,
+	0x5f, 0xc3, 0x78, 0x97, 0x88, 0x71, 0xd7, 0x9f, 0xb6, 0xe5, 0x9e, 0x3e, 0x89, 0x09, 0x80, 0xaa,
+	0x8c, 0xf3, 0xbf, 0x64, 0x8f, 0x70, 0x0d, 0x2a, 0xcb, 0x52, 0xad, 0x8d, 0xb0, 0xf7, 0x8f, 0x09,
+	0xf7, 0x34, 0xc8, 0xb4, 0x13, 0xb6, 0x16, 0x62, 0x19, 0x86, 0x43, 0x0b, 0xe1, 0x5f, 0xaa, 0xde,
+	0x33, 0x6d, 0xc4, 0x50, 0xb0, 0x11, 0x1c, 0x2d, 0x26, 0xcc, 0xf4, 0x4a, 0x47, 0x62, 0x49, 0x12,
+	0x46, 0x2e, 0xfd, 0x1a, 0x80, 0x3e, 0x81, 0x8c, 0x9e, 0x43, 0xbf, 0xbc, 0xcd, 0xe8, 0x72, 0x02,
+	0x53, 0x5c, 0x36, 0xb2, 0x93, 0x9d, 0xc2, 0x64, 0x3b, 0xfd, 0xe2, 0xcb, 0x2f, 0x3f, 0xde, 0xf4,
+	0x9c, 0x43, 0xe3, 0x38, 0xae, 0x4f, 0x52, 0x2c, 0xd0, 0x7b, 0x0d, 0x46, 0x22, 0x77, 0x16, 0x4d,
+	0x9d, 0x54, 0x3c, 0x49, 0x4f, 0xb2, 0xd3, 0x5d, 0x46, 0x2b, 0xa2, 0x92, 0x20, 0x9a, 0x42, 0x85,
+	0x04, 0xa2, 0xa8, 0xc6, 0x70, 0xdc, 0x14, 0x1b, 0xb4, 0x8b, 0xde, 0x6a, 0x00, 0xc1, 0x87, 0x41,
+	0xd7, 0x4e, 0xea, 0x18, 0x93, 0x92, 0x6c, 0xa1, 0x9b, 0x50, 0x45, 0x36, 0x27, 0xc8, 0x4a, 0x68,
+	0x26, 0x81, 0x2c, 0xbc, 0x3d, 0xb8, 0x19, 0x55, 0xa7, 0x5d, 0xf4, 0x51, 0x03, 0x14, 0x17, 0x08,
+	0x54, 0xec, 0xdc, 0xbc, 0x4d, 0x4c, 0xb2, 0xd7, 0xbb, 0x48, 0x69, 0x01, 0xdf, 0x14, 0xc0, 0x33,
+	0xc8, 0x48, 0x00, 0x16, 0x93, 0x6b, 0x4d, 0x30, 0xc2, 0x8f, 0x3e, 0x69, 0x90, 0x4e, 0x92, 0x13,
+	0x34, 0xdb, 0x15, 0x70, 0x54, 0x7c, 0xce, 0x86, 0x7c, 0x4b, 0x20, 0xdf, 0x40, 0xa5, 0x04, 0x64,
+	0xa5, 0x59, 0x1c, 0x37, 0xd5, 0x53, 0x1b, 0xf6, 0xe7, 0x76, 0x6c, 0x25, 0x3f, 0x5d, 0x62, 0x47,
+	0xc5, 0xea, 0x6c, 0xd8, 0x0f, 0x04, 0xf6, 0x02, 0xba, 0xd7, 0x79, 0xd2, 0x4a, 0xd9, 0x38, 0x6e,
+	0x06, 0xaa, 0x17, 0x7d, 0x91, 0xf9, 0xf2, 0xfe, 0x61, 0x4e, 0x3b, 0x38, 0xcc, 0x69, 0xdf, 0x0f,
+	0x73, 0xda, 0xeb, 0xa3, 0x5c, 0xea, 0xe0, 0x28, 0x97, 0xfa, 0x7a, 0x94, 0x4b, 0x3d, 0x9d, 0x33,
+	0xeb, 0xee, 0xda, 0x56, 0xc5, 0xa8, 0xb2, 0x86, 0xec, 0x54, 0x75, 0x88, 0x3b, 0x5d, 0x23, 0x4c,
+	0x9e, 0xa6, 0xc5, 0x3f, 0x89, 0x2a, 0xdb, 0xc0, 0xcf, 0x02, 0x04, 0xd7, 0xb3, 0x29, 0xaf, 0xf4,
+	0x0b, 0xd7, 0xec, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x0d, 0x6e, 0xed, 0x2d, 0x09, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EncryptionKey returns the encryption key registered by an account.
+	EncryptionKey(ctx context.Context, in *QueryEncryptionKeyRequest, opts ...grpc.CallOption) (*QueryEncryptionKeyResponse, error)
+	// WrappedKey returns a wrapped key by id.
+	WrappedKey(ctx context.Context, in *QueryWrappedKeyRequest, opts ...grpc.CallOption) (*QueryWrappedKeyResponse, error)
+	// WrappedKeysByOwner returns the wrapped keys an owner has shared.
+	WrappedKeysByOwner(ctx context.Context, in *QueryWrappedKeysByOwnerRequest, opts ...grpc.CallOption) (*QueryWrappedKeysResponse, error)
+	// WrappedKeysByGrantee returns the wrapped keys shared with a grantee.
+	WrappedKeysByGrantee(ctx context.Context, in *QueryWrappedKeysByGranteeRequest, opts ...grpc.CallOption) (*QueryWrappedKeysResponse, error)
+	// WrappedKeysByConsent returns the wrapped keys an owner shared under a
+	// consent.
+	WrappedKeysByConsent(ctx context.Context, in *QueryWrappedKeysByConsentRequest, opts ...grpc.CallOption) (*QueryWrappedKeysResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hippo.keyshare.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptionKey(ctx context.Context, in *QueryEncryptionKeyRequest, opts ...grpc.CallOption) (*QueryEncryptionKeyResponse, error) {
+	out := new(QueryEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, "/hippo.keyshare.v1.Query/EncryptionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WrappedKey(ctx context.Context, in *QueryWrappedKeyRequest, opts ...grpc.CallOption) (*QueryWrappedKeyResponse, error) {
+	out := new(QueryWrappedKeyResponse)
+	err := c.cc.Invoke(ctx, "/hippo.keyshare.v1.Query/WrappedKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WrappedKeysByOwner(ctx context.Context, in *QueryWrappedKeysByOwnerRequest, opts ...grpc.CallOption) (*QueryWrappedKeysResponse, error) {
+	out := new(QueryWrappedKeysResponse)
+	err := c.cc.Invoke(ctx, "/hippo.keyshare.v1.Query/WrappedKeysByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WrappedKeysByGrantee(ctx context.Context, in *QueryWrappedKeysByGranteeRequest, opts ...grpc.CallOption) (*QueryWrappedKeysResponse, error) {
+	out := new(QueryWrappedKeysResponse)
+	err := c.cc.Invoke(ctx, "/hippo.keyshare.v1.Query/WrappedKeysByGrantee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WrappedKeysByConsent(ctx context.Context, in *QueryWrappedKeysByConsentRequest, opts ...grpc.CallOption) (*QueryWrappedKeysResponse, error) {
+	out := new(QueryWrappedKeysResponse)
+	err := c.cc.Invoke(ctx, "/hippo.keyshare.v1.Query/WrappedKeysByConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EncryptionKey returns the encryption key registered by an account.
+	EncryptionKey(context.Context, *QueryEncryptionKeyRequest) (*QueryEncryptionKeyResponse, error)
+	// WrappedKey returns a wrapped key by id.
+	WrappedKey(context.Context, *QueryWrappedKeyRequest) (*QueryWrappedKeyResponse, error)
+	// WrappedKeysByOwner returns the wrapped keys an owner has shared.
+	WrappedKeysByOwner(context.Context, *QueryWrappedKeysByOwnerRequest) (*QueryWrappedKeysResponse, error)
+	// WrappedKeysByGrantee returns the wrapped keys shared with a grantee.
+	WrappedKeysByGrantee(context.Context, *QueryWrappedKeysByGranteeRequest) (*QueryWrappedKeysResponse, error)
+	// WrappedKeysByConsent returns the wrapped keys an owner shared under a
+	// consent.
+	WrappedKeysByConsent(context.Context, *QueryWrappedKeysByConsentRequest) (*QueryWrappedKeysResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EncryptionKey(ctx context.Context, req *QueryEncryptionKeyRequest) (*QueryEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionKey not implemented")
+}
+func (*UnimplementedQueryServer) WrappedKey(ctx context.Context, req *QueryWrappedKeyRequest) (*QueryWrappedKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedKey not implemented")
+}
+func (*UnimplementedQueryServer) WrappedKeysByOwner(ctx context.Context, req *QueryWrappedKeysByOwnerRequest) (*QueryWrappedKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedKeysByOwner not implemented")
+}
+func (*UnimplementedQueryServer) WrappedKeysByGrantee(ctx context.Context, req *QueryWrappedKeysByGranteeRequest) (*QueryWrappedKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedKeysByGrantee not implemented")
+}
+func (*UnimplementedQueryServer) WrappedKeysByConsent(ctx context.Context, req *QueryWrappedKeysByConsentRequest) (*QueryWrappedKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedKeysByConsent not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.keyshare.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.keyshare.v1.Query/EncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptionKey(ctx, req.(*QueryEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WrappedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWrappedKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WrappedKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.keyshare.v1.Query/WrappedKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WrappedKey(ctx, req.(*QueryWrappedKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WrappedKeysByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWrappedKeysByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WrappedKeysByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.keyshare.v1.Query/WrappedKeysByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WrappedKeysByOwner(ctx, req.(*QueryWrappedKeysByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WrappedKeysByGrantee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWrappedKeysByGranteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WrappedKeysByGrantee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.keyshare.v1.Query/WrappedKeysByGrantee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WrappedKeysByGrantee(ctx, req.(*QueryWrappedKeysByGranteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WrappedKeysByConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWrappedKeysByConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WrappedKeysByConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.keyshare.v1.Query/WrappedKeysByConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WrappedKeysByConsent(ctx, req.(*QueryWrappedKeysByConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hippo.keyshare.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EncryptionKey",
+			Handler:    _Query_EncryptionKey_Handler,
+		},
+		{
+			MethodName: "WrappedKey",
+			Handler:    _Query_WrappedKey_Handler,
+		},
+		{
+			MethodName: "WrappedKeysByOwner",
+			Handler:    _Query_WrappedKeysByOwner_Handler,
+		},
+		{
+			MethodName: "WrappedKeysByGrantee",
+			Handler:    _Query_WrappedKeysByGrantee_Handler,
+		},
+		{
+			MethodName: "WrappedKeysByConsent",
+			Handler:    _Query_WrappedKeysByConsent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hippo/keyshare/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptionKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptionKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptionKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EncryptionKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WrappedKeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WrappedKeyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WrappedKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedKeysByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedKeysByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedKeysByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedKeysByGranteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedKeysByGranteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedKeysByGranteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedKeysByConsentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedKeysByConsentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedKeysByConsentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsentId) > 0 {
+		i -= len(m.ConsentId)
+		copy(dAtA[i:], m.ConsentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WrappedKeys) > 0 {
+		for iNdEx := len(m.WrappedKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WrappedKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEncryptionKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEncryptionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EncryptionKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWrappedKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WrappedKeyId != 0 {
+		n += 1 + sovQuery(uint64(m.WrappedKeyId))
+	}
+	return n
+}
+
+func (m *QueryWrappedKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WrappedKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWrappedKeysByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWrappedKeysByGranteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWrappedKeysByConsentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWrappedKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WrappedKeys) > 0 {
+		for _, e := range m.WrappedKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptionKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptionKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptionKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EncryptionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrappedKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedKeyId", wireType)
+			}
+			m.WrappedKeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrappedKeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrappedKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WrappedKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrappedKeysByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedKeysByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedKeysByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrappedKeysByGranteeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedKeysByGranteeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedKeysByGranteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrappedKeysByConsentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedKeysByConsentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedKeysByConsentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrappedKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrappedKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrappedKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedKeys = append(m.WrappedKeys, WrappedKey{})
+			if err := m.WrappedKeys[len(m.WrappedKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,82 @@
+package liquidstake
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.liquidstake.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the liquidstake module parameters",
+				},
+				{
+					RpcMethod:      "TokenizeShareRecord",
+					Use:            "record [id]",
+					Short:          "Query a tokenize share record by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordByDenom",
+					Use:            "record-by-denom [denom]",
+					Short:          "Query the tokenize share record of a share token denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "TokenizeShareRecords",
+					Use:       "records",
+					Short:     "Query all tokenize share records",
+				},
+				{
+					RpcMethod:      "LiquidValidator",
+					Use:            "liquid-validator [validator-address]",
+					Short:          "Query the liquid staked shares of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the liquid staked tokens and their share of the bonded tokens",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.liquidstake.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-shares [validator-address] [amount] [owner]",
+					Short:          "Tokenize part of a delegation into transferable share tokens",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount]",
+					Short:          "Redeem share tokens back into a delegation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferTokenizeShareRecord",
+					Use:            "transfer-record [record-id] [new-owner]",
+					Short:          "Transfer the ownership of a tokenize share record and its rewards",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "record_id"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "WithdrawTokenizeShareRecordReward",
+					Use:            "withdraw-record-reward [record-id]",
+					Short:          "Withdraw the rewards of a tokenize share record",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "record_id"}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
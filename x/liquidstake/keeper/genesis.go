@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+)
+
+// InitGenesis initializes the liquidstake module state from a genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, record := range gs.Records {
+		if err := k.Records.Set(ctx, record.Id, record); err != nil {
+			return err
+		}
+		if err := k.RecordIDByDenom.Set(ctx, record.GetShareTokenDenom(), record.Id); err != nil {
+			return err
+		}
+	}
+	if err := k.LastRecordID.Set(ctx, gs.LastRecordId); err != nil {
+		return err
+	}
+
+	for _, v := range gs.LiquidValidators {
+		validator, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(v.OperatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.LiquidShares.Set(ctx, validator, v.LiquidShares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the liquidstake module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastRecordID, err := k.LastRecordID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params, LastRecordId: lastRecordID}
+
+	if err := k.Records.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (bool, error) {
+		gs.Records = append(gs.Records, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	valCodec := k.stakingKeeper.ValidatorAddressCodec()
+	if err := k.LiquidShares.Walk(ctx, nil, func(validator sdk.ValAddress, shares math.LegacyDec) (bool, error) {
+		addr, err := valCodec.BytesToString(validator)
+		if err != nil {
+			return true, err
+		}
+		gs.LiquidValidators = append(gs.LiquidValidators, types.LiquidValidator{OperatorAddress: addr, LiquidShares: shares})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
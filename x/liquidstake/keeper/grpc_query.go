@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the liquidstake
+// QueryServer interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// TokenizeShareRecord implements types.QueryServer.
+func (k queryServer) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	record, err := k.GetRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryTokenizeShareRecordResponse{Record: record, Denom: record.GetShareTokenDenom()}, nil
+}
+
+// TokenizeShareRecordByDenom implements types.QueryServer.
+func (k queryServer) TokenizeShareRecordByDenom(ctx context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	record, err := k.GetRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecords implements types.QueryServer.
+func (k queryServer) TokenizeShareRecords(ctx context.Context, req *types.QueryTokenizeShareRecordsRequest) (*types.QueryTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	records, pageRes, err := query.CollectionPaginate(ctx, k.Records, req.Pagination,
+		func(_ uint64, record types.TokenizeShareRecord) (types.TokenizeShareRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// LiquidValidator implements types.QueryServer.
+func (k queryServer) LiquidValidator(ctx context.Context, req *types.QueryLiquidValidatorRequest) (*types.QueryLiquidValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	shares, err := k.GetLiquidShares(ctx, validator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryLiquidValidatorResponse{
+		LiquidValidator: types.LiquidValidator{OperatorAddress: req.ValidatorAddress, LiquidShares: shares},
+	}, nil
+}
+
+// TotalLiquidStaked implements types.QueryServer.
+func (k queryServer) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.TotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	bonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	share := math.LegacyZeroDec()
+	if bonded.IsPositive() {
+		share = tokens.ToLegacyDec().QuoInt(bonded)
+	}
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens, BondedShare: share}, nil
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+)
+
+// Keeper tokenizes delegations into transferable share tokens and caps how
+// much of the stake may be liquid.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	stakingKeeper types.StakingKeeper
+
+	// the address capable of executing MsgUpdateParams, typically the x/gov
+	// module account.
+	authority string
+
+	Schema          collections.Schema
+	Params          collections.Item[types.Params]
+	Records         collections.Map[uint64, types.TokenizeShareRecord]
+	LastRecordID    collections.Sequence
+	RecordIDByDenom collections.Map[string, uint64]
+	LiquidShares    collections.Map[sdk.ValAddress, math.LegacyDec]
+}
+
+// NewKeeper creates a new liquidstake Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid liquidstake authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:             cdc,
+		storeService:    storeService,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		distrKeeper:     distrKeeper,
+		stakingKeeper:   stakingKeeper,
+		authority:       authority,
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Records:         collections.NewMap(sb, types.RecordsKey, "records", collections.Uint64Key, codec.CollValue[types.TokenizeShareRecord](cdc)),
+		LastRecordID:    collections.NewSequence(sb, types.RecordIDKey, "last_record_id"),
+		RecordIDByDenom: collections.NewMap(sb, types.RecordIDByDenomKey, "record_id_by_denom", collections.StringKey, collections.Uint64Value),
+		LiquidShares:    collections.NewMap(sb, types.LiquidSharesKey, "liquid_shares", sdk.ValAddressKey, sdk.LegacyDecValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetRecord returns the tokenize share record with id.
+func (k Keeper) GetRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.Records.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrRecordNotFound, "id %d", id)
+	}
+	return record, err
+}
+
+// GetRecordByDenom returns the tokenize share record whose share tokens are
+// denom.
+func (k Keeper) GetRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	id, err := k.RecordIDByDenom.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrRecordNotFound, "denom %s", denom)
+	}
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+	return k.GetRecord(ctx, id)
+}
+
+// GetLiquidShares returns the delegator shares of a validator held by
+// tokenize share records.
+func (k Keeper) GetLiquidShares(ctx context.Context, validator sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.LiquidShares.Get(ctx, validator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+	return shares, err
+}
+
+// addLiquidShares adds delta, which may be negative, to the liquid shares of
+// a validator.
+func (k Keeper) addLiquidShares(ctx context.Context, validator sdk.ValAddress, delta math.LegacyDec) error {
+	shares, err := k.GetLiquidShares(ctx, validator)
+	if err != nil {
+		return err
+	}
+	shares = shares.Add(delta)
+	if !shares.IsPositive() {
+		return k.LiquidShares.Remove(ctx, validator)
+	}
+	return k.LiquidShares.Set(ctx, validator, shares)
+}
+
+// TotalLiquidStakedTokens returns the tokens backing the shares held by all
+// tokenize share records.
+func (k Keeper) TotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.LiquidShares.Walk(ctx, nil, func(valAddr sdk.ValAddress, shares math.LegacyDec) (bool, error) {
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+		total = total.Add(validator.TokensFromShares(shares).TruncateInt())
+		return false, nil
+	})
+	return total, err
+}
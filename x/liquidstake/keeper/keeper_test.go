@@ -0,0 +1,328 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+)
+
+var (
+	operator  = sdk.AccAddress([]byte("operator____________"))
+	delegator = sdk.AccAddress([]byte("delegator___________"))
+	user      = sdk.AccAddress([]byte("user________________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	validator sdk.ValAddress
+	tokens    math.Int
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.LiquidStakeKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.LiquidStakeKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.LiquidStakeKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the operator and the delegator each bond half of the stake
+	s.tokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	s.validator = s.createValidator(s.tokens)
+	s.fund(delegator, s.tokens)
+	_, err := stakingkeeper.NewMsgServerImpl(s.app.StakingKeeper).Delegate(s.ctx, stakingtypes.NewMsgDelegate(
+		delegator.String(), s.validator.String(), sdk.NewCoin(consensus.DefaultHippoDenom, s.tokens),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, amount math.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, amount))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, addr, coins))
+}
+
+// createValidator bonds a validator with the given self delegation and
+// returns its operator address.
+func (s *KeeperTestSuite) createValidator(tokens math.Int) sdk.ValAddress {
+	s.Require().NoError(s.app.StakingKeeper.SetParams(s.ctx, stakingtypes.NewParams(
+		stakingtypes.DefaultUnbondingTime, stakingtypes.DefaultMaxValidators, stakingtypes.DefaultMaxEntries,
+		stakingtypes.DefaultHistoricalEntries, consensus.DefaultHippoDenom, stakingtypes.DefaultMinCommissionRate,
+	)))
+	s.Require().NoError(s.app.DistrKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()))
+
+	s.fund(operator, tokens)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator).String(), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(consensus.DefaultHippoDenom, tokens),
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 2)),
+		math.OneInt(),
+	)
+	s.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(s.app.StakingKeeper).CreateValidator(s.ctx, msg)
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.EndBlocker(s.ctx)
+	s.Require().NoError(err)
+
+	return sdk.ValAddress(operator)
+}
+
+func (s *KeeperTestSuite) delegation(addr sdk.AccAddress) math.LegacyDec {
+	delegation, err := s.app.StakingKeeper.GetDelegation(s.ctx, addr, s.validator)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return delegation.Shares
+}
+
+func (s *KeeperTestSuite) tokenize(amount math.Int, owner sdk.AccAddress) (sdk.Coin, error) {
+	res, err := s.msgServer.TokenizeShares(s.ctx, &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    s.validator.String(),
+		Amount:              sdk.NewCoin(consensus.DefaultHippoDenom, amount),
+		TokenizedShareOwner: owner.String(),
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return res.Amount, nil
+}
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeem() {
+	amount := s.tokens.QuoRaw(5)
+	shareTokens, err := s.tokenize(amount, delegator)
+	s.Require().NoError(err)
+	s.Require().Equal(s.validator.String()+"/1", shareTokens.Denom)
+	s.Require().Equal(amount, shareTokens.Amount)
+	s.Require().Equal(shareTokens, s.app.BankKeeper.GetBalance(s.ctx, delegator, shareTokens.Denom))
+
+	// the shares moved to the record, the validator is untouched
+	record, err := s.app.LiquidStakeKeeper.GetRecordByDenom(s.ctx, shareTokens.Denom)
+	s.Require().NoError(err)
+	s.Require().Equal(delegator.String(), record.Owner)
+	recordAddr := authtypes.NewModuleAddress(record.ModuleAccount)
+	s.Require().Equal(math.LegacyNewDecFromInt(amount), s.delegation(recordAddr))
+	s.Require().Equal(math.LegacyNewDecFromInt(s.tokens.Sub(amount)), s.delegation(delegator))
+	validator, err := s.app.StakingKeeper.GetValidator(s.ctx, s.validator)
+	s.Require().NoError(err)
+	s.Require().Equal(s.tokens.MulRaw(2), validator.Tokens)
+
+	liquid, err := s.app.LiquidStakeKeeper.GetLiquidShares(s.ctx, s.validator)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecFromInt(amount), liquid)
+
+	// share tokens are transferable and anyone holding them can redeem
+	half := sdk.NewCoin(shareTokens.Denom, amount.QuoRaw(2))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, delegator, user, sdk.NewCoins(half)))
+	res, err := s.msgServer.RedeemTokensForShares(s.ctx, &types.MsgRedeemTokensForShares{DelegatorAddress: user.String(), Amount: half})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(consensus.DefaultHippoDenom, half.Amount), res.Amount)
+	s.Require().Equal(math.LegacyNewDecFromInt(half.Amount), s.delegation(user))
+	s.Require().Equal(math.LegacyNewDecFromInt(amount.Sub(half.Amount)), s.delegation(recordAddr))
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, user, shareTokens.Denom).IsZero())
+
+	// redeeming the last share tokens deletes the record
+	_, err = s.msgServer.RedeemTokensForShares(s.ctx, &types.MsgRedeemTokensForShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           sdk.NewCoin(shareTokens.Denom, amount.Sub(half.Amount)),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecFromInt(s.tokens.Sub(half.Amount)), s.delegation(delegator))
+	s.Require().True(s.delegation(recordAddr).IsZero())
+	s.Require().True(s.app.BankKeeper.GetSupply(s.ctx, shareTokens.Denom).IsZero())
+	_, err = s.app.LiquidStakeKeeper.GetRecord(s.ctx, record.Id)
+	s.Require().ErrorIs(err, types.ErrRecordNotFound)
+	liquid, err = s.app.LiquidStakeKeeper.GetLiquidShares(s.ctx, s.validator)
+	s.Require().NoError(err)
+	s.Require().True(liquid.IsZero())
+
+	_, err = s.msgServer.RedeemTokensForShares(s.ctx, &types.MsgRedeemTokensForShares{DelegatorAddress: delegator.String(), Amount: half})
+	s.Require().ErrorIs(err, types.ErrRecordNotFound)
+}
+
+func (s *KeeperTestSuite) TestTokenizeCaps() {
+	// a quarter of the 200 bonded tokens may be liquid
+	_, err := s.tokenize(s.tokens.QuoRaw(2).AddRaw(1), delegator)
+	s.Require().ErrorIs(err, types.ErrGlobalLiquidStakingCap)
+	_, err = s.tokenize(s.tokens.QuoRaw(4), delegator)
+	s.Require().NoError(err)
+	_, err = s.tokenize(s.tokens.QuoRaw(4), delegator)
+	s.Require().NoError(err)
+	_, err = s.tokenize(math.OneInt(), delegator)
+	s.Require().ErrorIs(err, types.ErrGlobalLiquidStakingCap)
+
+	// a tenth of the validator shares may be liquid, half of them already are
+	params := types.NewParams(math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 1))
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+	_, err = s.tokenize(math.OneInt(), delegator)
+	s.Require().ErrorIs(err, types.ErrValidatorLiquidStakingCap)
+}
+
+func (s *KeeperTestSuite) TestTokenizeSafeguards() {
+	_, err := s.msgServer.TokenizeShares(s.ctx, &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    s.validator.String(),
+		Amount:              sdk.NewCoin("uatom", math.OneInt()),
+		TokenizedShareOwner: delegator.String(),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidAmount)
+
+	_, err = s.msgServer.TokenizeShares(s.ctx, &types.MsgTokenizeShares{
+		DelegatorAddress:    operator.String(),
+		ValidatorAddress:    s.validator.String(),
+		Amount:              sdk.NewCoin(consensus.DefaultHippoDenom, math.OneInt()),
+		TokenizedShareOwner: operator.String(),
+	})
+	s.Require().ErrorIs(err, types.ErrSelfBond)
+
+	// more than delegated
+	_, err = s.tokenize(s.tokens.AddRaw(1), delegator)
+	s.Require().Error(err)
+
+	validator, err := s.app.StakingKeeper.GetValidator(s.ctx, s.validator)
+	s.Require().NoError(err)
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.StakingKeeper.Jail(s.ctx, consAddr))
+	_, err = s.tokenize(math.OneInt(), delegator)
+	s.Require().ErrorIs(err, types.ErrValidatorJailed)
+}
+
+func (s *KeeperTestSuite) TestTransferAndWithdrawReward() {
+	shareTokens, err := s.tokenize(s.tokens.QuoRaw(5), delegator)
+	s.Require().NoError(err)
+	record, err := s.app.LiquidStakeKeeper.GetRecordByDenom(s.ctx, shareTokens.Denom)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.TransferTokenizeShareRecord(s.ctx, &types.MsgTransferTokenizeShareRecord{
+		RecordId: record.Id, Sender: user.String(), NewOwner: user.String(),
+	})
+	s.Require().ErrorIs(err, types.ErrNotRecordOwner)
+	_, err = s.msgServer.TransferTokenizeShareRecord(s.ctx, &types.MsgTransferTokenizeShareRecord{
+		RecordId: record.Id, Sender: delegator.String(), NewOwner: user.String(),
+	})
+	s.Require().NoError(err)
+
+	// allocate rewards to the validator in a later block, delegations earn
+	// nothing in the block they are created in
+	s.ctx = s.ctx.WithBlockHeight(2)
+	rewards := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(1_000_000)))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, rewards))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	validator, err := s.app.StakingKeeper.GetValidator(s.ctx, s.validator)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.DistrKeeper.AllocateTokensToValidator(s.ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	_, err = s.msgServer.WithdrawTokenizeShareRecordReward(s.ctx, &types.MsgWithdrawTokenizeShareRecordReward{
+		Owner: delegator.String(), RecordId: record.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrNotRecordOwner)
+
+	res, err := s.msgServer.WithdrawTokenizeShareRecordReward(s.ctx, &types.MsgWithdrawTokenizeShareRecordReward{
+		Owner: user.String(), RecordId: record.Id,
+	})
+	s.Require().NoError(err)
+	// the record holds a tenth of the shares, the validator keeps a tenth as commission
+	s.Require().Equal(math.NewInt(90_000), res.Amount.AmountOf(consensus.DefaultHippoDenom))
+	s.Require().Equal(res.Amount, s.app.BankKeeper.GetAllBalances(s.ctx, user))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1))
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: user.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(math.LegacyNewDec(2), math.LegacyOneDec())})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestQueries() {
+	amount := s.tokens.QuoRaw(5)
+	shareTokens, err := s.tokenize(amount, user)
+	s.Require().NoError(err)
+
+	record, err := s.queryServer.TokenizeShareRecord(s.ctx, &types.QueryTokenizeShareRecordRequest{Id: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(shareTokens.Denom, record.Denom)
+	s.Require().Equal(user.String(), record.Record.Owner)
+
+	byDenom, err := s.queryServer.TokenizeShareRecordByDenom(s.ctx, &types.QueryTokenizeShareRecordByDenomRequest{Denom: shareTokens.Denom})
+	s.Require().NoError(err)
+	s.Require().Equal(record.Record, byDenom.Record)
+
+	_, err = s.queryServer.TokenizeShareRecord(s.ctx, &types.QueryTokenizeShareRecordRequest{Id: 2})
+	s.Require().Error(err)
+
+	records, err := s.queryServer.TokenizeShareRecords(s.ctx, &types.QueryTokenizeShareRecordsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TokenizeShareRecord{record.Record}, records.Records)
+
+	liquid, err := s.queryServer.LiquidValidator(s.ctx, &types.QueryLiquidValidatorRequest{ValidatorAddress: s.validator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecFromInt(amount), liquid.LiquidValidator.LiquidShares)
+
+	total, err := s.queryServer.TotalLiquidStaked(s.ctx, &types.QueryTotalLiquidStakedRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(amount, total.Tokens)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 1), total.BondedShare)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	_, err := s.tokenize(s.tokens.QuoRaw(5), user)
+	s.Require().NoError(err)
+
+	exported, err := s.app.LiquidStakeKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), exported.LastRecordId)
+	s.Require().Len(exported.Records, 1)
+	s.Require().Len(exported.LiquidValidators, 1)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.LiquidStakeKeeper.InitGenesis(s.ctx, exported))
+	reexported, err := s.app.LiquidStakeKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported, reexported)
+}
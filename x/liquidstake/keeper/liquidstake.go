@@ -0,0 +1,327 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+)
+
+// TokenizeShares moves amount of the delegation of delegator to validator
+// into a new tokenize share record owned by owner and mints share tokens
+// for the moved shares to owner. The delegation stays bonded, so the share
+// tokens keep earning rewards and remain exposed to slashing.
+func (k Keeper) TokenizeShares(
+	ctx context.Context,
+	delegator sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	amount sdk.Coin,
+	owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if amount.Denom != bondDenom || !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "expected a positive amount of %s, got %s", bondDenom, amount)
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if validator.IsJailed() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrValidatorJailed, validator.GetOperator())
+	}
+	// the self bond backs the validator's own stake in the network and
+	// guards its min self delegation, it is never liquid
+	if bytes.Equal(delegator, valAddr) {
+		return sdk.Coin{}, types.ErrSelfBond
+	}
+	// a delegation received through a redelegation can still be slashed for
+	// infractions at the source validator, which the record would escape
+	redelegating, err := k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if redelegating {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+	// vesting accounts track their delegations to release locked tokens,
+	// which tokenizing would bypass
+	if _, ok := k.accountKeeper.GetAccount(ctx, delegator).(vestingexported.VestingAccount); ok {
+		return sdk.Coin{}, types.ErrVestingAccount
+	}
+
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, valAddr, amount.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.checkCaps(ctx, validator.DelegatorShares, valAddr, shares, amount.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	last, err := k.LastRecordID.Peek(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	id := last + 1
+	if err := k.LastRecordID.Set(ctx, id); err != nil {
+		return sdk.Coin{}, err
+	}
+	record := types.TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: types.RecordModuleAccountName(id),
+		Validator:     validator.GetOperator(),
+	}
+	recordAddr := authtypes.NewModuleAddress(record.ModuleAccount)
+	if k.accountKeeper.GetAccount(ctx, recordAddr) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccount(ctx, authtypes.NewEmptyModuleAccount(record.ModuleAccount)))
+	}
+
+	_, newShares, err := k.moveShares(ctx, delegator, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareTokens.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "%s is too small to tokenize", amount)
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.Records.Set(ctx, id, record); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.RecordIDByDenom.Set(ctx, shareTokens.Denom, id); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.addLiquidShares(ctx, valAddr, newShares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyOwner, record.Owner),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, shareTokens.String()),
+		),
+	)
+	return shareTokens, nil
+}
+
+// checkCaps checks that tokenizing shares worth tokens of a validator with
+// delegatorShares keeps the liquid stake within the global and validator
+// caps.
+func (k Keeper) checkCaps(ctx context.Context, delegatorShares math.LegacyDec, valAddr sdk.ValAddress, shares math.LegacyDec, tokens math.Int) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	liquidTokens, err := k.TotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	bondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	if liquidTokens.Add(tokens).ToLegacyDec().GT(params.GlobalLiquidStakingCap.MulInt(bondedTokens)) {
+		return types.ErrGlobalLiquidStakingCap
+	}
+
+	liquidShares, err := k.GetLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if liquidShares.Add(shares).GT(params.ValidatorLiquidStakingCap.Mul(delegatorShares)) {
+		return types.ErrValidatorLiquidStakingCap
+	}
+	return nil
+}
+
+// moveShares moves shares of the delegation of from to validator over to
+// to, leaving the backing tokens bonded. It returns the moved tokens and the
+// shares to received for them.
+func (k Keeper) moveShares(ctx context.Context, from, to sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) (math.Int, math.LegacyDec, error) {
+	tokens, err := k.stakingKeeper.Unbond(ctx, from, valAddr, shares)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+	// Unbond may have removed an unbonded validator without shares left
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+	newShares, err := k.stakingKeeper.Delegate(ctx, to, tokens, validator.GetStatus(), validator, false)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+	return tokens, newShares, nil
+}
+
+// RedeemTokensForShares burns amount of share tokens of delegator and moves
+// the delegation shares they represent from the tokenize share record back
+// to delegator. The record is deleted together with its last share tokens,
+// sending its remaining rewards to the record owner.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delegator sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	if !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "expected a positive amount, got %s", amount)
+	}
+	record, err := k.GetRecordByDenom(ctx, amount.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	recordAddr := authtypes.NewModuleAddress(record.ModuleAccount)
+
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, recordAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+	if amount.Amount.GT(supply) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "%s exceeds the supply of %s", amount, supply)
+	}
+	last := amount.Amount.Equal(supply)
+	shares := delegation.Shares
+	if !last {
+		shares = shares.MulInt(amount.Amount).QuoInt(supply)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokens, _, err := k.moveShares(ctx, recordAddr, delegator, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.addLiquidShares(ctx, valAddr, shares.Neg()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if last {
+		owner, err := k.accountKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		// removing the delegation withdrew its rewards to the record account
+		if balance := k.bankKeeper.GetAllBalances(ctx, recordAddr); !balance.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balance); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+		if err := k.Records.Remove(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.RecordIDByDenom.Remove(ctx, amount.Denom); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	redeemed := sdk.NewCoin(bondDenom, tokens)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, redeemed.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+	return redeemed, nil
+}
+
+// TransferRecord transfers the ownership of a tokenize share record, and so
+// the right to its rewards, from sender to newOwner.
+func (k Keeper) TransferRecord(ctx context.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, err := k.GetRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+	if record.Owner != sender.String() {
+		return errorsmod.Wrapf(types.ErrNotRecordOwner, "record %d is owned by %s", id, record.Owner)
+	}
+	record.Owner = newOwner.String()
+	if err := k.Records.Set(ctx, id, record); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferRecord,
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, record.Owner),
+		),
+	)
+	return nil
+}
+
+// WithdrawRecordReward withdraws the rewards of the delegation held by a
+// tokenize share record to its owner.
+func (k Keeper) WithdrawRecordReward(ctx context.Context, owner sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	record, err := k.GetRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if record.Owner != owner.String() {
+		return nil, errorsmod.Wrapf(types.ErrNotRecordOwner, "record %d is owned by %s", id, record.Owner)
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+	recordAddr := authtypes.NewModuleAddress(record.ModuleAccount)
+
+	if _, err := k.distrKeeper.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+		return nil, err
+	}
+	// the balance also holds rewards withdrawn whenever the delegation of
+	// the record changed
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRecordReward,
+			sdk.NewAttribute(types.AttributeKeyOwner, record.Owner),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()),
+		),
+	)
+	return rewards, nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the liquidstake MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// TokenizeShares implements types.MsgServer.
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	delegator, err := k.accountKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tokenized share owner address: %s", err)
+	}
+
+	amount, err := k.Keeper.TokenizeShares(goCtx, delegator, validator, msg.Amount, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgTokenizeSharesResponse{Amount: amount}, nil
+}
+
+// RedeemTokensForShares implements types.MsgServer.
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegator, err := k.accountKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	amount, err := k.Keeper.RedeemTokensForShares(goCtx, delegator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRedeemTokensForSharesResponse{Amount: amount}, nil
+}
+
+// TransferTokenizeShareRecord implements types.MsgServer.
+func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	sender, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	newOwner, err := k.accountKeeper.AddressCodec().StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address: %s", err)
+	}
+
+	if err := k.TransferRecord(goCtx, msg.RecordId, sender, newOwner); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// WithdrawTokenizeShareRecordReward implements types.MsgServer.
+func (k msgServer) WithdrawTokenizeShareRecordReward(goCtx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	owner, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	rewards, err := k.WithdrawRecordReward(goCtx, owner, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: rewards}, nil
+}
+
+// UpdateParams implements types.MsgServer. Lowering a cap does not touch the
+// records above it, it only stops new tokenizations.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the liquidstake messages on the amino
+// codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeShares{}, "hippo/x/liquidstake/MsgTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemTokensForShares{}, "hippo/x/liquidstake/MsgRedeemTokens")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferTokenizeShareRecord{}, "hippo/x/liquidstake/MsgTransferRecord")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawTokenizeShareRecordReward{}, "hippo/x/liquidstake/MsgWithdrawReward")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/liquidstake/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/liquidstake/Params", nil)
+}
+
+// RegisterInterfaces registers the liquidstake messages on the interface
+// registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgTransferTokenizeShareRecord{},
+		&MsgWithdrawTokenizeShareRecordReward{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,17 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/liquidstake module sentinel errors
+var (
+	ErrInvalidParams             = errorsmod.Register(ModuleName, 2, "invalid params")
+	ErrInvalidAmount             = errorsmod.Register(ModuleName, 3, "invalid amount")
+	ErrRecordNotFound            = errorsmod.Register(ModuleName, 4, "tokenize share record not found")
+	ErrNotRecordOwner            = errorsmod.Register(ModuleName, 5, "not the owner of the tokenize share record")
+	ErrValidatorJailed           = errorsmod.Register(ModuleName, 6, "validator is jailed")
+	ErrSelfBond                  = errorsmod.Register(ModuleName, 7, "validator self bond cannot be tokenized")
+	ErrRedelegationInProgress    = errorsmod.Register(ModuleName, 8, "delegation has a redelegation in progress")
+	ErrVestingAccount            = errorsmod.Register(ModuleName, 9, "vesting accounts cannot tokenize delegations")
+	ErrGlobalLiquidStakingCap    = errorsmod.Register(ModuleName, 10, "global liquid staking cap exceeded")
+	ErrValidatorLiquidStakingCap = errorsmod.Register(ModuleName, 11, "validator liquid staking cap exceeded")
+)
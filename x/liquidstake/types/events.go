@@ -0,0 +1,17 @@
+package types
+
+// liquidstake module event types and attributes
+const (
+	EventTypeTokenizeShares       = "tokenize_shares"
+	EventTypeRedeemShares         = "redeem_tokens_for_shares"
+	EventTypeTransferRecord       = "transfer_tokenize_share_record"
+	EventTypeWithdrawRecordReward = "withdraw_tokenize_share_record_reward"
+
+	AttributeKeyDelegator = "delegator"
+	AttributeKeyValidator = "validator"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyNewOwner  = "new_owner"
+	AttributeKeyRecordID  = "record_id"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyShares    = "shares"
+)
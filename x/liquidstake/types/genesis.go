@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default liquidstake genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation. addrCodec and valCodec
+// decode the record owners and validators.
+func (gs GenesisState) Validate(addrCodec, valCodec address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]bool, len(gs.Records))
+	for _, r := range gs.Records {
+		if ids[r.Id] {
+			return fmt.Errorf("duplicate tokenize share record %d", r.Id)
+		}
+		ids[r.Id] = true
+		if r.Id == 0 || r.Id > gs.LastRecordId {
+			return fmt.Errorf("tokenize share record id %d out of range", r.Id)
+		}
+		if r.ModuleAccount != RecordModuleAccountName(r.Id) {
+			return fmt.Errorf("invalid module account of tokenize share record %d: %s", r.Id, r.ModuleAccount)
+		}
+		if _, err := addrCodec.StringToBytes(r.Owner); err != nil {
+			return fmt.Errorf("invalid owner of tokenize share record %d: %w", r.Id, err)
+		}
+		if _, err := valCodec.StringToBytes(r.Validator); err != nil {
+			return fmt.Errorf("invalid validator of tokenize share record %d: %w", r.Id, err)
+		}
+	}
+
+	validators := make(map[string]bool, len(gs.LiquidValidators))
+	for _, v := range gs.LiquidValidators {
+		if validators[v.OperatorAddress] {
+			return fmt.Errorf("duplicate liquid validator %s", v.OperatorAddress)
+		}
+		validators[v.OperatorAddress] = true
+		if _, err := valCodec.StringToBytes(v.OperatorAddress); err != nil {
+			return fmt.Errorf("invalid liquid validator address: %w", err)
+		}
+		if v.LiquidShares.IsNil() || v.LiquidShares.IsNegative() {
+			return fmt.Errorf("liquid shares of %s must not be negative: %s", v.OperatorAddress, v.LiquidShares)
+		}
+	}
+
+	return nil
+}
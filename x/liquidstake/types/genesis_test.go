@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/liquidstake/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr string
+	}{
+		{"default", types.DefaultParams(), ""},
+		{"zero caps", types.NewParams(math.LegacyZeroDec(), math.LegacyZeroDec()), ""},
+		{"full caps", types.NewParams(math.LegacyOneDec(), math.LegacyOneDec()), ""},
+		{"nil global cap", types.Params{ValidatorLiquidStakingCap: math.LegacyOneDec()}, "global liquid staking cap"},
+		{"negative global cap", types.NewParams(math.LegacyNewDec(-1), math.LegacyOneDec()), "global liquid staking cap"},
+		{"validator cap above one", types.NewParams(math.LegacyOneDec(), math.LegacyNewDec(2)), "validator liquid staking cap"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
+
+func TestGenesisValidate(t *testing.T) {
+	addrCodec := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	valCodec := address.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+	owner, err := addrCodec.BytesToString([]byte("owner_______________"))
+	require.NoError(t, err)
+	validator, err := valCodec.BytesToString([]byte("validator___________"))
+	require.NoError(t, err)
+
+	record := types.TokenizeShareRecord{Id: 1, Owner: owner, ModuleAccount: types.RecordModuleAccountName(1), Validator: validator}
+	liquid := types.LiquidValidator{OperatorAddress: validator, LiquidShares: math.LegacyNewDec(10)}
+	params := types.DefaultParams()
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", types.GenesisState{Params: params, Records: []types.TokenizeShareRecord{record}, LastRecordId: 1, LiquidValidators: []types.LiquidValidator{liquid}}, ""},
+		{"invalid params", types.GenesisState{Params: types.Params{}}, "global liquid staking cap"},
+		{"duplicate record", types.GenesisState{Params: params, Records: []types.TokenizeShareRecord{record, record}, LastRecordId: 1}, "duplicate tokenize share record"},
+		{"record id above last", types.GenesisState{Params: params, Records: []types.TokenizeShareRecord{record}}, "out of range"},
+		{"wrong module account", types.GenesisState{Params: params, Records: []types.TokenizeShareRecord{{Id: 1, Owner: owner, ModuleAccount: "liquidstake_2", Validator: validator}}, LastRecordId: 1}, "invalid module account"},
+		{"invalid owner", types.GenesisState{Params: params, Records: []types.TokenizeShareRecord{{Id: 1, Owner: "invalid", ModuleAccount: types.RecordModuleAccountName(1), Validator: validator}}, LastRecordId: 1}, "invalid owner"},
+		{"duplicate liquid validator", types.GenesisState{Params: params, LiquidValidators: []types.LiquidValidator{liquid, liquid}}, "duplicate liquid validator"},
+		{"negative liquid shares", types.GenesisState{Params: params, LiquidValidators: []types.LiquidValidator{{OperatorAddress: validator, LiquidShares: math.LegacyNewDec(-1)}}}, "must not be negative"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(addrCodec, valCodec)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
+
+func TestShareTokenDenom(t *testing.T) {
+	record := types.TokenizeShareRecord{Id: 3, Validator: "hippovaloper1abc"}
+	require.Equal(t, "hippovaloper1abc/3", record.GetShareTokenDenom())
+	require.Equal(t, "liquidstake_3", types.RecordModuleAccountName(3))
+}
@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "liquidstake"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey          = collections.NewPrefix(0)
+	RecordsKey         = collections.NewPrefix(1)
+	RecordIDKey        = collections.NewPrefix(2)
+	RecordIDByDenomKey = collections.NewPrefix(3)
+	LiquidSharesKey    = collections.NewPrefix(4)
+)
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultGlobalLiquidStakingCap allows a quarter of the bonded tokens to
+	// be liquid staked.
+	DefaultGlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	// DefaultValidatorLiquidStakingCap allows half of the delegator shares of
+	// a validator to be liquid staked.
+	DefaultValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+)
+
+// NewParams creates a new Params instance.
+func NewParams(globalCap, validatorCap math.LegacyDec) Params {
+	return Params{
+		GlobalLiquidStakingCap:    globalCap,
+		ValidatorLiquidStakingCap: validatorCap,
+	}
+}
+
+// DefaultParams returns the default liquidstake parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultGlobalLiquidStakingCap, DefaultValidatorLiquidStakingCap)
+}
+
+// Validate performs basic validation of the liquidstake parameters.
+func (p Params) Validate() error {
+	if err := validateCap("global liquid staking cap", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+	return validateCap("validator liquid staking cap", p.ValidatorLiquidStakingCap)
+}
+
+func validateCap(name string, v math.LegacyDec) error {
+	if v.IsNil() || v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1: %s", name, v)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package types
+
+import "fmt"
+
+// GetShareTokenDenom returns the denom of the share tokens minted for the
+// record, e.g. hippovaloper1.../3.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// RecordModuleAccountName returns the name of the module account holding
+// the delegation of the record with id.
+func RecordModuleAccountName(id uint64) string {
+	return fmt.Sprintf("%s_%d", ModuleName, id)
+}
@@ -0,0 +1,64 @@
+package abci
+
+import (
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagPriceFeedURL     = "oracle.price-feed-url"
+	flagPriceFeedTimeout = "oracle.price-feed-timeout"
+
+	// DefaultPriceFeedTimeout bounds the time a validator waits for its price
+	// feed before voting without prices.
+	DefaultPriceFeedTimeout = 500 * time.Millisecond
+)
+
+// Config defines the node local configuration of the price oracle.
+type Config struct {
+	// PriceFeedURL is the HTTP endpoint the validator fetches prices from.
+	// Validators without a feed vote without prices.
+	PriceFeedURL string `mapstructure:"price-feed-url"`
+	// PriceFeedTimeout bounds a request to the price feed.
+	PriceFeedTimeout time.Duration `mapstructure:"price-feed-timeout"`
+}
+
+// DefaultConfig returns the default oracle configuration.
+func DefaultConfig() Config {
+	return Config{
+		PriceFeedTimeout: DefaultPriceFeedTimeout,
+	}
+}
+
+// ReadConfig reads the oracle configuration from the app options.
+func ReadConfig(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get(flagPriceFeedURL); v != nil {
+		cfg.PriceFeedURL = cast.ToString(v)
+	}
+	if v := appOpts.Get(flagPriceFeedTimeout); v != nil {
+		if timeout := cast.ToDuration(v); timeout > 0 {
+			cfg.PriceFeedTimeout = timeout
+		}
+	}
+	return cfg
+}
+
+// ConfigTemplate is the app.toml section of the oracle configuration.
+const ConfigTemplate = `
+###############################################################################
+###                              Oracle Configuration                       ###
+###############################################################################
+
+[oracle]
+
+# HTTP endpoint returning the prices of the tracked assets as a JSON object,
+# e.g. {"ATOM/USD":"10.5"}. Validators without a price feed vote without
+# prices and accrue misses.
+price-feed-url = "{{ .Oracle.PriceFeedURL }}"
+
+# Maximum time to wait for the price feed when extending a vote.
+price-feed-timeout = "{{ .Oracle.PriceFeedTimeout }}"
+`
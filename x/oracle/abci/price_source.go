@@ -0,0 +1,66 @@
+package abci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"cosmossdk.io/math"
+)
+
+// PriceSource provides the prices a validator reports in its vote extension.
+type PriceSource interface {
+	FetchPrices(ctx context.Context, assets []string) (map[string]math.LegacyDec, error)
+}
+
+// HTTPPriceSource fetches prices from an HTTP endpoint returning a JSON object
+// of asset to decimal price, e.g. {"ATOM/USD":"10.5"}.
+type HTTPPriceSource struct {
+	url    string
+	client *http.Client
+}
+
+var _ PriceSource = HTTPPriceSource{}
+
+// NewHTTPPriceSource returns a price source querying the given URL.
+func NewHTTPPriceSource(url string) HTTPPriceSource {
+	return HTTPPriceSource{url: url, client: http.DefaultClient}
+}
+
+// FetchPrices implements PriceSource. Assets missing from the response are
+// left out of the result.
+func (s HTTPPriceSource) FetchPrices(ctx context.Context, assets []string) (map[string]math.LegacyDec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price feed returned status %d", resp.StatusCode)
+	}
+
+	var raw map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode price feed response: %w", err)
+	}
+
+	prices := make(map[string]math.LegacyDec, len(assets))
+	for _, asset := range assets {
+		v, ok := raw[asset]
+		if !ok {
+			continue
+		}
+		price, err := math.LegacyNewDecFromStr(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price of %s: %w", asset, err)
+		}
+		prices[asset] = price
+	}
+	return prices, nil
+}
@@ -0,0 +1,130 @@
+package abci
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// ProposalHandler injects the vote extensions of the previous height as the
+// first transaction of a block proposal so every node aggregates the same
+// price reports. Transaction selection and verification is left to the
+// wrapped handlers.
+type ProposalHandler struct {
+	logger   log.Logger
+	keeper   keeper.Keeper
+	valStore baseapp.ValidatorStore
+	prepare  sdk.PrepareProposalHandler
+	process  sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a proposal handler wrapping the given prepare
+// and process handlers.
+func NewProposalHandler(
+	logger log.Logger,
+	keeper keeper.Keeper,
+	valStore baseapp.ValidatorStore,
+	prepare sdk.PrepareProposalHandler,
+	process sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:   logger.With("module", "x/"+types.ModuleName),
+		keeper:   keeper,
+		valStore: valStore,
+		prepare:  prepare,
+		process:  process,
+	}
+}
+
+// voteExtensionsEnabled reports whether the proposal at the context height
+// carries the vote extensions of the previous height.
+func voteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 &&
+		ctx.BlockHeight() > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// PrepareProposal prepends the extended commit of the previous height to the
+// transactions selected by the wrapped handler.
+func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *cmtabci.RequestPrepareProposal) (*cmtabci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx) {
+			return h.prepare(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidVoteExtension, err.Error())
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(bz)) > req.MaxTxBytes {
+			return nil, fmt.Errorf("extended commit of %d bytes exceeds the max tx bytes %d", len(bz), req.MaxTxBytes)
+		}
+
+		// leave room for the injected commit
+		wrapped := *req
+		wrapped.MaxTxBytes -= int64(len(bz))
+		resp, err := h.prepare(ctx, &wrapped)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{bz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposal rejects proposals without a valid extended commit of the
+// previous height and passes the remaining transactions to the wrapped
+// handler.
+func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *cmtabci.RequestProcessProposal) (*cmtabci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx) {
+			return h.process(ctx, req)
+		}
+
+		reject := &cmtabci.ResponseProcessProposal{Status: cmtabci.ResponseProcessProposal_REJECT}
+		if len(req.Txs) == 0 {
+			h.logger.Error("rejecting proposal without extended commit", "height", req.Height)
+			return reject, nil
+		}
+
+		var commit cmtabci.ExtendedCommitInfo
+		if err := commit.Unmarshal(req.Txs[0]); err != nil {
+			h.logger.Error("rejecting proposal with undecodable extended commit", "height", req.Height, "err", err)
+			return reject, nil
+		}
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), commit); err != nil {
+			h.logger.Error("rejecting proposal with invalid extended commit", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		wrapped := *req
+		wrapped.Txs = req.Txs[1:]
+		return h.process(ctx, &wrapped)
+	}
+}
+
+// PreBlocker aggregates the price reports injected in the finalized block.
+// It must run before the block transactions so they observe the new prices.
+func (h *ProposalHandler) PreBlocker(ctx sdk.Context, req *cmtabci.RequestFinalizeBlock) error {
+	if !voteExtensionsEnabled(ctx) || len(req.Txs) == 0 {
+		return nil
+	}
+
+	var commit cmtabci.ExtendedCommitInfo
+	if err := commit.Unmarshal(req.Txs[0]); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidProposal, err.Error())
+	}
+	return h.keeper.AggregateVotes(ctx, commit.Votes)
+}
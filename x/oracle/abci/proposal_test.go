@@ -0,0 +1,77 @@
+package abci_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	oracleabci "github.com/hippocrat-dao/hippo-protocol/x/oracle/abci"
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+func TestProposalHandler(t *testing.T) {
+	hippoApp, ctx := setup(t)
+
+	var processed [][]byte
+	h := oracleabci.NewProposalHandler(
+		log.NewNopLogger(),
+		hippoApp.OracleKeeper,
+		hippoApp.StakingKeeper,
+		func(_ sdk.Context, req *cmtabci.RequestPrepareProposal) (*cmtabci.ResponsePrepareProposal, error) {
+			return &cmtabci.ResponsePrepareProposal{Txs: req.Txs}, nil
+		},
+		func(_ sdk.Context, req *cmtabci.RequestProcessProposal) (*cmtabci.ResponseProcessProposal, error) {
+			processed = req.Txs
+			return &cmtabci.ResponseProcessProposal{Status: cmtabci.ResponseProcessProposal_ACCEPT}, nil
+		},
+	)
+
+	// without vote extensions the wrapped handlers see the proposal as is
+	txs := [][]byte{[]byte("tx")}
+	prepared, err := h.PrepareProposal()(ctx, &cmtabci.RequestPrepareProposal{Height: 3, Txs: txs, MaxTxBytes: 100})
+	require.NoError(t, err)
+	require.Equal(t, txs, prepared.Txs)
+
+	res, err := h.ProcessProposal()(ctx, &cmtabci.RequestProcessProposal{Height: 3, Txs: txs})
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.ResponseProcessProposal_ACCEPT, res.Status)
+	require.Equal(t, txs, processed)
+	require.NoError(t, h.PreBlocker(ctx, &cmtabci.RequestFinalizeBlock{Height: 3, Txs: txs}))
+
+	// with vote extensions the first tx must be the extended commit
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}})
+
+	res, err = h.ProcessProposal()(ctx, &cmtabci.RequestProcessProposal{Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.ResponseProcessProposal_REJECT, res.Status)
+
+	res, err = h.ProcessProposal()(ctx, &cmtabci.RequestProcessProposal{Height: 3, Txs: [][]byte{{0xff}}})
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.ResponseProcessProposal_REJECT, res.Status)
+
+	ext, err := (&types.OracleVoteExtension{Prices: []types.AssetPrice{
+		{Asset: "ATOM/USD", Price: math.LegacyNewDec(10)},
+		{Asset: "HP/USD", Price: math.LegacyMustNewDecFromStr("0.02")},
+	}}).Marshal()
+	require.NoError(t, err)
+	commit := cmtabci.ExtendedCommitInfo{Votes: []cmtabci.ExtendedVoteInfo{{
+		Validator:     cmtabci.Validator{Address: []byte("validator___________"), Power: 10},
+		VoteExtension: ext,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}}}
+	bz, err := commit.Marshal()
+	require.NoError(t, err)
+
+	// the finalized block aggregates the injected reports
+	require.NoError(t, h.PreBlocker(ctx, &cmtabci.RequestFinalizeBlock{Height: 3, Txs: [][]byte{bz, []byte("tx")}}))
+	price, err := hippoApp.OracleKeeper.GetPrice(ctx, "ATOM/USD")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(10), price.Price)
+
+	require.ErrorIs(t, h.PreBlocker(ctx, &cmtabci.RequestFinalizeBlock{Height: 3, Txs: [][]byte{{0xff}}}), types.ErrInvalidProposal)
+}
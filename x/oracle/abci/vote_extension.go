@@ -0,0 +1,100 @@
+package abci
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// VoteExtensionHandler extends the precommit votes of a validator with the
+// prices of the tracked assets and verifies the extensions of its peers.
+type VoteExtensionHandler struct {
+	logger  log.Logger
+	keeper  keeper.Keeper
+	source  PriceSource
+	timeout time.Duration
+}
+
+// NewVoteExtensionHandler returns a vote extension handler reporting the
+// prices of source. A nil source makes the validator vote without prices.
+func NewVoteExtensionHandler(logger log.Logger, keeper keeper.Keeper, source PriceSource, timeout time.Duration) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:  logger.With("module", "x/"+types.ModuleName),
+		keeper:  keeper,
+		source:  source,
+		timeout: timeout,
+	}
+}
+
+// ExtendVote returns the prices the validator reports. Failing to fetch
+// prices never fails the vote; the validator then reports nothing and accrues
+// misses instead.
+func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *cmtabci.RequestExtendVote) (*cmtabci.ResponseExtendVote, error) {
+		params, err := h.keeper.Params.Get(ctx)
+		if err != nil || h.source == nil || len(params.Assets) == 0 {
+			return &cmtabci.ResponseExtendVote{}, nil
+		}
+
+		fetchCtx, cancel := context.WithTimeout(ctx.Context(), h.timeout)
+		defer cancel()
+
+		prices, err := h.source.FetchPrices(fetchCtx, params.Assets)
+		if err != nil {
+			h.logger.Error("failed to fetch oracle prices", "height", ctx.BlockHeight(), "err", err)
+			return &cmtabci.ResponseExtendVote{}, nil
+		}
+
+		var ext types.OracleVoteExtension
+		for _, asset := range params.Assets {
+			price, ok := prices[asset]
+			if !ok || price.IsNil() || !price.IsPositive() {
+				continue
+			}
+			ext.Prices = append(ext.Prices, types.AssetPrice{Asset: asset, Price: price})
+		}
+		sort.Slice(ext.Prices, func(i, j int) bool { return ext.Prices[i].Asset < ext.Prices[j].Asset })
+
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &cmtabci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtension rejects extensions that cannot be decoded or report
+// invalid prices.
+func (h *VoteExtensionHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *cmtabci.RequestVerifyVoteExtension) (*cmtabci.ResponseVerifyVoteExtension, error) {
+		reject := &cmtabci.ResponseVerifyVoteExtension{Status: cmtabci.ResponseVerifyVoteExtension_REJECT}
+		accept := &cmtabci.ResponseVerifyVoteExtension{Status: cmtabci.ResponseVerifyVoteExtension_ACCEPT}
+
+		if len(req.VoteExtension) == 0 {
+			return accept, nil
+		}
+
+		params, err := h.keeper.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ext, err := types.DecodeVoteExtension(req.VoteExtension)
+		if err != nil {
+			h.logger.Debug("rejecting undecodable vote extension", "height", req.Height, "err", err)
+			return reject, nil
+		}
+		if err := ext.Validate(params.Assets); err != nil {
+			h.logger.Debug("rejecting invalid vote extension", "height", req.Height, "err", err)
+			return reject, nil
+		}
+		return accept, nil
+	}
+}
@@ -0,0 +1,131 @@
+package abci_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	oracleabci "github.com/hippocrat-dao/hippo-protocol/x/oracle/abci"
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+type stubSource struct {
+	prices map[string]math.LegacyDec
+	err    error
+}
+
+func (s stubSource) FetchPrices(context.Context, []string) (map[string]math.LegacyDec, error) {
+	return s.prices, s.err
+}
+
+var setupOnce sync.Once
+
+func setup(t *testing.T) (*app.App, sdk.Context) {
+	t.Helper()
+	setupOnce.Do(consensus.SetWalletConfig)
+
+	hippoApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+	ctx := hippoApp.NewContextLegacy(true, cmtproto.Header{Height: 3, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	gs := types.DefaultGenesisState()
+	gs.Params.Assets = []string{"ATOM/USD", "HP/USD"}
+	require.NoError(t, hippoApp.OracleKeeper.InitGenesis(ctx, gs))
+	return hippoApp, ctx
+}
+
+func TestExtendVote(t *testing.T) {
+	hippoApp, ctx := setup(t)
+	extend := func(source oracleabci.PriceSource) types.OracleVoteExtension {
+		h := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), hippoApp.OracleKeeper, source, time.Second)
+		res, err := h.ExtendVote()(ctx, &cmtabci.RequestExtendVote{Height: 3})
+		require.NoError(t, err)
+		ext, err := types.DecodeVoteExtension(res.VoteExtension)
+		require.NoError(t, err)
+		return ext
+	}
+
+	ext := extend(stubSource{prices: map[string]math.LegacyDec{
+		"HP/USD":   math.LegacyMustNewDecFromStr("0.02"),
+		"ATOM/USD": math.LegacyMustNewDecFromStr("10.5"),
+		"BTC/USD":  math.LegacyNewDec(60000),
+	}})
+	require.Equal(t, []types.AssetPrice{
+		{Asset: "ATOM/USD", Price: math.LegacyMustNewDecFromStr("10.5")},
+		{Asset: "HP/USD", Price: math.LegacyMustNewDecFromStr("0.02")},
+	}, ext.Prices)
+
+	// non-positive prices are left out
+	ext = extend(stubSource{prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyZeroDec()}})
+	require.Empty(t, ext.Prices)
+
+	// failing or missing feeds vote without prices
+	require.Empty(t, extend(stubSource{err: errors.New("feed down")}).Prices)
+	require.Empty(t, extend(nil).Prices)
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	hippoApp, ctx := setup(t)
+	h := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), hippoApp.OracleKeeper, nil, time.Second)
+
+	verify := func(bz []byte) cmtabci.ResponseVerifyVoteExtension_VerifyStatus {
+		res, err := h.VerifyVoteExtension()(ctx, &cmtabci.RequestVerifyVoteExtension{Height: 3, VoteExtension: bz})
+		require.NoError(t, err)
+		return res.Status
+	}
+	marshal := func(prices ...types.AssetPrice) []byte {
+		bz, err := (&types.OracleVoteExtension{Prices: prices}).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	require.Equal(t, cmtabci.ResponseVerifyVoteExtension_ACCEPT, verify(nil))
+	require.Equal(t, cmtabci.ResponseVerifyVoteExtension_ACCEPT, verify(marshal(types.AssetPrice{Asset: "HP/USD", Price: math.LegacyOneDec()})))
+	require.Equal(t, cmtabci.ResponseVerifyVoteExtension_REJECT, verify(marshal(types.AssetPrice{Asset: "BTC/USD", Price: math.LegacyOneDec()})))
+	require.Equal(t, cmtabci.ResponseVerifyVoteExtension_REJECT, verify([]byte("not a vote extension")))
+}
+
+func TestHTTPPriceSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"ATOM/USD":"10.5","BTC/USD":"60000"}`))
+	}))
+	defer server.Close()
+
+	prices, err := oracleabci.NewHTTPPriceSource(server.URL).FetchPrices(context.Background(), []string{"ATOM/USD", "HP/USD"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]math.LegacyDec{"ATOM/USD": math.LegacyMustNewDecFromStr("10.5")}, prices)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	_, err = oracleabci.NewHTTPPriceSource(failing.URL).FetchPrices(context.Background(), []string{"ATOM/USD"})
+	require.Error(t, err)
+}
+
+func TestReadConfig(t *testing.T) {
+	cfg := oracleabci.ReadConfig(simtestutil.AppOptionsMap{})
+	require.Equal(t, oracleabci.DefaultConfig(), cfg)
+
+	cfg = oracleabci.ReadConfig(simtestutil.AppOptionsMap{
+		"oracle.price-feed-url":     "http://localhost:8080/prices",
+		"oracle.price-feed-timeout": "2s",
+	})
+	require.Equal(t, "http://localhost:8080/prices", cfg.PriceFeedURL)
+	require.Equal(t, 2*time.Second, cfg.PriceFeedTimeout)
+}
@@ -0,0 +1,47 @@
+package oracle
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.oracle.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the oracle module parameters",
+				},
+				{
+					RpcMethod:      "Price",
+					Use:            "price [asset]",
+					Short:          "Query the latest aggregated price of an asset",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "asset"}},
+				},
+				{
+					RpcMethod: "Prices",
+					Use:       "prices",
+					Short:     "Query the latest aggregated prices of all assets",
+				},
+				{
+					RpcMethod:      "MissCounter",
+					Use:            "miss-counter [validator-cons-address]",
+					Short:          "Query the reports a validator missed in the current slash window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.oracle.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
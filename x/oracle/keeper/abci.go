@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// EndBlocker slashes the validators that missed more reports than allowed at
+// the end of every slash window and starts a new window.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if uint64(sdkCtx.BlockHeight())%params.SlashWindow != 0 {
+		return nil
+	}
+
+	allowed := params.AllowedMisses()
+	iter, err := k.MissCounters.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if kv.Value <= allowed {
+			continue
+		}
+		if err := k.slash(sdkCtx, kv.Key, kv.Value, params); err != nil {
+			return err
+		}
+	}
+
+	return k.MissCounters.Clear(ctx, nil)
+}
+
+// slash punishes a bonded validator for missing reports. Validators that
+// left the active set in the meantime are not slashed.
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, misses uint64, params types.Params) error {
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !validator.IsBonded() || validator.IsJailed() {
+		return nil
+	}
+
+	power := validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	// the misses were counted over the whole window, so infractions are
+	// attributed to the stake bonded at its last block
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+	if distributionHeight < 0 {
+		distributionHeight = 0
+	}
+	if err := k.slashingKeeper.Slash(ctx, consAddr, params.SlashFraction, power, distributionHeight); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMisses, strconv.FormatUint(misses, 10)),
+			sdk.NewAttribute(types.AttributeKeyFraction, params.SlashFraction.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// report is a price reported by a validator.
+type report struct {
+	validator int
+	price     types.WeightedPrice
+}
+
+// AggregateVotes sets the price of every tracked asset enough voting power
+// reported to the stake weighted median of the reports. Validators that signed
+// the block but sent an empty or invalid extension, left out an asset or
+// reported a price too far from the median get a miss. Validators that did not
+// sign the block carry no extension and are left to the slashing module.
+func (k Keeper) AggregateVotes(ctx context.Context, votes []abci.ExtendedVoteInfo) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(params.Assets) == 0 {
+		return nil
+	}
+
+	var totalPower int64
+	missed := make([]bool, len(votes))
+	reports := make(map[string][]report, len(params.Assets))
+	for i, vote := range votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		ext, err := types.DecodeVoteExtension(vote.VoteExtension)
+		if err != nil || ext.Validate(params.Assets) != nil {
+			missed[i] = true
+			continue
+		}
+		if len(ext.Prices) < len(params.Assets) {
+			missed[i] = true
+		}
+		for _, p := range ext.Prices {
+			reports[p.Asset] = append(reports[p.Asset], report{
+				validator: i,
+				price:     types.WeightedPrice{Price: p.Price, Power: vote.Validator.Power},
+			})
+		}
+	}
+	if totalPower <= 0 {
+		return nil
+	}
+
+	for _, asset := range params.Assets {
+		var (
+			prices []types.WeightedPrice
+			power  int64
+		)
+		for _, r := range reports[asset] {
+			prices = append(prices, r.price)
+			power += r.price.Power
+		}
+		if math.LegacyNewDec(power).LT(params.MinVotingPower.MulInt64(totalPower)) {
+			continue
+		}
+
+		median, ok := types.WeightedMedian(prices)
+		if !ok {
+			continue
+		}
+		for _, r := range reports[asset] {
+			if types.Deviation(r.price.Price, median).GT(params.MaxDeviation) {
+				missed[r.validator] = true
+			}
+		}
+
+		if err := k.Prices.Set(ctx, asset, types.Price{
+			Asset:       asset,
+			Price:       median,
+			BlockHeight: sdkCtx.BlockHeight(),
+			BlockTime:   sdkCtx.BlockTime(),
+		}); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdatePrice,
+				sdk.NewAttribute(types.AttributeKeyAsset, asset),
+				sdk.NewAttribute(types.AttributeKeyPrice, median.String()),
+				sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+			),
+		)
+	}
+
+	for i, vote := range votes {
+		if !missed[i] {
+			continue
+		}
+		if err := k.addMiss(ctx, sdk.ConsAddress(vote.Validator.Address)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addMiss counts a miss of validator in the current slash window.
+func (k Keeper) addMiss(ctx context.Context, validator sdk.ConsAddress) error {
+	misses, err := k.MissCounters.Get(ctx, validator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	misses++
+	if err := k.MissCounters.Set(ctx, validator, misses); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleMiss,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+			sdk.NewAttribute(types.AttributeKeyMisses, strconv.FormatUint(misses, 10)),
+		),
+	)
+	return nil
+}
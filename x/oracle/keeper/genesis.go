@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// InitGenesis initializes the oracle module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, price := range gs.Prices {
+		if err := k.Prices.Set(ctx, price.Asset, price); err != nil {
+			return err
+		}
+	}
+
+	for _, counter := range gs.MissCounters {
+		validator, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(counter.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.MissCounters.Set(ctx, validator, counter.Misses); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the oracle module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+
+	if err := k.Prices.Walk(ctx, nil, func(_ string, price types.Price) (bool, error) {
+		gs.Prices = append(gs.Prices, price)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	consCodec := k.stakingKeeper.ConsensusAddressCodec()
+	if err := k.MissCounters.Walk(ctx, nil, func(validator sdk.ConsAddress, misses uint64) (bool, error) {
+		addr, err := consCodec.BytesToString(validator)
+		if err != nil {
+			return true, err
+		}
+		gs.MissCounters = append(gs.MissCounters, types.MissCounter{Validator: addr, Misses: misses})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
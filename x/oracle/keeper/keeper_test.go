@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+var (
+	valA = sdk.ConsAddress([]byte("validatorA__________"))
+	valB = sdk.ConsAddress([]byte("validatorB__________"))
+	valC = sdk.ConsAddress([]byte("validatorC__________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	gs := types.DefaultGenesisState()
+	gs.Params.Assets = []string{"ATOM/USD", "HP/USD"}
+	gs.Params.SlashWindow = 4
+	s.Require().NoError(s.app.OracleKeeper.InitGenesis(s.ctx, gs))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.OracleKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.OracleKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func vote(validator sdk.ConsAddress, power int64, prices ...string) abci.ExtendedVoteInfo {
+	var ext types.OracleVoteExtension
+	for i := 0; i+1 < len(prices); i += 2 {
+		ext.Prices = append(ext.Prices, types.AssetPrice{Asset: prices[i], Price: math.LegacyMustNewDecFromStr(prices[i+1])})
+	}
+	bz, err := ext.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Address: validator, Power: power},
+		VoteExtension: bz,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func (s *KeeperTestSuite) misses(validator sdk.ConsAddress) uint64 {
+	res, err := s.queryServer.MissCounter(s.ctx, &types.QueryMissCounterRequest{Validator: validator.String()})
+	s.Require().NoError(err)
+	return res.Misses
+}
+
+func (s *KeeperTestSuite) TestAggregateVotes() {
+	s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+		vote(valA, 10, "ATOM/USD", "10", "HP/USD", "0.020"),
+		vote(valB, 10, "ATOM/USD", "10.2", "HP/USD", "0.021"),
+		vote(valC, 10, "ATOM/USD", "20", "HP/USD", "0.022"),
+	}))
+
+	price, err := s.app.OracleKeeper.GetPrice(s.ctx, "ATOM/USD")
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("10.2"), price.Price)
+	s.Require().Equal(int64(1), price.BlockHeight)
+	s.Require().Equal(s.ctx.BlockTime(), price.BlockTime)
+
+	price, err = s.app.OracleKeeper.GetPrice(s.ctx, "HP/USD")
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.021"), price.Price)
+
+	// valC reported ATOM/USD far from the median
+	s.Require().Zero(s.misses(valA))
+	s.Require().Zero(s.misses(valB))
+	s.Require().Equal(uint64(1), s.misses(valC))
+}
+
+func (s *KeeperTestSuite) TestAggregateVotesMisses() {
+	absent := vote(valC, 10)
+	absent.BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	invalid := vote(valB, 10, "BTC/USD", "1")
+
+	s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+		vote(valA, 20, "ATOM/USD", "10"),
+		invalid,
+		absent,
+	}))
+
+	// valA has exactly half of the power and leaves out HP/USD
+	price, err := s.app.OracleKeeper.GetPrice(s.ctx, "ATOM/USD")
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(10), price.Price)
+	_, err = s.app.OracleKeeper.GetPrice(s.ctx, "HP/USD")
+	s.Require().ErrorIs(err, types.ErrPriceNotFound)
+
+	s.Require().Equal(uint64(1), s.misses(valA))
+	s.Require().Equal(uint64(1), s.misses(valB))
+	// valC did not sign the block, the slashing module counts it as down
+	s.Require().Zero(s.misses(valC))
+}
+
+func (s *KeeperTestSuite) TestAggregateVotesInsufficientPower() {
+	s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+		vote(valA, 10, "ATOM/USD", "10", "HP/USD", "0.02"),
+		vote(valB, 11),
+	}))
+
+	_, err := s.app.OracleKeeper.GetPrice(s.ctx, "ATOM/USD")
+	s.Require().ErrorIs(err, types.ErrPriceNotFound)
+	s.Require().Equal(uint64(1), s.misses(valB))
+}
+
+// createValidator bonds a validator with the given self delegation and
+// returns its consensus address.
+func (s *KeeperTestSuite) createValidator(tokens math.Int) sdk.ConsAddress {
+	s.Require().NoError(s.app.StakingKeeper.SetParams(s.ctx, stakingtypes.NewParams(
+		stakingtypes.DefaultUnbondingTime, stakingtypes.DefaultMaxValidators, stakingtypes.DefaultMaxEntries,
+		stakingtypes.DefaultHistoricalEntries, consensus.DefaultHippoDenom, stakingtypes.DefaultMinCommissionRate,
+	)))
+
+	s.Require().NoError(s.app.DistrKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()))
+
+	operator := sdk.AccAddress([]byte("operator____________"))
+	coins := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, tokens))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, operator, coins))
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator).String(), pubKey, coins[0], stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 2)),
+		math.OneInt(),
+	)
+	s.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(s.app.StakingKeeper).CreateValidator(s.ctx, msg)
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.EndBlocker(s.ctx)
+	s.Require().NoError(err)
+
+	return sdk.ConsAddress(pubKey.Address())
+}
+
+func (s *KeeperTestSuite) TestEndBlockerSlashes() {
+	tokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	validator := s.createValidator(tokens)
+
+	// the window allows 2 of 4 blocks to be missed
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+			vote(valA, 100, "ATOM/USD", "10", "HP/USD", "0.02"),
+			vote(validator, 100),
+		}))
+	}
+	s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+		vote(valB, 100),
+	}))
+	s.Require().Equal(uint64(3), s.misses(validator))
+	s.Require().Equal(uint64(1), s.misses(valB))
+
+	// not the end of the window
+	s.ctx = s.ctx.WithBlockHeight(3)
+	s.Require().NoError(s.app.OracleKeeper.EndBlocker(s.ctx))
+	s.Require().Equal(uint64(3), s.misses(validator))
+
+	s.ctx = s.ctx.WithBlockHeight(4)
+	s.Require().NoError(s.app.OracleKeeper.EndBlocker(s.ctx))
+
+	val, err := s.app.StakingKeeper.GetValidatorByConsAddr(s.ctx, validator)
+	s.Require().NoError(err)
+	expected := tokens.Sub(math.LegacyNewDecFromInt(tokens).Mul(types.DefaultSlashFraction).TruncateInt())
+	s.Require().Equal(expected, val.Tokens)
+
+	// a new window starts
+	s.Require().Zero(s.misses(validator))
+	s.Require().Zero(s.misses(valB))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+		vote(valA, 10, "ATOM/USD", "10", "HP/USD", "0.02"),
+	}))
+
+	params := types.DefaultParams()
+	params.Assets = []string{"HP/USD"}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("user________________")).String(),
+		Params:    params,
+	})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.SlashWindow = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	// prices of assets no longer tracked are removed
+	_, err = s.app.OracleKeeper.GetPrice(s.ctx, "ATOM/USD")
+	s.Require().ErrorIs(err, types.ErrPriceNotFound)
+	_, err = s.app.OracleKeeper.GetPrice(s.ctx, "HP/USD")
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestQueries() {
+	s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+		vote(valA, 10, "ATOM/USD", "10", "HP/USD", "0.02"),
+	}))
+
+	paramsRes, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"ATOM/USD", "HP/USD"}, paramsRes.Params.Assets)
+
+	priceRes, err := s.queryServer.Price(s.ctx, &types.QueryPriceRequest{Asset: "HP/USD"})
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.02"), priceRes.Price.Price)
+
+	_, err = s.queryServer.Price(s.ctx, &types.QueryPriceRequest{Asset: "BTC/USD"})
+	s.Require().Error(err)
+	_, err = s.queryServer.Price(s.ctx, nil)
+	s.Require().Error(err)
+
+	pricesRes, err := s.queryServer.Prices(s.ctx, &types.QueryPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(pricesRes.Prices, 2)
+
+	_, err = s.queryServer.MissCounter(s.ctx, &types.QueryMissCounterRequest{Validator: "invalid"})
+	s.Require().Error(err)
+	s.Require().Zero(s.misses(valB))
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	s.Require().NoError(s.app.OracleKeeper.AggregateVotes(s.ctx, []abci.ExtendedVoteInfo{
+		vote(valA, 10, "ATOM/USD", "10", "HP/USD", "0.02"),
+		vote(valB, 5),
+	}))
+
+	gs, err := s.app.OracleKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate(s.app.StakingKeeper.ConsensusAddressCodec()))
+	s.Require().Len(gs.Prices, 2)
+	s.Require().Equal([]types.MissCounter{{Validator: valB.String(), Misses: 1}}, gs.MissCounters)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.OracleKeeper.InitGenesis(s.ctx, gs))
+	exported, err := s.app.OracleKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the oracle MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams implements types.MsgServer. Prices of assets that are no
+// longer tracked are removed.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	tracked := make(map[string]bool, len(msg.Params.Assets))
+	for _, asset := range msg.Params.Assets {
+		tracked[asset] = true
+	}
+	iter, err := k.Prices.Iterate(goCtx, nil)
+	if err != nil {
+		return nil, err
+	}
+	assets, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	for _, asset := range assets {
+		if tracked[asset] {
+			continue
+		}
+		if err := k.Prices.Remove(goCtx, asset); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+// GetPrice returns the aggregated price of an asset.
+func (k Keeper) GetPrice(ctx context.Context, asset string) (types.Price, error) {
+	price, err := k.Prices.Get(ctx, asset)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Price{}, errorsmod.Wrapf(types.ErrPriceNotFound, "%s", asset)
+	}
+	return price, err
+}
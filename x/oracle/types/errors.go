@@ -0,0 +1,11 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/oracle module sentinel errors
+var (
+	ErrInvalidVoteExtension = errorsmod.Register(ModuleName, 2, "invalid vote extension")
+	ErrPriceNotFound        = errorsmod.Register(ModuleName, 3, "price not found")
+	ErrInvalidParams        = errorsmod.Register(ModuleName, 4, "invalid params")
+	ErrInvalidProposal      = errorsmod.Register(ModuleName, 5, "invalid proposal")
+)
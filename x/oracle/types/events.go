@@ -0,0 +1,15 @@
+package types
+
+// oracle module event types and attributes
+const (
+	EventTypeUpdatePrice = "update_price"
+	EventTypeOracleMiss  = "oracle_miss"
+	EventTypeOracleSlash = "oracle_slash"
+
+	AttributeKeyAsset     = "asset"
+	AttributeKeyPrice     = "price"
+	AttributeKeyPower     = "power"
+	AttributeKeyValidator = "validator"
+	AttributeKeyMisses    = "misses"
+	AttributeKeyFraction  = "fraction"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// StakingKeeper defines the expected staking keeper used to look up the
+// validators that reported prices.
+type StakingKeeper interface {
+	ConsensusAddressCodec() address.Codec
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+	PowerReduction(ctx context.Context) math.Int
+}
+
+// SlashingKeeper defines the expected slashing keeper used to punish
+// validators that miss reports.
+type SlashingKeeper interface {
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64) error
+}
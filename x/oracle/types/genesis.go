@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default oracle genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation. consCodec decodes the
+// consensus addresses of the miss counters.
+func (gs GenesisState) Validate(consCodec address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	assets := make(map[string]bool, len(gs.Prices))
+	for _, p := range gs.Prices {
+		if assets[p.Asset] {
+			return fmt.Errorf("duplicate price of %s", p.Asset)
+		}
+		assets[p.Asset] = true
+		if err := ValidateAsset(p.Asset); err != nil {
+			return err
+		}
+		if p.Price.IsNil() || !p.Price.IsPositive() {
+			return fmt.Errorf("price of %s must be positive: %s", p.Asset, p.Price)
+		}
+	}
+
+	validators := make(map[string]bool, len(gs.MissCounters))
+	for _, c := range gs.MissCounters {
+		if validators[c.Validator] {
+			return fmt.Errorf("duplicate miss counter of %s", c.Validator)
+		}
+		validators[c.Validator] = true
+		if _, err := consCodec.StringToBytes(c.Validator); err != nil {
+			return fmt.Errorf("invalid validator consensus address: %w", err)
+		}
+		if c.Misses > gs.Params.SlashWindow {
+			return fmt.Errorf("misses of %s exceed the slash window", c.Validator)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	withParams := func(malleate func(p *types.Params)) types.Params {
+		p := types.DefaultParams()
+		p.Assets = []string{"ATOM/USD"}
+		malleate(&p)
+		return p
+	}
+
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr string
+	}{
+		{"default", types.DefaultParams(), ""},
+		{"valid", withParams(func(*types.Params) {}), ""},
+		{"invalid asset", withParams(func(p *types.Params) { p.Assets = []string{"ATOMUSD"} }), "BASE/QUOTE"},
+		{"duplicate asset", withParams(func(p *types.Params) { p.Assets = []string{"ATOM/USD", "ATOM/USD"} }), "duplicate asset"},
+		{"zero min voting power", withParams(func(p *types.Params) { p.MinVotingPower = math.LegacyZeroDec() }), "min voting power"},
+		{"min voting power above one", withParams(func(p *types.Params) { p.MinVotingPower = math.LegacyNewDec(2) }), "min voting power"},
+		{"zero max deviation", withParams(func(p *types.Params) { p.MaxDeviation = math.LegacyZeroDec() }), "max deviation"},
+		{"zero slash window", withParams(func(p *types.Params) { p.SlashWindow = 0 }), "slash window"},
+		{"negative min valid", withParams(func(p *types.Params) { p.MinValidPerWindow = math.LegacyNewDec(-1) }), "min valid per window"},
+		{"slash fraction above one", withParams(func(p *types.Params) { p.SlashFraction = math.LegacyNewDec(2) }), "slash fraction"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
+
+func TestAllowedMisses(t *testing.T) {
+	p := types.DefaultParams()
+	require.Equal(t, uint64(1800), p.AllowedMisses())
+
+	p.SlashWindow = 3
+	require.Equal(t, uint64(1), p.AllowedMisses())
+
+	p.MinValidPerWindow = math.LegacyOneDec()
+	require.Zero(t, p.AllowedMisses())
+}
+
+func TestGenesisValidate(t *testing.T) {
+	consCodec := address.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix())
+	validator, err := consCodec.BytesToString([]byte("validator___________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Assets = []string{"ATOM/USD"}
+	price := types.Price{Asset: "ATOM/USD", Price: math.LegacyNewDec(10), BlockHeight: 1, BlockTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	counter := types.MissCounter{Validator: validator, Misses: 1}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", types.GenesisState{Params: params, Prices: []types.Price{price}, MissCounters: []types.MissCounter{counter}}, ""},
+		{"invalid params", types.GenesisState{Params: types.Params{}}, "min voting power"},
+		{"duplicate price", types.GenesisState{Params: params, Prices: []types.Price{price, price}}, "duplicate price"},
+		{"invalid price asset", types.GenesisState{Params: params, Prices: []types.Price{{Asset: "ATOM", Price: math.LegacyOneDec()}}}, "BASE/QUOTE"},
+		{"zero price", types.GenesisState{Params: params, Prices: []types.Price{{Asset: "ATOM/USD", Price: math.LegacyZeroDec()}}}, "positive"},
+		{"duplicate miss counter", types.GenesisState{Params: params, MissCounters: []types.MissCounter{counter, counter}}, "duplicate miss counter"},
+		{"invalid validator", types.GenesisState{Params: params, MissCounters: []types.MissCounter{{Validator: "invalid"}}}, "consensus address"},
+		{"misses exceed window", types.GenesisState{Params: params, MissCounters: []types.MissCounter{{Validator: validator, Misses: params.SlashWindow + 1}}}, "exceed the slash window"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(consCodec)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
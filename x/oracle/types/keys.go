@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey       = collections.NewPrefix(0)
+	PricesKey       = collections.NewPrefix(1)
+	MissCountersKey = collections.NewPrefix(2)
+)
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultSlashWindow counts misses over about six hours of blocks.
+const DefaultSlashWindow uint64 = 3600
+
+var (
+	// DefaultMinVotingPower requires half of the voting power to report an
+	// asset before its price moves.
+	DefaultMinVotingPower = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultMaxDeviation tolerates reports within 10% of the median.
+	DefaultMaxDeviation = math.LegacyNewDecWithPrec(1, 1)
+	// DefaultMinValidPerWindow requires valid reports in half of the blocks.
+	DefaultMinValidPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultSlashFraction slashes 0.01% of stake.
+	DefaultSlashFraction = math.LegacyNewDecWithPrec(1, 4)
+)
+
+// assetRegexp matches BASE/QUOTE asset pairs such as ATOM/USD.
+var assetRegexp = regexp.MustCompile(`^[A-Za-z0-9]{1,20}/[A-Za-z0-9]{1,20}$`)
+
+// NewParams creates a new Params instance.
+func NewParams(
+	assets []string,
+	minVotingPower, maxDeviation math.LegacyDec,
+	slashWindow uint64,
+	minValidPerWindow, slashFraction math.LegacyDec,
+) Params {
+	return Params{
+		Assets:            assets,
+		MinVotingPower:    minVotingPower,
+		MaxDeviation:      maxDeviation,
+		SlashWindow:       slashWindow,
+		MinValidPerWindow: minValidPerWindow,
+		SlashFraction:     slashFraction,
+	}
+}
+
+// DefaultParams returns the default oracle parameters. No assets are tracked
+// until governance adds them.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultMinVotingPower, DefaultMaxDeviation, DefaultSlashWindow, DefaultMinValidPerWindow, DefaultSlashFraction)
+}
+
+// ValidateAsset checks that asset is a BASE/QUOTE pair.
+func ValidateAsset(asset string) error {
+	if !assetRegexp.MatchString(asset) {
+		return fmt.Errorf("invalid asset %q, expected BASE/QUOTE", asset)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the oracle parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Assets))
+	for _, asset := range p.Assets {
+		if err := ValidateAsset(asset); err != nil {
+			return err
+		}
+		if seen[asset] {
+			return fmt.Errorf("duplicate asset %s", asset)
+		}
+		seen[asset] = true
+	}
+	if err := validateShare("min voting power", p.MinVotingPower); err != nil {
+		return err
+	}
+	if p.MinVotingPower.IsZero() {
+		return fmt.Errorf("min voting power must be positive")
+	}
+	if p.MaxDeviation.IsNil() || !p.MaxDeviation.IsPositive() {
+		return fmt.Errorf("max deviation must be positive: %s", p.MaxDeviation)
+	}
+	if p.SlashWindow == 0 {
+		return fmt.Errorf("slash window must be positive")
+	}
+	if err := validateShare("min valid per window", p.MinValidPerWindow); err != nil {
+		return err
+	}
+	return validateShare("slash fraction", p.SlashFraction)
+}
+
+// AllowedMisses returns how many blocks of a slash window a validator may
+// miss without being slashed.
+func (p Params) AllowedMisses() uint64 {
+	required := p.MinValidPerWindow.MulInt64(int64(p.SlashWindow)).Ceil().TruncateInt().Uint64()
+	return p.SlashWindow - required
+}
+
+func validateShare(name string, v math.LegacyDec) error {
+	if v.IsNil() || v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1: %s", name, v)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// DecodeVoteExtension decodes the vote extension of a validator. An empty
+// extension, sent by validators without a price feed, reports no prices.
+func DecodeVoteExtension(bz []byte) (OracleVoteExtension, error) {
+	var ext OracleVoteExtension
+	if err := ext.Unmarshal(bz); err != nil {
+		return OracleVoteExtension{}, err
+	}
+	return ext, nil
+}
+
+// Validate checks that the extension only reports positive prices of
+// tracked assets, each at most once.
+func (ext OracleVoteExtension) Validate(assets []string) error {
+	tracked := make(map[string]bool, len(assets))
+	for _, asset := range assets {
+		tracked[asset] = true
+	}
+
+	seen := make(map[string]bool, len(ext.Prices))
+	for _, p := range ext.Prices {
+		if !tracked[p.Asset] {
+			return fmt.Errorf("asset %q is not tracked", p.Asset)
+		}
+		if seen[p.Asset] {
+			return fmt.Errorf("duplicate price of %s", p.Asset)
+		}
+		seen[p.Asset] = true
+		if p.Price.IsNil() || !p.Price.IsPositive() {
+			return fmt.Errorf("price of %s must be positive: %s", p.Asset, p.Price)
+		}
+	}
+	return nil
+}
+
+// WeightedPrice is a price reported with the voting power of its reporter.
+type WeightedPrice struct {
+	Price math.LegacyDec
+	Power int64
+}
+
+// WeightedMedian returns the price at which at least half of the reporting
+// power reported the same or a lower price. It returns false when no power
+// reported.
+func WeightedMedian(prices []WeightedPrice) (math.LegacyDec, bool) {
+	sorted := make([]WeightedPrice, len(prices))
+	copy(sorted, prices)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Price.LT(sorted[j].Price) })
+
+	var total int64
+	for _, p := range sorted {
+		total += p.Power
+	}
+	if total <= 0 {
+		return math.LegacyDec{}, false
+	}
+
+	var cumulative int64
+	for _, p := range sorted {
+		cumulative += p.Power
+		if cumulative*2 >= total {
+			return p.Price, true
+		}
+	}
+	return sorted[len(sorted)-1].Price, true
+}
+
+// Deviation returns how far price is from reference, relative to reference.
+func Deviation(price, reference math.LegacyDec) math.LegacyDec {
+	return price.Sub(reference).Abs().Quo(reference)
+}
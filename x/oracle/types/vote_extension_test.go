@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/oracle/types"
+)
+
+func TestVoteExtensionValidate(t *testing.T) {
+	assets := []string{"ATOM/USD", "HP/USD"}
+	price := func(asset, p string) types.AssetPrice {
+		return types.AssetPrice{Asset: asset, Price: math.LegacyMustNewDecFromStr(p)}
+	}
+
+	testCases := []struct {
+		name   string
+		ext    types.OracleVoteExtension
+		expErr string
+	}{
+		{"empty", types.OracleVoteExtension{}, ""},
+		{"valid", types.OracleVoteExtension{Prices: []types.AssetPrice{price("ATOM/USD", "10.5"), price("HP/USD", "0.02")}}, ""},
+		{"untracked asset", types.OracleVoteExtension{Prices: []types.AssetPrice{price("BTC/USD", "1")}}, "not tracked"},
+		{"duplicate asset", types.OracleVoteExtension{Prices: []types.AssetPrice{price("HP/USD", "1"), price("HP/USD", "1")}}, "duplicate"},
+		{"zero price", types.OracleVoteExtension{Prices: []types.AssetPrice{price("HP/USD", "0")}}, "positive"},
+		{"negative price", types.OracleVoteExtension{Prices: []types.AssetPrice{price("HP/USD", "-1")}}, "positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := tc.ext.Marshal()
+			require.NoError(t, err)
+			ext, err := types.DecodeVoteExtension(bz)
+			require.NoError(t, err)
+
+			err = ext.Validate(assets)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+
+	_, err := types.DecodeVoteExtension([]byte("not a vote extension"))
+	require.Error(t, err)
+}
+
+func TestWeightedMedian(t *testing.T) {
+	wp := func(price string, power int64) types.WeightedPrice {
+		return types.WeightedPrice{Price: math.LegacyMustNewDecFromStr(price), Power: power}
+	}
+
+	testCases := []struct {
+		name     string
+		prices   []types.WeightedPrice
+		expected string
+	}{
+		{"single", []types.WeightedPrice{wp("10", 1)}, "10"},
+		{"equal power", []types.WeightedPrice{wp("12", 1), wp("10", 1), wp("11", 1)}, "11"},
+		{"heavy reporter", []types.WeightedPrice{wp("10", 1), wp("11", 1), wp("20", 5)}, "20"},
+		{"even split takes lower", []types.WeightedPrice{wp("10", 5), wp("20", 5)}, "10"},
+		{"outlier ignored", []types.WeightedPrice{wp("10", 3), wp("10.1", 3), wp("1000", 1)}, "10.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			median, ok := types.WeightedMedian(tc.prices)
+			require.True(t, ok)
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.expected), median)
+		})
+	}
+
+	_, ok := types.WeightedMedian(nil)
+	require.False(t, ok)
+	_, ok = types.WeightedMedian([]types.WeightedPrice{wp("10", 0)})
+	require.False(t, ok)
+}
+
+func TestDeviation(t *testing.T) {
+	ref := math.LegacyNewDec(10)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), types.Deviation(math.LegacyNewDec(11), ref))
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), types.Deviation(math.LegacyNewDec(9), ref))
+	require.True(t, types.Deviation(ref, ref).IsZero())
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.schema.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the schema module parameters",
+				},
+				{
+					RpcMethod:      "Schema",
+					Use:            "schema [schema-id]",
+					Short:          "Query a registered schema",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "schema_id"}},
+				},
+				{
+					RpcMethod: "Schemas",
+					Use:       "schemas",
+					Short:     "Query all registered schemas",
+				},
+				{
+					RpcMethod: "SchemaVersion",
+					Use:       "version [schema-id] [version]",
+					Short:     "Query a schema version, 0 selecting the latest",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_id"},
+						{ProtoField: "version"},
+					},
+				},
+				{
+					RpcMethod:      "SchemaVersions",
+					Use:            "versions [schema-id]",
+					Short:          "Query the published versions of a schema",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "schema_id"}},
+				},
+				{
+					RpcMethod: "ValidateSchemaRef",
+					Use:       "validate [schema-id] [version]",
+					Short:     "Check that a schema reference exists and is active",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_id"},
+						{ProtoField: "version"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.schema.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RegisterSchema",
+					Use:       "register [schema-id] [schema-type] [uri] [hash]",
+					Short:     "Register a schema with its first version (curators only)",
+					Long:      "Register a schema with its first version. The schema type is one of SCHEMA_TYPE_FHIR, SCHEMA_TYPE_OMOP or SCHEMA_TYPE_JSON_SCHEMA and the hash is the sha256 digest of the schema document.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_id"},
+						{ProtoField: "schema_type"},
+						{ProtoField: "uri"},
+						{ProtoField: "hash"},
+					},
+				},
+				{
+					RpcMethod: "PublishVersion",
+					Use:       "publish-version [schema-id] [uri] [hash]",
+					Short:     "Publish the next version of a schema (curators only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_id"},
+						{ProtoField: "uri"},
+						{ProtoField: "hash"},
+					},
+				},
+				{
+					RpcMethod: "SetDeprecated",
+					Use:       "set-deprecated [schema-id] [version] [deprecated]",
+					Short:     "Deprecate or reinstate a schema version, 0 selecting the whole schema (curators only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_id"},
+						{ProtoField: "version"},
+						{ProtoField: "deprecated"},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+// InitGenesis initializes the schema module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, schema := range gs.Schemas {
+		if err := k.Schemas.Set(ctx, schema.Id, schema); err != nil {
+			return err
+		}
+	}
+
+	for _, version := range gs.Versions {
+		if err := k.Versions.Set(ctx, collections.Join(version.SchemaId, version.Version), version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the schema module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+
+	if err := k.Schemas.Walk(ctx, nil, func(_ string, schema types.Schema) (bool, error) {
+		gs.Schemas = append(gs.Schemas, schema)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Versions.Walk(ctx, nil, func(_ collections.Pair[string, uint32], version types.SchemaVersion) (bool, error) {
+		gs.Versions = append(gs.Versions, version)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
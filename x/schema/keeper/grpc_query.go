@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the schema QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Schema implements types.QueryServer.
+func (k queryServer) Schema(ctx context.Context, req *types.QuerySchemaRequest) (*types.QuerySchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	schema, err := k.GetSchema(ctx, req.SchemaId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QuerySchemaResponse{Schema: schema}, nil
+}
+
+// Schemas implements types.QueryServer.
+func (k queryServer) Schemas(ctx context.Context, req *types.QuerySchemasRequest) (*types.QuerySchemasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	schemas, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Schemas, req.Pagination,
+		func(_ string, schema types.Schema) (types.Schema, error) {
+			return schema, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySchemasResponse{Schemas: schemas, Pagination: pageRes}, nil
+}
+
+// SchemaVersion implements types.QueryServer.
+func (k queryServer) SchemaVersion(ctx context.Context, req *types.QuerySchemaVersionRequest) (*types.QuerySchemaVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	_, version, err := k.ResolveSchemaRef(ctx, req.SchemaId, req.Version)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QuerySchemaVersionResponse{Version: version}, nil
+}
+
+// SchemaVersions implements types.QueryServer.
+func (k queryServer) SchemaVersions(ctx context.Context, req *types.QuerySchemaVersionsRequest) (*types.QuerySchemaVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.SchemaId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty schema id")
+	}
+	versions, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Versions, req.Pagination,
+		func(_ collections.Pair[string, uint32], version types.SchemaVersion) (types.SchemaVersion, error) {
+			return version, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint32](req.SchemaId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySchemaVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
+// ValidateSchemaRef implements types.QueryServer.
+func (k queryServer) ValidateSchemaRef(ctx context.Context, req *types.QueryValidateSchemaRefRequest) (*types.QueryValidateSchemaRefResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	schema, version, err := k.ResolveSchemaRef(ctx, req.SchemaId, req.Version)
+	switch {
+	case errors.Is(err, types.ErrSchemaNotFound), errors.Is(err, types.ErrSchemaVersionNotFound):
+		return &types.QueryValidateSchemaRefResponse{}, nil
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryValidateSchemaRefResponse{
+		Exists:  true,
+		Active:  schema.IsActive(version),
+		Version: version.Version,
+	}, nil
+}
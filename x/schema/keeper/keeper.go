@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+// Keeper manages the healthcare data schema registry.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+
+	// the address capable of curating the registry and executing
+	// MsgUpdateParams, typically the x/gov module account.
+	authority string
+
+	Schema   collections.Schema
+	Params   collections.Item[types.Params]
+	Schemas  collections.Map[string, types.Schema]
+	Versions collections.Map[collections.Pair[string, uint32], types.SchemaVersion]
+}
+
+// NewKeeper creates a new schema Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid schema authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		accountKeeper: accountKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Schemas:       collections.NewMap(sb, types.SchemasKey, "schemas", collections.StringKey, codec.CollValue[types.Schema](cdc)),
+		Versions: collections.NewMap(sb, types.VersionsKey, "versions",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint32Key), codec.CollValue[types.SchemaVersion](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	curator  sdk.AccAddress
+	outsider sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.SchemaKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.SchemaKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the curator stands in for an x/group policy account
+	s.curator = sdk.AccAddress([]byte("curator_____________"))
+	s.outsider = sdk.AccAddress([]byte("outsider____________"))
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.Curators = []string{s.curator.String()}
+	s.Require().NoError(s.app.SchemaKeeper.InitGenesis(s.ctx, genesis))
+}
+
+func hash(doc string) []byte {
+	h := sha256.Sum256([]byte(doc))
+	return h[:]
+}
+
+func (s *KeeperTestSuite) registerSchema(id string) {
+	_, err := s.msgServer.RegisterSchema(s.ctx, &types.MsgRegisterSchema{
+		Curator:     s.curator.String(),
+		SchemaId:    id,
+		SchemaType:  types.SCHEMA_TYPE_FHIR,
+		Description: "FHIR R4 Patient resource",
+		Uri:         "https://hl7.org/fhir/R4/patient.html",
+		Hash:        hash(id + " v1"),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRegisterSchema() {
+	s.registerSchema("fhir.Patient")
+
+	res, err := s.queryServer.Schema(s.ctx, &types.QuerySchemaRequest{SchemaId: "fhir.Patient"})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), res.Schema.LatestVersion)
+	s.Require().Equal(types.SCHEMA_TYPE_FHIR, res.Schema.SchemaType)
+	s.Require().NoError(s.app.SchemaKeeper.ValidateSchemaRef(s.ctx, "fhir.Patient", 1))
+
+	// governance curates without being listed
+	_, err = s.msgServer.RegisterSchema(s.ctx, &types.MsgRegisterSchema{
+		Curator:    s.authority,
+		SchemaId:   "omop.person",
+		SchemaType: types.SCHEMA_TYPE_OMOP,
+		Uri:        "https://ohdsi.github.io/CommonDataModel/cdm54.html#person",
+		Hash:       hash("omop person"),
+	})
+	s.Require().NoError(err)
+
+	schemas, err := s.queryServer.Schemas(s.ctx, &types.QuerySchemasRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(schemas.Schemas, 2)
+}
+
+func (s *KeeperTestSuite) TestRegisterSchemaRejects() {
+	s.registerSchema("fhir.Patient")
+
+	valid := types.MsgRegisterSchema{
+		Curator:    s.curator.String(),
+		SchemaId:   "fhir.Observation",
+		SchemaType: types.SCHEMA_TYPE_FHIR,
+		Uri:        "https://hl7.org/fhir/R4/observation.html",
+		Hash:       hash("observation"),
+	}
+
+	msg := valid
+	msg.Curator = s.outsider.String()
+	_, err := s.msgServer.RegisterSchema(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	msg = valid
+	msg.SchemaId = "fhir.Patient"
+	_, err = s.msgServer.RegisterSchema(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrSchemaExists)
+
+	msg = valid
+	msg.SchemaId = "fhir/Observation"
+	_, err = s.msgServer.RegisterSchema(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidSchema)
+
+	msg = valid
+	msg.SchemaType = types.SCHEMA_TYPE_UNSPECIFIED
+	_, err = s.msgServer.RegisterSchema(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidSchema)
+
+	msg = valid
+	msg.Hash = msg.Hash[:16]
+	_, err = s.msgServer.RegisterSchema(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidSchema)
+}
+
+func (s *KeeperTestSuite) TestPublishVersion() {
+	s.registerSchema("fhir.Patient")
+
+	_, err := s.msgServer.PublishVersion(s.ctx, &types.MsgPublishVersion{
+		Curator:  s.outsider.String(),
+		SchemaId: "fhir.Patient",
+		Uri:      "https://hl7.org/fhir/R5/patient.html",
+		Hash:     hash("fhir.Patient v2"),
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	res, err := s.msgServer.PublishVersion(s.ctx, &types.MsgPublishVersion{
+		Curator:  s.curator.String(),
+		SchemaId: "fhir.Patient",
+		Uri:      "https://hl7.org/fhir/R5/patient.html",
+		Hash:     hash("fhir.Patient v2"),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), res.Version)
+
+	latest, err := s.queryServer.SchemaVersion(s.ctx, &types.QuerySchemaVersionRequest{SchemaId: "fhir.Patient"})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), latest.Version.Version)
+	s.Require().Equal("https://hl7.org/fhir/R5/patient.html", latest.Version.Uri)
+
+	versions, err := s.queryServer.SchemaVersions(s.ctx, &types.QuerySchemaVersionsRequest{SchemaId: "fhir.Patient"})
+	s.Require().NoError(err)
+	s.Require().Len(versions.Versions, 2)
+
+	_, err = s.msgServer.PublishVersion(s.ctx, &types.MsgPublishVersion{
+		Curator:  s.curator.String(),
+		SchemaId: "fhir.Unknown",
+		Uri:      "https://example.org",
+		Hash:     hash("unknown"),
+	})
+	s.Require().ErrorIs(err, types.ErrSchemaNotFound)
+}
+
+func (s *KeeperTestSuite) TestSetDeprecated() {
+	s.registerSchema("fhir.Patient")
+	_, err := s.msgServer.PublishVersion(s.ctx, &types.MsgPublishVersion{
+		Curator:  s.curator.String(),
+		SchemaId: "fhir.Patient",
+		Uri:      "https://hl7.org/fhir/R5/patient.html",
+		Hash:     hash("fhir.Patient v2"),
+	})
+	s.Require().NoError(err)
+
+	// deprecating a single version keeps the others active
+	_, err = s.msgServer.SetDeprecated(s.ctx, &types.MsgSetDeprecated{Curator: s.curator.String(), SchemaId: "fhir.Patient", Version: 1, Deprecated: true})
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.app.SchemaKeeper.ValidateSchemaRef(s.ctx, "fhir.Patient", 1), types.ErrSchemaInactive)
+	s.Require().NoError(s.app.SchemaKeeper.ValidateSchemaRef(s.ctx, "fhir.Patient", 2))
+
+	// deprecating the schema deactivates every version
+	_, err = s.msgServer.SetDeprecated(s.ctx, &types.MsgSetDeprecated{Curator: s.curator.String(), SchemaId: "fhir.Patient", Deprecated: true})
+	s.Require().NoError(err)
+	res, err := s.queryServer.ValidateSchemaRef(s.ctx, &types.QueryValidateSchemaRefRequest{SchemaId: "fhir.Patient"})
+	s.Require().NoError(err)
+	s.Require().True(res.Exists)
+	s.Require().False(res.Active)
+	s.Require().Equal(uint32(2), res.Version)
+
+	// and reinstating it restores them
+	_, err = s.msgServer.SetDeprecated(s.ctx, &types.MsgSetDeprecated{Curator: s.curator.String(), SchemaId: "fhir.Patient", Deprecated: false})
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.SchemaKeeper.ValidateSchemaRef(s.ctx, "fhir.Patient", 0))
+
+	_, err = s.msgServer.SetDeprecated(s.ctx, &types.MsgSetDeprecated{Curator: s.curator.String(), SchemaId: "fhir.Patient", Version: 3, Deprecated: true})
+	s.Require().ErrorIs(err, types.ErrSchemaVersionNotFound)
+
+	_, err = s.msgServer.SetDeprecated(s.ctx, &types.MsgSetDeprecated{Curator: s.outsider.String(), SchemaId: "fhir.Patient", Deprecated: true})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestValidateSchemaRefUnknown() {
+	s.Require().ErrorIs(s.app.SchemaKeeper.ValidateSchemaRef(s.ctx, "fhir.Patient", 0), types.ErrSchemaNotFound)
+
+	res, err := s.queryServer.ValidateSchemaRef(s.ctx, &types.QueryValidateSchemaRefRequest{SchemaId: "fhir.Patient"})
+	s.Require().NoError(err)
+	s.Require().False(res.Exists)
+
+	s.registerSchema("fhir.Patient")
+	s.Require().ErrorIs(s.app.SchemaKeeper.ValidateSchemaRef(s.ctx, "fhir.Patient", 2), types.ErrSchemaVersionNotFound)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams([]string{s.outsider.String()})
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.curator.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams([]string{"invalid"})})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	ok, err := s.app.SchemaKeeper.IsCurator(s.ctx, s.curator.String())
+	s.Require().NoError(err)
+	s.Require().False(ok, "removed curators lose access")
+	ok, err = s.app.SchemaKeeper.IsCurator(s.ctx, s.outsider.String())
+	s.Require().NoError(err)
+	s.Require().True(ok)
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	s.registerSchema("fhir.Patient")
+	s.registerSchema("fhir.Observation")
+
+	exported, err := s.app.SchemaKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate(s.app.AccountKeeper.AddressCodec()))
+	s.Require().Len(exported.Schemas, 2)
+	s.Require().Len(exported.Versions, 2)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.SchemaKeeper.InitGenesis(s.ctx, exported))
+	s.Require().NoError(s.app.SchemaKeeper.ValidateSchemaRef(s.ctx, "fhir.Observation", 1))
+}
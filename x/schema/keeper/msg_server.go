@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the schema MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterSchema implements types.MsgServer.
+func (k msgServer) RegisterSchema(goCtx context.Context, msg *types.MsgRegisterSchema) (*types.MsgRegisterSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCurator(ctx, msg.Curator); err != nil {
+		return nil, err
+	}
+
+	schema := types.Schema{
+		Id:            msg.SchemaId,
+		SchemaType:    msg.SchemaType,
+		Description:   msg.Description,
+		LatestVersion: 1,
+		CreatedAt:     ctx.BlockTime(),
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchema, err.Error())
+	}
+	version := types.SchemaVersion{
+		SchemaId:    msg.SchemaId,
+		Version:     1,
+		Uri:         msg.Uri,
+		Hash:        msg.Hash,
+		PublishedAt: ctx.BlockTime(),
+	}
+	if err := version.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchema, err.Error())
+	}
+
+	exists, err := k.Schemas.Has(ctx, schema.Id)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errorsmod.Wrap(types.ErrSchemaExists, schema.Id)
+	}
+
+	if err := k.Schemas.Set(ctx, schema.Id, schema); err != nil {
+		return nil, err
+	}
+	if err := k.Versions.Set(ctx, collections.Join(schema.Id, version.Version), version); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterSchema,
+			sdk.NewAttribute(types.AttributeKeySchemaID, schema.Id),
+			sdk.NewAttribute(types.AttributeKeySchemaType, schema.SchemaType.String()),
+			sdk.NewAttribute(types.AttributeKeyCurator, msg.Curator),
+		),
+		newPublishVersionEvent(version, msg.Curator),
+	})
+
+	return &types.MsgRegisterSchemaResponse{}, nil
+}
+
+// PublishVersion implements types.MsgServer.
+func (k msgServer) PublishVersion(goCtx context.Context, msg *types.MsgPublishVersion) (*types.MsgPublishVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCurator(ctx, msg.Curator); err != nil {
+		return nil, err
+	}
+
+	schema, err := k.GetSchema(ctx, msg.SchemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	version := types.SchemaVersion{
+		SchemaId:    schema.Id,
+		Version:     schema.LatestVersion + 1,
+		Uri:         msg.Uri,
+		Hash:        msg.Hash,
+		PublishedAt: ctx.BlockTime(),
+	}
+	if err := version.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchema, err.Error())
+	}
+
+	schema.LatestVersion = version.Version
+	if err := k.Schemas.Set(ctx, schema.Id, schema); err != nil {
+		return nil, err
+	}
+	if err := k.Versions.Set(ctx, collections.Join(schema.Id, version.Version), version); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newPublishVersionEvent(version, msg.Curator))
+
+	return &types.MsgPublishVersionResponse{Version: version.Version}, nil
+}
+
+// SetDeprecated implements types.MsgServer.
+func (k msgServer) SetDeprecated(goCtx context.Context, msg *types.MsgSetDeprecated) (*types.MsgSetDeprecatedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCurator(ctx, msg.Curator); err != nil {
+		return nil, err
+	}
+
+	if msg.Version == 0 {
+		schema, err := k.GetSchema(ctx, msg.SchemaId)
+		if err != nil {
+			return nil, err
+		}
+		schema.Deprecated = msg.Deprecated
+		if err := k.Schemas.Set(ctx, schema.Id, schema); err != nil {
+			return nil, err
+		}
+	} else {
+		_, version, err := k.ResolveSchemaRef(ctx, msg.SchemaId, msg.Version)
+		if err != nil {
+			return nil, err
+		}
+		version.Deprecated = msg.Deprecated
+		if err := k.Versions.Set(ctx, collections.Join(version.SchemaId, version.Version), version); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDeprecated,
+			sdk.NewAttribute(types.AttributeKeySchemaID, msg.SchemaId),
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(uint64(msg.Version), 10)),
+			sdk.NewAttribute(types.AttributeKeyDeprecated, strconv.FormatBool(msg.Deprecated)),
+			sdk.NewAttribute(types.AttributeKeyCurator, msg.Curator),
+		),
+	)
+
+	return &types.MsgSetDeprecatedResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(k.accountKeeper.AddressCodec()); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkCurator fails unless addr is the authority or a curator.
+func (k msgServer) checkCurator(ctx context.Context, addr string) error {
+	ok, err := k.IsCurator(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorsmod.Wrap(types.ErrUnauthorized, addr)
+	}
+	return nil
+}
+
+func newPublishVersionEvent(version types.SchemaVersion, curator string) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypePublishVersion,
+		sdk.NewAttribute(types.AttributeKeySchemaID, version.SchemaId),
+		sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(uint64(version.Version), 10)),
+		sdk.NewAttribute(types.AttributeKeyURI, version.Uri),
+		sdk.NewAttribute(types.AttributeKeyHash, hex.EncodeToString(version.Hash)),
+		sdk.NewAttribute(types.AttributeKeyCurator, curator),
+	)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+// GetSchema returns the schema with the given id.
+func (k Keeper) GetSchema(ctx context.Context, id string) (types.Schema, error) {
+	schema, err := k.Schemas.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Schema{}, errorsmod.Wrapf(types.ErrSchemaNotFound, "schema %s", id)
+	}
+	return schema, err
+}
+
+// ResolveSchemaRef returns the schema and the referenced version of it. A
+// zero version resolves to the latest version.
+func (k Keeper) ResolveSchemaRef(ctx context.Context, id string, version uint32) (types.Schema, types.SchemaVersion, error) {
+	schema, err := k.GetSchema(ctx, id)
+	if err != nil {
+		return types.Schema{}, types.SchemaVersion{}, err
+	}
+	if version == 0 {
+		version = schema.LatestVersion
+	}
+
+	v, err := k.Versions.Get(ctx, collections.Join(id, version))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Schema{}, types.SchemaVersion{}, errorsmod.Wrapf(types.ErrSchemaVersionNotFound, "schema %s version %d", id, version)
+	}
+	if err != nil {
+		return types.Schema{}, types.SchemaVersion{}, err
+	}
+	return schema, v, nil
+}
+
+// ValidateSchemaRef checks that a schema reference exists and is active. It
+// is the entry point for modules that let users reference a data category.
+func (k Keeper) ValidateSchemaRef(ctx context.Context, id string, version uint32) error {
+	schema, v, err := k.ResolveSchemaRef(ctx, id, version)
+	if err != nil {
+		return err
+	}
+	if !schema.IsActive(v) {
+		return errorsmod.Wrapf(types.ErrSchemaInactive, "schema %s version %d", id, v.Version)
+	}
+	return nil
+}
+
+// IsCurator reports whether addr may curate the registry.
+func (k Keeper) IsCurator(ctx context.Context, addr string) (bool, error) {
+	if addr == k.authority {
+		return true, nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.IsCurator(addr), nil
+}
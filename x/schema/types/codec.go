@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the schema messages on the amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterSchema{}, "hippo/x/schema/MsgRegisterSchema")
+	legacy.RegisterAminoMsg(cdc, &MsgPublishVersion{}, "hippo/x/schema/MsgPublishVersion")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDeprecated{}, "hippo/x/schema/MsgSetDeprecated")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/schema/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/schema/Params", nil)
+}
+
+// RegisterInterfaces registers the schema messages on the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterSchema{},
+		&MsgPublishVersion{},
+		&MsgSetDeprecated{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
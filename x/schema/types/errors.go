@@ -0,0 +1,14 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/schema module sentinel errors
+var (
+	ErrUnauthorized          = errorsmod.Register(ModuleName, 2, "not a schema curator")
+	ErrInvalidSchema         = errorsmod.Register(ModuleName, 3, "invalid schema")
+	ErrSchemaExists          = errorsmod.Register(ModuleName, 4, "schema already exists")
+	ErrSchemaNotFound        = errorsmod.Register(ModuleName, 5, "schema not found")
+	ErrSchemaVersionNotFound = errorsmod.Register(ModuleName, 6, "schema version not found")
+	ErrSchemaInactive        = errorsmod.Register(ModuleName, 7, "schema is deprecated")
+	ErrInvalidParams         = errorsmod.Register(ModuleName, 8, "invalid params")
+)
@@ -0,0 +1,16 @@
+package types
+
+// schema module event types and attributes
+const (
+	EventTypeRegisterSchema = "register_schema"
+	EventTypePublishVersion = "publish_schema_version"
+	EventTypeSetDeprecated  = "set_schema_deprecated"
+
+	AttributeKeySchemaID   = "schema_id"
+	AttributeKeySchemaType = "schema_type"
+	AttributeKeyVersion    = "version"
+	AttributeKeyURI        = "uri"
+	AttributeKeyHash       = "hash"
+	AttributeKeyDeprecated = "deprecated"
+	AttributeKeyCurator    = "curator"
+)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default schema genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
+
+// Validate performs basic genesis state validation. Every schema must come
+// with all of its versions, numbered contiguously from one.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(ac); err != nil {
+		return err
+	}
+
+	schemas := make(map[string]Schema, len(gs.Schemas))
+	for _, s := range gs.Schemas {
+		if _, ok := schemas[s.Id]; ok {
+			return fmt.Errorf("duplicate schema %s", s.Id)
+		}
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("schema %s: %w", s.Id, err)
+		}
+		schemas[s.Id] = s
+	}
+
+	versions := make(map[string]map[uint32]bool, len(gs.Schemas))
+	for _, v := range gs.Versions {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("schema %s version %d: %w", v.SchemaId, v.Version, err)
+		}
+		s, ok := schemas[v.SchemaId]
+		if !ok {
+			return fmt.Errorf("version %d of unknown schema %s", v.Version, v.SchemaId)
+		}
+		if v.Version > s.LatestVersion {
+			return fmt.Errorf("schema %s version %d is after the latest version %d", v.SchemaId, v.Version, s.LatestVersion)
+		}
+		if versions[v.SchemaId] == nil {
+			versions[v.SchemaId] = make(map[uint32]bool)
+		}
+		if versions[v.SchemaId][v.Version] {
+			return fmt.Errorf("duplicate schema %s version %d", v.SchemaId, v.Version)
+		}
+		versions[v.SchemaId][v.Version] = true
+	}
+
+	for _, s := range gs.Schemas {
+		if uint32(len(versions[s.Id])) != s.LatestVersion {
+			return fmt.Errorf("schema %s has %d versions, expected %d", s.Id, len(versions[s.Id]), s.LatestVersion)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	ac := address.NewBech32Codec("hippo")
+	hash := sha256.Sum256([]byte("fhir patient"))
+
+	schema := types.Schema{Id: "fhir.Patient", SchemaType: types.SCHEMA_TYPE_FHIR, LatestVersion: 2}
+	version := func(v uint32) types.SchemaVersion {
+		return types.SchemaVersion{SchemaId: "fhir.Patient", Version: v, Uri: "https://hl7.org/fhir/R4/patient.html", Hash: hash[:]}
+	}
+	genesis := func(schemas []types.Schema, versions ...types.SchemaVersion) types.GenesisState {
+		return types.GenesisState{Params: types.DefaultParams(), Schemas: schemas, Versions: versions}
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", genesis([]types.Schema{schema}, version(1), version(2)), ""},
+		{"invalid params", types.GenesisState{Params: types.NewParams([]string{"invalid"})}, "invalid curator"},
+		{"duplicate schema", genesis([]types.Schema{schema, schema}, version(1), version(2)), "duplicate schema"},
+		{"invalid schema id", genesis([]types.Schema{{Id: "fhir/Patient", SchemaType: types.SCHEMA_TYPE_FHIR, LatestVersion: 1}}), "schema id"},
+		{"missing version", genesis([]types.Schema{schema}, version(1)), "has 1 versions"},
+		{"version after latest", genesis([]types.Schema{schema}, version(1), version(3)), "after the latest"},
+		{"duplicate version", genesis([]types.Schema{schema}, version(1), version(1)), "duplicate schema fhir.Patient version"},
+		{"orphan version", genesis(nil, version(1)), "unknown schema"},
+		{"invalid hash", genesis([]types.Schema{{Id: "fhir.Patient", SchemaType: types.SCHEMA_TYPE_FHIR, LatestVersion: 1}},
+			types.SchemaVersion{SchemaId: "fhir.Patient", Version: 1, Uri: "https://hl7.org", Hash: []byte{1}}), "sha256"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(ac)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "schema"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey   = collections.NewPrefix(0)
+	SchemasKey  = collections.NewPrefix(1)
+	VersionsKey = collections.NewPrefix(2)
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(curators []string) Params {
+	return Params{Curators: curators}
+}
+
+// DefaultParams returns the default schema parameters. Without curators the
+// registry is curated by governance alone.
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// Validate performs basic validation of the schema parameters.
+func (p Params) Validate(ac address.Codec) error {
+	seen := make(map[string]bool, len(p.Curators))
+	for _, curator := range p.Curators {
+		if _, err := ac.StringToBytes(curator); err != nil {
+			return fmt.Errorf("invalid curator address %q: %w", curator, err)
+		}
+		if seen[curator] {
+			return fmt.Errorf("duplicate curator %s", curator)
+		}
+		seen[curator] = true
+	}
+	return nil
+}
+
+// IsCurator reports whether addr is one of the curators.
+func (p Params) IsCurator(addr string) bool {
+	for _, curator := range p.Curators {
+		if curator == addr {
+			return true
+		}
+	}
+	return false
+}
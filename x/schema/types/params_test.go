@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/schema/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	ac := address.NewBech32Codec("hippo")
+	curator, err := ac.BytesToString([]byte("curator_____________"))
+	require.NoError(t, err)
+
+	require.NoError(t, types.DefaultParams().Validate(ac))
+	require.NoError(t, types.NewParams([]string{curator}).Validate(ac))
+	require.Error(t, types.NewParams([]string{"invalid"}).Validate(ac), "invalid curator address should be rejected")
+	require.Error(t, types.NewParams([]string{curator, curator}).Validate(ac), "duplicate curator should be rejected")
+}
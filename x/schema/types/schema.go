@@ -0,0 +1,70 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+)
+
+const (
+	// MaxSchemaIDLength bounds the length of a schema identifier.
+	MaxSchemaIDLength = 128
+	// MaxDescriptionLength bounds the length of a schema description.
+	MaxDescriptionLength = 512
+	// MaxURILength bounds the length of a schema document URI.
+	MaxURILength = 512
+)
+
+// schemaIDRegex restricts schema ids to characters that are safe in REST
+// paths and contract messages.
+var schemaIDRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:-]*$`)
+
+// ValidateSchemaID checks the format of a schema identifier.
+func ValidateSchemaID(id string) error {
+	if len(id) == 0 || len(id) > MaxSchemaIDLength {
+		return fmt.Errorf("schema id must be between 1 and %d characters", MaxSchemaIDLength)
+	}
+	if !schemaIDRegex.MatchString(id) {
+		return fmt.Errorf("schema id %q must start with a letter or digit and contain only letters, digits, '.', '_', ':' and '-'", id)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a schema.
+func (s Schema) Validate() error {
+	if err := ValidateSchemaID(s.Id); err != nil {
+		return err
+	}
+	if _, ok := SchemaType_name[int32(s.SchemaType)]; !ok || s.SchemaType == SCHEMA_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid schema type %s", s.SchemaType)
+	}
+	if len(s.Description) > MaxDescriptionLength {
+		return fmt.Errorf("description exceeds %d characters", MaxDescriptionLength)
+	}
+	if s.LatestVersion == 0 {
+		return fmt.Errorf("schema %s has no versions", s.Id)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a schema version.
+func (v SchemaVersion) Validate() error {
+	if err := ValidateSchemaID(v.SchemaId); err != nil {
+		return err
+	}
+	if v.Version == 0 {
+		return fmt.Errorf("version must be positive")
+	}
+	if v.Uri == "" || len(v.Uri) > MaxURILength {
+		return fmt.Errorf("uri must be between 1 and %d characters", MaxURILength)
+	}
+	if len(v.Hash) != sha256.Size {
+		return fmt.Errorf("hash must be a %d byte sha256 digest, got %d bytes", sha256.Size, len(v.Hash))
+	}
+	return nil
+}
+
+// IsActive reports whether version v of schema s may be referenced.
+func (s Schema) IsActive(v SchemaVersion) bool {
+	return !s.Deprecated && !v.Deprecated
+}
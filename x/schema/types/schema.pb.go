@@ -0,0 +1,1183 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/schema/v1/schema.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SchemaType is the vocabulary a schema document belongs to.
+type SchemaType int32
+
+const (
+	// SCHEMA_TYPE_UNSPECIFIED is invalid.
+	SCHEMA_TYPE_UNSPECIFIED SchemaType = 0
+	// SCHEMA_TYPE_FHIR is an HL7 FHIR resource or profile.
+	SCHEMA_TYPE_FHIR SchemaType = 1
+	// SCHEMA_TYPE_OMOP is an OMOP common data model table.
+	SCHEMA_TYPE_OMOP SchemaType = 2
+	// SCHEMA_TYPE_JSON_SCHEMA is a JSON schema document.
+	SCHEMA_TYPE_JSON_SCHEMA SchemaType = 3
+)
+
+var SchemaType_name = map[int32]string{
+	0: "SCHEMA_TYPE_UNSPECIFIED",
+	1: "SCHEMA_TYPE_FHIR",
+	2: "SCHEMA_TYPE_OMOP",
+	3: "SCHEMA_TYPE_JSON_SCHEMA",
+}
+
+var SchemaType_value = map[string]int32{
+	"SCHEMA_TYPE_UNSPECIFIED": 0,
+	"SCHEMA_TYPE_FHIR":        1,
+	"SCHEMA_TYPE_OMOP":        2,
+	"SCHEMA_TYPE_JSON_SCHEMA": 3,
+}
+
+func (x SchemaType) String() string {
+	return proto.EnumName(SchemaType_name, int32(x))
+}
+
+func (SchemaType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_17639ce116e5dd13, []int{0}
+}
+
+// Params defines the parameters of the schema module.
+type Params struct {
+	// curators are the accounts, typically x/group policies, allowed to curate
+	// the registry in addition to the module authority.
+	Curators []string `protobuf:"bytes,1,rep,name=curators,proto3" json:"curators,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17639ce116e5dd13, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCurators() []string {
+	if m != nil {
+		return m.Curators
+	}
+	return nil
+}
+
+// Schema is a registered data category.
+type Schema struct {
+	// id is the stable identifier datasets, bounties and consents refer to,
+	// for example "fhir.Patient" or "omop.person".
+	Id          string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SchemaType  SchemaType `protobuf:"varint,2,opt,name=schema_type,json=schemaType,proto3,enum=hippo.schema.v1.SchemaType" json:"schema_type,omitempty"`
+	Description string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// latest_version is the most recently published version.
+	LatestVersion uint32 `protobuf:"varint,4,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// deprecated marks every version of the schema as inactive.
+	Deprecated bool      `protobuf:"varint,5,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	CreatedAt  time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+}
+
+func (m *Schema) Reset()         { *m = Schema{} }
+func (m *Schema) String() string { return proto.CompactTextString(m) }
+func (*Schema) ProtoMessage()    {}
+func (*Schema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17639ce116e5dd13, []int{1}
+}
+func (m *Schema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema.Merge(m, src)
+}
+func (m *Schema) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schema) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema proto.InternalMessageInfo
+
+func (m *Schema) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Schema) GetSchemaType() SchemaType {
+	if m != nil {
+		return m.SchemaType
+	}
+	return SCHEMA_TYPE_UNSPECIFIED
+}
+
+func (m *Schema) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Schema) GetLatestVersion() uint32 {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return 0
+}
+
+func (m *Schema) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *Schema) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+// SchemaVersion is a published version of a schema document.
+type SchemaVersion struct {
+	SchemaId string `protobuf:"bytes,1,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Version  uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// uri locates the schema document.
+	Uri string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// hash is the sha256 hash of the schema document.
+	Hash        []byte    `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Deprecated  bool      `protobuf:"varint,5,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	PublishedAt time.Time `protobuf:"bytes,6,opt,name=published_at,json=publishedAt,proto3,stdtime" json:"published_at"`
+}
+
+func (m *SchemaVersion) Reset()         { *m = SchemaVersion{} }
+func (m *SchemaVersion) String() string { return proto.CompactTextString(m) }
+func (*SchemaVersion) ProtoMessage()    {}
+func (*SchemaVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17639ce116e5dd13, []int{2}
+}
+func (m *SchemaVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaVersion.Merge(m, src)
+}
+func (m *SchemaVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaVersion proto.InternalMessageInfo
+
+func (m *SchemaVersion) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
+func (m *SchemaVersion) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SchemaVersion) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *SchemaVersion) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *SchemaVersion) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *SchemaVersion) GetPublishedAt() time.Time {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("hippo.schema.v1.SchemaType", SchemaType_name, SchemaType_value)
+	proto.RegisterType((*Params)(nil), "hippo.schema.v1.Params")
+	proto.RegisterType((*Schema)(nil), "hippo.schema.v1.Schema")
+	proto.RegisterType((*SchemaVersion)(nil), "hippo.schema.v1.SchemaVersion")
+}
+
+func init() { proto.RegisterFile("hippo/schema/v1/schema.proto", fileDescriptor_17639ce116e5dd13) }
+
+var fileDescriptor_17639ce116e5dd13 = []byte{
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x4f, 0xd4, 0x40,
+	0x18, 0xc6, 0x3b, 0x5d, 0x5c, 0xd9, 0x59, 0x16, 0xeb, 0x04, 0x63, 0x5d, 0x4c, 0x69, 0x48, 0x4c,
+	0x1a, 0x12, 0xda, 0x80, 0xc6, 0x83, 0xf1, 0xb2, 0xe0, 0x12, 0xd6, 0x08, 0x6c, 0xba, 0x68, 0x22,
+	0x97, 0x66, 0xb6, 0x1d, 0xdb, 0x26, 0x5b, 0xa6, 0x99, 0x99, 0x25, 0xf0, 0x0d, 0xd4, 0x13, 0xdf,
+	0xc1, 0x8b, 0x47, 0x0e, 0x7e, 0x08, 0x8e, 0xc4, 0x93, 0xf1, 0xa0, 0x86, 0x3d, 0xf0, 0x35, 0xcc,
+	0xce, 0xb4, 0xcb, 0x66, 0x2f, 0x26, 0x5e, 0x9a, 0xe7, 0xfd, 0xbd, 0xef, 0xfc, 0x79, 0x9e, 0x0e,
+	0x7c, 0x9c, 0xa4, 0x79, 0x4e, 0x3d, 0x1e, 0x26, 0x24, 0xc3, 0xde, 0xc9, 0x46, 0xa1, 0xdc, 0x9c,
+	0x51, 0x41, 0xd1, 0x3d, 0xd9, 0x75, 0x0b, 0x76, 0xb2, 0xd1, 0xbc, 0x8f, 0xb3, 0xf4, 0x98, 0x7a,
+	0xf2, 0xab, 0x66, 0x9a, 0x8f, 0x42, 0xca, 0x33, 0xca, 0x03, 0x59, 0x79, 0xaa, 0x28, 0x5a, 0x4b,
+	0x31, 0x8d, 0xa9, 0xe2, 0x63, 0x55, 0xd0, 0x95, 0x98, 0xd2, 0x78, 0x40, 0x3c, 0x59, 0xf5, 0x87,
+	0x1f, 0x3c, 0x91, 0x66, 0x84, 0x0b, 0x9c, 0xe5, 0x6a, 0x60, 0xf5, 0x08, 0x56, 0xbb, 0x98, 0xe1,
+	0x8c, 0xa3, 0x67, 0x70, 0x3e, 0x1c, 0x32, 0x2c, 0x28, 0xe3, 0x26, 0xb0, 0x2b, 0x4e, 0x6d, 0xcb,
+	0xfc, 0xfe, 0x6d, 0x7d, 0xa9, 0x38, 0xa4, 0x15, 0x45, 0x8c, 0x70, 0xde, 0x13, 0x2c, 0x3d, 0x8e,
+	0xfd, 0xc9, 0xe4, 0x8b, 0xe6, 0xe7, 0x9b, 0x8b, 0xb5, 0x07, 0xca, 0xd8, 0x69, 0x69, 0x4d, 0xed,
+	0xb8, 0xfa, 0x49, 0x87, 0xd5, 0x9e, 0x24, 0x68, 0x11, 0xea, 0x69, 0x64, 0x02, 0x1b, 0x38, 0x35,
+	0x5f, 0x4f, 0x23, 0xf4, 0x12, 0xd6, 0xd5, 0x6c, 0x20, 0xce, 0x72, 0x62, 0xea, 0x36, 0x70, 0x16,
+	0x37, 0x97, 0xdd, 0x99, 0x08, 0x5c, 0xb5, 0xfa, 0xf0, 0x2c, 0x27, 0x3e, 0xe4, 0x13, 0x8d, 0x6c,
+	0x58, 0x8f, 0x08, 0x0f, 0x59, 0x9a, 0x8b, 0x94, 0x1e, 0x9b, 0x15, 0xb9, 0xed, 0x34, 0x42, 0x4f,
+	0xe0, 0xe2, 0x00, 0x0b, 0xc2, 0x45, 0x70, 0x42, 0x18, 0x1f, 0x0f, 0xcd, 0xd9, 0xc0, 0x69, 0xf8,
+	0x0d, 0x45, 0xdf, 0x29, 0x88, 0x2c, 0x08, 0x23, 0x92, 0x33, 0x12, 0x62, 0x41, 0x22, 0xf3, 0x8e,
+	0x0d, 0x9c, 0x79, 0x7f, 0x8a, 0xa0, 0x5d, 0x08, 0x43, 0x46, 0xc6, 0x32, 0xc0, 0xc2, 0xac, 0xda,
+	0xc0, 0xa9, 0x6f, 0x36, 0x5d, 0x95, 0xa9, 0x5b, 0x66, 0xea, 0x1e, 0x96, 0x99, 0x6e, 0x35, 0x2e,
+	0x7f, 0xad, 0x68, 0xe7, 0xbf, 0x57, 0xc0, 0xd7, 0x9b, 0x8b, 0x35, 0xe0, 0xd7, 0x8a, 0xc5, 0x2d,
+	0xb1, 0xfa, 0x13, 0xc0, 0x86, 0x72, 0x53, 0x9e, 0xbd, 0x0c, 0x6b, 0x45, 0x04, 0x93, 0x64, 0xe6,
+	0x15, 0xe8, 0x44, 0xc8, 0x84, 0x77, 0xcb, 0x8b, 0xeb, 0xf2, 0xe2, 0x65, 0x89, 0x0c, 0x58, 0x19,
+	0xb2, 0xb4, 0xf0, 0x3c, 0x96, 0x08, 0xc1, 0xb9, 0x04, 0xf3, 0x44, 0x3a, 0x5c, 0xf0, 0xa5, 0xfe,
+	0xa7, 0xb1, 0x37, 0x70, 0x21, 0x1f, 0xf6, 0x07, 0x29, 0x4f, 0xfe, 0xd3, 0x5a, 0x7d, 0xb2, 0xbc,
+	0x25, 0xd6, 0x4e, 0x21, 0xbc, 0xfd, 0x53, 0x68, 0x19, 0x3e, 0xec, 0x6d, 0xef, 0xb6, 0xf7, 0x5a,
+	0xc1, 0xe1, 0xfb, 0x6e, 0x3b, 0x78, 0xbb, 0xdf, 0xeb, 0xb6, 0xb7, 0x3b, 0x3b, 0x9d, 0xf6, 0x2b,
+	0x43, 0x43, 0x4b, 0xd0, 0x98, 0x6e, 0xee, 0xec, 0x76, 0x7c, 0x03, 0xcc, 0xd2, 0x83, 0xbd, 0x83,
+	0xae, 0xa1, 0xcf, 0x6e, 0xf4, 0xba, 0x77, 0xb0, 0x1f, 0x28, 0x60, 0x54, 0x9a, 0x73, 0x1f, 0xbf,
+	0x58, 0xda, 0x56, 0xf7, 0xf2, 0xda, 0x02, 0x57, 0xd7, 0x16, 0xf8, 0x73, 0x6d, 0x81, 0xf3, 0x91,
+	0xa5, 0x5d, 0x8d, 0x2c, 0xed, 0xc7, 0xc8, 0xd2, 0x8e, 0x9e, 0xc7, 0xa9, 0x48, 0x86, 0x7d, 0x37,
+	0xa4, 0x99, 0x27, 0x9f, 0x55, 0xc8, 0xb0, 0x58, 0x8f, 0x30, 0x55, 0xd5, 0xba, 0xb4, 0x18, 0xd2,
+	0xc1, 0xed, 0xab, 0x1d, 0xbf, 0x44, 0xde, 0xaf, 0xca, 0xc6, 0xd3, 0xbf, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0xe6, 0xd3, 0xc2, 0xe5, 0xad, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curators) > 0 {
+		for iNdEx := len(m.Curators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Curators[iNdEx])
+			copy(dAtA[i:], m.Curators[iNdEx])
+			i = encodeVarintSchema(dAtA, i, uint64(len(m.Curators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSchema(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LatestVersion != 0 {
+		i = encodeVarintSchema(dAtA, i, uint64(m.LatestVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSchema(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SchemaType != 0 {
+		i = encodeVarintSchema(dAtA, i, uint64(m.SchemaType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSchema(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchemaVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PublishedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSchema(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSchema(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintSchema(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintSchema(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SchemaId) > 0 {
+		i -= len(m.SchemaId)
+		copy(dAtA[i:], m.SchemaId)
+		i = encodeVarintSchema(dAtA, i, uint64(len(m.SchemaId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchema(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchema(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Curators) > 0 {
+		for _, s := range m.Curators {
+			l = len(s)
+			n += 1 + l + sovSchema(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Schema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSchema(uint64(l))
+	}
+	if m.SchemaType != 0 {
+		n += 1 + sovSchema(uint64(m.SchemaType))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSchema(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovSchema(uint64(m.LatestVersion))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovSchema(uint64(l))
+	return n
+}
+
+func (m *SchemaVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SchemaId)
+	if l > 0 {
+		n += 1 + l + sovSchema(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSchema(uint64(m.Version))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovSchema(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSchema(uint64(l))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt)
+	n += 1 + l + sovSchema(uint64(l))
+	return n
+}
+
+func sovSchema(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchema(x uint64) (n int) {
+	return sovSchema(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curators = append(m.Curators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaType", wireType)
+			}
+			m.SchemaType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaType |= SchemaType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			m.LatestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PublishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchema(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchema
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchema
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchema
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchema
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchema
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchema        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchema          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchema = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/keeper"
+)
+
+// AutoGrantDecorator issues a sponsored fee allowance to a new account the
+// first time it names a sponsor pool as fee granter. It must run before the
+// DeductFeeDecorator, which then charges the fee to the pool through the
+// freshly granted allowance.
+//
+// The grant is written to the ante handler's cached context, so it is only
+// committed when the rest of the ante chain, signature verification included,
+// succeeds. Once the fee is deducted, what the fee payer spent of a sponsored
+// allowance is returned to the reserved budget of the sponsor.
+type AutoGrantDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewAutoGrantDecorator returns a new AutoGrantDecorator.
+func NewAutoGrantDecorator(k keeper.Keeper) AutoGrantDecorator {
+	return AutoGrantDecorator{keeper: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d AutoGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	granter := feeTx.FeeGranter()
+	if len(granter) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.keeper.GrantOnDemand(ctx, granter, feeTx.FeePayer(), tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+	return newCtx, d.keeper.RecordGrantUse(newCtx, granter, feeTx.FeePayer())
+}
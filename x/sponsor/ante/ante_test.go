@@ -0,0 +1,95 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/ante"
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+func TestAutoGrantDecorator(t *testing.T) {
+	consensus.SetWalletConfig()
+	hippo := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+	ctx := hippo.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, hippo.SponsorKeeper.InitGenesis(ctx, types.DefaultGenesisState()))
+
+	coins := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(100)))
+	sponsor := sdk.AccAddress([]byte("sponsor_____________"))
+	user := sdk.AccAddress([]byte("user________________"))
+	require.NoError(t, hippo.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, hippo.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sponsor, coins))
+
+	msgSend := banktypes.NewMsgSend(user, sponsor, coins)
+	res, err := keeper.NewMsgServerImpl(hippo.SponsorKeeper).RegisterSponsor(ctx, &types.MsgRegisterSponsor{
+		Sponsor:         sponsor.String(),
+		SpendLimit:      coins,
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{sdk.MsgTypeURL(msgSend)},
+		Deposit:         coins,
+	})
+	require.NoError(t, err)
+	pool := sdk.MustAccAddressFromBech32(res.PoolAddress)
+
+	buildTx := func(granter sdk.AccAddress) sdk.Tx {
+		builder := hippo.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgSend))
+		builder.SetFeeAmount(coins)
+		builder.SetFeeGranter(granter)
+		return builder.GetTx()
+	}
+	nextCalled := false
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+	// deductFee stands for the fee decorators, spending part of the allowance
+	spent := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(30)))
+	deductFee := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, hippo.FeeGrantKeeper.UseGrantedFees(ctx, pool, user, spent, []sdk.Msg{msgSend})
+	}
+	decorator := ante.NewAutoGrantDecorator(hippo.SponsorKeeper)
+
+	// without a fee granter the decorator does nothing
+	_, err = decorator.AnteHandle(ctx, buildTx(nil), false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+	require.Nil(t, hippo.AccountKeeper.GetAccount(ctx, user))
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(ctx, buildTx(pool), false, deductFee)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+	_, err = hippo.FeeGrantKeeper.GetAllowance(ctx, pool, user)
+	require.NoError(t, err)
+
+	// what the fee payer spent is no longer reserved
+	sponsorState, err := hippo.SponsorKeeper.GetSponsor(ctx, sponsor)
+	require.NoError(t, err)
+	require.Equal(t, coins.Sub(spent...), sponsorState.Outstanding)
+
+	// ineligible fee payers are rejected before the fee is deducted
+	acc := hippo.AccountKeeper.GetAccount(ctx, user)
+	require.NoError(t, acc.SetSequence(1))
+	hippo.AccountKeeper.SetAccount(ctx, acc)
+	require.NoError(t, hippo.FeeGrantKeeper.UseGrantedFees(ctx, pool, user, coins.Sub(spent...), []sdk.Msg{msgSend}))
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(ctx, buildTx(pool), false, next)
+	require.ErrorIs(t, err, types.ErrNotEligible)
+	require.False(t, nextCalled)
+}
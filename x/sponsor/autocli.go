@@ -0,0 +1,92 @@
+package sponsor
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.sponsor.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the sponsor module parameters",
+				},
+				{
+					RpcMethod:      "Sponsor",
+					Use:            "sponsor [address]",
+					Short:          "Query a sponsor by address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Sponsors",
+					Use:       "sponsors",
+					Short:     "Query all sponsors",
+				},
+				{
+					RpcMethod:      "SponsorBudget",
+					Use:            "budget [address]",
+					Short:          "Query the remaining budget of a sponsor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SponsoredAccount",
+					Use:            "sponsored-account [address]",
+					Short:          "Query the sponsored allowances an address received",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.sponsor.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RegisterSponsor",
+					Use:       "register-sponsor [name] [spend-limit] [grant-period] [allowed-messages]",
+					Short:     "Register as a sponsor paying the first fees of new accounts",
+					Long: "Register as a sponsor. Each new account naming the sponsor pool as fee granter is granted an " +
+						"allowance of spend-limit, valid for grant-period, restricted to the comma separated message type urls.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "spend_limit"},
+						{ProtoField: "grant_period"},
+						{ProtoField: "allowed_messages"},
+					},
+				},
+				{
+					RpcMethod: "UpdateSponsor",
+					Use:       "update-sponsor [spend-limit] [grant-period] [allowed-messages] [active]",
+					Short:     "Change the allowance terms of a sponsor or pause it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "spend_limit"},
+						{ProtoField: "grant_period"},
+						{ProtoField: "allowed_messages"},
+						{ProtoField: "active"},
+					},
+				},
+				{
+					RpcMethod: "FundPool",
+					Use:       "fund-pool [sponsor] [amount]",
+					Short:     "Deposit into the pool of a sponsor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sponsor"},
+						{ProtoField: "amount", Varargs: true},
+					},
+				},
+				{
+					RpcMethod:      "WithdrawPool",
+					Use:            "withdraw-pool [amount]",
+					Short:          "Withdraw unspent budget from the pool of a sponsor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker returns to the sponsor budgets what remains of the allowances
+// that expired. An allowance stays valid up to its expiration time, so it can
+// no longer be used once a block at that time has ended.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	iter, err := k.GrantQueue.Iterate(ctx, collections.NewPrefixUntilTripleRange[time.Time, sdk.AccAddress, sdk.AccAddress](sdkCtx.BlockTime()))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		sponsorAddr, grantee := key.K2(), key.K3()
+		grant, err := k.Grants.Get(ctx, collections.Join(sponsorAddr, grantee))
+		if err != nil {
+			return err
+		}
+		sponsor, err := k.GetSponsor(ctx, sponsorAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.releaseGrant(ctx, sponsorAddr, &sponsor, grantee, grant); err != nil {
+			return err
+		}
+		if err := k.Sponsors.Set(ctx, sponsorAddr, sponsor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
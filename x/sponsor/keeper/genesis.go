@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+// InitGenesis initializes the sponsor module state from a genesis state and
+// rebuilds the pool index. Pool accounts and balances are part of the auth
+// and bank genesis.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, sponsor := range gs.Sponsors {
+		if err := k.SetSponsor(ctx, sponsor); err != nil {
+			return err
+		}
+	}
+
+	for _, account := range gs.SponsoredAccounts {
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(account.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.SponsoredAccounts.Set(ctx, addr, account); err != nil {
+			return err
+		}
+	}
+
+	for _, grant := range gs.Grants {
+		sponsor, err := k.accountKeeper.AddressCodec().StringToBytes(grant.Sponsor)
+		if err != nil {
+			return err
+		}
+		grantee, err := k.accountKeeper.AddressCodec().StringToBytes(grant.Grantee)
+		if err != nil {
+			return err
+		}
+		if err := k.Grants.Set(ctx, collections.Join[sdk.AccAddress, sdk.AccAddress](sponsor, grantee), grant); err != nil {
+			return err
+		}
+		if err := k.GrantQueue.Set(ctx, collections.Join3[time.Time, sdk.AccAddress, sdk.AccAddress](grant.Expiration, sponsor, grantee)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the sponsor module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+
+	if err := k.Sponsors.Walk(ctx, nil, func(_ sdk.AccAddress, sponsor types.Sponsor) (bool, error) {
+		gs.Sponsors = append(gs.Sponsors, sponsor)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.SponsoredAccounts.Walk(ctx, nil, func(_ sdk.AccAddress, account types.SponsoredAccount) (bool, error) {
+		gs.SponsoredAccounts = append(gs.SponsoredAccounts, account)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Grants.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], grant types.Grant) (bool, error) {
+		gs.Grants = append(gs.Grants, grant)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+// GrantOnDemand issues a fee allowance from a sponsor pool to a new account
+// the first time it names the pool as fee granter. It is a no-op when the
+// granter is not a sponsor pool or an allowance already exists, leaving the
+// fee deduction to the feegrant module.
+func (k Keeper) GrantOnDemand(ctx context.Context, granter, grantee sdk.AccAddress, msgs []sdk.Msg) error {
+	sponsorAddr, err := k.PoolIndex.Get(ctx, granter)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee); err == nil {
+		return nil
+	}
+
+	sponsor, err := k.GetSponsor(ctx, sponsorAddr)
+	if err != nil {
+		return err
+	}
+
+	msgTypeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	return k.grant(ctx, sponsorAddr, sponsor, grantee, msgTypeURLs)
+}
+
+// grant checks that grantee is eligible for an allowance of sponsor covering
+// msgTypeURLs and issues it.
+func (k Keeper) grant(ctx context.Context, sponsorAddr sdk.AccAddress, sponsor types.Sponsor, grantee sdk.AccAddress, msgTypeURLs []string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !sponsor.Active {
+		return errorsmod.Wrapf(types.ErrSponsorInactive, "%s", sponsor.Address)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := params.CheckTerms(sponsor.SpendLimit, sponsor.GrantPeriod, sponsor.AllowedMessages); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidSponsor, "terms of %s: %s", sponsor.Address, err)
+	}
+
+	// the grant is issued in the ante handler of the first tx of grantee,
+	// which increments its sequence, so this and the per address limit below
+	// only stop an address from being granted again. Neither bounds a party
+	// creating new addresses, the per sponsor limit and the reserved budget
+	// do.
+	if acc := k.accountKeeper.GetAccount(ctx, grantee); acc != nil && acc.GetSequence() > 0 {
+		return errorsmod.Wrapf(types.ErrNotEligible, "%s has already sent transactions", grantee)
+	}
+
+	if !sponsor.Allows(msgTypeURLs) {
+		return errorsmod.Wrapf(types.ErrMsgNotAllowed, "sponsor %s covers %v", sponsor.Address, sponsor.AllowedMessages)
+	}
+
+	account, err := k.SponsoredAccounts.Get(ctx, grantee)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		account = types.SponsoredAccount{Address: grantee.String(), WindowStart: sdkCtx.BlockTime()}
+	case err != nil:
+		return err
+	}
+	if !sdkCtx.BlockTime().Before(account.WindowStart.Add(params.RateLimitWindow)) {
+		account.WindowStart = sdkCtx.BlockTime()
+		account.GrantsInWindow = 0
+	}
+	if account.GrantsInWindow >= params.MaxGrantsPerAddress {
+		return errorsmod.Wrapf(types.ErrRateLimited, "%s received %d allowances since %s",
+			grantee, account.GrantsInWindow, account.WindowStart)
+	}
+
+	if !sdkCtx.BlockTime().Before(sponsor.WindowStart.Add(params.RateLimitWindow)) {
+		sponsor.WindowStart = sdkCtx.BlockTime()
+		sponsor.GrantsInWindow = 0
+	}
+	if sponsor.GrantsInWindow >= params.MaxGrantsPerSponsor {
+		return errorsmod.Wrapf(types.ErrRateLimited, "%s issued %d allowances since %s",
+			sponsor.Address, sponsor.GrantsInWindow, sponsor.WindowStart)
+	}
+
+	pool, err := k.accountKeeper.AddressCodec().StringToBytes(sponsor.PoolAddress)
+	if err != nil {
+		return err
+	}
+	// the allowances issued before draw on the same balance
+	balance := k.bankKeeper.GetAllBalances(ctx, pool)
+	if available, hasNeg := balance.SafeSub(sponsor.Outstanding...); hasNeg || !available.IsAllGTE(sponsor.SpendLimit) {
+		return errorsmod.Wrapf(types.ErrBudgetExhausted, "pool of %s holds %s of which %s is reserved", sponsor.Address, balance, sponsor.Outstanding)
+	}
+
+	expiration := sdkCtx.BlockTime().Add(sponsor.GrantPeriod)
+	allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sponsor.SpendLimit,
+		Expiration: &expiration,
+	}, sponsor.AllowedMessages)
+	if err != nil {
+		return err
+	}
+	if err := k.feegrantKeeper.GrantAllowance(ctx, pool, grantee, allowance); err != nil {
+		return err
+	}
+
+	if err := k.addGrant(ctx, sponsorAddr, &sponsor, grantee, expiration); err != nil {
+		return err
+	}
+
+	account.LastSponsor = sponsor.Address
+	account.GrantsInWindow++
+	account.TotalGrants++
+	if err := k.SponsoredAccounts.Set(ctx, grantee, account); err != nil {
+		return err
+	}
+
+	sponsor.GrantsIssued++
+	sponsor.GrantsInWindow++
+	if err := k.Sponsors.Set(ctx, sponsorAddr, sponsor); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAllowance,
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsor.Address),
+			sdk.NewAttribute(types.AttributeKeyPool, sponsor.PoolAddress),
+			sdk.NewAttribute(types.AttributeKeyGrantee, account.Address),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, sponsor.SpendLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, expiration.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// addGrant reserves the spend limit of an allowance of sponsor to grantee in
+// the sponsor budget. The caller stores sponsor.
+func (k Keeper) addGrant(ctx context.Context, sponsorAddr sdk.AccAddress, sponsor *types.Sponsor, grantee sdk.AccAddress, expiration time.Time) error {
+	// an allowance spent or expired between two blocks is still tracked
+	previous, err := k.Grants.Get(ctx, collections.Join(sponsorAddr, grantee))
+	switch {
+	case err == nil:
+		if err := k.releaseGrant(ctx, sponsorAddr, sponsor, grantee, previous); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	grant := types.Grant{
+		Sponsor:    sponsor.Address,
+		Grantee:    grantee.String(),
+		Remaining:  sponsor.SpendLimit,
+		Expiration: expiration,
+	}
+	if err := k.Grants.Set(ctx, collections.Join(sponsorAddr, grantee), grant); err != nil {
+		return err
+	}
+	if err := k.GrantQueue.Set(ctx, collections.Join3(expiration, sponsorAddr, grantee)); err != nil {
+		return err
+	}
+	sponsor.Outstanding = sponsor.Outstanding.Add(grant.Remaining...)
+	return nil
+}
+
+// releaseGrant removes grant and returns what remains of it to the sponsor
+// budget. The caller stores sponsor.
+func (k Keeper) releaseGrant(ctx context.Context, sponsorAddr sdk.AccAddress, sponsor *types.Sponsor, grantee sdk.AccAddress, grant types.Grant) error {
+	if err := k.Grants.Remove(ctx, collections.Join(sponsorAddr, grantee)); err != nil {
+		return err
+	}
+	if err := k.GrantQueue.Remove(ctx, collections.Join3(grant.Expiration, sponsorAddr, grantee)); err != nil {
+		return err
+	}
+	sponsor.Outstanding = sponsor.Outstanding.Sub(grant.Remaining...)
+	return nil
+}
+
+// RecordGrantUse returns to the budget of a sponsor what grantee has spent of
+// its allowance since the last call. The feegrant module does not report the
+// fees it deducts, so the remaining spend limit is read back from the
+// allowance, which is removed once it is used up.
+func (k Keeper) RecordGrantUse(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	sponsorAddr, err := k.PoolIndex.Get(ctx, granter)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	grant, err := k.Grants.Get(ctx, collections.Join(sponsorAddr, grantee))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	sponsor, err := k.GetSponsor(ctx, sponsorAddr)
+	if err != nil {
+		return err
+	}
+
+	var remaining sdk.Coins
+	allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	switch {
+	case err == nil:
+		if remaining, err = spendLimit(allowance); err != nil {
+			return err
+		}
+	case !errors.Is(err, sdkerrors.ErrNotFound):
+		return err
+	}
+
+	if remaining.IsZero() {
+		if err := k.releaseGrant(ctx, sponsorAddr, &sponsor, grantee, grant); err != nil {
+			return err
+		}
+		return k.Sponsors.Set(ctx, sponsorAddr, sponsor)
+	}
+	spent, hasNeg := grant.Remaining.SafeSub(remaining...)
+	if hasNeg || spent.IsZero() {
+		return nil
+	}
+	grant.Remaining = remaining
+	if err := k.Grants.Set(ctx, collections.Join(sponsorAddr, grantee), grant); err != nil {
+		return err
+	}
+	sponsor.Outstanding = sponsor.Outstanding.Sub(spent...)
+	return k.Sponsors.Set(ctx, sponsorAddr, sponsor)
+}
+
+// spendLimit returns the spend limit left of an allowance issued by a sponsor.
+func spendLimit(allowance feegrant.FeeAllowanceI) (sdk.Coins, error) {
+	allowed, ok := allowance.(*feegrant.AllowedMsgAllowance)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsor, "unexpected allowance %T", allowance)
+	}
+	inner, err := allowed.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	basic, ok := inner.(*feegrant.BasicAllowance)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsor, "unexpected allowance %T", inner)
+	}
+	return basic.SpendLimit, nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the sponsor QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (k queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Sponsor implements types.QueryServer.
+func (k queryServer) Sponsor(ctx context.Context, req *types.QuerySponsorRequest) (*types.QuerySponsorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sponsor, err := k.getSponsor(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySponsorResponse{Sponsor: sponsor}, nil
+}
+
+// Sponsors implements types.QueryServer.
+func (k queryServer) Sponsors(ctx context.Context, req *types.QuerySponsorsRequest) (*types.QuerySponsorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sponsors, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Sponsors, req.Pagination,
+		func(_ sdk.AccAddress, sponsor types.Sponsor) (types.Sponsor, error) {
+			return sponsor, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsorsResponse{Sponsors: sponsors, Pagination: pageRes}, nil
+}
+
+// SponsorBudget implements types.QueryServer.
+func (k queryServer) SponsorBudget(ctx context.Context, req *types.QuerySponsorBudgetRequest) (*types.QuerySponsorBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sponsor, err := k.getSponsor(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	remaining, grants, err := k.Budget(ctx, sponsor)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsorBudgetResponse{
+		Remaining:       remaining,
+		TotalDeposited:  sponsor.TotalDeposited,
+		GrantsIssued:    sponsor.GrantsIssued,
+		GrantsRemaining: grants,
+		Outstanding:     sponsor.Outstanding,
+	}, nil
+}
+
+// SponsoredAccount implements types.QueryServer.
+func (k queryServer) SponsoredAccount(ctx context.Context, req *types.QuerySponsoredAccountRequest) (*types.QuerySponsoredAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	account, err := k.SponsoredAccounts.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%s has not been sponsored", req.Address)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsoredAccountResponse{Account: account}, nil
+}
+
+// getSponsor looks up a sponsor by address, mapping failures to gRPC status
+// errors.
+func (k queryServer) getSponsor(ctx context.Context, address string) (types.Sponsor, error) {
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return types.Sponsor{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sponsor, err := k.GetSponsor(ctx, addr)
+	if err != nil {
+		return types.Sponsor{}, status.Error(codes.NotFound, err.Error())
+	}
+	return sponsor, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+// Keeper manages sponsor pools and the fee allowances they grant to new
+// accounts.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper types.FeeGrantKeeper
+
+	// the address capable of executing MsgUpdateParams, typically the x/gov
+	// module account.
+	authority string
+
+	Schema   collections.Schema
+	Params   collections.Item[types.Params]
+	Sponsors collections.Map[sdk.AccAddress, types.Sponsor]
+	// PoolIndex maps a pool address to its sponsor.
+	PoolIndex         collections.Map[sdk.AccAddress, sdk.AccAddress]
+	SponsoredAccounts collections.Map[sdk.AccAddress, types.SponsoredAccount]
+	// Grants are the allowances neither spent nor expired, by sponsor and
+	// grantee.
+	Grants collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.Grant]
+	// GrantQueue orders the grants by expiration.
+	GrantQueue collections.KeySet[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress]]
+}
+
+// NewKeeper creates a new sponsor Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeeGrantKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid sponsor authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:               cdc,
+		storeService:      storeService,
+		accountKeeper:     accountKeeper,
+		bankKeeper:        bankKeeper,
+		feegrantKeeper:    feegrantKeeper,
+		authority:         authority,
+		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Sponsors:          collections.NewMap(sb, types.SponsorsKey, "sponsors", sdk.AccAddressKey, codec.CollValue[types.Sponsor](cdc)),
+		PoolIndex:         collections.NewMap(sb, types.PoolIndexKey, "pool_index", sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.AccAddressKey)),
+		SponsoredAccounts: collections.NewMap(sb, types.SponsoredAccountsKey, "sponsored_accounts", sdk.AccAddressKey, codec.CollValue[types.SponsoredAccount](cdc)),
+		Grants:            collections.NewMap(sb, types.GrantsKey, "grants", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey), codec.CollValue[types.Grant](cdc)),
+		GrantQueue:        collections.NewKeySet(sb, types.GrantQueueKey, "grant_queue", collections.TripleKeyCodec(sdk.TimeKey, sdk.AccAddressKey, sdk.AccAddressKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
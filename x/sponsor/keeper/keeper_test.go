@@ -0,0 +1,444 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+var (
+	msgSend     = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgDelegate = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	sponsor sdk.AccAddress
+	pool    sdk.AccAddress
+	user    sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.SponsorKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.SponsorKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.SponsorKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.sponsor = sdk.AccAddress([]byte("sponsor_____________"))
+	s.user = sdk.AccAddress([]byte("user________________"))
+
+	s.fund(s.sponsor, coins(1000))
+	res, err := s.msgServer.RegisterSponsor(s.ctx, &types.MsgRegisterSponsor{
+		Sponsor:         s.sponsor.String(),
+		Name:            "hospital app",
+		SpendLimit:      coins(100),
+		GrantPeriod:     7 * 24 * time.Hour,
+		AllowedMessages: []string{msgSend},
+		Deposit:         coins(250),
+	})
+	s.Require().NoError(err)
+	s.pool = sdk.MustAccAddressFromBech32(res.PoolAddress)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(amount)))
+}
+
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, amount sdk.Coins) {
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, amount))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, addr, amount))
+}
+
+func (s *KeeperTestSuite) send(from sdk.AccAddress) []sdk.Msg {
+	return []sdk.Msg{banktypes.NewMsgSend(from, s.sponsor, coins(1))}
+}
+
+func (s *KeeperTestSuite) TestRegisterSponsor() {
+	sponsor, err := s.app.SponsorKeeper.GetSponsor(s.ctx, s.sponsor)
+	s.Require().NoError(err)
+	s.Require().True(sponsor.Active)
+	s.Require().Equal(coins(250), sponsor.TotalDeposited)
+	s.Require().NotEqual(s.sponsor.String(), sponsor.PoolAddress)
+
+	s.Require().Equal(coins(250), s.app.BankKeeper.GetAllBalances(s.ctx, s.pool))
+	s.Require().Equal(coins(750), s.app.BankKeeper.GetAllBalances(s.ctx, s.sponsor))
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, s.pool))
+
+	// registering twice fails
+	_, err = s.msgServer.RegisterSponsor(s.ctx, &types.MsgRegisterSponsor{
+		Sponsor:         s.sponsor.String(),
+		SpendLimit:      coins(100),
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{msgSend},
+	})
+	s.Require().ErrorIs(err, types.ErrSponsorExists)
+
+	// a pool cannot register as a sponsor
+	_, err = s.msgServer.RegisterSponsor(s.ctx, &types.MsgRegisterSponsor{
+		Sponsor:         s.pool.String(),
+		SpendLimit:      coins(100),
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{msgSend},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSponsor)
+}
+
+func (s *KeeperTestSuite) TestRegisterSponsorSkipsUsedPoolAddress() {
+	other := sdk.AccAddress([]byte("other_sponsor_______"))
+
+	// pre-fund the address the pool would be derived at
+	res, err := s.msgServer.RegisterSponsor(s.ctx, &types.MsgRegisterSponsor{
+		Sponsor:         other.String(),
+		SpendLimit:      coins(100),
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{msgSend},
+	})
+	s.Require().NoError(err)
+	first := res.PoolAddress
+
+	s.SetupTest()
+	s.fund(sdk.MustAccAddressFromBech32(first), coins(1))
+
+	res, err = s.msgServer.RegisterSponsor(s.ctx, &types.MsgRegisterSponsor{
+		Sponsor:         other.String(),
+		SpendLimit:      coins(100),
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{msgSend},
+	})
+	s.Require().NoError(err)
+	s.Require().NotEqual(first, res.PoolAddress)
+}
+
+func (s *KeeperTestSuite) TestRegisterSponsorTermsBounded() {
+	params := types.DefaultParams()
+	other := sdk.AccAddress([]byte("other_sponsor_______"))
+
+	for name, msg := range map[string]*types.MsgRegisterSponsor{
+		"spend limit above max": {
+			SpendLimit:      params.MaxSpendLimit.Add(coins(1)...),
+			GrantPeriod:     time.Hour,
+			AllowedMessages: []string{msgSend},
+		},
+		"grant period above max": {
+			SpendLimit:      coins(1),
+			GrantPeriod:     params.MaxGrantPeriod + time.Second,
+			AllowedMessages: []string{msgSend},
+		},
+		"no allowed messages": {
+			SpendLimit:  coins(1),
+			GrantPeriod: time.Hour,
+		},
+		"invalid message type url": {
+			SpendLimit:      coins(1),
+			GrantPeriod:     time.Hour,
+			AllowedMessages: []string{"cosmos.bank.v1beta1.MsgSend"},
+		},
+	} {
+		msg.Sponsor = other.String()
+		_, err := s.msgServer.RegisterSponsor(s.ctx, msg)
+		s.Require().ErrorIs(err, types.ErrInvalidSponsor, name)
+	}
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemand() {
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user)))
+
+	allowance, err := s.app.FeeGrantKeeper.GetAllowance(s.ctx, s.pool, s.user)
+	s.Require().NoError(err)
+	allowed, ok := allowance.(*feegrant.AllowedMsgAllowance)
+	s.Require().True(ok)
+	s.Require().Equal([]string{msgSend}, allowed.AllowedMessages)
+	basic, err := allowed.GetAllowance()
+	s.Require().NoError(err)
+	s.Require().Equal(coins(100), basic.(*feegrant.BasicAllowance).SpendLimit)
+	s.Require().Equal(s.ctx.BlockTime().Add(7*24*time.Hour), *basic.(*feegrant.BasicAllowance).Expiration)
+
+	// the grantee account is created so the rest of the ante chain can run
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, s.user))
+
+	// the allowance pays fees from the pool
+	s.Require().NoError(s.app.FeeGrantKeeper.UseGrantedFees(s.ctx, s.pool, s.user, coins(10), s.send(s.user)))
+
+	// an existing allowance is left to the feegrant module
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user)))
+
+	sponsor, err := s.app.SponsorKeeper.GetSponsor(s.ctx, s.sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), sponsor.GrantsIssued)
+
+	account, err := s.queryServer.SponsoredAccount(s.ctx, &types.QuerySponsoredAccountRequest{Address: s.user.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.sponsor.String(), account.Account.LastSponsor)
+	s.Require().Equal(uint32(1), account.Account.GrantsInWindow)
+	s.Require().Equal(uint64(1), account.Account.TotalGrants)
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandIgnoresOtherGranters() {
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, granter, s.user, s.send(s.user)))
+
+	_, err := s.app.FeeGrantKeeper.GetAllowance(s.ctx, granter, s.user)
+	s.Require().Error(err)
+	s.Require().Nil(s.app.AccountKeeper.GetAccount(s.ctx, s.user))
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandMsgNotAllowed() {
+	msgs := append(s.send(s.user), &stakingtypes.MsgDelegate{DelegatorAddress: s.user.String()})
+	err := s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, msgs)
+	s.Require().ErrorIs(err, types.ErrMsgNotAllowed)
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandExistingAccount() {
+	acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user)
+	s.Require().NoError(acc.SetSequence(1))
+	s.app.AccountKeeper.SetAccount(s.ctx, acc)
+
+	err := s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user))
+	s.Require().ErrorIs(err, types.ErrNotEligible)
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandInactive() {
+	_, err := s.msgServer.UpdateSponsor(s.ctx, &types.MsgUpdateSponsor{
+		Sponsor:         s.sponsor.String(),
+		SpendLimit:      coins(100),
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{msgSend, msgDelegate},
+		Active:          false,
+	})
+	s.Require().NoError(err)
+
+	err = s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user))
+	s.Require().ErrorIs(err, types.ErrSponsorInactive)
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandRateLimited() {
+	other := sdk.AccAddress([]byte("other_sponsor_______"))
+	s.fund(other, coins(100))
+	res, err := s.msgServer.RegisterSponsor(s.ctx, &types.MsgRegisterSponsor{
+		Sponsor:         other.String(),
+		SpendLimit:      coins(100),
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{msgSend},
+		Deposit:         coins(100),
+	})
+	s.Require().NoError(err)
+	otherPool := sdk.MustAccAddressFromBech32(res.PoolAddress)
+
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user)))
+
+	// farming a second sponsor within the window is rejected
+	err = s.app.SponsorKeeper.GrantOnDemand(s.ctx, otherPool, s.user, s.send(s.user))
+	s.Require().ErrorIs(err, types.ErrRateLimited)
+
+	// the window resets after the rate limit window
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.DefaultRateLimitWindow))
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, otherPool, s.user, s.send(s.user)))
+
+	account, err := s.app.SponsorKeeper.SponsoredAccounts.Get(s.ctx, s.user)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), account.GrantsInWindow)
+	s.Require().Equal(uint64(2), account.TotalGrants)
+	s.Require().Equal(other.String(), account.LastSponsor)
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandBudgetExhausted() {
+	_, err := s.msgServer.WithdrawPool(s.ctx, &types.MsgWithdrawPool{
+		Sponsor: s.sponsor.String(),
+		Amount:  coins(200),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(coins(950), s.app.BankKeeper.GetAllBalances(s.ctx, s.sponsor))
+
+	err = s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user))
+	s.Require().ErrorIs(err, types.ErrBudgetExhausted)
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandReservesOutstanding() {
+	first := sdk.AccAddress([]byte("first_user__________"))
+	second := sdk.AccAddress([]byte("second_user_________"))
+	third := sdk.AccAddress([]byte("third_user__________"))
+	outstanding := func() sdk.Coins {
+		sponsor, err := s.app.SponsorKeeper.GetSponsor(s.ctx, s.sponsor)
+		s.Require().NoError(err)
+		return sponsor.Outstanding
+	}
+
+	// the pool holds 250, enough for two allowances of 100
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, first, s.send(first)))
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, second, s.send(second)))
+	s.Require().Equal(coins(200), outstanding())
+	err := s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, third, s.send(third))
+	s.Require().ErrorIs(err, types.ErrBudgetExhausted)
+
+	// spending an allowance lowers the balance and the reservation alike
+	spend := func(grantee sdk.AccAddress, amount sdk.Coins) {
+		s.Require().NoError(s.app.FeeGrantKeeper.UseGrantedFees(s.ctx, s.pool, grantee, amount, s.send(grantee)))
+		s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.pool, s.sponsor, amount))
+		s.Require().NoError(s.app.SponsorKeeper.RecordGrantUse(s.ctx, s.pool, grantee))
+	}
+	spend(first, coins(60))
+	s.Require().Equal(coins(140), outstanding())
+	err = s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, third, s.send(third))
+	s.Require().ErrorIs(err, types.ErrBudgetExhausted)
+
+	// a used up allowance is no longer tracked
+	spend(first, coins(40))
+	s.Require().Equal(coins(100), outstanding())
+	_, err = s.app.SponsorKeeper.Grants.Get(s.ctx, collections.Join(s.sponsor, first))
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+
+	// expired allowances are released at the end of the block
+	s.Require().NoError(s.app.SponsorKeeper.EndBlocker(s.ctx))
+	s.Require().Equal(coins(100), outstanding())
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(7 * 24 * time.Hour))
+	s.Require().NoError(s.app.SponsorKeeper.EndBlocker(s.ctx))
+	s.Require().True(outstanding().IsZero())
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, third, s.send(third)))
+	s.Require().Equal(coins(100), outstanding())
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandSponsorRateLimited() {
+	params := types.DefaultParams()
+	params.MaxGrantsPerSponsor = 2
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+	s.fund(s.pool, coins(1000))
+
+	// new addresses do not get around the sponsor limit
+	for _, name := range []string{"first_user__________", "second_user_________"} {
+		user := sdk.AccAddress([]byte(name))
+		s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, user, s.send(user)))
+	}
+	err = s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user))
+	s.Require().ErrorIs(err, types.ErrRateLimited)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.DefaultRateLimitWindow))
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user)))
+
+	sponsor, err := s.app.SponsorKeeper.GetSponsor(s.ctx, s.sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), sponsor.GrantsInWindow)
+	s.Require().Equal(uint64(3), sponsor.GrantsIssued)
+}
+
+func (s *KeeperTestSuite) TestGrantOnDemandTermsAboveParams() {
+	params := types.DefaultParams()
+	params.MaxSpendLimit = coins(50)
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	err = s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user))
+	s.Require().ErrorIs(err, types.ErrInvalidSponsor)
+}
+
+func (s *KeeperTestSuite) TestSponsorBudget() {
+	funder := sdk.AccAddress([]byte("funder______________"))
+	s.fund(funder, coins(100))
+	_, err := s.msgServer.FundPool(s.ctx, &types.MsgFundPool{
+		Depositor: funder.String(),
+		Sponsor:   s.sponsor.String(),
+		Amount:    coins(100),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user)))
+	s.Require().NoError(s.app.FeeGrantKeeper.UseGrantedFees(s.ctx, s.pool, s.user, coins(10), s.send(s.user)))
+	s.Require().NoError(s.app.SponsorKeeper.RecordGrantUse(s.ctx, s.pool, s.user))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.pool, s.sponsor, coins(10)))
+
+	res, err := s.queryServer.SponsorBudget(s.ctx, &types.QuerySponsorBudgetRequest{Address: s.sponsor.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(coins(340), res.Remaining)
+	s.Require().Equal(coins(350), res.TotalDeposited)
+	s.Require().Equal(uint64(1), res.GrantsIssued)
+	// the unspent allowance is reserved
+	s.Require().Equal(coins(90), res.Outstanding)
+	s.Require().Equal(uint64(2), res.GrantsRemaining)
+
+	_, err = s.queryServer.SponsorBudget(s.ctx, &types.QuerySponsorBudgetRequest{Address: s.user.String()})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestWithdrawPoolOverBalance() {
+	_, err := s.msgServer.WithdrawPool(s.ctx, &types.MsgWithdrawPool{
+		Sponsor: s.sponsor.String(),
+		Amount:  coins(251),
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.WithdrawPool(s.ctx, &types.MsgWithdrawPool{
+		Sponsor: s.user.String(),
+		Amount:  coins(1),
+	})
+	s.Require().ErrorIs(err, types.ErrSponsorNotFound)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.sponsor.String(), Params: types.DefaultParams()})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params := types.DefaultParams()
+	params.MaxGrantsPerAddress = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+}
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	s.Require().NoError(s.app.SponsorKeeper.GrantOnDemand(s.ctx, s.pool, s.user, s.send(s.user)))
+
+	gs, err := s.app.SponsorKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate(s.app.AccountKeeper.AddressCodec()))
+	s.Require().Len(gs.Sponsors, 1)
+	s.Require().Len(gs.SponsoredAccounts, 1)
+	s.Require().Len(gs.Grants, 1)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.SponsorKeeper.InitGenesis(s.ctx, gs))
+	sponsor, err := s.app.SponsorKeeper.PoolIndex.Get(s.ctx, sdk.MustAccAddressFromBech32(gs.Sponsors[0].PoolAddress))
+	s.Require().NoError(err)
+	s.Require().Equal(s.sponsor, sponsor)
+
+	// the imported grants are released once they expire
+	s.ctx = s.ctx.WithBlockTime(gs.Grants[0].Expiration)
+	s.Require().NoError(s.app.SponsorKeeper.EndBlocker(s.ctx))
+	_, err = s.app.SponsorKeeper.Grants.Get(s.ctx, collections.Join(s.sponsor, s.user))
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the sponsor MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterSponsor implements types.MsgServer.
+func (k msgServer) RegisterSponsor(goCtx context.Context, msg *types.MsgRegisterSponsor) (*types.MsgRegisterSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorAddr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsor, "invalid sponsor address: %s", err)
+	}
+	if !msg.Deposit.IsValid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "invalid deposit: %s", msg.Deposit)
+	}
+	if err := k.checkTerms(ctx, msg.SpendLimit, msg.GrantPeriod, msg.AllowedMessages); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Sponsors.Get(ctx, sponsorAddr); err == nil {
+		return nil, errorsmod.Wrapf(types.ErrSponsorExists, "%s", msg.Sponsor)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	// a pool cannot register as a sponsor of its own
+	if has, err := k.PoolIndex.Has(ctx, sponsorAddr); err != nil {
+		return nil, err
+	} else if has {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsor, "%s is a sponsor pool", msg.Sponsor)
+	}
+
+	pool, err := k.createPool(ctx, sponsorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsor := types.Sponsor{
+		Address:         msg.Sponsor,
+		PoolAddress:     pool.String(),
+		Name:            msg.Name,
+		SpendLimit:      msg.SpendLimit,
+		GrantPeriod:     msg.GrantPeriod,
+		AllowedMessages: msg.AllowedMessages,
+		Active:          true,
+	}
+	if err := sponsor.Validate(k.accountKeeper.AddressCodec()); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSponsor, err.Error())
+	}
+	if err := k.SetSponsor(ctx, sponsor); err != nil {
+		return nil, err
+	}
+
+	if !msg.Deposit.IsZero() {
+		if err := k.Keeper.FundPool(ctx, sponsorAddr, sponsorAddr, msg.Deposit); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterSponsor,
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsor.Address),
+			sdk.NewAttribute(types.AttributeKeyPool, sponsor.PoolAddress),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, sponsor.SpendLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Deposit.String()),
+		),
+	)
+
+	return &types.MsgRegisterSponsorResponse{PoolAddress: sponsor.PoolAddress}, nil
+}
+
+// UpdateSponsor implements types.MsgServer.
+func (k msgServer) UpdateSponsor(goCtx context.Context, msg *types.MsgUpdateSponsor) (*types.MsgUpdateSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorAddr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsor, "invalid sponsor address: %s", err)
+	}
+	sponsor, err := k.GetSponsor(ctx, sponsorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkTerms(ctx, msg.SpendLimit, msg.GrantPeriod, msg.AllowedMessages); err != nil {
+		return nil, err
+	}
+
+	sponsor.SpendLimit = msg.SpendLimit
+	sponsor.GrantPeriod = msg.GrantPeriod
+	sponsor.AllowedMessages = msg.AllowedMessages
+	sponsor.Active = msg.Active
+	if err := k.Sponsors.Set(ctx, sponsorAddr, sponsor); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateSponsor,
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsor.Address),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, sponsor.SpendLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyActive, strconv.FormatBool(sponsor.Active)),
+		),
+	)
+
+	return &types.MsgUpdateSponsorResponse{}, nil
+}
+
+// FundPool implements types.MsgServer.
+func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*types.MsgFundPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Depositor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "invalid depositor address: %s", err)
+	}
+	sponsorAddr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsor, "invalid sponsor address: %s", err)
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.Keeper.FundPool(ctx, depositor, sponsorAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundPool,
+			sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgFundPoolResponse{}, nil
+}
+
+// WithdrawPool implements types.MsgServer.
+func (k msgServer) WithdrawPool(goCtx context.Context, msg *types.MsgWithdrawPool) (*types.MsgWithdrawPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorAddr, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSponsor, "invalid sponsor address: %s", err)
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.Keeper.WithdrawPool(ctx, sponsorAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawPool,
+			sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawPoolResponse{}, nil
+}
+
+// checkTerms validates allowance terms against the current parameters.
+func (k msgServer) checkTerms(ctx context.Context, spendLimit sdk.Coins, grantPeriod time.Duration, allowedMessages []string) error {
+	if err := types.ValidateTerms(spendLimit, grantPeriod, allowedMessages); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSponsor, err.Error())
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := params.CheckTerms(spendLimit, grantPeriod, allowedMessages); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSponsor, err.Error())
+	}
+	return nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+// GetSponsor returns the sponsor registered by addr.
+func (k Keeper) GetSponsor(ctx context.Context, addr sdk.AccAddress) (types.Sponsor, error) {
+	sponsor, err := k.Sponsors.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Sponsor{}, errorsmod.Wrapf(types.ErrSponsorNotFound, "%s", addr)
+	}
+	return sponsor, err
+}
+
+// createPool creates the account holding the budget of a sponsor. The address
+// is derived from the sponsor address and a nonce, skipping addresses that
+// already hold an account so a pool cannot be front-run by funding it first.
+func (k Keeper) createPool(ctx context.Context, sponsor sdk.AccAddress) (sdk.AccAddress, error) {
+	for nonce := uint64(0); ; nonce++ {
+		pool := sdk.AccAddress(address.Module(types.ModuleName, sponsor, sdk.Uint64ToBigEndian(nonce)))
+		if k.accountKeeper.GetAccount(ctx, pool) != nil {
+			continue
+		}
+
+		// a module account without permissions cannot sign, so only this
+		// keeper and the feegrant allowances it issues move the pool funds.
+		acc := k.accountKeeper.NewAccount(ctx, authtypes.NewModuleAccount(
+			&authtypes.BaseAccount{Address: pool.String()}, pool.String(),
+		))
+		k.accountKeeper.SetAccount(ctx, acc)
+
+		return pool, nil
+	}
+}
+
+// SetSponsor stores a sponsor and indexes its pool.
+func (k Keeper) SetSponsor(ctx context.Context, sponsor types.Sponsor) error {
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(sponsor.Address)
+	if err != nil {
+		return err
+	}
+	pool, err := k.accountKeeper.AddressCodec().StringToBytes(sponsor.PoolAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Sponsors.Set(ctx, addr, sponsor); err != nil {
+		return err
+	}
+	return k.PoolIndex.Set(ctx, pool, addr)
+}
+
+// FundPool moves amount from depositor into the pool of a sponsor.
+func (k Keeper) FundPool(ctx context.Context, depositor, sponsorAddr sdk.AccAddress, amount sdk.Coins) error {
+	sponsor, err := k.GetSponsor(ctx, sponsorAddr)
+	if err != nil {
+		return err
+	}
+	pool, err := k.accountKeeper.AddressCodec().StringToBytes(sponsor.PoolAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, depositor, pool, amount); err != nil {
+		return err
+	}
+
+	sponsor.TotalDeposited = sponsor.TotalDeposited.Add(amount...)
+	return k.Sponsors.Set(ctx, sponsorAddr, sponsor)
+}
+
+// WithdrawPool returns amount from the pool of a sponsor to the sponsor.
+func (k Keeper) WithdrawPool(ctx context.Context, sponsorAddr sdk.AccAddress, amount sdk.Coins) error {
+	sponsor, err := k.GetSponsor(ctx, sponsorAddr)
+	if err != nil {
+		return err
+	}
+	pool, err := k.accountKeeper.AddressCodec().StringToBytes(sponsor.PoolAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, pool, sponsorAddr, amount)
+}
+
+// Budget returns the remaining pool balance of a sponsor and how many more
+// allowances it backs at the current spend limit once the outstanding
+// allowances are reserved.
+func (k Keeper) Budget(ctx context.Context, sponsor types.Sponsor) (sdk.Coins, uint64, error) {
+	pool, err := k.accountKeeper.AddressCodec().StringToBytes(sponsor.PoolAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+	balance := k.bankKeeper.GetAllBalances(ctx, pool)
+	available, hasNeg := balance.SafeSub(sponsor.Outstanding...)
+	if hasNeg {
+		return balance, 0, nil
+	}
+
+	var grants uint64
+	for i, limit := range sponsor.SpendLimit {
+		n := available.AmountOf(limit.Denom).Quo(limit.Amount)
+		if !n.IsUint64() {
+			continue
+		}
+		if i == 0 || n.Uint64() < grants {
+			grants = n.Uint64()
+		}
+	}
+
+	return balance, grants, nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the sponsor messages on the amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterSponsor{}, "hippo/x/sponsor/MsgRegisterSponsor")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSponsor{}, "hippo/x/sponsor/MsgUpdateSponsor")
+	legacy.RegisterAminoMsg(cdc, &MsgFundPool{}, "hippo/x/sponsor/MsgFundPool")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawPool{}, "hippo/x/sponsor/MsgWithdrawPool")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/sponsor/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/sponsor/Params", nil)
+}
+
+// RegisterInterfaces registers the sponsor messages on the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterSponsor{},
+		&MsgUpdateSponsor{},
+		&MsgFundPool{},
+		&MsgWithdrawPool{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
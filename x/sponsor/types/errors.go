@@ -0,0 +1,17 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/sponsor module sentinel errors
+var (
+	ErrInvalidSponsor  = errorsmod.Register(ModuleName, 2, "invalid sponsor")
+	ErrSponsorNotFound = errorsmod.Register(ModuleName, 3, "sponsor not found")
+	ErrSponsorExists   = errorsmod.Register(ModuleName, 4, "sponsor already registered")
+	ErrSponsorInactive = errorsmod.Register(ModuleName, 5, "sponsor is inactive")
+	ErrNotEligible     = errorsmod.Register(ModuleName, 6, "account not eligible for sponsorship")
+	ErrRateLimited     = errorsmod.Register(ModuleName, 7, "sponsorship rate limit exceeded")
+	ErrBudgetExhausted = errorsmod.Register(ModuleName, 8, "sponsor budget exhausted")
+	ErrMsgNotAllowed   = errorsmod.Register(ModuleName, 9, "message not covered by sponsor")
+	ErrInvalidAmount   = errorsmod.Register(ModuleName, 10, "invalid amount")
+	ErrInvalidParams   = errorsmod.Register(ModuleName, 11, "invalid params")
+)
@@ -0,0 +1,19 @@
+package types
+
+// sponsor module event types and attributes
+const (
+	EventTypeRegisterSponsor = "register_sponsor"
+	EventTypeUpdateSponsor   = "update_sponsor"
+	EventTypeFundPool        = "fund_sponsor_pool"
+	EventTypeWithdrawPool    = "withdraw_sponsor_pool"
+	EventTypeGrantAllowance  = "sponsor_grant_allowance"
+
+	AttributeKeySponsor    = "sponsor"
+	AttributeKeyPool       = "pool"
+	AttributeKeyDepositor  = "depositor"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyAmount     = "amount"
+	AttributeKeySpendLimit = "spend_limit"
+	AttributeKeyExpiration = "expiration"
+	AttributeKeyActive     = "active"
+)
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns the default sponsor genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	sponsors := make(map[string]bool, len(gs.Sponsors))
+	pools := make(map[string]bool, len(gs.Sponsors))
+	for _, s := range gs.Sponsors {
+		if sponsors[s.Address] {
+			return fmt.Errorf("duplicate sponsor %s", s.Address)
+		}
+		sponsors[s.Address] = true
+		if pools[s.PoolAddress] {
+			return fmt.Errorf("duplicate pool address %s", s.PoolAddress)
+		}
+		pools[s.PoolAddress] = true
+		if err := s.Validate(ac); err != nil {
+			return fmt.Errorf("sponsor %s: %w", s.Address, err)
+		}
+	}
+	for _, s := range gs.Sponsors {
+		if pools[s.Address] {
+			return fmt.Errorf("sponsor %s is also a pool address", s.Address)
+		}
+	}
+
+	accounts := make(map[string]bool, len(gs.SponsoredAccounts))
+	for _, a := range gs.SponsoredAccounts {
+		if accounts[a.Address] {
+			return fmt.Errorf("duplicate sponsored account %s", a.Address)
+		}
+		accounts[a.Address] = true
+		if err := a.Validate(ac); err != nil {
+			return fmt.Errorf("sponsored account %s: %w", a.Address, err)
+		}
+	}
+
+	// the outstanding amount of a sponsor is what remains of its grants
+	grants := make(map[string]bool, len(gs.Grants))
+	outstanding := make(map[string]sdk.Coins, len(gs.Sponsors))
+	for _, g := range gs.Grants {
+		key := g.Sponsor + "/" + g.Grantee
+		if grants[key] {
+			return fmt.Errorf("duplicate grant of %s to %s", g.Sponsor, g.Grantee)
+		}
+		grants[key] = true
+		if err := g.Validate(ac); err != nil {
+			return fmt.Errorf("grant of %s to %s: %w", g.Sponsor, g.Grantee, err)
+		}
+		if !sponsors[g.Sponsor] {
+			return fmt.Errorf("grant of unknown sponsor %s", g.Sponsor)
+		}
+		outstanding[g.Sponsor] = outstanding[g.Sponsor].Add(g.Remaining...)
+	}
+	for _, s := range gs.Sponsors {
+		if !s.Outstanding.Equal(outstanding[s.Address]) {
+			return fmt.Errorf("outstanding %s of sponsor %s does not match its grants %s", s.Outstanding, s.Address, outstanding[s.Address])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	ac := address.NewBech32Codec("hippo")
+	sponsorAddr, err := ac.BytesToString([]byte("sponsor_____________"))
+	require.NoError(t, err)
+	pool, err := ac.BytesToString([]byte("pool________________"))
+	require.NoError(t, err)
+	user, err := ac.BytesToString([]byte("user________________"))
+	require.NoError(t, err)
+
+	sponsor := types.Sponsor{
+		Address:         sponsorAddr,
+		PoolAddress:     pool,
+		Name:            "hospital app",
+		SpendLimit:      sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(100))),
+		GrantPeriod:     time.Hour,
+		AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Active:          true,
+	}
+	account := types.SponsoredAccount{
+		Address:        user,
+		LastSponsor:    sponsorAddr,
+		GrantsInWindow: 1,
+		TotalGrants:    1,
+	}
+	grant := types.Grant{
+		Sponsor:    sponsorAddr,
+		Grantee:    user,
+		Remaining:  sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(60))),
+		Expiration: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	granting := sponsor
+	granting.Outstanding = grant.Remaining
+	withSponsor := func(malleate func(s *types.Sponsor)) types.GenesisState {
+		s := sponsor
+		malleate(&s)
+		return types.GenesisState{Params: types.DefaultParams(), Sponsors: []types.Sponsor{s}}
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", types.GenesisState{
+			Params:            types.DefaultParams(),
+			Sponsors:          []types.Sponsor{sponsor},
+			SponsoredAccounts: []types.SponsoredAccount{account},
+		}, ""},
+		{"duplicate sponsor", types.GenesisState{
+			Params:   types.DefaultParams(),
+			Sponsors: []types.Sponsor{sponsor, sponsor},
+		}, "duplicate sponsor"},
+		{"shared pool", types.GenesisState{
+			Params: types.DefaultParams(),
+			Sponsors: []types.Sponsor{sponsor, func() types.Sponsor {
+				s := sponsor
+				s.Address = user
+				return s
+			}()},
+		}, "duplicate pool"},
+		{"pool is a sponsor", types.GenesisState{
+			Params: types.DefaultParams(),
+			Sponsors: []types.Sponsor{sponsor, func() types.Sponsor {
+				s := sponsor
+				s.Address = pool
+				s.PoolAddress = user
+				return s
+			}()},
+		}, "also a pool"},
+		{"pool is the sponsor", withSponsor(func(s *types.Sponsor) { s.PoolAddress = sponsorAddr }), "cannot be the sponsor"},
+		{"long name", withSponsor(func(s *types.Sponsor) { s.Name = strings.Repeat("a", types.MaxNameLength+1) }), "name longer"},
+		{"empty spend limit", withSponsor(func(s *types.Sponsor) { s.SpendLimit = nil }), "spend limit"},
+		{"zero grant period", withSponsor(func(s *types.Sponsor) { s.GrantPeriod = 0 }), "grant period"},
+		{"no allowed messages", withSponsor(func(s *types.Sponsor) { s.AllowedMessages = nil }), "allowed messages"},
+		{"duplicate allowed message", withSponsor(func(s *types.Sponsor) {
+			s.AllowedMessages = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}
+		}), "duplicate allowed message"},
+		{"duplicate sponsored account", types.GenesisState{
+			Params:            types.DefaultParams(),
+			SponsoredAccounts: []types.SponsoredAccount{account, account},
+		}, "duplicate sponsored account"},
+		{"grants in window above total", types.GenesisState{
+			Params: types.DefaultParams(),
+			SponsoredAccounts: []types.SponsoredAccount{func() types.SponsoredAccount {
+				a := account
+				a.GrantsInWindow = 2
+				return a
+			}()},
+		}, "exceed total grants"},
+		{"grant", types.GenesisState{
+			Params:   types.DefaultParams(),
+			Sponsors: []types.Sponsor{granting},
+			Grants:   []types.Grant{grant},
+		}, ""},
+		{"duplicate grant", types.GenesisState{
+			Params:   types.DefaultParams(),
+			Sponsors: []types.Sponsor{granting},
+			Grants:   []types.Grant{grant, grant},
+		}, "duplicate grant"},
+		{"grant of unknown sponsor", types.GenesisState{
+			Params: types.DefaultParams(),
+			Grants: []types.Grant{grant},
+		}, "unknown sponsor"},
+		{"spent grant", types.GenesisState{
+			Params:   types.DefaultParams(),
+			Sponsors: []types.Sponsor{sponsor},
+			Grants: []types.Grant{func() types.Grant {
+				g := grant
+				g.Remaining = nil
+				return g
+			}()},
+		}, "invalid remaining"},
+		{"outstanding above grants", types.GenesisState{
+			Params:   types.DefaultParams(),
+			Sponsors: []types.Sponsor{granting},
+		}, "does not match"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(ac)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "sponsor"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey            = collections.NewPrefix(0)
+	SponsorsKey          = collections.NewPrefix(1)
+	PoolIndexKey         = collections.NewPrefix(2)
+	SponsoredAccountsKey = collections.NewPrefix(3)
+	GrantsKey            = collections.NewPrefix(4)
+	GrantQueueKey        = collections.NewPrefix(5)
+)
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+)
+
+const (
+	// DefaultMaxGrantsPerAddress lets an address be onboarded once per window.
+	DefaultMaxGrantsPerAddress uint32 = 1
+	// DefaultRateLimitWindow is the default window the per address grant
+	// count is tracked over.
+	DefaultRateLimitWindow = 30 * 24 * time.Hour
+	// DefaultMaxGrantPeriod is the default upper bound of an allowance
+	// lifetime.
+	DefaultMaxGrantPeriod = 90 * 24 * time.Hour
+	// DefaultMaxAllowedMessages is the default upper bound of the message
+	// types a sponsor may cover.
+	DefaultMaxAllowedMessages uint32 = 16
+	// DefaultMaxGrantsPerSponsor lets a sponsor onboard 10,000 accounts per
+	// window.
+	DefaultMaxGrantsPerSponsor uint32 = 10_000
+)
+
+// DefaultMaxSpendLimit caps a single allowance at 10 HP by default.
+var DefaultMaxSpendLimit = sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewIntWithDecimal(10, int(consensus.DefaultHippoPrecision))))
+
+// NewParams creates a new Params instance.
+func NewParams(
+	maxGrantsPerAddress uint32,
+	rateLimitWindow time.Duration,
+	maxSpendLimit sdk.Coins,
+	maxGrantPeriod time.Duration,
+	maxAllowedMessages uint32,
+	maxGrantsPerSponsor uint32,
+) Params {
+	return Params{
+		MaxGrantsPerAddress: maxGrantsPerAddress,
+		RateLimitWindow:     rateLimitWindow,
+		MaxSpendLimit:       maxSpendLimit,
+		MaxGrantPeriod:      maxGrantPeriod,
+		MaxAllowedMessages:  maxAllowedMessages,
+		MaxGrantsPerSponsor: maxGrantsPerSponsor,
+	}
+}
+
+// DefaultParams returns the default sponsor parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxGrantsPerAddress,
+		DefaultRateLimitWindow,
+		DefaultMaxSpendLimit,
+		DefaultMaxGrantPeriod,
+		DefaultMaxAllowedMessages,
+		DefaultMaxGrantsPerSponsor,
+	)
+}
+
+// Validate performs basic validation of the sponsor parameters.
+func (p Params) Validate() error {
+	if p.MaxGrantsPerAddress == 0 {
+		return fmt.Errorf("max grants per address must be positive")
+	}
+	if p.RateLimitWindow <= 0 {
+		return fmt.Errorf("rate limit window must be positive: %s", p.RateLimitWindow)
+	}
+	if p.MaxSpendLimit.Empty() || !p.MaxSpendLimit.IsValid() {
+		return fmt.Errorf("invalid max spend limit: %s", p.MaxSpendLimit)
+	}
+	if p.MaxGrantPeriod <= 0 {
+		return fmt.Errorf("max grant period must be positive: %s", p.MaxGrantPeriod)
+	}
+	if p.MaxAllowedMessages == 0 {
+		return fmt.Errorf("max allowed messages must be positive")
+	}
+	if p.MaxGrantsPerSponsor == 0 {
+		return fmt.Errorf("max grants per sponsor must be positive")
+	}
+	return nil
+}
+
+// CheckTerms reports whether sponsor terms stay within the parameter bounds.
+// Terms are checked when set and again before every grant, so sponsors
+// registered under looser parameters stop granting until updated.
+func (p Params) CheckTerms(spendLimit sdk.Coins, grantPeriod time.Duration, allowedMessages []string) error {
+	if !spendLimit.IsAllLTE(p.MaxSpendLimit) {
+		return fmt.Errorf("spend limit %s exceeds %s", spendLimit, p.MaxSpendLimit)
+	}
+	if grantPeriod > p.MaxGrantPeriod {
+		return fmt.Errorf("grant period %s exceeds %s", grantPeriod, p.MaxGrantPeriod)
+	}
+	if len(allowedMessages) > int(p.MaxAllowedMessages) {
+		return fmt.Errorf("%d allowed messages exceed %d", len(allowedMessages), p.MaxAllowedMessages)
+	}
+	return nil
+}
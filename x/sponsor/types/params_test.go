@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/sponsor/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.MaxGrantsPerAddress = 0
+	require.Error(t, params.Validate(), "zero grants per address should be rejected")
+
+	params = types.DefaultParams()
+	params.RateLimitWindow = 0
+	require.Error(t, params.Validate(), "zero rate limit window should be rejected")
+
+	params = types.DefaultParams()
+	params.MaxSpendLimit = nil
+	require.Error(t, params.Validate(), "empty max spend limit should be rejected")
+
+	params = types.DefaultParams()
+	params.MaxGrantPeriod = -time.Hour
+	require.Error(t, params.Validate(), "negative max grant period should be rejected")
+
+	params = types.DefaultParams()
+	params.MaxAllowedMessages = 0
+	require.Error(t, params.Validate(), "zero allowed messages should be rejected")
+
+	params = types.DefaultParams()
+	params.MaxGrantsPerSponsor = 0
+	require.Error(t, params.Validate(), "zero grants per sponsor should be rejected")
+}
+
+func TestParamsCheckTerms(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxAllowedMessages = 1
+	limit := sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(1)))
+
+	require.NoError(t, params.CheckTerms(limit, time.Hour, []string{"/a"}))
+	require.Error(t, params.CheckTerms(params.MaxSpendLimit.Add(limit...), time.Hour, []string{"/a"}))
+	require.Error(t, params.CheckTerms(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1))), time.Hour, []string{"/a"}),
+		"denoms without a max spend limit should be rejected")
+	require.Error(t, params.CheckTerms(limit, params.MaxGrantPeriod+1, []string{"/a"}))
+	require.Error(t, params.CheckTerms(limit, time.Hour, []string{"/a", "/b"}))
+}
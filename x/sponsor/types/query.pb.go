@@ -0,0 +1,2373 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/sponsor/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QuerySponsorRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySponsorRequest) Reset()         { *m = QuerySponsorRequest{} }
+func (m *QuerySponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorRequest) ProtoMessage()    {}
+func (*QuerySponsorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{2}
+}
+func (m *QuerySponsorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorRequest.Merge(m, src)
+}
+func (m *QuerySponsorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QuerySponsorResponse struct {
+	Sponsor Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor"`
+}
+
+func (m *QuerySponsorResponse) Reset()         { *m = QuerySponsorResponse{} }
+func (m *QuerySponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorResponse) ProtoMessage()    {}
+func (*QuerySponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{3}
+}
+func (m *QuerySponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorResponse.Merge(m, src)
+}
+func (m *QuerySponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorResponse) GetSponsor() Sponsor {
+	if m != nil {
+		return m.Sponsor
+	}
+	return Sponsor{}
+}
+
+type QuerySponsorsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorsRequest) Reset()         { *m = QuerySponsorsRequest{} }
+func (m *QuerySponsorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorsRequest) ProtoMessage()    {}
+func (*QuerySponsorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{4}
+}
+func (m *QuerySponsorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorsRequest.Merge(m, src)
+}
+func (m *QuerySponsorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorsRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySponsorsResponse struct {
+	Sponsors   []Sponsor           `protobuf:"bytes,1,rep,name=sponsors,proto3" json:"sponsors"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorsResponse) Reset()         { *m = QuerySponsorsResponse{} }
+func (m *QuerySponsorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorsResponse) ProtoMessage()    {}
+func (*QuerySponsorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{5}
+}
+func (m *QuerySponsorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorsResponse.Merge(m, src)
+}
+func (m *QuerySponsorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorsResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorsResponse) GetSponsors() []Sponsor {
+	if m != nil {
+		return m.Sponsors
+	}
+	return nil
+}
+
+func (m *QuerySponsorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySponsorBudgetRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySponsorBudgetRequest) Reset()         { *m = QuerySponsorBudgetRequest{} }
+func (m *QuerySponsorBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorBudgetRequest) ProtoMessage()    {}
+func (*QuerySponsorBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{6}
+}
+func (m *QuerySponsorBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorBudgetRequest.Merge(m, src)
+}
+func (m *QuerySponsorBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorBudgetRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorBudgetRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QuerySponsorBudgetResponse struct {
+	// remaining is the current pool balance.
+	Remaining      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	TotalDeposited github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_deposited,json=totalDeposited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposited"`
+	GrantsIssued   uint64                                   `protobuf:"varint,3,opt,name=grants_issued,json=grantsIssued,proto3" json:"grants_issued,omitempty"`
+	// grants_remaining is how many more allowances the remaining budget backs
+	// at the current spend limit, once outstanding is reserved.
+	GrantsRemaining uint64 `protobuf:"varint,4,opt,name=grants_remaining,json=grantsRemaining,proto3" json:"grants_remaining,omitempty"`
+	// outstanding is the part of the pool balance reserved by the allowances
+	// issued that are neither spent nor expired.
+	Outstanding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=outstanding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"outstanding"`
+}
+
+func (m *QuerySponsorBudgetResponse) Reset()         { *m = QuerySponsorBudgetResponse{} }
+func (m *QuerySponsorBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorBudgetResponse) ProtoMessage()    {}
+func (*QuerySponsorBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{7}
+}
+func (m *QuerySponsorBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorBudgetResponse.Merge(m, src)
+}
+func (m *QuerySponsorBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorBudgetResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorBudgetResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *QuerySponsorBudgetResponse) GetTotalDeposited() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalDeposited
+	}
+	return nil
+}
+
+func (m *QuerySponsorBudgetResponse) GetGrantsIssued() uint64 {
+	if m != nil {
+		return m.GrantsIssued
+	}
+	return 0
+}
+
+func (m *QuerySponsorBudgetResponse) GetGrantsRemaining() uint64 {
+	if m != nil {
+		return m.GrantsRemaining
+	}
+	return 0
+}
+
+func (m *QuerySponsorBudgetResponse) GetOutstanding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Outstanding
+	}
+	return nil
+}
+
+type QuerySponsoredAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySponsoredAccountRequest) Reset()         { *m = QuerySponsoredAccountRequest{} }
+func (m *QuerySponsoredAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsoredAccountRequest) ProtoMessage()    {}
+func (*QuerySponsoredAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{8}
+}
+func (m *QuerySponsoredAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsoredAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsoredAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsoredAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsoredAccountRequest.Merge(m, src)
+}
+func (m *QuerySponsoredAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsoredAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsoredAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsoredAccountRequest proto.InternalMessageInfo
+
+func (m *QuerySponsoredAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QuerySponsoredAccountResponse struct {
+	Account SponsoredAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+}
+
+func (m *QuerySponsoredAccountResponse) Reset()         { *m = QuerySponsoredAccountResponse{} }
+func (m *QuerySponsoredAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsoredAccountResponse) ProtoMessage()    {}
+func (*QuerySponsoredAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_992dedee9027fbe8, []int{9}
+}
+func (m *QuerySponsoredAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsoredAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsoredAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsoredAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsoredAccountResponse.Merge(m, src)
+}
+func (m *QuerySponsoredAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsoredAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsoredAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsoredAccountResponse proto.InternalMessageInfo
+
+func (m *QuerySponsoredAccountResponse) GetAccount() SponsoredAccount {
+	if m != nil {
+		return m.Account
+	}
+	return SponsoredAccount{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "hippo.sponsor.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hippo.sponsor.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySponsorRequest)(nil), "hippo.sponsor.v1.QuerySponsorRequest")
+	proto.RegisterType((*QuerySponsorResponse)(nil), "hippo.sponsor.v1.QuerySponsorResponse")
+	proto.RegisterType((*QuerySponsorsRequest)(nil), "hippo.sponsor.v1.QuerySponsorsRequest")
+	proto.RegisterType((*QuerySponsorsResponse)(nil), "hippo.sponsor.v1.QuerySponsorsResponse")
+	proto.RegisterType((*QuerySponsorBudgetRequest)(nil), "hippo.sponsor.v1.QuerySponsorBudgetRequest")
+	proto.RegisterType((*QuerySponsorBudgetResponse)(nil), "hippo.sponsor.v1.QuerySponsorBudgetResponse")
+	proto.RegisterType((*QuerySponsoredAccountRequest)(nil), "hippo.sponsor.v1.QuerySponsoredAccountRequest")
+	proto.RegisterType((*QuerySponsoredAccountResponse)(nil), "hippo.sponsor.v1.QuerySponsoredAccountResponse")
+}
+
+func init() { proto.RegisterFile("hippo/sponsor/v1/query.proto", fileDescriptor_992dedee9027fbe8) }
+
+var fileDescriptor_992dedee9027fbe8 = []byte{
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0xf2, 0x6f, 0x61, 0xf8, 0xf1, 0x03, 0x47, 0x4c, 0x96, 0x66, 0x2d, 0xa4, 0x22, 0x20,
+	0xb0, 0x9d, 0x2c, 0x84, 0x68, 0x62, 0x62, 0x74, 0x35, 0x12, 0x6f, 0x50, 0x13, 0x0f, 0x1e, 0x24,
+	0xb3, 0xed, 0xa4, 0x34, 0xb2, 0x9d, 0xd2, 0x99, 0xa2, 0xc4, 0x18, 0x13, 0x0f, 0x9e, 0x4d, 0xfc,
+	0x00, 0x46, 0x4f, 0xc6, 0x93, 0x1f, 0x83, 0xc4, 0x0b, 0x89, 0x17, 0x4f, 0x6a, 0xc0, 0xc4, 0xaf,
+	0x61, 0x76, 0x66, 0xba, 0xdb, 0xee, 0xba, 0x74, 0x2f, 0x5c, 0x76, 0xdb, 0x77, 0x9e, 0xe7, 0x7d,
+	0x9e, 0x77, 0xde, 0x99, 0xb7, 0xa0, 0xbc, 0xeb, 0x87, 0x21, 0x45, 0x2c, 0xa4, 0x01, 0xa3, 0x11,
+	0x3a, 0xa8, 0xa2, 0xfd, 0x98, 0x44, 0x87, 0x56, 0x18, 0x51, 0x4e, 0xe1, 0x94, 0x58, 0xb5, 0xd4,
+	0xaa, 0x75, 0x50, 0xd5, 0x2f, 0xe0, 0x86, 0x1f, 0x50, 0x24, 0x7e, 0x25, 0x48, 0x5f, 0x76, 0x28,
+	0x6b, 0x50, 0x86, 0xea, 0x98, 0x11, 0xc9, 0x46, 0x07, 0xd5, 0x3a, 0xe1, 0xb8, 0x8a, 0x42, 0xec,
+	0xf9, 0x01, 0xe6, 0x3e, 0x0d, 0x14, 0xd6, 0x48, 0x63, 0x13, 0x94, 0x43, 0xfd, 0x64, 0x7d, 0xda,
+	0xa3, 0x1e, 0x15, 0x8f, 0xa8, 0xf9, 0xa4, 0xa2, 0x65, 0x8f, 0x52, 0x6f, 0x8f, 0x20, 0x1c, 0xfa,
+	0x08, 0x07, 0x01, 0xe5, 0x22, 0x25, 0x4b, 0x72, 0x76, 0x95, 0x90, 0xf8, 0x15, 0xeb, 0xe6, 0x34,
+	0x80, 0xdb, 0x4d, 0x57, 0x5b, 0x38, 0xc2, 0x0d, 0x66, 0x93, 0xfd, 0x98, 0x30, 0x6e, 0xda, 0xe0,
+	0x62, 0x26, 0x2a, 0x38, 0x04, 0xde, 0x04, 0x23, 0xa1, 0x88, 0x94, 0xb4, 0x39, 0x6d, 0x69, 0x7c,
+	0xad, 0x64, 0x75, 0x6e, 0x81, 0x25, 0x19, 0xb5, 0xb1, 0xa3, 0x1f, 0xb3, 0x85, 0x4f, 0x7f, 0xbe,
+	0x2c, 0x6b, 0xb6, 0xa2, 0x98, 0x48, 0xe5, 0x7c, 0x28, 0xc1, 0x4a, 0x0a, 0x96, 0x40, 0x11, 0xbb,
+	0x6e, 0x44, 0x98, 0x4c, 0x3a, 0x66, 0x27, 0xaf, 0xe6, 0x23, 0x30, 0x9d, 0x25, 0x28, 0x17, 0xb7,
+	0x40, 0x51, 0x09, 0x2a, 0x1b, 0x33, 0xdd, 0x36, 0x14, 0x27, 0xed, 0x23, 0x21, 0x99, 0x4f, 0xb2,
+	0x79, 0x93, 0xa2, 0xe1, 0x7d, 0x00, 0xda, 0x2d, 0x51, 0xa9, 0x17, 0x2c, 0xd9, 0x13, 0xab, 0xd9,
+	0x13, 0x4b, 0x76, 0x5f, 0x75, 0xc6, 0xda, 0xc2, 0x1e, 0x51, 0x5c, 0x3b, 0xc5, 0x34, 0x3f, 0x6a,
+	0xe0, 0x52, 0x87, 0x80, 0x72, 0x7e, 0x1b, 0x8c, 0x2a, 0x13, 0xcd, 0x62, 0x07, 0xfb, 0xb6, 0xde,
+	0x62, 0xc1, 0xcd, 0x8c, 0xc7, 0x01, 0xe1, 0x71, 0x31, 0xd7, 0xa3, 0x94, 0xcf, 0x98, 0xdc, 0x00,
+	0x33, 0x69, 0x8f, 0xb5, 0xd8, 0xf5, 0x08, 0xcf, 0xef, 0xc9, 0xd7, 0x41, 0xa0, 0xff, 0x8b, 0xa7,
+	0x0a, 0x0c, 0xc0, 0x58, 0x44, 0x1a, 0xd8, 0x0f, 0xfc, 0xc0, 0x6b, 0x55, 0x98, 0x76, 0x97, 0xf8,
+	0xba, 0x4b, 0xfd, 0xa0, 0xb6, 0xd1, 0xac, 0xf0, 0xf3, 0xcf, 0xd9, 0x25, 0xcf, 0xe7, 0xbb, 0x71,
+	0xdd, 0x72, 0x68, 0x03, 0xa9, 0x2b, 0x20, 0xff, 0x2a, 0xcc, 0x7d, 0x8a, 0xf8, 0x61, 0x48, 0x98,
+	0x20, 0x30, 0xb9, 0x1b, 0x6d, 0x09, 0x78, 0x08, 0x26, 0x39, 0xe5, 0x78, 0x6f, 0xc7, 0x25, 0x21,
+	0x65, 0x3e, 0x27, 0x6e, 0x69, 0xe0, 0x9c, 0x54, 0xff, 0x17, 0x42, 0xf7, 0x12, 0x1d, 0x78, 0x05,
+	0x4c, 0x78, 0x11, 0x0e, 0x38, 0xdb, 0xf1, 0x19, 0x8b, 0x89, 0x5b, 0x1a, 0x9c, 0xd3, 0x96, 0x86,
+	0xec, 0xff, 0x64, 0xf0, 0x81, 0x88, 0xc1, 0x6b, 0x60, 0x4a, 0x81, 0xda, 0xdb, 0x32, 0x24, 0x70,
+	0x93, 0x32, 0x6e, 0xb7, 0x4a, 0x89, 0xc0, 0x38, 0x8d, 0x39, 0xe3, 0x38, 0x70, 0x9b, 0xa8, 0xe1,
+	0x73, 0x2a, 0x23, 0x2d, 0x62, 0xde, 0x00, 0xe5, 0x74, 0x33, 0x89, 0x7b, 0xc7, 0x71, 0x68, 0x1c,
+	0xf4, 0x71, 0x0e, 0x76, 0xc1, 0xe5, 0x1e, 0x4c, 0x75, 0x12, 0x36, 0x41, 0x11, 0xcb, 0x90, 0xba,
+	0x49, 0x66, 0xcf, 0x93, 0xde, 0x22, 0x67, 0x6e, 0xab, 0x62, 0xaf, 0x1d, 0x0f, 0x83, 0x61, 0x21,
+	0x05, 0x9f, 0x81, 0x11, 0x39, 0x5d, 0xe0, 0x7c, 0x77, 0xae, 0xee, 0x21, 0xa6, 0x5f, 0xcd, 0x41,
+	0x49, 0xa7, 0xe6, 0xdc, 0xeb, 0x6f, 0xbf, 0xdf, 0x0d, 0xe8, 0xb0, 0x84, 0xba, 0x46, 0xa5, 0x9c,
+	0x5c, 0xf0, 0x8d, 0x06, 0x8a, 0xca, 0x2b, 0xec, 0x95, 0x34, 0x3b, 0xd5, 0xf4, 0x85, 0x3c, 0x98,
+	0x12, 0x5f, 0x15, 0xe2, 0x0b, 0x70, 0x1e, 0xf5, 0x9a, 0xd3, 0x0c, 0xbd, 0x50, 0x9b, 0xfe, 0x12,
+	0xbe, 0x02, 0xa3, 0xc9, 0x4c, 0x81, 0x39, 0x0a, 0xad, 0x5d, 0x58, 0xcc, 0xc5, 0x29, 0x2b, 0xa6,
+	0xb0, 0x52, 0x86, 0x7a, 0x6f, 0x2b, 0xf0, 0xbd, 0x06, 0x26, 0x32, 0x37, 0x1f, 0xae, 0x9c, 0x9d,
+	0x3e, 0x33, 0x57, 0xf4, 0xd5, 0xfe, 0xc0, 0xca, 0xd0, 0xba, 0x30, 0x54, 0x81, 0x2b, 0xfd, 0xec,
+	0x0d, 0xaa, 0x4b, 0x3f, 0x1f, 0x34, 0x30, 0xd5, 0x79, 0xae, 0xa0, 0x75, 0xb6, 0x6e, 0xe7, 0xb9,
+	0xd7, 0x51, 0xdf, 0xf8, 0xfc, 0x36, 0xaa, 0x73, 0x9c, 0xb2, 0x5a, 0xdb, 0x3e, 0x3a, 0x31, 0xb4,
+	0xe3, 0x13, 0x43, 0xfb, 0x75, 0x62, 0x68, 0x6f, 0x4f, 0x8d, 0xc2, 0xf1, 0xa9, 0x51, 0xf8, 0x7e,
+	0x6a, 0x14, 0x1e, 0x5f, 0x4f, 0x5d, 0x66, 0x91, 0xc9, 0x89, 0x30, 0xaf, 0xb8, 0x98, 0xca, 0xb7,
+	0x8a, 0xf8, 0x66, 0x3b, 0x74, 0x0f, 0x3d, 0x6f, 0x49, 0x88, 0x1b, 0x5e, 0x1f, 0x11, 0x2b, 0xeb,
+	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x35, 0x90, 0xda, 0xb2, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Sponsor returns a sponsor by address.
+	Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error)
+	// Sponsors returns all sponsors.
+	Sponsors(ctx context.Context, in *QuerySponsorsRequest, opts ...grpc.CallOption) (*QuerySponsorsResponse, error)
+	// SponsorBudget returns the remaining budget of a sponsor.
+	SponsorBudget(ctx context.Context, in *QuerySponsorBudgetRequest, opts ...grpc.CallOption) (*QuerySponsorBudgetResponse, error)
+	// SponsoredAccount returns the sponsorship history of an address.
+	SponsoredAccount(ctx context.Context, in *QuerySponsoredAccountRequest, opts ...grpc.CallOption) (*QuerySponsoredAccountResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hippo.sponsor.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error) {
+	out := new(QuerySponsorResponse)
+	err := c.cc.Invoke(ctx, "/hippo.sponsor.v1.Query/Sponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsors(ctx context.Context, in *QuerySponsorsRequest, opts ...grpc.CallOption) (*QuerySponsorsResponse, error) {
+	out := new(QuerySponsorsResponse)
+	err := c.cc.Invoke(ctx, "/hippo.sponsor.v1.Query/Sponsors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorBudget(ctx context.Context, in *QuerySponsorBudgetRequest, opts ...grpc.CallOption) (*QuerySponsorBudgetResponse, error) {
+	out := new(QuerySponsorBudgetResponse)
+	err := c.cc.Invoke(ctx, "/hippo.sponsor.v1.Query/SponsorBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsoredAccount(ctx context.Context, in *QuerySponsoredAccountRequest, opts ...grpc.CallOption) (*QuerySponsoredAccountResponse, error) {
+	out := new(QuerySponsoredAccountResponse)
+	err := c.cc.Invoke(ctx, "/hippo.sponsor.v1.Query/SponsoredAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Sponsor returns a sponsor by address.
+	Sponsor(context.Context, *QuerySponsorRequest) (*QuerySponsorResponse, error)
+	// Sponsors returns all sponsors.
+	Sponsors(context.Context, *QuerySponsorsRequest) (*QuerySponsorsResponse, error)
+	// SponsorBudget returns the remaining budget of a sponsor.
+	SponsorBudget(context.Context, *QuerySponsorBudgetRequest) (*QuerySponsorBudgetResponse, error)
+	// SponsoredAccount returns the sponsorship history of an address.
+	SponsoredAccount(context.Context, *QuerySponsoredAccountRequest) (*QuerySponsoredAccountResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Sponsor(ctx context.Context, req *QuerySponsorRequest) (*QuerySponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsor not implemented")
+}
+func (*UnimplementedQueryServer) Sponsors(ctx context.Context, req *QuerySponsorsRequest) (*QuerySponsorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsors not implemented")
+}
+func (*UnimplementedQueryServer) SponsorBudget(ctx context.Context, req *QuerySponsorBudgetRequest) (*QuerySponsorBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorBudget not implemented")
+}
+func (*UnimplementedQueryServer) SponsoredAccount(ctx context.Context, req *QuerySponsoredAccountRequest) (*QuerySponsoredAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsoredAccount not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.sponsor.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.sponsor.v1.Query/Sponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsor(ctx, req.(*QuerySponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.sponsor.v1.Query/Sponsors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsors(ctx, req.(*QuerySponsorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.sponsor.v1.Query/SponsorBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorBudget(ctx, req.(*QuerySponsorBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsoredAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsoredAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsoredAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hippo.sponsor.v1.Query/SponsoredAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsoredAccount(ctx, req.(*QuerySponsoredAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hippo.sponsor.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Sponsor",
+			Handler:    _Query_Sponsor_Handler,
+		},
+		{
+			MethodName: "Sponsors",
+			Handler:    _Query_Sponsors_Handler,
+		},
+		{
+			MethodName: "SponsorBudget",
+			Handler:    _Query_SponsorBudget_Handler,
+		},
+		{
+			MethodName: "SponsoredAccount",
+			Handler:    _Query_SponsoredAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hippo/sponsor/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Sponsor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsors) > 0 {
+		for iNdEx := len(m.Sponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outstanding) > 0 {
+		for iNdEx := len(m.Outstanding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outstanding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GrantsRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GrantsRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GrantsIssued != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GrantsIssued))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalDeposited) > 0 {
+		for iNdEx := len(m.TotalDeposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsoredAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsoredAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsoredAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsoredAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsoredAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsoredAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sponsors) > 0 {
+		for _, e := range m.Sponsors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalDeposited) > 0 {
+		for _, e := range m.TotalDeposited {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GrantsIssued != 0 {
+		n += 1 + sovQuery(uint64(m.GrantsIssued))
+	}
+	if m.GrantsRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.GrantsRemaining))
+	}
+	if len(m.Outstanding) > 0 {
+		for _, e := range m.Outstanding {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySponsoredAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsoredAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsors = append(m.Sponsors, Sponsor{})
+			if err := m.Sponsors[len(m.Sponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposited = append(m.TotalDeposited, types.Coin{})
+			if err := m.TotalDeposited[len(m.TotalDeposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantsIssued", wireType)
+			}
+			m.GrantsIssued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantsIssued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantsRemaining", wireType)
+			}
+			m.GrantsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantsRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outstanding = append(m.Outstanding, types.Coin{})
+			if err := m.Outstanding[len(m.Outstanding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsoredAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsoredAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsoredAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsoredAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsoredAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsoredAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
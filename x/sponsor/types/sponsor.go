@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNameLength bounds the display name of a sponsor.
+const MaxNameLength = 64
+
+// ValidateTerms performs stateless validation of the allowance terms a
+// sponsor grants to each account.
+func ValidateTerms(spendLimit sdk.Coins, grantPeriod time.Duration, allowedMessages []string) error {
+	if spendLimit.Empty() || !spendLimit.IsValid() {
+		return fmt.Errorf("invalid spend limit: %s", spendLimit)
+	}
+	if grantPeriod <= 0 {
+		return fmt.Errorf("grant period must be positive: %s", grantPeriod)
+	}
+	if len(allowedMessages) == 0 {
+		return fmt.Errorf("allowed messages cannot be empty")
+	}
+	seen := make(map[string]bool, len(allowedMessages))
+	for _, msg := range allowedMessages {
+		if !strings.HasPrefix(msg, "/") || len(msg) == 1 {
+			return fmt.Errorf("invalid message type url %q", msg)
+		}
+		if seen[msg] {
+			return fmt.Errorf("duplicate allowed message %s", msg)
+		}
+		seen[msg] = true
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a sponsor.
+func (s Sponsor) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(s.Address); err != nil {
+		return fmt.Errorf("invalid sponsor address: %w", err)
+	}
+	if _, err := ac.StringToBytes(s.PoolAddress); err != nil {
+		return fmt.Errorf("invalid pool address: %w", err)
+	}
+	if s.Address == s.PoolAddress {
+		return fmt.Errorf("pool address cannot be the sponsor address")
+	}
+	if len(s.Name) > MaxNameLength {
+		return fmt.Errorf("name longer than %d characters", MaxNameLength)
+	}
+	if !s.TotalDeposited.IsValid() {
+		return fmt.Errorf("invalid total deposited: %s", s.TotalDeposited)
+	}
+	if !s.Outstanding.IsValid() {
+		return fmt.Errorf("invalid outstanding: %s", s.Outstanding)
+	}
+	return ValidateTerms(s.SpendLimit, s.GrantPeriod, s.AllowedMessages)
+}
+
+// Allows reports whether every message type url is covered by the sponsor.
+func (s Sponsor) Allows(msgTypeURLs []string) bool {
+	for _, url := range msgTypeURLs {
+		allowed := false
+		for _, msg := range s.AllowedMessages {
+			if msg == url {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate performs stateless validation of a grant.
+func (g Grant) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(g.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsor address: %w", err)
+	}
+	if _, err := ac.StringToBytes(g.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee address: %w", err)
+	}
+	if g.Remaining.Empty() || !g.Remaining.IsValid() {
+		return fmt.Errorf("invalid remaining: %s", g.Remaining)
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a sponsored account.
+func (a SponsoredAccount) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(a.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if _, err := ac.StringToBytes(a.LastSponsor); err != nil {
+		return fmt.Errorf("invalid last sponsor address: %w", err)
+	}
+	if uint64(a.GrantsInWindow) > a.TotalGrants {
+		return fmt.Errorf("grants in window %d exceed total grants %d", a.GrantsInWindow, a.TotalGrants)
+	}
+	return nil
+}
@@ -0,0 +1,2016 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/sponsor/v1/sponsor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the sponsor module.
+type Params struct {
+	// max_grants_per_address bounds how many sponsored allowances a single
+	// address receives within rate_limit_window, across all sponsors. Only
+	// addresses that have not sent a transaction are granted, and the grant is
+	// issued in the ante handler of their first one, so it stops an address
+	// from collecting the allowances of several sponsors. It does not stop one
+	// party from creating new addresses, which max_grants_per_sponsor and the
+	// pool budget bound.
+	MaxGrantsPerAddress uint32        `protobuf:"varint,1,opt,name=max_grants_per_address,json=maxGrantsPerAddress,proto3" json:"max_grants_per_address,omitempty"`
+	RateLimitWindow     time.Duration `protobuf:"bytes,2,opt,name=rate_limit_window,json=rateLimitWindow,proto3,stdduration" json:"rate_limit_window"`
+	// max_spend_limit caps the allowance a sponsor may grant to one account.
+	MaxSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_spend_limit,json=maxSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_spend_limit"`
+	// max_grant_period caps how long a sponsored allowance stays valid.
+	MaxGrantPeriod time.Duration `protobuf:"bytes,4,opt,name=max_grant_period,json=maxGrantPeriod,proto3,stdduration" json:"max_grant_period"`
+	// max_allowed_messages bounds the message types a sponsor may cover.
+	MaxAllowedMessages uint32 `protobuf:"varint,5,opt,name=max_allowed_messages,json=maxAllowedMessages,proto3" json:"max_allowed_messages,omitempty"`
+	// max_grants_per_sponsor bounds how many allowances a single sponsor
+	// issues within rate_limit_window.
+	MaxGrantsPerSponsor uint32 `protobuf:"varint,6,opt,name=max_grants_per_sponsor,json=maxGrantsPerSponsor,proto3" json:"max_grants_per_sponsor,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_583f8b0e575578f8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxGrantsPerAddress() uint32 {
+	if m != nil {
+		return m.MaxGrantsPerAddress
+	}
+	return 0
+}
+
+func (m *Params) GetRateLimitWindow() time.Duration {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxSpendLimit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGrantPeriod() time.Duration {
+	if m != nil {
+		return m.MaxGrantPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAllowedMessages() uint32 {
+	if m != nil {
+		return m.MaxAllowedMessages
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGrantsPerSponsor() uint32 {
+	if m != nil {
+		return m.MaxGrantsPerSponsor
+	}
+	return 0
+}
+
+// Sponsor is an app or institution paying the first fees of the accounts it
+// onboards.
+type Sponsor struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pool_address holds the sponsor budget and is the granter of every
+	// allowance the sponsor issues.
+	PoolAddress string `protobuf:"bytes,2,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// spend_limit is the allowance granted to each new account.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// grant_period is how long each allowance stays valid.
+	GrantPeriod time.Duration `protobuf:"bytes,5,opt,name=grant_period,json=grantPeriod,proto3,stdduration" json:"grant_period"`
+	// allowed_messages are the message type URLs the allowances cover.
+	AllowedMessages []string                                 `protobuf:"bytes,6,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	Active          bool                                     `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	TotalDeposited  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=total_deposited,json=totalDeposited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposited"`
+	GrantsIssued    uint64                                   `protobuf:"varint,9,opt,name=grants_issued,json=grantsIssued,proto3" json:"grants_issued,omitempty"`
+	// outstanding is the part of the pool balance reserved by the allowances
+	// issued that are neither spent nor expired.
+	Outstanding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=outstanding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"outstanding"`
+	// window_start and grants_in_window track the allowances issued within
+	// the rate limit window.
+	WindowStart    time.Time `protobuf:"bytes,11,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	GrantsInWindow uint32    `protobuf:"varint,12,opt,name=grants_in_window,json=grantsInWindow,proto3" json:"grants_in_window,omitempty"`
+}
+
+func (m *Sponsor) Reset()         { *m = Sponsor{} }
+func (m *Sponsor) String() string { return proto.CompactTextString(m) }
+func (*Sponsor) ProtoMessage()    {}
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_583f8b0e575578f8, []int{1}
+}
+func (m *Sponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsor.Merge(m, src)
+}
+func (m *Sponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsor proto.InternalMessageInfo
+
+func (m *Sponsor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Sponsor) GetPoolAddress() string {
+	if m != nil {
+		return m.PoolAddress
+	}
+	return ""
+}
+
+func (m *Sponsor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Sponsor) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *Sponsor) GetGrantPeriod() time.Duration {
+	if m != nil {
+		return m.GrantPeriod
+	}
+	return 0
+}
+
+func (m *Sponsor) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *Sponsor) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Sponsor) GetTotalDeposited() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalDeposited
+	}
+	return nil
+}
+
+func (m *Sponsor) GetGrantsIssued() uint64 {
+	if m != nil {
+		return m.GrantsIssued
+	}
+	return 0
+}
+
+func (m *Sponsor) GetOutstanding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Outstanding
+	}
+	return nil
+}
+
+func (m *Sponsor) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *Sponsor) GetGrantsInWindow() uint32 {
+	if m != nil {
+		return m.GrantsInWindow
+	}
+	return 0
+}
+
+// Grant is an allowance issued by a sponsor that is neither spent nor
+// expired.
+type Grant struct {
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// remaining is the part of the spend limit the grantee has not spent.
+	Remaining  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	Expiration time.Time                                `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *Grant) Reset()         { *m = Grant{} }
+func (m *Grant) String() string { return proto.CompactTextString(m) }
+func (*Grant) ProtoMessage()    {}
+func (*Grant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_583f8b0e575578f8, []int{2}
+}
+func (m *Grant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Grant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Grant.Merge(m, src)
+}
+func (m *Grant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Grant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Grant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Grant proto.InternalMessageInfo
+
+func (m *Grant) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Grant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *Grant) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *Grant) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// SponsoredAccount tracks the allowances granted to an address for rate
+// limiting.
+type SponsoredAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// last_sponsor is the sponsor of the most recent allowance.
+	LastSponsor    string    `protobuf:"bytes,2,opt,name=last_sponsor,json=lastSponsor,proto3" json:"last_sponsor,omitempty"`
+	WindowStart    time.Time `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	GrantsInWindow uint32    `protobuf:"varint,4,opt,name=grants_in_window,json=grantsInWindow,proto3" json:"grants_in_window,omitempty"`
+	TotalGrants    uint64    `protobuf:"varint,5,opt,name=total_grants,json=totalGrants,proto3" json:"total_grants,omitempty"`
+}
+
+func (m *SponsoredAccount) Reset()         { *m = SponsoredAccount{} }
+func (m *SponsoredAccount) String() string { return proto.CompactTextString(m) }
+func (*SponsoredAccount) ProtoMessage()    {}
+func (*SponsoredAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_583f8b0e575578f8, []int{3}
+}
+func (m *SponsoredAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsoredAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredAccount.Merge(m, src)
+}
+func (m *SponsoredAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredAccount proto.InternalMessageInfo
+
+func (m *SponsoredAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SponsoredAccount) GetLastSponsor() string {
+	if m != nil {
+		return m.LastSponsor
+	}
+	return ""
+}
+
+func (m *SponsoredAccount) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *SponsoredAccount) GetGrantsInWindow() uint32 {
+	if m != nil {
+		return m.GrantsInWindow
+	}
+	return 0
+}
+
+func (m *SponsoredAccount) GetTotalGrants() uint64 {
+	if m != nil {
+		return m.TotalGrants
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hippo.sponsor.v1.Params")
+	proto.RegisterType((*Sponsor)(nil), "hippo.sponsor.v1.Sponsor")
+	proto.RegisterType((*Grant)(nil), "hippo.sponsor.v1.Grant")
+	proto.RegisterType((*SponsoredAccount)(nil), "hippo.sponsor.v1.SponsoredAccount")
+}
+
+func init() { proto.RegisterFile("hippo/sponsor/v1/sponsor.proto", fileDescriptor_583f8b0e575578f8) }
+
+var fileDescriptor_583f8b0e575578f8 = []byte{
+	// 824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0x8e, 0x93, 0x10, 0xc8, 0x24, 0x21, 0x61, 0x8a, 0x90, 0xa1, 0x92, 0x93, 0xa6, 0x9b, 0x14,
+	0x29, 0x76, 0x03, 0xaa, 0x2a, 0xb5, 0x2b, 0x52, 0xa4, 0x0a, 0x95, 0x4a, 0xd4, 0x41, 0xaa, 0xd4,
+	0x8d, 0x35, 0xb1, 0xa7, 0x66, 0x54, 0xdb, 0xe3, 0x7a, 0x26, 0x21, 0xbc, 0x42, 0x57, 0x2c, 0xbb,
+	0x69, 0xd7, 0x15, 0x2b, 0x16, 0x7d, 0x08, 0x96, 0xa8, 0xab, 0xae, 0x4a, 0x05, 0xba, 0xe2, 0x35,
+	0xae, 0x3c, 0x33, 0x4e, 0x72, 0x01, 0x29, 0xe2, 0xea, 0x66, 0x93, 0x78, 0xce, 0xef, 0xcc, 0xf7,
+	0x9d, 0xf3, 0x01, 0xe3, 0x8c, 0xc4, 0x31, 0xb5, 0x58, 0x4c, 0x23, 0x46, 0x13, 0x6b, 0xdc, 0xcb,
+	0x3e, 0xcd, 0x38, 0xa1, 0x9c, 0xc2, 0x86, 0xf0, 0x9b, 0x99, 0x71, 0xdc, 0xdb, 0xd9, 0x40, 0x21,
+	0x89, 0xa8, 0x25, 0x7e, 0x65, 0xd0, 0x8e, 0xe1, 0x52, 0x16, 0x52, 0x66, 0x0d, 0x11, 0xc3, 0xd6,
+	0xb8, 0x37, 0xc4, 0x1c, 0xf5, 0x2c, 0x97, 0x92, 0x48, 0xf9, 0xb7, 0xa5, 0xdf, 0x11, 0x27, 0x4b,
+	0x1e, 0x94, 0x6b, 0xd3, 0xa7, 0x3e, 0x95, 0xf6, 0xf4, 0x2b, 0x2b, 0xe8, 0x53, 0xea, 0x07, 0xd8,
+	0x12, 0xa7, 0xe1, 0xe8, 0x67, 0xcb, 0x1b, 0x25, 0x88, 0x13, 0x9a, 0x15, 0x6c, 0x3e, 0xf5, 0x73,
+	0x12, 0x62, 0xc6, 0x51, 0x18, 0xcb, 0x80, 0xf6, 0x9b, 0x02, 0x28, 0x9d, 0xa0, 0x04, 0x85, 0x0c,
+	0xee, 0x83, 0xad, 0x10, 0x4d, 0x1c, 0x3f, 0x41, 0x11, 0x67, 0x4e, 0x8c, 0x13, 0x07, 0x79, 0x5e,
+	0x82, 0x19, 0xd3, 0xb5, 0x96, 0xd6, 0xa9, 0xd9, 0x1f, 0x85, 0x68, 0xf2, 0xad, 0x70, 0x9e, 0xe0,
+	0xe4, 0x40, 0xba, 0xe0, 0x29, 0xd8, 0x48, 0x10, 0xc7, 0x4e, 0x40, 0x42, 0xc2, 0x9d, 0x73, 0x12,
+	0x79, 0xf4, 0x5c, 0xcf, 0xb7, 0xb4, 0x4e, 0x65, 0x6f, 0xdb, 0x94, 0xcd, 0xcd, 0xac, 0xb9, 0x79,
+	0xa8, 0x2e, 0xd7, 0xaf, 0xdd, 0xfc, 0xd7, 0xcc, 0xfd, 0x7e, 0xd7, 0xd4, 0xfe, 0x7a, 0xbc, 0xde,
+	0xd5, 0xec, 0x7a, 0x5a, 0xe2, 0x38, 0xad, 0xf0, 0xa3, 0x28, 0x00, 0x27, 0xa0, 0x9e, 0x5e, 0x85,
+	0xc5, 0x38, 0xf2, 0x64, 0x69, 0xbd, 0xd0, 0x2a, 0x88, 0x9a, 0x0a, 0x94, 0x14, 0x41, 0x53, 0x21,
+	0x68, 0x7e, 0x43, 0x49, 0xd4, 0xff, 0x22, 0xad, 0x79, 0x75, 0xd7, 0xec, 0xf8, 0x84, 0x9f, 0x8d,
+	0x86, 0xa6, 0x4b, 0x43, 0x85, 0xa0, 0xfa, 0xeb, 0x32, 0xef, 0x17, 0x8b, 0x5f, 0xc4, 0x98, 0x89,
+	0x04, 0x26, 0x7b, 0xd7, 0x42, 0x34, 0x19, 0xa4, 0x7d, 0x44, 0x7f, 0x68, 0x83, 0xc6, 0x14, 0x84,
+	0x14, 0x03, 0x42, 0x3d, 0xbd, 0xf8, 0xca, 0xe7, 0xac, 0x67, 0x40, 0x9d, 0x88, 0x7c, 0xf8, 0x39,
+	0xd8, 0x4c, 0x6b, 0xa2, 0x20, 0xa0, 0xe7, 0xd8, 0x73, 0x42, 0xcc, 0x18, 0xf2, 0x31, 0xd3, 0x57,
+	0x04, 0xac, 0x30, 0x44, 0x93, 0x03, 0xe9, 0xfa, 0x5e, 0x79, 0x5e, 0xa0, 0x42, 0xcd, 0x95, 0x5e,
+	0x7a, 0x4e, 0xc5, 0x40, 0xba, 0xbe, 0xfa, 0xf8, 0xb7, 0xc7, 0xeb, 0xdd, 0x2d, 0x39, 0xa6, 0x93,
+	0xe9, 0xa0, 0x4a, 0x72, 0xdb, 0x7f, 0x94, 0xc0, 0xaa, 0x0a, 0x84, 0x7b, 0x60, 0x75, 0x9e, 0xd9,
+	0x72, 0x5f, 0xff, 0xe7, 0xef, 0xee, 0xa6, 0x02, 0x56, 0x11, 0x3b, 0xe0, 0x09, 0x89, 0x7c, 0x3b,
+	0x0b, 0x84, 0x5f, 0x83, 0x6a, 0x4c, 0x69, 0x30, 0x1d, 0x89, 0xfc, 0x82, 0xc4, 0x4a, 0x1a, 0x9d,
+	0x0d, 0x09, 0x04, 0xc5, 0x08, 0x85, 0x58, 0x2f, 0xa4, 0x49, 0xb6, 0xf8, 0x86, 0xbf, 0x82, 0xca,
+	0x3c, 0xbd, 0xc5, 0x25, 0xd1, 0x0b, 0xd8, 0x8c, 0xdb, 0xef, 0x40, 0xf5, 0x1d, 0x5e, 0x57, 0x5e,
+	0xc9, 0x6b, 0xc5, 0x9f, 0x23, 0xf5, 0x33, 0xd0, 0x78, 0x46, 0x68, 0xa9, 0x55, 0xe8, 0x94, 0xed,
+	0x3a, 0x7a, 0xc2, 0xe6, 0x16, 0x28, 0x21, 0x97, 0x93, 0x31, 0xd6, 0x57, 0x5b, 0x5a, 0x67, 0xcd,
+	0x56, 0x27, 0x78, 0x01, 0xea, 0x9c, 0x72, 0x14, 0x38, 0x1e, 0x8e, 0x29, 0x23, 0x1c, 0x7b, 0xfa,
+	0xda, 0x92, 0x60, 0x58, 0x17, 0x8d, 0x0e, 0xb3, 0x3e, 0xf0, 0x53, 0x50, 0x53, 0xc3, 0x45, 0x18,
+	0x1b, 0x61, 0x4f, 0x2f, 0xb7, 0xb4, 0x4e, 0xd1, 0x96, 0xf8, 0xb0, 0x23, 0x61, 0x83, 0x09, 0xa8,
+	0xd0, 0x11, 0x67, 0x1c, 0x45, 0x1e, 0x89, 0x7c, 0x1d, 0x2c, 0xe9, 0x6e, 0xf3, 0x4d, 0xe0, 0x31,
+	0xa8, 0x4a, 0x11, 0x71, 0x18, 0x47, 0x09, 0xd7, 0x2b, 0x82, 0xa3, 0x9d, 0x67, 0x1c, 0x9d, 0x66,
+	0x3a, 0x26, 0x49, 0xba, 0x9c, 0x91, 0x24, 0xd3, 0x07, 0x69, 0x36, 0xec, 0x80, 0x46, 0xf6, 0xcc,
+	0x28, 0x13, 0xa7, 0xaa, 0xd8, 0xa0, 0x75, 0xf5, 0xd2, 0x48, 0x2a, 0x4e, 0xfb, 0x2a, 0x0f, 0x56,
+	0xc4, 0x46, 0xa5, 0xdb, 0x91, 0x2d, 0xdb, 0xc2, 0xed, 0x60, 0xb3, 0x8d, 0x12, 0xf5, 0x30, 0x5e,
+	0xb8, 0x18, 0x59, 0x20, 0x8c, 0x40, 0x39, 0xc1, 0x21, 0x22, 0x51, 0x8a, 0xed, 0xb2, 0xd4, 0x6d,
+	0xd6, 0x02, 0x1e, 0x01, 0x80, 0x27, 0x31, 0x91, 0xa3, 0xad, 0x34, 0xed, 0x15, 0xb8, 0xce, 0x25,
+	0xb7, 0xff, 0xcc, 0x83, 0x86, 0x12, 0x13, 0xec, 0x1d, 0xb8, 0x2e, 0x1d, 0x49, 0xdc, 0xde, 0x47,
+	0x55, 0x02, 0xc4, 0xf8, 0x54, 0xdd, 0x16, 0xaa, 0x4a, 0x1a, 0x9d, 0xc9, 0xd8, 0xd3, 0x51, 0x29,
+	0x7c, 0xf0, 0x51, 0x29, 0xbe, 0x34, 0x2a, 0xf0, 0x13, 0x50, 0x95, 0x6b, 0x2b, 0xed, 0x42, 0x46,
+	0x8a, 0x76, 0x45, 0xd8, 0xa4, 0x28, 0xf7, 0x7f, 0xb8, 0xb9, 0x37, 0xb4, 0xdb, 0x7b, 0x43, 0xfb,
+	0xff, 0xde, 0xd0, 0x2e, 0x1f, 0x8c, 0xdc, 0xed, 0x83, 0x91, 0xfb, 0xf7, 0xc1, 0xc8, 0xfd, 0xf4,
+	0xe5, 0x1c, 0x7f, 0x42, 0xaa, 0xdd, 0x04, 0xf1, 0xae, 0x87, 0xa8, 0x3c, 0x75, 0xc5, 0xad, 0x5d,
+	0x1a, 0xcc, 0x29, 0xb8, 0x20, 0x75, 0x58, 0x12, 0x9e, 0xfd, 0xb7, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x19, 0xa1, 0x2d, 0x24, 0x88, 0x08, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGrantsPerSponsor != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.MaxGrantsPerSponsor))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxAllowedMessages != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.MaxAllowedMessages))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxGrantPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxGrantPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSponsor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.MaxSpendLimit) > 0 {
+		for iNdEx := len(m.MaxSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RateLimitWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSponsor(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxGrantsPerAddress != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.MaxGrantsPerAddress))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GrantsInWindow != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.GrantsInWindow))
+		i--
+		dAtA[i] = 0x60
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSponsor(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Outstanding) > 0 {
+		for iNdEx := len(m.Outstanding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outstanding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.GrantsIssued != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.GrantsIssued))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TotalDeposited) > 0 {
+		for iNdEx := len(m.TotalDeposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintSponsor(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GrantPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GrantPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSponsor(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSponsor(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsoredAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalGrants != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.TotalGrants))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GrantsInWindow != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.GrantsInWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSponsor(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LastSponsor) > 0 {
+		i -= len(m.LastSponsor)
+		copy(dAtA[i:], m.LastSponsor)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.LastSponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSponsor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSponsor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGrantsPerAddress != 0 {
+		n += 1 + sovSponsor(uint64(m.MaxGrantsPerAddress))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitWindow)
+	n += 1 + l + sovSponsor(uint64(l))
+	if len(m.MaxSpendLimit) > 0 {
+		for _, e := range m.MaxSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxGrantPeriod)
+	n += 1 + l + sovSponsor(uint64(l))
+	if m.MaxAllowedMessages != 0 {
+		n += 1 + sovSponsor(uint64(m.MaxAllowedMessages))
+	}
+	if m.MaxGrantsPerSponsor != 0 {
+		n += 1 + sovSponsor(uint64(m.MaxGrantsPerSponsor))
+	}
+	return n
+}
+
+func (m *Sponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GrantPeriod)
+	n += 1 + l + sovSponsor(uint64(l))
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.TotalDeposited) > 0 {
+		for _, e := range m.TotalDeposited {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if m.GrantsIssued != 0 {
+		n += 1 + sovSponsor(uint64(m.GrantsIssued))
+	}
+	if len(m.Outstanding) > 0 {
+		for _, e := range m.Outstanding {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovSponsor(uint64(l))
+	if m.GrantsInWindow != 0 {
+		n += 1 + sovSponsor(uint64(m.GrantsInWindow))
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovSponsor(uint64(l))
+	return n
+}
+
+func (m *SponsoredAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = len(m.LastSponsor)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovSponsor(uint64(l))
+	if m.GrantsInWindow != 0 {
+		n += 1 + sovSponsor(uint64(m.GrantsInWindow))
+	}
+	if m.TotalGrants != 0 {
+		n += 1 + sovSponsor(uint64(m.TotalGrants))
+	}
+	return n
+}
+
+func sovSponsor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSponsor(x uint64) (n int) {
+	return sovSponsor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGrantsPerAddress", wireType)
+			}
+			m.MaxGrantsPerAddress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGrantsPerAddress |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RateLimitWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSpendLimit = append(m.MaxSpendLimit, types.Coin{})
+			if err := m.MaxSpendLimit[len(m.MaxSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGrantPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxGrantPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedMessages", wireType)
+			}
+			m.MaxAllowedMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAllowedMessages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGrantsPerSponsor", wireType)
+			}
+			m.MaxGrantsPerSponsor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGrantsPerSponsor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GrantPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposited = append(m.TotalDeposited, types.Coin{})
+			if err := m.TotalDeposited[len(m.TotalDeposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantsIssued", wireType)
+			}
+			m.GrantsIssued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantsIssued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outstanding = append(m.Outstanding, types.Coin{})
+			if err := m.Outstanding[len(m.Outstanding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantsInWindow", wireType)
+			}
+			m.GrantsInWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantsInWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsoredAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsoredAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsoredAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastSponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantsInWindow", wireType)
+			}
+			m.GrantsInWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantsInWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGrants", wireType)
+			}
+			m.TotalGrants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGrants |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSponsor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSponsor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSponsor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSponsor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSponsor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSponsor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSponsor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,70 @@
+package treasury
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.treasury.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Stream",
+					Use:            "stream [id]",
+					Short:          "Query a funding stream",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Streams",
+					Use:       "streams",
+					Short:     "Query all funding streams",
+				},
+				{
+					RpcMethod:      "Grant",
+					Use:            "grant [id]",
+					Short:          "Query a milestone grant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Grants",
+					Use:       "grants",
+					Short:     "Query all milestone grants",
+				},
+				{
+					RpcMethod: "PoolBalance",
+					Use:       "pool-balance",
+					Short:     "Query the community pool balance and the part of it committed to streams and grants",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.treasury.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateStream",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CancelStream",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateGrant",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "ReleaseMilestone",
+					Use:            "release-milestone [grant-id] [milestone]",
+					Short:          "Release a milestone of a grant to its recipient, as the approver of the grant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "grant_id"}, {ProtoField: "milestone"}},
+				},
+				{
+					RpcMethod: "CancelGrant",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
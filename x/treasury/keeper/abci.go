@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+// EndBlocker pays the streams that are due and removes the streams that
+// reached their cap or end time. A payment the community pool cannot cover
+// is skipped, the stream tries again at its next interval.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var streams []types.Stream
+	if err := k.Streams.Walk(ctx, nil, func(_ uint64, stream types.Stream) (bool, error) {
+		streams = append(streams, stream)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, stream := range streams {
+		if stream.EndTime != nil && !sdkCtx.BlockTime().Before(*stream.EndTime) {
+			if err := k.endStream(sdkCtx, stream, "end_time"); err != nil {
+				return err
+			}
+			continue
+		}
+		elapsed := sdkCtx.BlockHeight() - stream.StartHeight
+		if elapsed <= 0 || uint64(elapsed)%stream.Interval != 0 {
+			continue
+		}
+
+		if err := k.payStream(sdkCtx, &stream); err != nil {
+			return err
+		}
+		if stream.Remaining().IsZero() {
+			if err := k.endStream(sdkCtx, stream, "cap"); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.Streams.Set(ctx, stream.Id, stream); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// payStream pays the amount due to the recipient of stream, capped at what
+// the stream is still to pay out.
+func (k Keeper) payStream(ctx sdk.Context, stream *types.Stream) error {
+	recipient, err := k.addressCodec.StringToBytes(stream.Recipient)
+	if err != nil {
+		return err
+	}
+	payment := stream.Amount.Min(stream.Remaining())
+
+	// the payment is dropped as a whole if the pool cannot cover it or the
+	// recipient cannot receive it
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.distrKeeper.DistributeFromFeePool(cacheCtx, payment, recipient); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStreamUnderfunded,
+				sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, payment.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+		return nil
+	}
+	write()
+	stream.Paid = stream.Paid.Add(payment...)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamPayment,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, payment.String()),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) endStream(ctx sdk.Context, stream types.Stream, reason string) error {
+	if err := k.Streams.Remove(ctx, stream.Id); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, stream.Paid.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
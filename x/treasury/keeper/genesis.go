@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+// InitGenesis initializes the treasury module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	for _, stream := range gs.Streams {
+		if err := k.Streams.Set(ctx, stream.Id, stream); err != nil {
+			return err
+		}
+	}
+	if err := k.LastStreamID.Set(ctx, gs.LastStreamId); err != nil {
+		return err
+	}
+
+	for _, grant := range gs.Grants {
+		if err := k.Grants.Set(ctx, grant.Id, grant); err != nil {
+			return err
+		}
+	}
+	return k.LastGrantID.Set(ctx, gs.LastGrantId)
+}
+
+// ExportGenesis exports the treasury module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	lastStreamID, err := k.LastStreamID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastGrantID, err := k.LastGrantID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{LastStreamId: lastStreamID, LastGrantId: lastGrantID}
+
+	if err := k.Streams.Walk(ctx, nil, func(_ uint64, stream types.Stream) (bool, error) {
+		gs.Streams = append(gs.Streams, stream)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Grants.Walk(ctx, nil, func(_ uint64, grant types.Grant) (bool, error) {
+		gs.Grants = append(gs.Grants, grant)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the treasury QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Stream implements types.QueryServer.
+func (k queryServer) Stream(ctx context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	stream, err := k.GetStream(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryStreamResponse{Stream: stream}, nil
+}
+
+// Streams implements types.QueryServer.
+func (k queryServer) Streams(ctx context.Context, req *types.QueryStreamsRequest) (*types.QueryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	streams, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Streams, req.Pagination,
+		func(_ uint64, stream types.Stream) (types.Stream, error) {
+			return stream, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+// Grant implements types.QueryServer.
+func (k queryServer) Grant(ctx context.Context, req *types.QueryGrantRequest) (*types.QueryGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	grant, err := k.GetGrant(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryGrantResponse{Grant: grant}, nil
+}
+
+// Grants implements types.QueryServer.
+func (k queryServer) Grants(ctx context.Context, req *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	grants, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Grants, req.Pagination,
+		func(_ uint64, grant types.Grant) (types.Grant, error) {
+			return grant, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+// PoolBalance implements types.QueryServer.
+func (k queryServer) PoolBalance(ctx context.Context, _ *types.QueryPoolBalanceRequest) (*types.QueryPoolBalanceResponse, error) {
+	total, committed, uncommitted, err := k.Keeper.PoolBalance(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPoolBalanceResponse{Total: total, Committed: committed, Uncommitted: uncommitted}, nil
+}
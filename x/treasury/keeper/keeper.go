@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+// Keeper pays funding streams and milestone grants from the community pool.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	addressCodec address.Codec
+
+	distrKeeper types.DistributionKeeper
+
+	// the address capable of creating and cancelling streams and grants,
+	// typically the x/gov module account.
+	authority string
+
+	Schema       collections.Schema
+	Streams      collections.Map[uint64, types.Stream]
+	LastStreamID collections.Sequence
+	Grants       collections.Map[uint64, types.Grant]
+	LastGrantID  collections.Sequence
+}
+
+// NewKeeper creates a new treasury Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
+	authority string,
+) Keeper {
+	if _, err := accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid treasury authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		addressCodec: accountKeeper.AddressCodec(),
+		distrKeeper:  distrKeeper,
+		authority:    authority,
+		Streams:      collections.NewMap(sb, types.StreamsKey, "streams", collections.Uint64Key, codec.CollValue[types.Stream](cdc)),
+		LastStreamID: collections.NewSequence(sb, types.LastStreamIDKey, "last_stream_id"),
+		Grants:       collections.NewMap(sb, types.GrantsKey, "grants", collections.Uint64Key, codec.CollValue[types.Grant](cdc)),
+		LastGrantID:  collections.NewSequence(sb, types.LastGrantIDKey, "last_grant_id"),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetStream returns the stream with id.
+func (k Keeper) GetStream(ctx context.Context, id uint64) (types.Stream, error) {
+	stream, err := k.Streams.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Stream{}, errorsmod.Wrapf(types.ErrStreamNotFound, "id %d", id)
+	}
+	return stream, err
+}
+
+// GetGrant returns the grant with id.
+func (k Keeper) GetGrant(ctx context.Context, id uint64) (types.Grant, error) {
+	grant, err := k.Grants.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Grant{}, errorsmod.Wrapf(types.ErrGrantNotFound, "id %d", id)
+	}
+	return grant, err
+}
+
+// nextID increments seq and returns the new value, so ids start at 1.
+func nextID(ctx context.Context, seq collections.Sequence) (uint64, error) {
+	last, err := seq.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return last + 1, seq.Set(ctx, last+1)
+}
@@ -0,0 +1,322 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+var (
+	funder    = sdk.AccAddress([]byte("funder______________"))
+	recipient = sdk.AccAddress([]byte("recipient___________"))
+	approver  = sdk.AccAddress([]byte("approver____________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.TreasuryKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+	s.Require().NoError(s.app.DistrKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.TreasuryKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.TreasuryKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.fundCommunityPool(1000)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(consensus.DefaultHippoDenom, amount))
+}
+
+func (s *KeeperTestSuite) fundCommunityPool(amount int64) {
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins(amount)))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, funder, coins(amount)))
+	s.Require().NoError(s.app.DistrKeeper.FundCommunityPool(s.ctx, coins(amount), funder))
+}
+
+func (s *KeeperTestSuite) balance(addr sdk.AccAddress) math.Int {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, consensus.DefaultHippoDenom).Amount
+}
+
+// endBlock advances to the given height and runs the treasury end blocker.
+func (s *KeeperTestSuite) endBlock(height int64) {
+	s.ctx = s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.app.TreasuryKeeper.EndBlocker(s.ctx))
+}
+
+func (s *KeeperTestSuite) hasEvent(eventType string) bool {
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *KeeperTestSuite) createStream(amount int64, interval uint64, cap int64) uint64 {
+	res, err := s.msgServer.CreateStream(s.ctx, &types.MsgCreateStream{
+		Authority: s.authority,
+		Title:     "core development",
+		Recipient: recipient.String(),
+		Amount:    coins(amount),
+		Interval:  interval,
+		Cap:       coins(cap),
+	})
+	s.Require().NoError(err)
+	return res.StreamId
+}
+
+func (s *KeeperTestSuite) TestCreateStream() {
+	msg := &types.MsgCreateStream{
+		Authority: recipient.String(),
+		Title:     "core development",
+		Recipient: recipient.String(),
+		Amount:    coins(100),
+		Interval:  10,
+		Cap:       coins(500),
+	}
+	_, err := s.msgServer.CreateStream(s.ctx, msg)
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = s.authority
+	msg.Cap = coins(2000)
+	_, err = s.msgServer.CreateStream(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInsufficientFunds)
+
+	msg.Cap = coins(500)
+	msg.Interval = 0
+	_, err = s.msgServer.CreateStream(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidStream)
+
+	msg.Interval = 10
+	past := s.ctx.BlockTime()
+	msg.EndTime = &past
+	_, err = s.msgServer.CreateStream(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidStream)
+
+	msg.EndTime = nil
+	res, err := s.msgServer.CreateStream(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.StreamId)
+
+	stream, err := s.queryServer.Stream(s.ctx, &types.QueryStreamRequest{Id: res.StreamId})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), stream.Stream.StartHeight)
+	s.Require().Equal(coins(500), stream.Stream.Remaining())
+
+	// the second stream would commit more than the pool holds
+	_, err = s.msgServer.CreateStream(s.ctx, &types.MsgCreateStream{
+		Authority: s.authority,
+		Title:     "audits",
+		Recipient: recipient.String(),
+		Amount:    coins(100),
+		Interval:  10,
+		Cap:       coins(600),
+	})
+	s.Require().ErrorIs(err, types.ErrInsufficientFunds)
+}
+
+func (s *KeeperTestSuite) TestStreamPayments() {
+	id := s.createStream(200, 10, 500)
+
+	s.endBlock(5)
+	s.Require().True(s.balance(recipient).IsZero())
+
+	s.endBlock(11)
+	s.Require().Equal(math.NewInt(200), s.balance(recipient))
+	s.Require().True(s.hasEvent(types.EventTypeStreamPayment))
+
+	s.endBlock(21)
+	s.Require().Equal(math.NewInt(400), s.balance(recipient))
+
+	// the last payment is capped at what is left
+	s.endBlock(31)
+	s.Require().Equal(math.NewInt(500), s.balance(recipient))
+	s.Require().True(s.hasEvent(types.EventTypeEndStream))
+
+	_, err := s.app.TreasuryKeeper.GetStream(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrStreamNotFound)
+}
+
+func (s *KeeperTestSuite) TestStreamEndTime() {
+	endTime := s.ctx.BlockTime().Add(time.Hour)
+	res, err := s.msgServer.CreateStream(s.ctx, &types.MsgCreateStream{
+		Authority: s.authority,
+		Title:     "core development",
+		Recipient: recipient.String(),
+		Amount:    coins(100),
+		Interval:  1,
+		Cap:       coins(500),
+		EndTime:   &endTime,
+	})
+	s.Require().NoError(err)
+
+	s.endBlock(2)
+	s.Require().Equal(math.NewInt(100), s.balance(recipient))
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.endBlock(3)
+	s.Require().Equal(math.NewInt(100), s.balance(recipient))
+	s.Require().True(s.hasEvent(types.EventTypeEndStream))
+
+	_, err = s.app.TreasuryKeeper.GetStream(s.ctx, res.StreamId)
+	s.Require().ErrorIs(err, types.ErrStreamNotFound)
+}
+
+func (s *KeeperTestSuite) TestStreamUnderfunded() {
+	id := s.createStream(300, 1, 900)
+
+	// the pool is drained outside the treasury, e.g. by a spend proposal
+	s.Require().NoError(s.app.DistrKeeper.DistributeFromFeePool(s.ctx, coins(800), funder))
+
+	s.endBlock(2)
+	s.Require().True(s.balance(recipient).IsZero())
+	s.Require().True(s.hasEvent(types.EventTypeStreamUnderfunded))
+
+	stream, err := s.app.TreasuryKeeper.GetStream(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().True(stream.Paid.IsZero())
+
+	// the payment is retried at the next interval
+	s.fundCommunityPool(300)
+	s.endBlock(3)
+	s.Require().Equal(math.NewInt(300), s.balance(recipient))
+}
+
+func (s *KeeperTestSuite) TestCancelStream() {
+	id := s.createStream(100, 10, 500)
+
+	_, err := s.msgServer.CancelStream(s.ctx, &types.MsgCancelStream{Authority: recipient.String(), StreamId: id})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.CancelStream(s.ctx, &types.MsgCancelStream{Authority: s.authority, StreamId: id})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CancelStream(s.ctx, &types.MsgCancelStream{Authority: s.authority, StreamId: id})
+	s.Require().ErrorIs(err, types.ErrStreamNotFound)
+
+	s.endBlock(11)
+	s.Require().True(s.balance(recipient).IsZero())
+}
+
+func (s *KeeperTestSuite) TestGrants() {
+	res, err := s.msgServer.CreateGrant(s.ctx, &types.MsgCreateGrant{
+		Authority: s.authority,
+		Title:     "clinical data pilot",
+		Recipient: recipient.String(),
+		Approver:  approver.String(),
+		Milestones: []types.Milestone{
+			{Description: "design", Amount: coins(100)},
+			{Description: "pilot", Amount: coins(200)},
+		},
+	})
+	s.Require().NoError(err)
+	id := res.GrantId
+
+	_, err = s.msgServer.ReleaseMilestone(s.ctx, &types.MsgReleaseMilestone{Sender: recipient.String(), GrantId: id, Milestone: 0})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ReleaseMilestone(s.ctx, &types.MsgReleaseMilestone{Sender: approver.String(), GrantId: id, Milestone: 2})
+	s.Require().ErrorIs(err, types.ErrInvalidMilestone)
+
+	_, err = s.msgServer.ReleaseMilestone(s.ctx, &types.MsgReleaseMilestone{Sender: approver.String(), GrantId: id, Milestone: 0})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(100), s.balance(recipient))
+
+	_, err = s.msgServer.ReleaseMilestone(s.ctx, &types.MsgReleaseMilestone{Sender: approver.String(), GrantId: id, Milestone: 0})
+	s.Require().ErrorIs(err, types.ErrInvalidMilestone)
+
+	pool, err := s.queryServer.PoolBalance(s.ctx, &types.QueryPoolBalanceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(coins(200), pool.Committed)
+	s.Require().Equal(math.LegacyNewDec(700), pool.Uncommitted.AmountOf(consensus.DefaultHippoDenom))
+
+	// the authority may release milestones as well, the grant is done
+	// after the last one
+	_, err = s.msgServer.ReleaseMilestone(s.ctx, &types.MsgReleaseMilestone{Sender: s.authority, GrantId: id, Milestone: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(300), s.balance(recipient))
+
+	_, err = s.app.TreasuryKeeper.GetGrant(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrGrantNotFound)
+}
+
+func (s *KeeperTestSuite) TestCancelGrant() {
+	res, err := s.msgServer.CreateGrant(s.ctx, &types.MsgCreateGrant{
+		Authority:  s.authority,
+		Title:      "clinical data pilot",
+		Recipient:  recipient.String(),
+		Milestones: []types.Milestone{{Description: "design", Amount: coins(100)}},
+	})
+	s.Require().NoError(err)
+
+	// without an approver only the authority releases milestones
+	_, err = s.msgServer.ReleaseMilestone(s.ctx, &types.MsgReleaseMilestone{Sender: approver.String(), GrantId: res.GrantId})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.CancelGrant(s.ctx, &types.MsgCancelGrant{Authority: s.authority, GrantId: res.GrantId})
+	s.Require().NoError(err)
+
+	pool, err := s.queryServer.PoolBalance(s.ctx, &types.QueryPoolBalanceRequest{})
+	s.Require().NoError(err)
+	s.Require().True(pool.Committed.IsZero())
+	s.Require().Equal(math.LegacyNewDec(1000), pool.Uncommitted.AmountOf(consensus.DefaultHippoDenom))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.createStream(100, 10, 500)
+	_, err := s.msgServer.CreateGrant(s.ctx, &types.MsgCreateGrant{
+		Authority:  s.authority,
+		Title:      "clinical data pilot",
+		Recipient:  recipient.String(),
+		Milestones: []types.Milestone{{Description: "design", Amount: coins(100)}},
+	})
+	s.Require().NoError(err)
+
+	gs, err := s.app.TreasuryKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate(s.app.AccountKeeper.AddressCodec()))
+	s.Require().Len(gs.Streams, 1)
+	s.Require().Len(gs.Grants, 1)
+
+	s.SetupTest()
+	s.Require().NoError(s.app.TreasuryKeeper.InitGenesis(s.ctx, gs))
+	exported, err := s.app.TreasuryKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the treasury MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) checkAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// CreateStream implements types.MsgServer.
+func (k msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateStream(goCtx, types.Stream{
+		Title:     msg.Title,
+		Recipient: msg.Recipient,
+		Amount:    msg.Amount,
+		Interval:  msg.Interval,
+		Cap:       msg.Cap,
+		EndTime:   msg.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateStreamResponse{StreamId: id}, nil
+}
+
+// CancelStream implements types.MsgServer.
+func (k msgServer) CancelStream(goCtx context.Context, msg *types.MsgCancelStream) (*types.MsgCancelStreamResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelStream(goCtx, msg.StreamId); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelStreamResponse{}, nil
+}
+
+// CreateGrant implements types.MsgServer.
+func (k msgServer) CreateGrant(goCtx context.Context, msg *types.MsgCreateGrant) (*types.MsgCreateGrantResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateGrant(goCtx, types.Grant{
+		Title:      msg.Title,
+		Recipient:  msg.Recipient,
+		Approver:   msg.Approver,
+		Milestones: msg.Milestones,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateGrantResponse{GrantId: id}, nil
+}
+
+// ReleaseMilestone implements types.MsgServer.
+func (k msgServer) ReleaseMilestone(goCtx context.Context, msg *types.MsgReleaseMilestone) (*types.MsgReleaseMilestoneResponse, error) {
+	if err := k.Keeper.ReleaseMilestone(goCtx, msg.Sender, msg.GrantId, msg.Milestone); err != nil {
+		return nil, err
+	}
+	return &types.MsgReleaseMilestoneResponse{}, nil
+}
+
+// CancelGrant implements types.MsgServer.
+func (k msgServer) CancelGrant(goCtx context.Context, msg *types.MsgCancelGrant) (*types.MsgCancelGrantResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelGrant(goCtx, msg.GrantId); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelGrantResponse{}, nil
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+// CreateStream stores a new stream starting at the current height and
+// returns its id. The uncommitted community pool must cover its cap.
+func (k Keeper) CreateStream(ctx context.Context, stream types.Stream) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stream.Paid = sdk.NewCoins()
+	stream.StartHeight = sdkCtx.BlockHeight()
+	if err := stream.Validate(k.addressCodec); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidStream, err.Error())
+	}
+	if stream.EndTime != nil && !stream.EndTime.After(sdkCtx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidStream, "end time %s is not in the future", stream.EndTime)
+	}
+	if err := k.checkUncommitted(ctx, stream.Cap); err != nil {
+		return 0, err
+	}
+
+	id, err := nextID(ctx, k.LastStreamID)
+	if err != nil {
+		return 0, err
+	}
+	stream.Id = id
+	if err := k.Streams.Set(ctx, id, stream); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, stream.Cap.String()),
+		),
+	)
+	return id, nil
+}
+
+// CancelStream removes a stream, what it did not pay out stays in the
+// community pool.
+func (k Keeper) CancelStream(ctx context.Context, id uint64) error {
+	stream, err := k.GetStream(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := k.Streams.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, stream.Remaining().String()),
+		),
+	)
+	return nil
+}
+
+// CreateGrant stores a new grant and returns its id. The uncommitted
+// community pool must cover all of its milestones.
+func (k Keeper) CreateGrant(ctx context.Context, grant types.Grant) (uint64, error) {
+	for i := range grant.Milestones {
+		grant.Milestones[i].Released = false
+	}
+	if err := grant.Validate(k.addressCodec); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidGrant, err.Error())
+	}
+	if err := k.checkUncommitted(ctx, grant.Remaining()); err != nil {
+		return 0, err
+	}
+
+	id, err := nextID(ctx, k.LastGrantID)
+	if err != nil {
+		return 0, err
+	}
+	grant.Id = id
+	if err := k.Grants.Set(ctx, id, grant); err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGrant,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, grant.Remaining().String()),
+		),
+	)
+	return id, nil
+}
+
+// ReleaseMilestone pays a milestone of a grant to its recipient. Only the
+// authority and the approver of the grant may release milestones. The grant
+// is removed once all of its milestones are released.
+func (k Keeper) ReleaseMilestone(ctx context.Context, sender string, id uint64, milestone uint32) error {
+	grant, err := k.GetGrant(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sender != k.authority && (grant.Approver == "" || sender != grant.Approver) {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s", sender)
+	}
+	if int(milestone) >= len(grant.Milestones) {
+		return errorsmod.Wrapf(types.ErrInvalidMilestone, "grant %d has %d milestones", id, len(grant.Milestones))
+	}
+	if grant.Milestones[milestone].Released {
+		return errorsmod.Wrapf(types.ErrInvalidMilestone, "milestone %d is already released", milestone)
+	}
+
+	recipient, err := k.addressCodec.StringToBytes(grant.Recipient)
+	if err != nil {
+		return err
+	}
+	amount := grant.Milestones[milestone].Amount
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "%s", err)
+	}
+
+	grant.Milestones[milestone].Released = true
+	if grant.Remaining().IsZero() {
+		err = k.Grants.Remove(ctx, id)
+	} else {
+		err = k.Grants.Set(ctx, id, grant)
+	}
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseMilestone,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMilestone, strconv.FormatUint(uint64(milestone), 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// CancelGrant removes a grant, its unreleased milestones stay in the
+// community pool.
+func (k Keeper) CancelGrant(ctx context.Context, id uint64) error {
+	grant, err := k.GetGrant(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := k.Grants.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelGrant,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, grant.Remaining().String()),
+		),
+	)
+	return nil
+}
+
+// Committed returns what streams and grants are still to pay out.
+func (k Keeper) Committed(ctx context.Context) (sdk.Coins, error) {
+	committed := sdk.NewCoins()
+	if err := k.Streams.Walk(ctx, nil, func(_ uint64, stream types.Stream) (bool, error) {
+		committed = committed.Add(stream.Remaining()...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Grants.Walk(ctx, nil, func(_ uint64, grant types.Grant) (bool, error) {
+		committed = committed.Add(grant.Remaining()...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	return committed, nil
+}
+
+// PoolBalance returns the community pool balance together with the part of
+// it that is committed and the uncommitted rest.
+func (k Keeper) PoolBalance(ctx context.Context) (total sdk.DecCoins, committed sdk.Coins, uncommitted sdk.DecCoins, err error) {
+	res, err := k.distrKeeper.CommunityPool(ctx, &distrtypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	committed, err = k.Committed(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	uncommitted = sdk.NewDecCoins()
+	for _, coin := range res.Pool {
+		rest := coin.Amount.Sub(committed.AmountOf(coin.Denom).ToLegacyDec())
+		if rest.IsPositive() {
+			uncommitted = uncommitted.Add(sdk.NewDecCoinFromDec(coin.Denom, rest))
+		}
+	}
+	return res.Pool, committed, uncommitted, nil
+}
+
+// checkUncommitted checks that the uncommitted community pool covers amount.
+func (k Keeper) checkUncommitted(ctx context.Context, amount sdk.Coins) error {
+	_, _, uncommitted, err := k.PoolBalance(ctx)
+	if err != nil {
+		return err
+	}
+	for _, coin := range amount {
+		if uncommitted.AmountOf(coin.Denom).LT(coin.Amount.ToLegacyDec()) {
+			return errorsmod.Wrapf(types.ErrInsufficientFunds, "%s uncommitted, %s needed", uncommitted, amount)
+		}
+	}
+	return nil
+}
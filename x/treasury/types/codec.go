@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the treasury messages on the amino
+// codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateStream{}, "hippo/x/treasury/MsgCreateStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelStream{}, "hippo/x/treasury/MsgCancelStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGrant{}, "hippo/x/treasury/MsgCreateGrant")
+	legacy.RegisterAminoMsg(cdc, &MsgReleaseMilestone{}, "hippo/x/treasury/MsgReleaseMilestone")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelGrant{}, "hippo/x/treasury/MsgCancelGrant")
+}
+
+// RegisterInterfaces registers the treasury messages on the interface
+// registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateStream{},
+		&MsgCancelStream{},
+		&MsgCreateGrant{},
+		&MsgReleaseMilestone{},
+		&MsgCancelGrant{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,14 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/treasury module sentinel errors
+var (
+	ErrInvalidStream     = errorsmod.Register(ModuleName, 2, "invalid stream")
+	ErrStreamNotFound    = errorsmod.Register(ModuleName, 3, "stream not found")
+	ErrInvalidGrant      = errorsmod.Register(ModuleName, 4, "invalid grant")
+	ErrGrantNotFound     = errorsmod.Register(ModuleName, 5, "grant not found")
+	ErrInvalidMilestone  = errorsmod.Register(ModuleName, 6, "invalid milestone")
+	ErrUnauthorized      = errorsmod.Register(ModuleName, 7, "sender may not release milestones of the grant")
+	ErrInsufficientFunds = errorsmod.Register(ModuleName, 8, "community pool cannot cover the commitment")
+)
@@ -0,0 +1,20 @@
+package types
+
+// treasury module event types and attributes
+const (
+	EventTypeCreateStream      = "create_stream"
+	EventTypeStreamPayment     = "stream_payment"
+	EventTypeStreamUnderfunded = "stream_underfunded"
+	EventTypeEndStream         = "end_stream"
+	EventTypeCancelStream      = "cancel_stream"
+	EventTypeCreateGrant       = "create_grant"
+	EventTypeReleaseMilestone  = "release_milestone"
+	EventTypeCancelGrant       = "cancel_grant"
+
+	AttributeKeyStreamID  = "stream_id"
+	AttributeKeyGrantID   = "grant_id"
+	AttributeKeyMilestone = "milestone"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyReason    = "reason"
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default treasury genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation. addrCodec decodes the
+// recipients and approvers.
+func (gs GenesisState) Validate(addrCodec address.Codec) error {
+	streams := make(map[uint64]bool, len(gs.Streams))
+	for _, s := range gs.Streams {
+		if streams[s.Id] {
+			return fmt.Errorf("duplicate stream %d", s.Id)
+		}
+		streams[s.Id] = true
+		if s.Id == 0 || s.Id > gs.LastStreamId {
+			return fmt.Errorf("stream id %d out of range", s.Id)
+		}
+		if err := s.Validate(addrCodec); err != nil {
+			return fmt.Errorf("invalid stream %d: %w", s.Id, err)
+		}
+	}
+
+	grants := make(map[uint64]bool, len(gs.Grants))
+	for _, g := range gs.Grants {
+		if grants[g.Id] {
+			return fmt.Errorf("duplicate grant %d", g.Id)
+		}
+		grants[g.Id] = true
+		if g.Id == 0 || g.Id > gs.LastGrantId {
+			return fmt.Errorf("grant id %d out of range", g.Id)
+		}
+		if err := g.Validate(addrCodec); err != nil {
+			return fmt.Errorf("invalid grant %d: %w", g.Id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/treasury/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	addrCodec := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	recipient, err := addrCodec.BytesToString([]byte("recipient___________"))
+	require.NoError(t, err)
+
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(amount))) }
+	endTime := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := types.Stream{Id: 1, Title: "infra", Recipient: recipient, Amount: coins(10), Interval: 1, Cap: coins(100), Paid: coins(20), EndTime: &endTime}
+	grant := types.Grant{Id: 1, Title: "audit", Recipient: recipient, Milestones: []types.Milestone{{Description: "report", Amount: coins(50)}}}
+
+	withStream := func(malleate func(s *types.Stream)) types.GenesisState {
+		s := stream
+		malleate(&s)
+		return types.GenesisState{Streams: []types.Stream{s}, LastStreamId: 1}
+	}
+	withGrant := func(malleate func(g *types.Grant)) types.GenesisState {
+		g := grant
+		g.Milestones = append([]types.Milestone(nil), grant.Milestones...)
+		malleate(&g)
+		return types.GenesisState{Grants: []types.Grant{g}, LastGrantId: 1}
+	}
+
+	testCases := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{"default", *types.DefaultGenesisState(), ""},
+		{"valid", types.GenesisState{Streams: []types.Stream{stream}, LastStreamId: 1, Grants: []types.Grant{grant}, LastGrantId: 1}, ""},
+		{"duplicate stream", types.GenesisState{Streams: []types.Stream{stream, stream}, LastStreamId: 1}, "duplicate stream"},
+		{"stream id above last", types.GenesisState{Streams: []types.Stream{stream}}, "out of range"},
+		{"empty title", withStream(func(s *types.Stream) { s.Title = "" }), "title"},
+		{"invalid recipient", withStream(func(s *types.Stream) { s.Recipient = "invalid" }), "invalid recipient"},
+		{"zero amount", withStream(func(s *types.Stream) { s.Amount = nil }), "amount must be positive"},
+		{"zero interval", withStream(func(s *types.Stream) { s.Interval = 0 }), "interval"},
+		{"cap of other denom", withStream(func(s *types.Stream) { s.Cap = sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1))) }), "must have the denoms"},
+		{"paid above cap", withStream(func(s *types.Stream) { s.Paid = coins(101) }), "exceeds cap"},
+		{"duplicate grant", types.GenesisState{Grants: []types.Grant{grant, grant}, LastGrantId: 1}, "duplicate grant"},
+		{"invalid approver", withGrant(func(g *types.Grant) { g.Approver = "invalid" }), "invalid approver"},
+		{"no milestones", withGrant(func(g *types.Grant) { g.Milestones = nil }), "milestones"},
+		{"milestone without description", withGrant(func(g *types.Grant) { g.Milestones[0].Description = "" }), "description"},
+		{"zero milestone", withGrant(func(g *types.Grant) { g.Milestones[0].Amount = nil }), "must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate(addrCodec)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
+
+func TestRemaining(t *testing.T) {
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("ahp", math.NewInt(amount))) }
+
+	stream := types.Stream{Cap: coins(100), Paid: coins(30)}
+	require.Equal(t, coins(70), stream.Remaining())
+
+	grant := types.Grant{Milestones: []types.Milestone{
+		{Amount: coins(10), Released: true},
+		{Amount: coins(20)},
+		{Amount: coins(30)},
+	}}
+	require.Equal(t, coins(50), grant.Remaining())
+}
@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "treasury"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	StreamsKey      = collections.NewPrefix(0)
+	LastStreamIDKey = collections.NewPrefix(1)
+	GrantsKey       = collections.NewPrefix(2)
+	LastGrantIDKey  = collections.NewPrefix(3)
+)
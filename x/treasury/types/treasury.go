@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTitleLength bounds the titles of streams and grants.
+const MaxTitleLength = 140
+
+// Validate performs basic validation of the stream.
+func (s Stream) Validate(addrCodec address.Codec) error {
+	if err := validateTitle(s.Title); err != nil {
+		return err
+	}
+	if _, err := addrCodec.StringToBytes(s.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if !s.Amount.IsValid() || s.Amount.IsZero() {
+		return fmt.Errorf("amount must be positive: %s", s.Amount)
+	}
+	if s.Interval == 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if !s.Cap.IsValid() || !s.Amount.DenomsSubsetOf(s.Cap) || !s.Cap.DenomsSubsetOf(s.Amount) {
+		return fmt.Errorf("cap %s must have the denoms of amount %s", s.Cap, s.Amount)
+	}
+	if !s.Paid.IsValid() || !s.Paid.IsAllLTE(s.Cap) {
+		return fmt.Errorf("paid %s exceeds cap %s", s.Paid, s.Cap)
+	}
+	if s.EndTime != nil && s.EndTime.Equal(time.Time{}) {
+		return fmt.Errorf("end time must not be zero")
+	}
+	return nil
+}
+
+// Remaining returns what the stream is still to pay out.
+func (s Stream) Remaining() sdk.Coins {
+	return s.Cap.Sub(s.Paid...)
+}
+
+// Validate performs basic validation of the grant.
+func (g Grant) Validate(addrCodec address.Codec) error {
+	if err := validateTitle(g.Title); err != nil {
+		return err
+	}
+	if _, err := addrCodec.StringToBytes(g.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if g.Approver != "" {
+		if _, err := addrCodec.StringToBytes(g.Approver); err != nil {
+			return fmt.Errorf("invalid approver: %w", err)
+		}
+	}
+	if len(g.Milestones) == 0 {
+		return fmt.Errorf("grant must have milestones")
+	}
+	for i, m := range g.Milestones {
+		if m.Description == "" {
+			return fmt.Errorf("milestone %d must have a description", i)
+		}
+		if !m.Amount.IsValid() || m.Amount.IsZero() {
+			return fmt.Errorf("amount of milestone %d must be positive: %s", i, m.Amount)
+		}
+	}
+	return nil
+}
+
+// Remaining returns the amount of the unreleased milestones of the grant.
+func (g Grant) Remaining() sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, m := range g.Milestones {
+		if !m.Released {
+			remaining = remaining.Add(m.Amount...)
+		}
+	}
+	return remaining
+}
+
+func validateTitle(title string) error {
+	if title == "" || len(title) > MaxTitleLength {
+		return fmt.Errorf("title must be between 1 and %d characters", MaxTitleLength)
+	}
+	return nil
+}
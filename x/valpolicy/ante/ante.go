@@ -0,0 +1,63 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/keeper"
+)
+
+// maxNestedMsgs bounds how deep authz executions are unwrapped.
+const maxNestedMsgs = 6
+
+// ValidatorPolicyDecorator rejects MsgCreateValidator and MsgEditValidator
+// that violate the validator policy, including ones executed through authz,
+// before they reach the mempool. Messages dispatched by x/group proposals and
+// wasm contracts are checked by the staking Msg service, see
+// keeper.NewStakingMsgServer.
+type ValidatorPolicyDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewValidatorPolicyDecorator returns a new ValidatorPolicyDecorator.
+func NewValidatorPolicyDecorator(k keeper.Keeper) ValidatorPolicyDecorator {
+	return ValidatorPolicyDecorator{keeper: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d ValidatorPolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs(), 0); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d ValidatorPolicyDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg, depth int) error {
+	if depth > maxNestedMsgs {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too many nested authz executions")
+	}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
+			if err := d.keeper.CheckCreateValidator(ctx, msg); err != nil {
+				return err
+			}
+		case *stakingtypes.MsgEditValidator:
+			if err := d.keeper.CheckEditValidator(ctx, msg); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, inner, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
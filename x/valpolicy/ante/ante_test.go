@@ -0,0 +1,74 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/ante"
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+)
+
+func TestValidatorPolicyDecorator(t *testing.T) {
+	consensus.SetWalletConfig()
+	hippo := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), app.EmptyWasmOptions)
+	ctx := hippo.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, hippo.ValPolicyKeeper.InitGenesis(ctx, types.DefaultGenesisState()))
+
+	operator := sdk.AccAddress([]byte("operator____________"))
+	createValidator := func(rate math.LegacyDec) sdk.Msg {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(operator).String(), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(1)),
+			stakingtypes.NewDescription("validator", "", "", "", ""),
+			stakingtypes.NewCommissionRates(rate, math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 2)),
+			math.OneInt(),
+		)
+		require.NoError(t, err)
+		return msg
+	}
+	buildTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := hippo.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		return builder.GetTx()
+	}
+	nextCalled := false
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+	decorator := ante.NewValidatorPolicyDecorator(hippo.ValPolicyKeeper)
+
+	_, err := decorator.AnteHandle(ctx, buildTx(createValidator(math.LegacyNewDecWithPrec(1, 1))), false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(ctx, buildTx(createValidator(math.LegacyNewDecWithPrec(5, 1))), false, next)
+	require.ErrorIs(t, err, types.ErrCommissionTooHigh)
+	require.False(t, nextCalled)
+
+	// messages executed through authz are checked too
+	exec := authz.NewMsgExec(operator, []sdk.Msg{createValidator(math.LegacyNewDecWithPrec(5, 1))})
+	_, err = decorator.AnteHandle(ctx, buildTx(&exec), false, next)
+	require.ErrorIs(t, err, types.ErrCommissionTooHigh)
+	require.False(t, nextCalled)
+
+	// other messages pass untouched
+	send := banktypes.NewMsgSend(operator, operator, sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.OneInt())))
+	_, err = decorator.AnteHandle(ctx, buildTx(send), false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+}
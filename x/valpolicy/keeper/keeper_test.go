@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.ValPolicyKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.Require().NoError(s.app.StakingKeeper.SetParams(s.ctx, stakingtypes.NewParams(
+		stakingtypes.DefaultUnbondingTime, stakingtypes.DefaultMaxValidators, stakingtypes.DefaultMaxEntries,
+		stakingtypes.DefaultHistoricalEntries, consensus.DefaultHippoDenom, stakingtypes.DefaultMinCommissionRate,
+	)))
+	s.Require().NoError(s.app.DistrKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.ValPolicyKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.ValPolicyKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func (s *KeeperTestSuite) setParams(params types.Params) {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+}
+
+// createValidatorMsg returns a MsgCreateValidator of operator bonding tokens
+// at the given commission.
+func createValidatorMsg(operator sdk.AccAddress, tokens math.Int, rate, maxChangeRate math.LegacyDec) *stakingtypes.MsgCreateValidator {
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator).String(), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(consensus.DefaultHippoDenom, tokens),
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(rate, math.LegacyOneDec(), maxChangeRate),
+		math.OneInt(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return msg
+}
+
+// createValidator creates a validator bypassing the policy, as validators
+// created before the policy was tightened were.
+func (s *KeeperTestSuite) createValidator(msg *stakingtypes.MsgCreateValidator) sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	s.Require().NoError(err)
+	operator := sdk.AccAddress(valAddr)
+	coins := sdk.NewCoins(msg.Value)
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, operator, coins))
+	_, err = stakingkeeper.NewMsgServerImpl(s.app.StakingKeeper).CreateValidator(s.ctx, msg)
+	s.Require().NoError(err)
+	return valAddr
+}
+
+func (s *KeeperTestSuite) TestCheckCreateValidator() {
+	s.setParams(types.NewParams(math.NewInt(1_000), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)))
+	operator := sdk.AccAddress([]byte("operator____________"))
+	rate, maxChange := math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 2)
+
+	msg := createValidatorMsg(operator, math.NewInt(1_000), rate, maxChange)
+	msg.MinSelfDelegation = math.NewInt(1_000)
+	s.Require().NoError(s.app.ValPolicyKeeper.CheckCreateValidator(s.ctx, msg))
+
+	lowBond := createValidatorMsg(operator, math.NewInt(999), rate, maxChange)
+	s.Require().ErrorIs(s.app.ValPolicyKeeper.CheckCreateValidator(s.ctx, lowBond), types.ErrSelfDelegationTooLow)
+
+	lowMin := createValidatorMsg(operator, math.NewInt(1_000), rate, maxChange)
+	s.Require().ErrorIs(s.app.ValPolicyKeeper.CheckCreateValidator(s.ctx, lowMin), types.ErrSelfDelegationTooLow)
+
+	highRate := createValidatorMsg(operator, math.NewInt(1_000), math.LegacyNewDecWithPrec(3, 1), maxChange)
+	highRate.MinSelfDelegation = math.NewInt(1_000)
+	s.Require().ErrorIs(s.app.ValPolicyKeeper.CheckCreateValidator(s.ctx, highRate), types.ErrCommissionTooHigh)
+
+	highChange := createValidatorMsg(operator, math.NewInt(1_000), rate, math.LegacyNewDecWithPrec(2, 2))
+	highChange.MinSelfDelegation = math.NewInt(1_000)
+	s.Require().ErrorIs(s.app.ValPolicyKeeper.CheckCreateValidator(s.ctx, highChange), types.ErrCommissionChangeTooBig)
+}
+
+func (s *KeeperTestSuite) TestCheckEditValidator() {
+	operator := sdk.AccAddress([]byte("operator____________"))
+	valAddr := s.createValidator(createValidatorMsg(operator, math.NewInt(1_000), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 2)))
+	s.setParams(types.NewParams(math.NewInt(100), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)))
+
+	edit := func(rate *math.LegacyDec, minSelfDelegation *math.Int) *stakingtypes.MsgEditValidator {
+		return stakingtypes.NewMsgEditValidator(valAddr.String(), stakingtypes.Description{}, rate, minSelfDelegation)
+	}
+	within := math.LegacyNewDecWithPrec(11, 2)
+	tooFar := math.LegacyNewDecWithPrec(12, 2)
+	tooHigh := math.LegacyNewDecWithPrec(21, 2)
+	lower := math.LegacyNewDecWithPrec(5, 2)
+	enough, tooLow := math.NewInt(100), math.NewInt(99)
+
+	s.Require().NoError(s.app.ValPolicyKeeper.CheckEditValidator(s.ctx, edit(nil, nil)))
+	s.Require().NoError(s.app.ValPolicyKeeper.CheckEditValidator(s.ctx, edit(&within, &enough)))
+	// the change cap only applies to increases
+	s.Require().NoError(s.app.ValPolicyKeeper.CheckEditValidator(s.ctx, edit(&lower, nil)))
+	s.Require().ErrorIs(s.app.ValPolicyKeeper.CheckEditValidator(s.ctx, edit(&tooFar, nil)), types.ErrCommissionChangeTooBig)
+	s.Require().ErrorIs(s.app.ValPolicyKeeper.CheckEditValidator(s.ctx, edit(&tooHigh, nil)), types.ErrCommissionTooHigh)
+	s.Require().ErrorIs(s.app.ValPolicyKeeper.CheckEditValidator(s.ctx, edit(nil, &tooLow)), types.ErrSelfDelegationTooLow)
+}
+
+func (s *KeeperTestSuite) TestLowerCommissionAbovePolicy() {
+	operator := sdk.AccAddress([]byte("operator____________"))
+	valAddr := s.createValidator(createValidatorMsg(operator, math.NewInt(1_000), math.LegacyNewDecWithPrec(25, 2), math.LegacyNewDecWithPrec(5, 2)))
+	s.setParams(types.NewParams(math.OneInt(), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)))
+
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(s.app.StakingKeeper)
+	unchanged := stakingtypes.NewDescription(stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc, stakingtypes.DoNotModifyDesc)
+	edit := func(rate math.LegacyDec) error {
+		msg := stakingtypes.NewMsgEditValidator(valAddr.String(), unchanged, &rate, nil)
+		if err := s.app.ValPolicyKeeper.CheckEditValidator(s.ctx, msg); err != nil {
+			return err
+		}
+		_, err := stakingMsgServer.EditValidator(s.ctx, msg)
+		return err
+	}
+
+	// a validator above the max commission rate lowers its rate step by step,
+	// by more than the policy change cap but within its own max change rate
+	for _, rate := range []math.LegacyDec{
+		math.LegacyNewDecWithPrec(24, 2),
+		math.LegacyNewDecWithPrec(21, 2),
+		math.LegacyNewDecWithPrec(18, 2),
+	} {
+		s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(25 * time.Hour))
+		s.Require().NoError(edit(rate))
+		validator, err := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+		s.Require().NoError(err)
+		s.Require().Equal(rate, validator.Commission.Rate)
+	}
+
+	// once within the policy, it is held to it again
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(25 * time.Hour))
+	s.Require().ErrorIs(edit(math.LegacyNewDecWithPrec(21, 2)), types.ErrCommissionTooHigh)
+	s.Require().ErrorIs(edit(math.LegacyNewDecWithPrec(2, 1)), types.ErrCommissionChangeTooBig)
+	s.Require().NoError(edit(math.LegacyNewDecWithPrec(19, 2)))
+}
+
+func (s *KeeperTestSuite) TestStakingMsgService() {
+	s.setParams(types.NewParams(math.NewInt(1_000), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)))
+	rate, maxChange := math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 2)
+	fund := func(addr sdk.AccAddress) {
+		coins := sdk.NewCoins(sdk.NewCoin(consensus.DefaultHippoDenom, math.NewInt(1_000)))
+		s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins))
+		s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, addr, coins))
+	}
+
+	// contracts dispatch their messages through the app's message router
+	operator := sdk.AccAddress([]byte("operator____________"))
+	fund(operator)
+	lowBond := createValidatorMsg(operator, math.NewInt(999), rate, maxChange)
+	_, err := s.app.MsgServiceRouter().Handler(lowBond)(s.ctx, lowBond)
+	s.Require().ErrorIs(err, types.ErrSelfDelegationTooLow)
+
+	// so do x/group proposals
+	member := sdk.AccAddress([]byte("member______________"))
+	createGroup, err := group.NewMsgCreateGroupWithPolicy(member.String(), []group.MemberRequest{{Address: member.String(), Weight: "1"}}, "", "", false, group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	s.Require().NoError(err)
+	groupRes, err := s.app.GroupKeeper.CreateGroupWithPolicy(s.ctx, createGroup)
+	s.Require().NoError(err)
+	policyAddr := sdk.MustAccAddressFromBech32(groupRes.GroupPolicyAddress)
+	fund(policyAddr)
+
+	propose := func(msg sdk.Msg) uint64 {
+		submit, err := group.NewMsgSubmitProposal(policyAddr.String(), []string{member.String()}, []sdk.Msg{msg}, "", group.Exec_EXEC_TRY, "validator", "create the group validator")
+		s.Require().NoError(err)
+		res, err := s.app.GroupKeeper.SubmitProposal(s.ctx, submit)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	id := propose(createValidatorMsg(policyAddr, math.NewInt(999), rate, maxChange))
+	proposal, err := s.app.GroupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.Proposal.ExecutorResult)
+
+	compliant := createValidatorMsg(policyAddr, math.NewInt(1_000), rate, maxChange)
+	compliant.MinSelfDelegation = math.NewInt(1_000)
+	propose(compliant)
+	_, err = s.app.StakingKeeper.GetValidator(s.ctx, sdk.ValAddress(policyAddr))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestEnforcePolicy() {
+	compliantMsg := createValidatorMsg(sdk.AccAddress([]byte("compliant___________")), math.NewInt(1_000), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 2))
+	compliantMsg.MinSelfDelegation = math.NewInt(100)
+	compliant := s.createValidator(compliantMsg)
+	greedy := s.createValidator(createValidatorMsg(sdk.AccAddress([]byte("greedy______________")), math.NewInt(1_000), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1)))
+	small := s.createValidator(createValidatorMsg(sdk.AccAddress([]byte("small_______________")), math.NewInt(10), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 2)))
+	s.setParams(types.NewParams(math.NewInt(100), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)))
+
+	res, err := s.queryServer.Violations(s.ctx, &types.QueryViolationsRequest{})
+	s.Require().NoError(err)
+	reasons := make(map[string][]string)
+	for _, v := range res.Violations {
+		reasons[v.ValidatorAddress] = v.Reasons
+	}
+	s.Require().Len(reasons, 2)
+	s.Require().NotContains(reasons, compliant.String())
+	// min self delegation, commission rate and max change rate
+	s.Require().Len(reasons[greedy.String()], 3)
+	// self bond and min self delegation
+	s.Require().Len(reasons[small.String()], 2)
+
+	// reporting leaves the validators untouched
+	violations, err := s.app.ValPolicyKeeper.EnforcePolicy(s.ctx, false)
+	s.Require().NoError(err)
+	s.Require().Equal(res.Violations, violations)
+	validator, err := s.app.StakingKeeper.GetValidator(s.ctx, greedy)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(5, 1), validator.Commission.Rate)
+
+	_, err = s.app.ValPolicyKeeper.EnforcePolicy(s.ctx, true)
+	s.Require().NoError(err)
+	validator, err = s.app.StakingKeeper.GetValidator(s.ctx, greedy)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 2), validator.Commission.MaxChangeRate)
+	s.Require().Equal(math.NewInt(100), validator.MinSelfDelegation)
+	s.Require().Equal(s.ctx.BlockTime(), validator.Commission.UpdateTime)
+
+	// the self bond of the small validator cannot be adjusted
+	validator, err = s.app.StakingKeeper.GetValidator(s.ctx, small)
+	s.Require().NoError(err)
+	s.Require().Equal(math.OneInt(), validator.MinSelfDelegation)
+	violations, err = s.app.ValPolicyKeeper.Violations(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(violations, 1)
+	s.Require().Equal(small.String(), violations[0].ValidatorAddress)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(math.NewInt(100), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2))
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("user________________")).String(),
+		Params:    params,
+	})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.MinSelfDelegation = math.ZeroInt()
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	s.setParams(params)
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+)
+
+// CheckCreateValidator checks that a new validator bonds and declares at
+// least the minimum self delegation and that its commission stays within
+// the policy.
+func (k Keeper) CheckCreateValidator(ctx context.Context, msg *stakingtypes.MsgCreateValidator) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if msg.Value.Amount.LT(params.MinSelfDelegation) {
+		return errorsmod.Wrapf(types.ErrSelfDelegationTooLow, "self bond %s is below %s", msg.Value.Amount, params.MinSelfDelegation)
+	}
+	if msg.MinSelfDelegation.LT(params.MinSelfDelegation) {
+		return errorsmod.Wrapf(types.ErrSelfDelegationTooLow, "min self delegation %s is below %s", msg.MinSelfDelegation, params.MinSelfDelegation)
+	}
+	if msg.Commission.Rate.GT(params.MaxCommissionRate) {
+		return errorsmod.Wrapf(types.ErrCommissionTooHigh, "%s is above %s", msg.Commission.Rate, params.MaxCommissionRate)
+	}
+	if msg.Commission.MaxChangeRate.GT(params.MaxCommissionChangeRate) {
+		return errorsmod.Wrapf(types.ErrCommissionChangeTooBig, "max change rate %s is above %s", msg.Commission.MaxChangeRate, params.MaxCommissionChangeRate)
+	}
+	return nil
+}
+
+// CheckEditValidator checks that an edit keeps the validator within the
+// policy. The staking module allows one commission change every 24 hours,
+// so capping the change caps the daily change. Lowering the commission rate
+// is always allowed, so that a validator created before the policy was
+// tightened can move toward it.
+func (k Keeper) CheckEditValidator(ctx context.Context, msg *stakingtypes.MsgEditValidator) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if msg.MinSelfDelegation != nil && msg.MinSelfDelegation.LT(params.MinSelfDelegation) {
+		return errorsmod.Wrapf(types.ErrSelfDelegationTooLow, "min self delegation %s is below %s", msg.MinSelfDelegation, params.MinSelfDelegation)
+	}
+	if msg.CommissionRate == nil {
+		return nil
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		// left to the staking module to reject
+		return nil
+	}
+	if msg.CommissionRate.LTE(validator.Commission.Rate) {
+		return nil
+	}
+	if msg.CommissionRate.GT(params.MaxCommissionRate) {
+		return errorsmod.Wrapf(types.ErrCommissionTooHigh, "%s is above %s", msg.CommissionRate, params.MaxCommissionRate)
+	}
+	if change := msg.CommissionRate.Sub(validator.Commission.Rate); change.GT(params.MaxCommissionChangeRate) {
+		return errorsmod.Wrapf(types.ErrCommissionChangeTooBig, "change %s is above %s", change, params.MaxCommissionChangeRate)
+	}
+	return nil
+}
+
+// Violations returns the validators that violate the policy. The policy is
+// only enforced on new staking messages, so validators created before it
+// was tightened may violate it.
+func (k Keeper) Violations(ctx context.Context) ([]types.Violation, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := k.stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []types.Violation
+	for _, validator := range validators {
+		reasons, _, err := k.checkValidator(ctx, params, validator)
+		if err != nil {
+			return nil, err
+		}
+		if len(reasons) > 0 {
+			violations = append(violations, types.Violation{ValidatorAddress: validator.GetOperator(), Reasons: reasons})
+		}
+	}
+	return violations, nil
+}
+
+// EnforcePolicy reports the validators that violate the policy and, if
+// adjust is set, brings them in line with it: commission rates and max
+// change rates above the policy are lowered, and min self delegations below
+// it are raised where the self bond covers them. A self bond below the
+// policy cannot be adjusted and is only reported. It is meant to be run by
+// upgrade handlers that tighten the policy.
+func (k Keeper) EnforcePolicy(ctx context.Context, adjust bool) ([]types.Violation, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := k.stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []types.Violation
+	for _, validator := range validators {
+		reasons, selfBond, err := k.checkValidator(ctx, params, validator)
+		if err != nil {
+			return nil, err
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+		violations = append(violations, types.Violation{ValidatorAddress: validator.GetOperator(), Reasons: reasons})
+
+		adjusted := false
+		if adjust {
+			if adjusted, err = k.adjustValidator(sdkCtx, params, validator, selfBond); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, reason := range reasons {
+			k.Logger(sdkCtx).Info("validator violates the policy", "validator", validator.GetOperator(), "reason", reason, "adjusted", adjusted)
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePolicyViolation,
+					sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+					sdk.NewAttribute(types.AttributeKeyReason, reason),
+					sdk.NewAttribute(types.AttributeKeyAdjusted, strconv.FormatBool(adjusted)),
+				),
+			)
+		}
+	}
+	return violations, nil
+}
+
+// checkValidator returns how validator violates the policy together with its
+// self bond.
+func (k Keeper) checkValidator(ctx context.Context, params types.Params, validator stakingtypes.Validator) ([]string, math.Int, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return nil, math.Int{}, err
+	}
+	selfBond := math.ZeroInt()
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	switch {
+	case err == nil:
+		selfBond = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	case !errors.Is(err, stakingtypes.ErrNoDelegation):
+		return nil, math.Int{}, err
+	}
+
+	var reasons []string
+	if selfBond.LT(params.MinSelfDelegation) {
+		reasons = append(reasons, fmt.Sprintf("self bond %s is below %s", selfBond, params.MinSelfDelegation))
+	}
+	if validator.MinSelfDelegation.LT(params.MinSelfDelegation) {
+		reasons = append(reasons, fmt.Sprintf("min self delegation %s is below %s", validator.MinSelfDelegation, params.MinSelfDelegation))
+	}
+	if validator.Commission.Rate.GT(params.MaxCommissionRate) {
+		reasons = append(reasons, fmt.Sprintf("commission rate %s is above %s", validator.Commission.Rate, params.MaxCommissionRate))
+	}
+	if validator.Commission.MaxChangeRate.GT(params.MaxCommissionChangeRate) {
+		reasons = append(reasons, fmt.Sprintf("commission max change rate %s is above %s", validator.Commission.MaxChangeRate, params.MaxCommissionChangeRate))
+	}
+	return reasons, selfBond, nil
+}
+
+// adjustValidator brings the commission and min self delegation of validator
+// in line with the policy and reports whether it changed anything.
+func (k Keeper) adjustValidator(ctx sdk.Context, params types.Params, validator stakingtypes.Validator, selfBond math.Int) (bool, error) {
+	adjusted := false
+	commission := validator.Commission
+	if commission.Rate.GT(params.MaxCommissionRate) {
+		commission.Rate = params.MaxCommissionRate
+		adjusted = true
+	}
+	if commission.MaxChangeRate.GT(params.MaxCommissionChangeRate) {
+		commission.MaxChangeRate = params.MaxCommissionChangeRate
+		adjusted = true
+	}
+	if adjusted {
+		commission.UpdateTime = ctx.BlockTime()
+		validator.Commission = commission
+	}
+	// raising it above the self bond would leave the validator to be jailed
+	// on its next self undelegation
+	if validator.MinSelfDelegation.LT(params.MinSelfDelegation) && selfBond.GTE(params.MinSelfDelegation) {
+		validator.MinSelfDelegation = params.MinSelfDelegation
+		adjusted = true
+	}
+
+	if !adjusted {
+		return false, nil
+	}
+	return true, k.stakingKeeper.SetValidator(ctx, validator)
+}
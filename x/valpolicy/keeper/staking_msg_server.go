@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type stakingMsgServer struct {
+	stakingtypes.MsgServer
+	keeper Keeper
+}
+
+var _ stakingtypes.MsgServer = stakingMsgServer{}
+
+// NewStakingMsgServer wraps the staking MsgServer server so that the
+// validator policy is checked before a validator is created or edited. The
+// ante handler only sees the messages of a tx, this also covers the ones
+// dispatched by x/group proposals and wasm contracts.
+func NewStakingMsgServer(keeper Keeper, server stakingtypes.MsgServer) stakingtypes.MsgServer {
+	return stakingMsgServer{MsgServer: server, keeper: keeper}
+}
+
+// CreateValidator implements stakingtypes.MsgServer.
+func (s stakingMsgServer) CreateValidator(ctx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error) {
+	if err := s.keeper.CheckCreateValidator(ctx, msg); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.CreateValidator(ctx, msg)
+}
+
+// EditValidator implements stakingtypes.MsgServer.
+func (s stakingMsgServer) EditValidator(ctx context.Context, msg *stakingtypes.MsgEditValidator) (*stakingtypes.MsgEditValidatorResponse, error) {
+	if err := s.keeper.CheckEditValidator(ctx, msg); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.EditValidator(ctx, msg)
+}
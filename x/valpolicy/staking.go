@@ -0,0 +1,54 @@
+package valpolicy
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/keeper"
+)
+
+// StakingAppModule is the staking module with the validator policy checked
+// by its Msg service, so that every MsgCreateValidator and MsgEditValidator
+// is checked however it is dispatched.
+type StakingAppModule struct {
+	staking.AppModule
+
+	keeper keeper.Keeper
+}
+
+// NewStakingAppModule returns the staking module am checking the policy of k.
+func NewStakingAppModule(am staking.AppModule, k keeper.Keeper) StakingAppModule {
+	return StakingAppModule{AppModule: am, keeper: k}
+}
+
+// RegisterServices registers the staking module's services with its Msg
+// service wrapped by keeper.NewStakingMsgServer.
+func (am StakingAppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(stakingConfigurator{Configurator: cfg, keeper: am.keeper})
+}
+
+// stakingConfigurator hands the staking module a Msg server that wraps the
+// staking MsgServer it registers.
+type stakingConfigurator struct {
+	module.Configurator
+	keeper keeper.Keeper
+}
+
+func (c stakingConfigurator) MsgServer() gogogrpc.Server {
+	return stakingMsgRegistrar{Server: c.Configurator.MsgServer(), keeper: c.keeper}
+}
+
+type stakingMsgRegistrar struct {
+	gogogrpc.Server
+	keeper keeper.Keeper
+}
+
+func (r stakingMsgRegistrar) RegisterService(sd *grpc.ServiceDesc, impl any) {
+	if server, ok := impl.(stakingtypes.MsgServer); ok {
+		impl = keeper.NewStakingMsgServer(r.keeper, server)
+	}
+	r.Server.RegisterService(sd, impl)
+}
@@ -0,0 +1,11 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/valpolicy module sentinel errors
+var (
+	ErrInvalidParams          = errorsmod.Register(ModuleName, 2, "invalid params")
+	ErrSelfDelegationTooLow   = errorsmod.Register(ModuleName, 3, "self delegation below the validator policy")
+	ErrCommissionTooHigh      = errorsmod.Register(ModuleName, 4, "commission rate above the validator policy")
+	ErrCommissionChangeTooBig = errorsmod.Register(ModuleName, 5, "commission change rate above the validator policy")
+)
@@ -0,0 +1,10 @@
+package types
+
+// valpolicy module event types and attributes
+const (
+	EventTypePolicyViolation = "validator_policy_violation"
+
+	AttributeKeyValidator = "validator"
+	AttributeKeyReason    = "reason"
+	AttributeKeyAdjusted  = "adjusted"
+)
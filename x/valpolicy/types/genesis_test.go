@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/valpolicy/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr string
+	}{
+		{"default", types.DefaultParams(), ""},
+		{"valid", types.NewParams(math.NewInt(1_000), math.LegacyOneDec(), math.LegacyOneDec()), ""},
+		{"zero min self delegation", types.NewParams(math.ZeroInt(), math.LegacyOneDec(), math.LegacyOneDec()), "min self delegation"},
+		{"nil min self delegation", types.Params{MaxCommissionRate: math.LegacyOneDec(), MaxCommissionChangeRate: math.LegacyOneDec()}, "min self delegation"},
+		{"max commission above one", types.NewParams(math.OneInt(), math.LegacyNewDec(2), math.LegacyOneDec()), "max commission rate"},
+		{"negative max commission", types.NewParams(math.OneInt(), math.LegacyNewDec(-1), math.LegacyOneDec()), "max commission rate"},
+		{"zero max change", types.NewParams(math.OneInt(), math.LegacyOneDec(), math.LegacyZeroDec()), "max commission change rate"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Params: tc.params}.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.Contains(err.Error(), tc.expErr), err.Error())
+			}
+		})
+	}
+}
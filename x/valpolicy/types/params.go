@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultMinSelfDelegation only requires the self bond the staking
+	// module requires until governance raises it.
+	DefaultMinSelfDelegation = math.OneInt()
+	// DefaultMaxCommissionRate matches the max rate gentx uses by default.
+	DefaultMaxCommissionRate = math.LegacyNewDecWithPrec(2, 1)
+	// DefaultMaxCommissionChangeRate matches the max change rate gentx uses
+	// by default.
+	DefaultMaxCommissionChangeRate = math.LegacyNewDecWithPrec(1, 2)
+)
+
+// NewParams creates a new Params instance.
+func NewParams(minSelfDelegation math.Int, maxCommissionRate, maxCommissionChangeRate math.LegacyDec) Params {
+	return Params{
+		MinSelfDelegation:       minSelfDelegation,
+		MaxCommissionRate:       maxCommissionRate,
+		MaxCommissionChangeRate: maxCommissionChangeRate,
+	}
+}
+
+// DefaultParams returns the default valpolicy parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMinSelfDelegation, DefaultMaxCommissionRate, DefaultMaxCommissionChangeRate)
+}
+
+// Validate performs basic validation of the valpolicy parameters.
+func (p Params) Validate() error {
+	if p.MinSelfDelegation.IsNil() || !p.MinSelfDelegation.IsPositive() {
+		return fmt.Errorf("min self delegation must be positive: %s", p.MinSelfDelegation)
+	}
+	if p.MaxCommissionRate.IsNil() || p.MaxCommissionRate.IsNegative() || p.MaxCommissionRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max commission rate must be between 0 and 1: %s", p.MaxCommissionRate)
+	}
+	if p.MaxCommissionChangeRate.IsNil() || !p.MaxCommissionChangeRate.IsPositive() || p.MaxCommissionChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max commission change rate must be positive and at most 1: %s", p.MaxCommissionChangeRate)
+	}
+	return nil
+}
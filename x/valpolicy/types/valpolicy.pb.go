@@ -0,0 +1,663 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/valpolicy/v1/valpolicy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the policy validators are held to when they are created or
+// edited.
+type Params struct {
+	// min_self_delegation is the least self bond, in the bond denom, a
+	// validator is created with and the least min self delegation it may
+	// declare.
+	MinSelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_delegation"`
+	// max_commission_rate is the highest commission rate a validator may
+	// charge.
+	MaxCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate"`
+	// max_commission_change_rate is the most a validator may raise its
+	// commission rate by in a day, the staking module allows one change every
+	// 24 hours. Lowering the rate is not capped.
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1ab3ecc55910e98, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// Violation lists the ways a validator violates the policy.
+type Violation struct {
+	ValidatorAddress string   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Reasons          []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *Violation) Reset()         { *m = Violation{} }
+func (m *Violation) String() string { return proto.CompactTextString(m) }
+func (*Violation) ProtoMessage()    {}
+func (*Violation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1ab3ecc55910e98, []int{1}
+}
+func (m *Violation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Violation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Violation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Violation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Violation.Merge(m, src)
+}
+func (m *Violation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Violation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Violation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Violation proto.InternalMessageInfo
+
+func (m *Violation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *Violation) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hippo.valpolicy.v1.Params")
+	proto.RegisterType((*Violation)(nil), "hippo.valpolicy.v1.Violation")
+}
+
+func init() {
+	proto.RegisterFile("hippo/valpolicy/v1/valpolicy.proto", fileDescriptor_f1ab3ecc55910e98)
+}
+
+var fileDescriptor_f1ab3ecc55910e98 = []byte{
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x3f, 0x6b, 0xdb, 0x40,
+	0x18, 0xc6, 0x2d, 0x07, 0x52, 0x7c, 0x53, 0xad, 0xb4, 0x54, 0x75, 0x89, 0x92, 0x7a, 0x0a, 0x01,
+	0x4b, 0x0d, 0x85, 0x42, 0xbb, 0x35, 0xf1, 0x12, 0x28, 0xa5, 0xd8, 0x90, 0xa1, 0x8b, 0xfa, 0xe6,
+	0x74, 0x96, 0x8e, 0xde, 0xdd, 0x2b, 0xee, 0x2e, 0xc2, 0xfe, 0x0a, 0x9d, 0xfa, 0x31, 0x3a, 0x66,
+	0xc8, 0x87, 0xc8, 0x18, 0x32, 0x95, 0x0e, 0xa1, 0xd8, 0xd0, 0x7c, 0x8d, 0x92, 0x3b, 0x39, 0x7f,
+	0xdc, 0xb1, 0x8b, 0xd0, 0xf3, 0xdc, 0xc3, 0xf3, 0x93, 0xde, 0xf7, 0x48, 0xbf, 0xe4, 0x55, 0x85,
+	0x69, 0x0d, 0xa2, 0x42, 0xc1, 0xe9, 0x2c, 0xad, 0xf7, 0xee, 0x44, 0x52, 0x69, 0xb4, 0x18, 0x86,
+	0x2e, 0x93, 0xdc, 0xd9, 0xf5, 0x5e, 0xaf, 0x0b, 0x92, 0x2b, 0x4c, 0xdd, 0xd3, 0xc7, 0x7a, 0xcf,
+	0x29, 0x1a, 0x89, 0x26, 0x73, 0x2a, 0xf5, 0xa2, 0x39, 0x7a, 0x52, 0x60, 0x81, 0xde, 0xbf, 0x79,
+	0xf3, 0x6e, 0xff, 0x4f, 0x9b, 0xac, 0x7f, 0x02, 0x0d, 0xd2, 0x84, 0x5f, 0xc8, 0x86, 0xe4, 0x2a,
+	0x33, 0x4c, 0x4c, 0xb2, 0x9c, 0x09, 0x56, 0x80, 0xe5, 0xa8, 0xa2, 0x60, 0x3b, 0xd8, 0xe9, 0xec,
+	0xbf, 0x3a, 0xbf, 0xda, 0x6a, 0xfd, 0xba, 0xda, 0x7a, 0xea, 0x3b, 0x4d, 0xfe, 0x35, 0xe1, 0x98,
+	0x4a, 0xb0, 0x65, 0x72, 0xa8, 0xec, 0xe5, 0xd9, 0x80, 0x34, 0xb0, 0x43, 0x65, 0x7f, 0x5c, 0x9f,
+	0xee, 0x06, 0xa3, 0xae, 0xe4, 0x6a, 0xcc, 0xc4, 0x64, 0x78, 0x5b, 0x15, 0x4e, 0xc8, 0x86, 0x84,
+	0x69, 0x46, 0x51, 0x4a, 0x6e, 0x0c, 0x47, 0x95, 0x69, 0xb0, 0x2c, 0x6a, 0x3b, 0xc2, 0x9b, 0x86,
+	0xf0, 0xe2, 0x5f, 0xc2, 0x07, 0x56, 0x00, 0x9d, 0x0d, 0x19, 0xbd, 0xc7, 0x19, 0x32, 0xba, 0xe4,
+	0xc0, 0xf4, 0xe0, 0xb6, 0x71, 0x04, 0x96, 0x85, 0x86, 0xf4, 0x56, 0x38, 0xb4, 0x04, 0x55, 0x30,
+	0x8f, 0x5b, 0xfb, 0x2f, 0xdc, 0xb3, 0x07, 0xb8, 0x03, 0xd7, 0x7b, 0x03, 0x7d, 0xb7, 0xf9, 0xed,
+	0xfa, 0x74, 0x37, 0xf2, 0xab, 0x9c, 0xde, 0x5b, 0xa6, 0x9f, 0x6e, 0xff, 0x84, 0x74, 0x8e, 0x38,
+	0x0a, 0x3f, 0x88, 0x8f, 0xa4, 0x5b, 0x83, 0xe0, 0x39, 0x58, 0xd4, 0x19, 0xe4, 0xb9, 0x66, 0xc6,
+	0x34, 0x83, 0x7e, 0x79, 0x79, 0x36, 0xd8, 0x6c, 0xa0, 0x47, 0xcb, 0xcc, 0x7b, 0x1f, 0x19, 0x5b,
+	0xcd, 0x55, 0x31, 0x7a, 0x5c, 0xaf, 0xf8, 0x61, 0x44, 0x1e, 0x69, 0x06, 0x06, 0x95, 0x89, 0xda,
+	0xdb, 0x6b, 0x3b, 0x9d, 0xd1, 0x52, 0xee, 0x8f, 0xcf, 0xe7, 0x71, 0x70, 0x31, 0x8f, 0x83, 0xdf,
+	0xf3, 0x38, 0xf8, 0xbe, 0x88, 0x5b, 0x17, 0x8b, 0xb8, 0xf5, 0x73, 0x11, 0xb7, 0x3e, 0xbf, 0x2d,
+	0xb8, 0x2d, 0x4f, 0x8e, 0x13, 0x8a, 0x32, 0x75, 0x5f, 0x4d, 0x35, 0xd8, 0x41, 0x0e, 0xe8, 0xd5,
+	0xc0, 0xdd, 0x0f, 0x8a, 0xe2, 0xc1, 0xcf, 0xd8, 0x59, 0xc5, 0xcc, 0xf1, 0xba, 0x3b, 0x7b, 0xfd,
+	0x37, 0x00, 0x00, 0xff, 0xff, 0x54, 0xb0, 0x62, 0x00, 0xb9, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Violation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Violation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Violation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintValpolicy(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintValpolicy(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValpolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValpolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovValpolicy(uint64(l))
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovValpolicy(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovValpolicy(uint64(l))
+	return n
+}
+
+func (m *Violation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovValpolicy(uint64(l))
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovValpolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovValpolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValpolicy(x uint64) (n int) {
+	return sovValpolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Violation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Violation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Violation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValpolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValpolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValpolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValpolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValpolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValpolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValpolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValpolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,51 @@
+package zk
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.zk.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the zk module parameters and gas schedule",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "hippo.zk.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "VerifyGroth16",
+					Use:       "verify-groth16 [verifying-key] [proof]",
+					Short:     "Verify a Groth16 proof over BN254 on chain",
+					Long:      "Verify a Groth16 proof over BN254 on chain. The verifying key and proof may be given as hex, base64 or a file path, public inputs through repeated --public-inputs flags.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "verifying_key"},
+						{ProtoField: "proof"},
+					},
+				},
+				{
+					RpcMethod: "VerifyBulletproof",
+					Use:       "verify-bulletproof [proof] [bit-size] [transcript-label] [commitments]...",
+					Short:     "Verify a Bulletproofs range proof on chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proof"},
+						{ProtoField: "bit_size"},
+						{ProtoField: "transcript_label"},
+						{ProtoField: "commitments", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
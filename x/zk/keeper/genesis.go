@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+// InitGenesis initializes the zk module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	return k.Params.Set(ctx, gs.Params)
+}
+
+// ExportGenesis exports the zk module state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{Params: params}, nil
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hippocrat-dao/hippo-protocol/app"
+	"github.com/hippocrat-dao/hippo-protocol/types/consensus"
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/keeper"
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+// rangeProofHex proves 1037578891 is a 32 bit value committed to by
+// commitmentHex under the "doctest example" transcript.
+const (
+	rangeProofHex = "8026afd76427529f11bcc07e29a182e3122bab7595b61237dda31548ba96cc3e4a84148c615bb889cd99bab5519e2e7d815a2469b76b5e6bf56c1051264f9b5b0a75a84e179a21b7701de8b744612ecd96b5e73f2ad4ffda4dde5a0bf0fa5b4490bd0c7ec41b331068f3db152278f5147c876201e741a6817616ece7c58a6507a7736c1fc341bb3ab65cc6e7196855a42eed503f04b56b190fced87eab134400c9fdcb1eb43fc7fed2882b2f56b9eea62ce8a024bca4f23aa4d70afb323d4c0ad3a38d409012207bb35e174a112794008d2c3f8a0d7f4282ab718493096da30d5e432f7917f017e4ee80191990aed9a51d404700c1e441ef3c46e83129aa2f5b4a1047757dc4ce4c11d1ea429c7a95dd95bc13f7c9fd5b4c64c5aa97040948142a72e57ef4658bf2894029fc69dcd893fe5bf72d90aced60e2b4608b0bfa6a06f26414c843a86df58d95f92c1904565898262d1170ad70252445bd883ec208415ef350cb0515a602d37cbb668d78e6f6211fa4caf338513c5e551f3a36b33214c89e9681301b830da28be02204d062ca19b2edacd56fa5ce4c7e1d0a9f1fd85f7049fe27dffc601b41f35dce8b0f61b3c92a8f51ab40299e6bf452c81d95ee1880dede9a6da64b3237451715c8da5970296d3b34b0c9b585b355f31e2b71c46cd49fe004d2ec5371b3029ee2d6d0881d90d73ac81b1d16a82a74f46e36b14e33a6abaf35b81fdccbe00031d8c5918974f53d35973cd7077b839c2dbfcead70236581065006dbb5f1e1541fa6226e172e0e9471a7a0a1ed5aa627d26e9aac140f0b2ddee38a4502fe9f6327e81fdb849cd7c7698e9add48aecab22f512b56fd0b"
+	commitmentHex = "5e50cca6bdd5d8c04e1a2848d74d885647d93b883cb4f182fbb5e3bdbf00506c"
+	transcript    = "doctest example"
+
+	// basepointHex is the Ristretto basepoint, a valid commitment to another value.
+	basepointHex = "e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.App
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	authority   string
+
+	sender sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupSuite() {
+	consensus.SetWalletConfig()
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(s.T().TempDir()), app.EmptyWasmOptions)
+	s.ctx = s.app.NewContextLegacy(true, cmtproto.Header{Height: 1, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.Require().NoError(s.app.ZKKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.app.ZKKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.app.ZKKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.sender = sdk.AccAddress([]byte("sender______________"))
+}
+
+func (s *KeeperTestSuite) decodeHex(str string) []byte {
+	bz, err := hex.DecodeString(str)
+	s.Require().NoError(err)
+	return bz
+}
+
+// freshGasCtx returns the suite context with an empty gas meter.
+func (s *KeeperTestSuite) freshGasCtx() sdk.Context {
+	return s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+}
+
+func (s *KeeperTestSuite) TestVerifyBulletproof() {
+	ctx := s.freshGasCtx()
+	_, err := s.msgServer.VerifyBulletproof(ctx, &types.MsgVerifyBulletproof{
+		Sender:          s.sender.String(),
+		Proof:           s.decodeHex(rangeProofHex),
+		Commitments:     [][]byte{s.decodeHex(commitmentHex)},
+		BitSize:         32,
+		TranscriptLabel: transcript,
+	})
+	s.Require().NoError(err)
+
+	var found bool
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeVerifyProof {
+			continue
+		}
+		found = true
+		system, ok := ev.GetAttribute(types.AttributeKeyProofSystem)
+		s.Require().True(ok)
+		s.Require().Equal(types.ProofSystemBulletproof, system.Value)
+	}
+	s.Require().True(found, "verify_proof event should be emitted")
+}
+
+func (s *KeeperTestSuite) TestVerifyBulletproofFails() {
+	msg := &types.MsgVerifyBulletproof{
+		Sender:          s.sender.String(),
+		Proof:           s.decodeHex(rangeProofHex),
+		Commitments:     [][]byte{s.decodeHex(basepointHex)},
+		BitSize:         32,
+		TranscriptLabel: transcript,
+	}
+	_, err := s.msgServer.VerifyBulletproof(s.freshGasCtx(), msg)
+	s.Require().ErrorIs(err, types.ErrVerificationFailed)
+
+	msg.Proof = msg.Proof[:len(msg.Proof)-1]
+	_, err = s.msgServer.VerifyBulletproof(s.freshGasCtx(), msg)
+	s.Require().ErrorIs(err, types.ErrInvalidProof)
+
+	msg.Proof = s.decodeHex(rangeProofHex)
+	msg.BitSize = 128
+	_, err = s.msgServer.VerifyBulletproof(s.freshGasCtx(), msg)
+	s.Require().ErrorIs(err, types.ErrInvalidProof)
+}
+
+func (s *KeeperTestSuite) TestGasIsDeterministic() {
+	params := types.DefaultParams()
+	proof := s.decodeHex(rangeProofHex)
+
+	validCtx := s.freshGasCtx()
+	s.Require().NoError(s.app.ZKKeeper.VerifyBulletproof(validCtx, proof, [][]byte{s.decodeHex(commitmentHex)}, 32, transcript))
+
+	invalidCtx := s.freshGasCtx()
+	s.Require().Error(s.app.ZKKeeper.VerifyBulletproof(invalidCtx, proof, [][]byte{s.decodeHex(basepointHex)}, 32, transcript))
+
+	s.Require().Equal(params.BulletproofGas(32, 1), validCtx.GasMeter().GasConsumed()-s.storeGas())
+	s.Require().Equal(validCtx.GasMeter().GasConsumed(), invalidCtx.GasMeter().GasConsumed(), "failing proofs must pay the same gas")
+
+	groth16Ctx := s.freshGasCtx()
+	inputs := [][]byte{make([]byte, 32), make([]byte, 32)}
+	s.Require().ErrorIs(s.app.ZKKeeper.VerifyGroth16(groth16Ctx, nil, nil, inputs), types.ErrInvalidProof)
+	s.Require().Equal(params.Groth16Gas(len(inputs)), groth16Ctx.GasMeter().GasConsumed()-s.storeGas())
+}
+
+// storeGas returns the gas a fresh context spends reading the params.
+func (s *KeeperTestSuite) storeGas() uint64 {
+	ctx := s.freshGasCtx()
+	_, err := s.app.ZKKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	return ctx.GasMeter().GasConsumed()
+}
+
+func (s *KeeperTestSuite) TestVerifyGroth16TooManyInputs() {
+	params := types.DefaultParams()
+	inputs := make([][]byte, params.MaxPublicInputs+1)
+
+	_, err := s.msgServer.VerifyGroth16(s.ctx, &types.MsgVerifyGroth16{
+		Sender:       s.sender.String(),
+		PublicInputs: inputs,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidProof)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.Groth16BaseGas = 50_000
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.sender.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.MaxPublicInputs = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestExportGenesis() {
+	genesis, err := s.app.ZKKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultGenesisState(), genesis)
+}
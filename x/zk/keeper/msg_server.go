@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the zk MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// VerifyGroth16 implements types.MsgServer.
+func (k msgServer) VerifyGroth16(goCtx context.Context, msg *types.MsgVerifyGroth16) (*types.MsgVerifyGroth16Response, error) {
+	if err := k.Keeper.VerifyGroth16(goCtx, msg.VerifyingKey, msg.Proof, msg.PublicInputs); err != nil {
+		return nil, err
+	}
+
+	emitVerifyEvent(goCtx, msg.Sender, types.ProofSystemGroth16, msg.Proof)
+	return &types.MsgVerifyGroth16Response{}, nil
+}
+
+// VerifyBulletproof implements types.MsgServer.
+func (k msgServer) VerifyBulletproof(goCtx context.Context, msg *types.MsgVerifyBulletproof) (*types.MsgVerifyBulletproofResponse, error) {
+	if err := k.Keeper.VerifyBulletproof(goCtx, msg.Proof, msg.Commitments, msg.BitSize, msg.TranscriptLabel); err != nil {
+		return nil, err
+	}
+
+	emitVerifyEvent(goCtx, msg.Sender, types.ProofSystemBulletproof, msg.Proof)
+	return &types.MsgVerifyBulletproofResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// emitVerifyEvent records a successful verification, identifying the proof by
+// its sha256 hash.
+func emitVerifyEvent(goCtx context.Context, sender, proofSystem string, proof []byte) {
+	hash := sha256.Sum256(proof)
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVerifyProof,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyProofSystem, proofSystem),
+			sdk.NewAttribute(types.AttributeKeyProofHash, hex.EncodeToString(hash[:])),
+		),
+	)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/verifier"
+)
+
+// VerifyGroth16 charges the Groth16 verification gas and verifies the proof.
+// Gas is charged before verifying so failing proofs pay the same price.
+func (k Keeper) VerifyGroth16(ctx context.Context, verifyingKey, proof []byte, publicInputs [][]byte) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(publicInputs) > int(params.MaxPublicInputs) {
+		return errorsmod.Wrapf(types.ErrInvalidProof, "%d public inputs exceed the maximum of %d", len(publicInputs), params.MaxPublicInputs)
+	}
+
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(params.Groth16Gas(len(publicInputs)), "groth16 verification")
+
+	return wrapVerifierError(verifier.VerifyGroth16(verifyingKey, proof, publicInputs))
+}
+
+// VerifyBulletproof charges the range proof verification gas and verifies the
+// proof. Gas is charged before verifying so failing proofs pay the same price.
+func (k Keeper) VerifyBulletproof(ctx context.Context, proof []byte, commitments [][]byte, bitSize uint32, transcriptLabel string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if bitSize > verifier.MaxBulletproofGensCapacity || len(commitments) > verifier.MaxAggregatedCommitments {
+		return errorsmod.Wrapf(types.ErrInvalidProof, "range proof over %d commitments of %d bits is not supported", len(commitments), bitSize)
+	}
+
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(params.BulletproofGas(int(bitSize), len(commitments)), "bulletproof verification")
+
+	return wrapVerifierError(verifier.VerifyRangeProof(proof, commitments, int(bitSize), transcriptLabel))
+}
+
+// wrapVerifierError maps verifier errors onto the module errors, telling
+// malformed input apart from proofs that do not verify.
+func wrapVerifierError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errorsmod.IsOf(err, verifier.ErrGroth16Failed, verifier.ErrBulletproofFailed):
+		return errorsmod.Wrap(types.ErrVerificationFailed, err.Error())
+	default:
+		return errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+}
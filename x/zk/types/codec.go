@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the zk messages on the amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgVerifyGroth16{}, "hippo/x/zk/MsgVerifyGroth16")
+	legacy.RegisterAminoMsg(cdc, &MsgVerifyBulletproof{}, "hippo/x/zk/MsgVerifyBulletproof")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hippo/x/zk/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "hippo/x/zk/Params", nil)
+}
+
+// RegisterInterfaces registers the zk messages on the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgVerifyGroth16{},
+		&MsgVerifyBulletproof{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
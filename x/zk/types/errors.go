@@ -0,0 +1,10 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/zk module sentinel errors
+var (
+	ErrInvalidProof       = errorsmod.Register(ModuleName, 2, "invalid proof input")
+	ErrVerificationFailed = errorsmod.Register(ModuleName, 3, "proof verification failed")
+	ErrInvalidParams      = errorsmod.Register(ModuleName, 4, "invalid params")
+)
@@ -0,0 +1,13 @@
+package types
+
+// zk module event types and attributes
+const (
+	EventTypeVerifyProof = "verify_proof"
+
+	AttributeKeySender      = "sender"
+	AttributeKeyProofSystem = "proof_system"
+	AttributeKeyProofHash   = "proof_hash"
+
+	ProofSystemGroth16     = "groth16_bn254"
+	ProofSystemBulletproof = "bulletproofs"
+)
@@ -0,0 +1,11 @@
+package types
+
+// DefaultGenesisState returns the default zk genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "zk"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var ParamsKey = collections.NewPrefix(0)
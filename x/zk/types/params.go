@@ -0,0 +1,67 @@
+package types
+
+import "fmt"
+
+// Default gas schedule. The costs are calibrated against the 1000 gas charged
+// for a secp256k1 signature verification: a Groth16 verification takes about
+// thirty signature verifications worth of CPU time and a 32 bit range proof
+// about forty.
+const (
+	DefaultGroth16BaseGas       uint64 = 30_000
+	DefaultGroth16PerInputGas   uint64 = 1_000
+	DefaultBulletproofBaseGas   uint64 = 10_000
+	DefaultBulletproofPerBitGas uint64 = 1_000
+	DefaultMaxPublicInputs      uint32 = 32
+)
+
+// NewParams creates a new Params instance.
+func NewParams(groth16BaseGas, groth16PerInputGas, bulletproofBaseGas, bulletproofPerBitGas uint64, maxPublicInputs uint32) Params {
+	return Params{
+		Groth16BaseGas:       groth16BaseGas,
+		Groth16PerInputGas:   groth16PerInputGas,
+		BulletproofBaseGas:   bulletproofBaseGas,
+		BulletproofPerBitGas: bulletproofPerBitGas,
+		MaxPublicInputs:      maxPublicInputs,
+	}
+}
+
+// DefaultParams returns the default zk parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultGroth16BaseGas,
+		DefaultGroth16PerInputGas,
+		DefaultBulletproofBaseGas,
+		DefaultBulletproofPerBitGas,
+		DefaultMaxPublicInputs,
+	)
+}
+
+// Validate performs basic validation of the zk parameters. Verifications must
+// never be free, otherwise they could be used to stall block production.
+func (p Params) Validate() error {
+	if p.Groth16BaseGas == 0 {
+		return fmt.Errorf("groth16 base gas must be positive")
+	}
+	if p.BulletproofBaseGas == 0 {
+		return fmt.Errorf("bulletproof base gas must be positive")
+	}
+	if p.BulletproofPerBitGas == 0 {
+		return fmt.Errorf("bulletproof per bit gas must be positive")
+	}
+	if p.MaxPublicInputs == 0 {
+		return fmt.Errorf("max public inputs must be positive")
+	}
+	return nil
+}
+
+// Groth16Gas returns the gas charged for verifying a Groth16 proof with the
+// given number of public inputs.
+func (p Params) Groth16Gas(numPublicInputs int) uint64 {
+	return p.Groth16BaseGas + p.Groth16PerInputGas*uint64(numPublicInputs)
+}
+
+// BulletproofGas returns the gas charged for verifying a range proof over
+// numCommitments commitments of bitSize bits each.
+func (p Params) BulletproofGas(bitSize, numCommitments int) uint64 {
+	return p.BulletproofBaseGas + p.BulletproofPerBitGas*uint64(bitSize)*uint64(numCommitments)
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.Groth16BaseGas = 0
+	require.Error(t, params.Validate(), "free groth16 verification should be rejected")
+
+	params = types.DefaultParams()
+	params.BulletproofBaseGas = 0
+	require.Error(t, params.Validate(), "free bulletproof verification should be rejected")
+
+	params = types.DefaultParams()
+	params.BulletproofPerBitGas = 0
+	require.Error(t, params.Validate(), "zero per bit gas should be rejected")
+
+	params = types.DefaultParams()
+	params.MaxPublicInputs = 0
+	require.Error(t, params.Validate(), "zero max public inputs should be rejected")
+}
+
+func TestParamsGas(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, uint64(30_000), params.Groth16Gas(0))
+	require.Equal(t, uint64(33_000), params.Groth16Gas(3))
+	require.Equal(t, uint64(42_000), params.BulletproofGas(32, 1))
+	require.Equal(t, uint64(138_000), params.BulletproofGas(64, 2))
+}
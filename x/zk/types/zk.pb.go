@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hippo/zk/v1/zk.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the zk module. Verification gas only
+// depends on the declared size of the statement, so the cost of a
+// verification is known before it runs and is the same on every node.
+type Params struct {
+	// groth16_base_gas is charged for every Groth16 verification.
+	Groth16BaseGas uint64 `protobuf:"varint,1,opt,name=groth16_base_gas,json=groth16BaseGas,proto3" json:"groth16_base_gas,omitempty"`
+	// groth16_per_input_gas is charged for every public input.
+	Groth16PerInputGas uint64 `protobuf:"varint,2,opt,name=groth16_per_input_gas,json=groth16PerInputGas,proto3" json:"groth16_per_input_gas,omitempty"`
+	// bulletproof_base_gas is charged for every range proof verification.
+	BulletproofBaseGas uint64 `protobuf:"varint,3,opt,name=bulletproof_base_gas,json=bulletproofBaseGas,proto3" json:"bulletproof_base_gas,omitempty"`
+	// bulletproof_per_bit_gas is charged for every proven bit, that is bit size
+	// times the number of aggregated commitments.
+	BulletproofPerBitGas uint64 `protobuf:"varint,4,opt,name=bulletproof_per_bit_gas,json=bulletproofPerBitGas,proto3" json:"bulletproof_per_bit_gas,omitempty"`
+	// max_public_inputs bounds the number of Groth16 public inputs.
+	MaxPublicInputs uint32 `protobuf:"varint,5,opt,name=max_public_inputs,json=maxPublicInputs,proto3" json:"max_public_inputs,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8646caf526cf445e, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetGroth16BaseGas() uint64 {
+	if m != nil {
+		return m.Groth16BaseGas
+	}
+	return 0
+}
+
+func (m *Params) GetGroth16PerInputGas() uint64 {
+	if m != nil {
+		return m.Groth16PerInputGas
+	}
+	return 0
+}
+
+func (m *Params) GetBulletproofBaseGas() uint64 {
+	if m != nil {
+		return m.BulletproofBaseGas
+	}
+	return 0
+}
+
+func (m *Params) GetBulletproofPerBitGas() uint64 {
+	if m != nil {
+		return m.BulletproofPerBitGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPublicInputs() uint32 {
+	if m != nil {
+		return m.MaxPublicInputs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hippo.zk.v1.Params")
+}
+
+func init() { proto.RegisterFile("hippo/zk/v1/zk.proto", fileDescriptor_8646caf526cf445e) }
+
+var fileDescriptor_8646caf526cf445e = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc9, 0xc8, 0x2c, 0x28,
+	0xc8, 0xd7, 0xaf, 0xca, 0xd6, 0x2f, 0x33, 0xd4, 0xaf, 0xca, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0xe2, 0x06, 0x8b, 0xea, 0x55, 0x65, 0xeb, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6,
+	0xe5, 0xeb, 0x83, 0x49, 0x88, 0xbc, 0xd2, 0x24, 0x26, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc,
+	0x62, 0x21, 0x0d, 0x2e, 0x81, 0xf4, 0xa2, 0xfc, 0x92, 0x0c, 0x43, 0xb3, 0xf8, 0xa4, 0xc4, 0xe2,
+	0xd4, 0xf8, 0xf4, 0xc4, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x3e, 0xa8, 0xb8, 0x53,
+	0x62, 0x71, 0xaa, 0x7b, 0x62, 0xb1, 0x90, 0x21, 0x97, 0x28, 0x4c, 0x65, 0x41, 0x6a, 0x51, 0x7c,
+	0x66, 0x5e, 0x41, 0x69, 0x09, 0x58, 0x39, 0x13, 0x58, 0xb9, 0x10, 0x54, 0x32, 0x20, 0xb5, 0xc8,
+	0x13, 0x24, 0x05, 0xd2, 0x62, 0xc0, 0x25, 0x92, 0x54, 0x9a, 0x93, 0x93, 0x5a, 0x52, 0x50, 0x94,
+	0x9f, 0x9f, 0x86, 0xb0, 0x80, 0x19, 0xa2, 0x03, 0x49, 0x0e, 0x66, 0x89, 0x29, 0x97, 0x38, 0xb2,
+	0x0e, 0x90, 0x45, 0x49, 0x99, 0x10, 0x6b, 0x58, 0xc0, 0x9a, 0x90, 0x0d, 0x0c, 0x48, 0x2d, 0x72,
+	0xca, 0x04, 0x5b, 0xa4, 0xc5, 0x25, 0x98, 0x9b, 0x58, 0x11, 0x5f, 0x50, 0x9a, 0x94, 0x93, 0x99,
+	0x0c, 0x71, 0x5a, 0xb1, 0x04, 0xab, 0x02, 0xa3, 0x06, 0x6f, 0x10, 0x7f, 0x6e, 0x62, 0x45, 0x00,
+	0x58, 0x1c, 0xec, 0xac, 0x62, 0x2b, 0xb1, 0xae, 0xe7, 0x1b, 0xb4, 0x04, 0x21, 0xe1, 0x56, 0x01,
+	0x0a, 0x39, 0x48, 0x48, 0x38, 0xf9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
+	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
+	0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x58, 0x5f, 0x72,
+	0x51, 0x62, 0x89, 0x6e, 0x4a, 0x62, 0x3e, 0x84, 0xa7, 0x0b, 0x0e, 0xd4, 0xe4, 0xfc, 0x1c, 0x88,
+	0x71, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x41, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0xad, 0xaf, 0x70, 0x27, 0xa1, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPublicInputs != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.MaxPublicInputs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BulletproofPerBitGas != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.BulletproofPerBitGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BulletproofBaseGas != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.BulletproofBaseGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Groth16PerInputGas != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.Groth16PerInputGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Groth16BaseGas != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.Groth16BaseGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintZk(dAtA []byte, offset int, v uint64) int {
+	offset -= sovZk(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Groth16BaseGas != 0 {
+		n += 1 + sovZk(uint64(m.Groth16BaseGas))
+	}
+	if m.Groth16PerInputGas != 0 {
+		n += 1 + sovZk(uint64(m.Groth16PerInputGas))
+	}
+	if m.BulletproofBaseGas != 0 {
+		n += 1 + sovZk(uint64(m.BulletproofBaseGas))
+	}
+	if m.BulletproofPerBitGas != 0 {
+		n += 1 + sovZk(uint64(m.BulletproofPerBitGas))
+	}
+	if m.MaxPublicInputs != 0 {
+		n += 1 + sovZk(uint64(m.MaxPublicInputs))
+	}
+	return n
+}
+
+func sovZk(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozZk(x uint64) (n int) {
+	return sovZk(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16BaseGas", wireType)
+			}
+			m.Groth16BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Groth16BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groth16PerInputGas", wireType)
+			}
+			m.Groth16PerInputGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Groth16PerInputGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BulletproofBaseGas", wireType)
+			}
+			m.BulletproofBaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BulletproofBaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BulletproofPerBitGas", wireType)
+			}
+			m.BulletproofPerBitGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BulletproofPerBitGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPublicInputs", wireType)
+			}
+			m.MaxPublicInputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPublicInputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipZk(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthZk
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupZk
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthZk
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthZk        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowZk          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupZk = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,397 @@
+package verifier
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sync"
+
+	"github.com/gtank/merlin"
+	"github.com/gtank/ristretto255"
+	"golang.org/x/crypto/sha3"
+)
+
+// The range proof verifier follows the dalek-cryptography bulletproofs
+// construction (transcript protocol, generators and wire format), so proofs
+// created with the Rust crate, including inside contracts, verify natively.
+
+const (
+	pointSize  = 32
+	scalarSize = 32
+
+	// MaxBulletproofGensCapacity is the largest bit size a range proof may use.
+	MaxBulletproofGensCapacity = 64
+)
+
+var (
+	ErrInvalidBulletproof = errors.New("invalid bulletproof")
+	ErrBulletproofFailed  = errors.New("bulletproof verification failed")
+)
+
+// RangeProof is a decoded dalek-compatible aggregated range proof.
+type RangeProof struct {
+	A, S, T1, T2              []byte
+	Tx, TxBlinding, EBlinding *ristretto255.Scalar
+	L, R                      [][]byte
+	IPPa, IPPb                *ristretto255.Scalar
+}
+
+// DecodeRangeProof parses the byte encoding of a range proof: A, S, T_1, T_2,
+// t_x, t_x_blinding, e_blinding followed by the interleaved L and R points of
+// the inner product proof and its final a and b scalars.
+func DecodeRangeProof(bz []byte) (*RangeProof, error) {
+	if len(bz)%32 != 0 || len(bz) < 7*32+2*scalarSize {
+		return nil, fmt.Errorf("%w: unexpected length %d", ErrInvalidBulletproof, len(bz))
+	}
+
+	proof := &RangeProof{
+		A:  bz[0:32],
+		S:  bz[32:64],
+		T1: bz[64:96],
+		T2: bz[96:128],
+	}
+
+	var err error
+	if proof.Tx, err = decodeScalar(bz[128:160]); err != nil {
+		return nil, err
+	}
+	if proof.TxBlinding, err = decodeScalar(bz[160:192]); err != nil {
+		return nil, err
+	}
+	if proof.EBlinding, err = decodeScalar(bz[192:224]); err != nil {
+		return nil, err
+	}
+
+	ipp := bz[224:]
+	elements := len(ipp) / 32
+	if (elements-2)%2 != 0 {
+		return nil, fmt.Errorf("%w: malformed inner product proof", ErrInvalidBulletproof)
+	}
+	lgN := (elements - 2) / 2
+	if lgN >= 32 {
+		return nil, fmt.Errorf("%w: inner product proof too large", ErrInvalidBulletproof)
+	}
+	for i := 0; i < lgN; i++ {
+		proof.L = append(proof.L, ipp[2*i*32:(2*i+1)*32])
+		proof.R = append(proof.R, ipp[(2*i+1)*32:(2*i+2)*32])
+	}
+	pos := 2 * lgN * 32
+	if proof.IPPa, err = decodeScalar(ipp[pos : pos+32]); err != nil {
+		return nil, err
+	}
+	if proof.IPPb, err = decodeScalar(ipp[pos+32 : pos+64]); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// VerifyRangeProof verifies that every commitment opens to a value in
+// [0, 2^numBits) under the given transcript label. A single proof covers all
+// commitments, whose number must be a power of two.
+func VerifyRangeProof(proofBz []byte, commitments [][]byte, numBits int, label string) error {
+	if numBits != 8 && numBits != 16 && numBits != 32 && numBits != 64 {
+		return fmt.Errorf("%w: unsupported bit size %d", ErrInvalidBulletproof, numBits)
+	}
+	m := len(commitments)
+	if m == 0 || m > MaxAggregatedCommitments || m&(m-1) != 0 {
+		return fmt.Errorf("%w: number of commitments must be a power of two up to %d", ErrInvalidBulletproof, MaxAggregatedCommitments)
+	}
+
+	proof, err := DecodeRangeProof(proofBz)
+	if err != nil {
+		return err
+	}
+	nm := numBits * m
+	if 1<<len(proof.L) != nm {
+		return fmt.Errorf("%w: proof size does not match %d commitments of %d numBits", ErrInvalidBulletproof, m, numBits)
+	}
+
+	commitmentPoints := make([]*ristretto255.Element, m)
+	for i, c := range commitments {
+		if commitmentPoints[i], err = decodePoint(c); err != nil {
+			return err
+		}
+	}
+
+	t := merlin.NewTranscript(label)
+	t.AppendMessage([]byte("dom-sep"), []byte("rangeproof v1"))
+	appendU64(t, "n", uint64(numBits))
+	appendU64(t, "m", uint64(m))
+	for _, c := range commitments {
+		t.AppendMessage([]byte("V"), c)
+	}
+	if err := validateAndAppendPoint(t, "A", proof.A); err != nil {
+		return err
+	}
+	if err := validateAndAppendPoint(t, "S", proof.S); err != nil {
+		return err
+	}
+	y := challengeScalar(t, "y")
+	z := challengeScalar(t, "z")
+	if err := validateAndAppendPoint(t, "T_1", proof.T1); err != nil {
+		return err
+	}
+	if err := validateAndAppendPoint(t, "T_2", proof.T2); err != nil {
+		return err
+	}
+	x := challengeScalar(t, "x")
+	t.AppendMessage([]byte("t_x"), proof.Tx.Encode(nil))
+	t.AppendMessage([]byte("t_x_blinding"), proof.TxBlinding.Encode(nil))
+	t.AppendMessage([]byte("e_blinding"), proof.EBlinding.Encode(nil))
+	w := challengeScalar(t, "w")
+
+	xSq, xInvSq, s, err := proof.verificationScalars(nm, t)
+	if err != nil {
+		return err
+	}
+
+	// The two verification equations are combined with a batching challenge.
+	// It is derived from the transcript rather than sampled at random so every
+	// node computes the same result.
+	c := challengeScalar(t, "c")
+
+	zz := mul(z, z)
+	minusZ := ristretto255.NewScalar().Negate(z)
+	yInv := ristretto255.NewScalar().Invert(y)
+	a, b := proof.IPPa, proof.IPPb
+
+	scalars := []*ristretto255.Scalar{
+		scalarFromUint64(1),
+		x,
+		mul(c, x),
+		mul(mul(c, x), x),
+	}
+	scalars = append(scalars, xSq...)
+	scalars = append(scalars, xInvSq...)
+	scalars = append(scalars,
+		ristretto255.NewScalar().Subtract(ristretto255.NewScalar().Negate(proof.EBlinding), mul(c, proof.TxBlinding)),
+		ristretto255.NewScalar().Add(
+			mul(w, ristretto255.NewScalar().Subtract(proof.Tx, mul(a, b))),
+			mul(c, ristretto255.NewScalar().Subtract(delta(numBits, m, y, z), proof.Tx)),
+		),
+	)
+
+	// g_i = -z - a * s_i
+	for i := 0; i < nm; i++ {
+		scalars = append(scalars, ristretto255.NewScalar().Subtract(minusZ, mul(a, s[i])))
+	}
+	// h_i = z + y^-i * (z^2 * z^j * 2^k - b * s_inv_i), where s_inv is s reversed
+	two := scalarFromUint64(2)
+	yInvPow := scalarFromUint64(1)
+	zPow := scalarFromUint64(1)
+	for j := 0; j < m; j++ {
+		twoPow := scalarFromUint64(1)
+		for k := 0; k < numBits; k++ {
+			i := j*numBits + k
+			zAnd2 := mul(zPow, twoPow)
+			inner := ristretto255.NewScalar().Subtract(mul(zz, zAnd2), mul(b, s[nm-1-i]))
+			scalars = append(scalars, ristretto255.NewScalar().Add(z, mul(yInvPow, inner)))
+			yInvPow = mul(yInvPow, yInv)
+			twoPow = mul(twoPow, two)
+		}
+		zPow = mul(zPow, z)
+	}
+	// value commitment scalars c * z^2 * z^j
+	zPow = scalarFromUint64(1)
+	for j := 0; j < m; j++ {
+		scalars = append(scalars, mul(mul(c, zz), zPow))
+		zPow = mul(zPow, z)
+	}
+
+	points := make([]*ristretto255.Element, 0, len(scalars))
+	for _, bz := range [][]byte{proof.A, proof.S, proof.T1, proof.T2} {
+		p, err := decodePoint(bz)
+		if err != nil {
+			return err
+		}
+		points = append(points, p)
+	}
+	for _, group := range [][][]byte{proof.L, proof.R} {
+		for _, bz := range group {
+			p, err := decodePoint(bz)
+			if err != nil {
+				return err
+			}
+			points = append(points, p)
+		}
+	}
+	points = append(points, pedersenBlinding, ristretto255.NewElement().Base())
+	for j := 0; j < m; j++ {
+		points = append(points, generators('G', j)[:numBits]...)
+	}
+	for j := 0; j < m; j++ {
+		points = append(points, generators('H', j)[:numBits]...)
+	}
+	points = append(points, commitmentPoints...)
+
+	check := ristretto255.NewElement().VarTimeMultiScalarMult(scalars, points)
+	if check.Equal(ristretto255.NewElement().Zero()) != 1 {
+		return ErrBulletproofFailed
+	}
+	return nil
+}
+
+// verificationScalars replays the inner product argument challenges and
+// returns u_i^2, u_i^-2 and the s vector of the folded generators.
+func (p *RangeProof) verificationScalars(n int, t *merlin.Transcript) ([]*ristretto255.Scalar, []*ristretto255.Scalar, []*ristretto255.Scalar, error) {
+	lgN := len(p.L)
+
+	t.AppendMessage([]byte("dom-sep"), []byte("ipp v1"))
+	appendU64(t, "n", uint64(n))
+
+	challenges := make([]*ristretto255.Scalar, lgN)
+	for i := 0; i < lgN; i++ {
+		if err := validateAndAppendPoint(t, "L", p.L[i]); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := validateAndAppendPoint(t, "R", p.R[i]); err != nil {
+			return nil, nil, nil, err
+		}
+		challenges[i] = challengeScalar(t, "u")
+	}
+
+	allInv := scalarFromUint64(1)
+	sq := make([]*ristretto255.Scalar, lgN)
+	invSq := make([]*ristretto255.Scalar, lgN)
+	for i, u := range challenges {
+		inv := ristretto255.NewScalar().Invert(u)
+		allInv = mul(allInv, inv)
+		sq[i] = mul(u, u)
+		invSq[i] = mul(inv, inv)
+	}
+
+	s := make([]*ristretto255.Scalar, n)
+	s[0] = allInv
+	for i := 1; i < n; i++ {
+		lgI := bits.Len32(uint32(i)) - 1
+		k := 1 << lgI
+		s[i] = mul(s[i-k], sq[lgN-1-lgI])
+	}
+
+	return sq, invSq, s, nil
+}
+
+// delta computes (z - z^2) * <1, y^(nm)> - z^3 * <1, 2^n> * sum_j z^j.
+func delta(n, m int, y, z *ristretto255.Scalar) *ristretto255.Scalar {
+	sumY := sumOfPowers(y, n*m)
+	sum2 := sumOfPowers(scalarFromUint64(2), n)
+	sumZ := sumOfPowers(z, m)
+	zz := mul(z, z)
+	left := mul(ristretto255.NewScalar().Subtract(z, zz), sumY)
+	right := mul(mul(mul(zz, z), sum2), sumZ)
+	return ristretto255.NewScalar().Subtract(left, right)
+}
+
+func sumOfPowers(x *ristretto255.Scalar, n int) *ristretto255.Scalar {
+	sum := ristretto255.NewScalar()
+	pow := scalarFromUint64(1)
+	for i := 0; i < n; i++ {
+		sum.Add(sum, pow)
+		pow = mul(pow, x)
+	}
+	return sum
+}
+
+// pedersenBlinding is the blinding generator of the dalek Pedersen commitment
+// generators, derived by hashing the compressed Ristretto basepoint.
+var pedersenBlinding = func() *ristretto255.Element {
+	digest := sha3.Sum512(ristretto255.NewElement().Base().Encode(nil))
+	return ristretto255.NewElement().FromUniformBytes(digest[:])
+}()
+
+// MaxAggregatedCommitments bounds the number of commitments a single
+// aggregated range proof may cover.
+const MaxAggregatedCommitments = 16
+
+var (
+	generatorsOnce sync.Once
+	gensG, gensH   [][]*ristretto255.Element
+)
+
+// generators returns the first MaxBulletproofGensCapacity points of the G or H
+// generator chain of a party. The chains are derived once and shared.
+func generators(kind byte, party int) []*ristretto255.Element {
+	generatorsOnce.Do(func() {
+		for j := 0; j < MaxAggregatedCommitments; j++ {
+			gensG = append(gensG, generatorChain('G', j))
+			gensH = append(gensH, generatorChain('H', j))
+		}
+	})
+	if kind == 'G' {
+		return gensG[party]
+	}
+	return gensH[party]
+}
+
+// generatorChain derives the generators of a party by hashing
+// "GeneratorsChain" || label with SHAKE256 and mapping 64 byte blocks of its
+// output to points.
+func generatorChain(kind byte, party int) []*ristretto255.Element {
+	label := []byte{kind, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(label[1:], uint32(party))
+	shake := sha3.NewShake256()
+	_, _ = shake.Write([]byte("GeneratorsChain"))
+	_, _ = shake.Write(label)
+
+	points := make([]*ristretto255.Element, MaxBulletproofGensCapacity)
+	uniform := make([]byte, 64)
+	for i := range points {
+		_, _ = shake.Read(uniform)
+		points[i] = ristretto255.NewElement().FromUniformBytes(uniform)
+	}
+	return points
+}
+
+func appendU64(t *merlin.Transcript, label string, v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	t.AppendMessage([]byte(label), buf[:])
+}
+
+// validateAndAppendPoint rejects the identity point before appending it.
+func validateAndAppendPoint(t *merlin.Transcript, label string, point []byte) error {
+	if bytes.Equal(point, identityEncoding[:]) {
+		return fmt.Errorf("%w: identity point %s", ErrInvalidBulletproof, label)
+	}
+	t.AppendMessage([]byte(label), point)
+	return nil
+}
+
+var identityEncoding [pointSize]byte
+
+func challengeScalar(t *merlin.Transcript, label string) *ristretto255.Scalar {
+	return ristretto255.NewScalar().FromUniformBytes(t.ExtractBytes([]byte(label), 64))
+}
+
+func decodeScalar(bz []byte) (*ristretto255.Scalar, error) {
+	s := ristretto255.NewScalar()
+	if err := s.Decode(bz); err != nil {
+		return nil, fmt.Errorf("%w: non canonical scalar", ErrInvalidBulletproof)
+	}
+	return s, nil
+}
+
+func decodePoint(bz []byte) (*ristretto255.Element, error) {
+	p := ristretto255.NewElement()
+	if err := p.Decode(bz); err != nil {
+		return nil, fmt.Errorf("%w: invalid point encoding", ErrInvalidBulletproof)
+	}
+	return p, nil
+}
+
+func scalarFromUint64(v uint64) *ristretto255.Scalar {
+	var buf [scalarSize]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	s := ristretto255.NewScalar()
+	if err := s.Decode(buf[:]); err != nil {
+		panic(err) // unreachable, any 64 bit value is canonical
+	}
+	return s
+}
+
+func mul(x, y *ristretto255.Scalar) *ristretto255.Scalar {
+	return ristretto255.NewScalar().Multiply(x, y)
+}
@@ -0,0 +1,75 @@
+package verifier_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/verifier"
+)
+
+// rangeProofHex proves 1037578891 is a 32 bit value under the "doctest example"
+// transcript. It is the same proof the bulletproof contract of the e2e suite
+// verifies inside wasm.
+const (
+	rangeProofHex = "8026afd76427529f11bcc07e29a182e3122bab7595b61237dda31548ba96cc3e4a84148c615bb889cd99bab5519e2e7d815a2469b76b5e6bf56c1051264f9b5b0a75a84e179a21b7701de8b744612ecd96b5e73f2ad4ffda4dde5a0bf0fa5b4490bd0c7ec41b331068f3db152278f5147c876201e741a6817616ece7c58a6507a7736c1fc341bb3ab65cc6e7196855a42eed503f04b56b190fced87eab134400c9fdcb1eb43fc7fed2882b2f56b9eea62ce8a024bca4f23aa4d70afb323d4c0ad3a38d409012207bb35e174a112794008d2c3f8a0d7f4282ab718493096da30d5e432f7917f017e4ee80191990aed9a51d404700c1e441ef3c46e83129aa2f5b4a1047757dc4ce4c11d1ea429c7a95dd95bc13f7c9fd5b4c64c5aa97040948142a72e57ef4658bf2894029fc69dcd893fe5bf72d90aced60e2b4608b0bfa6a06f26414c843a86df58d95f92c1904565898262d1170ad70252445bd883ec208415ef350cb0515a602d37cbb668d78e6f6211fa4caf338513c5e551f3a36b33214c89e9681301b830da28be02204d062ca19b2edacd56fa5ce4c7e1d0a9f1fd85f7049fe27dffc601b41f35dce8b0f61b3c92a8f51ab40299e6bf452c81d95ee1880dede9a6da64b3237451715c8da5970296d3b34b0c9b585b355f31e2b71c46cd49fe004d2ec5371b3029ee2d6d0881d90d73ac81b1d16a82a74f46e36b14e33a6abaf35b81fdccbe00031d8c5918974f53d35973cd7077b839c2dbfcead70236581065006dbb5f1e1541fa6226e172e0e9471a7a0a1ed5aa627d26e9aac140f0b2ddee38a4502fe9f6327e81fdb849cd7c7698e9add48aecab22f512b56fd0b"
+	commitmentHex = "5e50cca6bdd5d8c04e1a2848d74d885647d93b883cb4f182fbb5e3bdbf00506c"
+	transcript    = "doctest example"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestVerifyRangeProof(t *testing.T) {
+	proof := mustDecodeHex(t, rangeProofHex)
+	commitment := mustDecodeHex(t, commitmentHex)
+
+	require.NoError(t, verifier.VerifyRangeProof(proof, [][]byte{commitment}, 32, transcript))
+}
+
+func TestVerifyRangeProofRejects(t *testing.T) {
+	proof := mustDecodeHex(t, rangeProofHex)
+	commitment := mustDecodeHex(t, commitmentHex)
+
+	// the Ristretto basepoint is a valid point committing to another value
+	otherCommitment := mustDecodeHex(t, "e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76")
+
+	tamperedTx := append([]byte(nil), proof...)
+	tamperedTx[128] ^= 0x01
+
+	tamperedL := append([]byte(nil), proof...)
+	copy(tamperedL[224:256], otherCommitment)
+
+	testCases := []struct {
+		name        string
+		proof       []byte
+		commitments [][]byte
+		bits        int
+		label       string
+		expErr      error
+	}{
+		{"wrong transcript", proof, [][]byte{commitment}, 32, "other", verifier.ErrBulletproofFailed},
+		{"wrong commitment", proof, [][]byte{otherCommitment}, 32, transcript, verifier.ErrBulletproofFailed},
+		{"tampered t_x", tamperedTx, [][]byte{commitment}, 32, transcript, verifier.ErrBulletproofFailed},
+		{"tampered inner product proof", tamperedL, [][]byte{commitment}, 32, transcript, verifier.ErrBulletproofFailed},
+		{"bit size mismatch", proof, [][]byte{commitment}, 16, transcript, verifier.ErrInvalidBulletproof},
+		{"unsupported bit size", proof, [][]byte{commitment}, 31, transcript, verifier.ErrInvalidBulletproof},
+		{"no commitments", proof, nil, 32, transcript, verifier.ErrInvalidBulletproof},
+		{"commitments not a power of two", proof, [][]byte{commitment, commitment, commitment}, 32, transcript, verifier.ErrInvalidBulletproof},
+		{"invalid commitment encoding", proof, [][]byte{make([]byte, 31)}, 32, transcript, verifier.ErrInvalidBulletproof},
+		{"truncated proof", proof[:len(proof)-32], [][]byte{commitment}, 32, transcript, verifier.ErrInvalidBulletproof},
+		{"empty proof", nil, [][]byte{commitment}, 32, transcript, verifier.ErrInvalidBulletproof},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifier.VerifyRangeProof(tc.proof, tc.commitments, tc.bits, tc.label)
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
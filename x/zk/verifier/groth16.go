@@ -0,0 +1,169 @@
+package verifier
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// Groth16 proofs and verifying keys use the uncompressed big-endian encoding
+// of the Ethereum BN254 precompiles (EIP-196/197), which is what snarkjs,
+// circom and gnark export for on-chain verifiers:
+//
+//	G1: x || y                          (64 bytes)
+//	G2: x.c1 || x.c0 || y.c1 || y.c0    (128 bytes)
+//
+// The point at infinity is encoded as all zeroes.
+
+const (
+	g1Size = 64
+	g2Size = 128
+
+	// Groth16ProofSize is the size of an encoded proof: A (G1), B (G2), C (G1).
+	Groth16ProofSize = 2*g1Size + g2Size
+	// FieldElementSize is the size of an encoded public input.
+	FieldElementSize = fr.Bytes
+
+	vkFixedSize = g1Size + 3*g2Size
+)
+
+var (
+	ErrInvalidGroth16 = errors.New("invalid groth16 input")
+	ErrGroth16Failed  = errors.New("groth16 verification failed")
+)
+
+// Groth16VerifyingKey is a decoded Groth16 verifying key.
+type Groth16VerifyingKey struct {
+	Alpha              bn254.G1Affine
+	Beta, Gamma, Delta bn254.G2Affine
+	// IC holds one point per public input plus the constant term.
+	IC []bn254.G1Affine
+}
+
+// NumPublicInputs returns the number of public inputs the key expects.
+func (vk *Groth16VerifyingKey) NumPublicInputs() int {
+	return len(vk.IC) - 1
+}
+
+// DecodeGroth16VerifyingKey parses alpha (G1), beta, gamma, delta (G2)
+// followed by the IC points (G1).
+func DecodeGroth16VerifyingKey(bz []byte) (*Groth16VerifyingKey, error) {
+	if len(bz) < vkFixedSize+g1Size || (len(bz)-vkFixedSize)%g1Size != 0 {
+		return nil, fmt.Errorf("%w: unexpected verifying key length %d", ErrInvalidGroth16, len(bz))
+	}
+
+	vk := &Groth16VerifyingKey{}
+	if err := decodeG1(&vk.Alpha, bz[0:64]); err != nil {
+		return nil, err
+	}
+	if err := decodeG2(&vk.Beta, bz[64:192]); err != nil {
+		return nil, err
+	}
+	if err := decodeG2(&vk.Gamma, bz[192:320]); err != nil {
+		return nil, err
+	}
+	if err := decodeG2(&vk.Delta, bz[320:448]); err != nil {
+		return nil, err
+	}
+
+	ic := bz[vkFixedSize:]
+	vk.IC = make([]bn254.G1Affine, len(ic)/g1Size)
+	for i := range vk.IC {
+		if err := decodeG1(&vk.IC[i], ic[i*g1Size:(i+1)*g1Size]); err != nil {
+			return nil, err
+		}
+	}
+
+	return vk, nil
+}
+
+// VerifyGroth16 verifies a Groth16 proof over BN254 against a verifying key
+// and the big-endian encoded public inputs.
+func VerifyGroth16(vkBz, proofBz []byte, publicInputs [][]byte) error {
+	vk, err := DecodeGroth16VerifyingKey(vkBz)
+	if err != nil {
+		return err
+	}
+	if len(publicInputs) != vk.NumPublicInputs() {
+		return fmt.Errorf("%w: expected %d public inputs, got %d", ErrInvalidGroth16, vk.NumPublicInputs(), len(publicInputs))
+	}
+	if len(proofBz) != Groth16ProofSize {
+		return fmt.Errorf("%w: unexpected proof length %d", ErrInvalidGroth16, len(proofBz))
+	}
+
+	var a, c bn254.G1Affine
+	var b bn254.G2Affine
+	if err := decodeG1(&a, proofBz[0:64]); err != nil {
+		return err
+	}
+	if err := decodeG2(&b, proofBz[64:192]); err != nil {
+		return err
+	}
+	if err := decodeG1(&c, proofBz[192:256]); err != nil {
+		return err
+	}
+
+	// vk_x = IC_0 + sum_i input_i * IC_{i+1}
+	var vkX bn254.G1Jac
+	vkX.FromAffine(&vk.IC[0])
+	for i, input := range publicInputs {
+		if len(input) != FieldElementSize {
+			return fmt.Errorf("%w: public input %d must be %d bytes", ErrInvalidGroth16, i, FieldElementSize)
+		}
+		var e fr.Element
+		if err := e.SetBytesCanonical(input); err != nil {
+			return fmt.Errorf("%w: public input %d is not a canonical field element", ErrInvalidGroth16, i)
+		}
+		var term bn254.G1Affine
+		term.ScalarMultiplication(&vk.IC[i+1], e.BigInt(new(big.Int)))
+		vkX.AddMixed(&term)
+	}
+	var vkXAff bn254.G1Affine
+	vkXAff.FromJacobian(&vkX)
+
+	// e(A, B) = e(alpha, beta) * e(vk_x, gamma) * e(C, delta)
+	var negAlpha, negVkX, negC bn254.G1Affine
+	negAlpha.Neg(&vk.Alpha)
+	negVkX.Neg(&vkXAff)
+	negC.Neg(&c)
+
+	ok, err := bn254.PairingCheck(
+		[]bn254.G1Affine{a, negAlpha, negVkX, negC},
+		[]bn254.G2Affine{b, vk.Beta, vk.Gamma, vk.Delta},
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidGroth16, err)
+	}
+	if !ok {
+		return ErrGroth16Failed
+	}
+	return nil
+}
+
+// The two most significant bits of an encoding are flags in the gnark format.
+// Canonical uncompressed encodings never set them, so they are rejected to
+// keep a single valid encoding per point.
+const flagMask = 0b11 << 6
+
+func decodeG1(p *bn254.G1Affine, bz []byte) error {
+	if len(bz) != g1Size || bz[0]&flagMask != 0 {
+		return fmt.Errorf("%w: invalid G1 encoding", ErrInvalidGroth16)
+	}
+	if _, err := p.SetBytes(bz); err != nil {
+		return fmt.Errorf("%w: invalid G1 point: %s", ErrInvalidGroth16, err)
+	}
+	return nil
+}
+
+func decodeG2(p *bn254.G2Affine, bz []byte) error {
+	if len(bz) != g2Size || bz[0]&flagMask != 0 {
+		return fmt.Errorf("%w: invalid G2 encoding", ErrInvalidGroth16)
+	}
+	if _, err := p.SetBytes(bz); err != nil {
+		return fmt.Errorf("%w: invalid G2 point: %s", ErrInvalidGroth16, err)
+	}
+	return nil
+}
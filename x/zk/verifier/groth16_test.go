@@ -0,0 +1,140 @@
+package verifier_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hippocrat-dao/hippo-protocol/x/zk/verifier"
+)
+
+// groth16Fixture builds a verifying key and a proof satisfying the Groth16
+// verification equation from a known trapdoor. The verifier never sees the
+// circuit, so this is equivalent to a proof produced by a prover.
+func groth16Fixture(t *testing.T, inputs []uint64) (vk, proof []byte, publicInputs [][]byte) {
+	t.Helper()
+
+	scalar := func(v uint64) fr.Element {
+		var e fr.Element
+		e.SetUint64(v)
+		return e
+	}
+	toBig := func(e fr.Element) *big.Int { return e.BigInt(new(big.Int)) }
+
+	_, _, g1, g2 := bn254.Generators()
+	alpha, beta, gamma, delta := scalar(11), scalar(13), scalar(17), scalar(19)
+
+	var alphaG1 bn254.G1Affine
+	var betaG2, gammaG2, deltaG2 bn254.G2Affine
+	alphaG1.ScalarMultiplication(&g1, toBig(alpha))
+	betaG2.ScalarMultiplication(&g2, toBig(beta))
+	gammaG2.ScalarMultiplication(&g2, toBig(gamma))
+	deltaG2.ScalarMultiplication(&g2, toBig(delta))
+
+	vk = append(vk, marshalG1(alphaG1)...)
+	vk = append(vk, marshalG2(betaG2)...)
+	vk = append(vk, marshalG2(gammaG2)...)
+	vk = append(vk, marshalG2(deltaG2)...)
+
+	// l = ic_0 + sum_i x_i * ic_{i+1}
+	l := scalar(23)
+	var ic bn254.G1Affine
+	ic.ScalarMultiplication(&g1, toBig(l))
+	vk = append(vk, marshalG1(ic)...)
+	for i, x := range inputs {
+		icI := scalar(29 + uint64(i))
+		ic.ScalarMultiplication(&g1, toBig(icI))
+		vk = append(vk, marshalG1(ic)...)
+
+		xE := scalar(x)
+		var term fr.Element
+		term.Mul(&xE, &icI)
+		l.Add(&l, &term)
+
+		xBz := xE.Bytes()
+		publicInputs = append(publicInputs, xBz[:])
+	}
+
+	// a * b = alpha * beta + l * gamma + c * delta
+	a, b := scalar(31337), scalar(4242)
+	var ab, alphaBeta, lGamma, c, deltaInv fr.Element
+	ab.Mul(&a, &b)
+	alphaBeta.Mul(&alpha, &beta)
+	lGamma.Mul(&l, &gamma)
+	c.Sub(&ab, &alphaBeta).Sub(&c, &lGamma)
+	deltaInv.Inverse(&delta)
+	c.Mul(&c, &deltaInv)
+
+	var aG1, cG1 bn254.G1Affine
+	var bG2 bn254.G2Affine
+	aG1.ScalarMultiplication(&g1, toBig(a))
+	bG2.ScalarMultiplication(&g2, toBig(b))
+	cG1.ScalarMultiplication(&g1, toBig(c))
+
+	proof = append(proof, marshalG1(aG1)...)
+	proof = append(proof, marshalG2(bG2)...)
+	proof = append(proof, marshalG1(cG1)...)
+
+	return vk, proof, publicInputs
+}
+
+func marshalG1(p bn254.G1Affine) []byte {
+	bz := p.RawBytes()
+	return bz[:]
+}
+
+func marshalG2(p bn254.G2Affine) []byte {
+	bz := p.RawBytes()
+	return bz[:]
+}
+
+func TestVerifyGroth16(t *testing.T) {
+	vk, proof, inputs := groth16Fixture(t, []uint64{18, 1})
+	require.NoError(t, verifier.VerifyGroth16(vk, proof, inputs))
+
+	decoded, err := verifier.DecodeGroth16VerifyingKey(vk)
+	require.NoError(t, err)
+	require.Equal(t, 2, decoded.NumPublicInputs())
+}
+
+func TestVerifyGroth16Rejects(t *testing.T) {
+	vk, proof, inputs := groth16Fixture(t, []uint64{18, 1})
+	_, otherProof, _ := groth16Fixture(t, []uint64{17, 1})
+
+	var modulus [32]byte
+	fr.Modulus().FillBytes(modulus[:])
+
+	flagged := append([]byte(nil), proof...)
+	flagged[0] |= 0x80
+
+	notOnCurve := append([]byte(nil), proof...)
+	notOnCurve[63] ^= 0x01
+
+	testCases := []struct {
+		name   string
+		vk     []byte
+		proof  []byte
+		inputs [][]byte
+		expErr error
+	}{
+		{"wrong public input", vk, proof, [][]byte{inputs[1], inputs[1]}, verifier.ErrGroth16Failed},
+		{"proof for other inputs", vk, otherProof, inputs, verifier.ErrGroth16Failed},
+		{"missing public input", vk, proof, inputs[:1], verifier.ErrInvalidGroth16},
+		{"non canonical public input", vk, proof, [][]byte{modulus[:], inputs[1]}, verifier.ErrInvalidGroth16},
+		{"short public input", vk, proof, [][]byte{inputs[0][1:], inputs[1]}, verifier.ErrInvalidGroth16},
+		{"truncated proof", vk, proof[:len(proof)-1], inputs, verifier.ErrInvalidGroth16},
+		{"flag bits set", vk, flagged, inputs, verifier.ErrInvalidGroth16},
+		{"point not on curve", vk, notOnCurve, inputs, verifier.ErrInvalidGroth16},
+		{"truncated verifying key", vk[:len(vk)-1], proof, inputs, verifier.ErrInvalidGroth16},
+		{"verifying key without IC", vk[:448], proof, nil, verifier.ErrInvalidGroth16},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, verifier.VerifyGroth16(tc.vk, tc.proof, tc.inputs), tc.expErr)
+		})
+	}
+}